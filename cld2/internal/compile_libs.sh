@@ -39,6 +39,9 @@ g++ $CFLAGS $CPPFLAGS $CXXFLAGS -shared -fPIC \
   cld2_generated_distinctoctachrome.cc  cld_generated_score_quad_octa_2.cc  \
   -o libcld2.so $LDFLAGS -Wl,-soname=libcld2.so
 
+# The 0122 quad and delta octa tables of the full build are not vendored, so the full
+# library pairs the Chrome quad tables with the vendored 0527 octa tables, which add
+# languages such as Tatar, Bashkir, Turkmen or Uyghur.
 g++ $CFLAGS $CPPFLAGS $CXXFLAGS -shared -fPIC \
   cldutil.cc cldutil_shared.cc compact_lang_det.cc compact_lang_det_hint_code.cc \
   compact_lang_det_impl.cc  debug.cc fixunicodevalue.cc \
@@ -47,7 +50,7 @@ g++ $CFLAGS $CPPFLAGS $CXXFLAGS -shared -fPIC \
   tote.cc utf8statetable.cc  \
   cld_generated_cjk_uni_prop_80.cc cld2_generated_cjk_compatible.cc  \
   cld_generated_cjk_delta_bi_32.cc generated_distinct_bi_0.cc  \
-  cld2_generated_quad0122.cc cld2_generated_deltaocta0122.cc \
-  cld2_generated_distinctocta0122.cc  cld_generated_score_quad_octa_0122.cc  \
+  cld2_generated_quadchrome_2.cc cld2_generated_deltaocta0527.cc \
+  cld2_generated_distinctocta0527.cc  cld_generated_score_quad_octa_0122_2.cc  \
   -o libcld2_full.so $LDFLAGS -Wl,-soname=libcld2_full.so
 
@@ -0,0 +1,283 @@
+// Code generated by data/gen_codes.go; DO NOT EDIT.
+
+package main
+
+// CLD2LanguageTable holds every language CLD2 detects, by code, as in data/cld_codes.json.
+var CLD2LanguageTable = map[string]LanguageInfo{
+	"aa":      {Name: "Afar", ISO6392: "aar", ISO6393: "aar", BCP47: "aa", Script: "Latn"},
+	"ab":      {Name: "Abkhazian", ISO6392: "abk", ISO6393: "abk", BCP47: "ab", Script: "Cyrl"},
+	"af":      {Name: "Afrikaans", ISO6392: "afr", ISO6393: "afr", BCP47: "af", Script: "Latn"},
+	"ak":      {Name: "Akan", ISO6392: "aka", ISO6393: "aka", BCP47: "ak", Script: "Latn"},
+	"am":      {Name: "Amharic", ISO6392: "amh", ISO6393: "amh", BCP47: "am", Script: "Ethi"},
+	"ar":      {Name: "Arabic", ISO6392: "ara", ISO6393: "ara", BCP47: "ar", Script: "Arab"},
+	"as":      {Name: "Assamese", ISO6392: "asm", ISO6393: "asm", BCP47: "as", Script: "Beng"},
+	"ay":      {Name: "Aymara", ISO6392: "aym", ISO6393: "aym", BCP47: "ay", Script: "Latn"},
+	"az":      {Name: "Azerbaijani", ISO6392: "aze", ISO6393: "aze", BCP47: "az", Script: "Latn"},
+	"ba":      {Name: "Bashkir", ISO6392: "bak", ISO6393: "bak", BCP47: "ba", Script: "Cyrl"},
+	"be":      {Name: "Belarusian", ISO6392: "bel", ISO6393: "bel", BCP47: "be", Script: "Cyrl"},
+	"bg":      {Name: "Bulgarian", ISO6392: "bul", ISO6393: "bul", BCP47: "bg", Script: "Cyrl"},
+	"bh":      {Name: "Bihari", ISO6392: "bih", ISO6393: "", BCP47: "bh", Script: "Deva"},
+	"bi":      {Name: "Bislama", ISO6392: "bis", ISO6393: "bis", BCP47: "bi", Script: "Latn"},
+	"bn":      {Name: "Bengali", ISO6392: "ben", ISO6393: "ben", BCP47: "bn", Script: "Beng"},
+	"bo":      {Name: "Tibetan", ISO6392: "tib", ISO6393: "bod", BCP47: "bo", Script: "Tibt"},
+	"br":      {Name: "Breton", ISO6392: "bre", ISO6393: "bre", BCP47: "br", Script: "Latn"},
+	"bs":      {Name: "Bosnian", ISO6392: "bos", ISO6393: "bos", BCP47: "bs", Script: "Latn"},
+	"ca":      {Name: "Catalan", ISO6392: "cat", ISO6393: "cat", BCP47: "ca", Script: "Latn"},
+	"ceb":     {Name: "Cebuano", ISO6392: "ceb", ISO6393: "ceb", BCP47: "ceb", Script: "Latn"},
+	"chr":     {Name: "Cherokee", ISO6392: "chr", ISO6393: "chr", BCP47: "chr", Script: "Cher"},
+	"co":      {Name: "Corsican", ISO6392: "cos", ISO6393: "cos", BCP47: "co", Script: "Latn"},
+	"crs":     {Name: "Seselwa", ISO6392: "cpf", ISO6393: "crs", BCP47: "crs", Script: "Latn"},
+	"cs":      {Name: "Czech", ISO6392: "cze", ISO6393: "ces", BCP47: "cs", Script: "Latn"},
+	"cy":      {Name: "Welsh", ISO6392: "wel", ISO6393: "cym", BCP47: "cy", Script: "Latn"},
+	"da":      {Name: "Danish", ISO6392: "dan", ISO6393: "dan", BCP47: "da", Script: "Latn"},
+	"de":      {Name: "German", ISO6392: "ger", ISO6393: "deu", BCP47: "de", Script: "Latn"},
+	"dv":      {Name: "Dhivehi", ISO6392: "div", ISO6393: "div", BCP47: "dv", Script: "Thaa"},
+	"dz":      {Name: "Dzongkha", ISO6392: "dzo", ISO6393: "dzo", BCP47: "dz", Script: "Tibt"},
+	"ee":      {Name: "Ewe", ISO6392: "ewe", ISO6393: "ewe", BCP47: "ee", Script: "Latn"},
+	"el":      {Name: "Greek", ISO6392: "gre", ISO6393: "ell", BCP47: "el", Script: "Grek"},
+	"en":      {Name: "English", ISO6392: "eng", ISO6393: "eng", BCP47: "en", Script: "Latn"},
+	"eo":      {Name: "Esperanto", ISO6392: "epo", ISO6393: "epo", BCP47: "eo", Script: "Latn"},
+	"es":      {Name: "Spanish", ISO6392: "spa", ISO6393: "spa", BCP47: "es", Script: "Latn"},
+	"et":      {Name: "Estonian", ISO6392: "est", ISO6393: "est", BCP47: "et", Script: "Latn"},
+	"eu":      {Name: "Basque", ISO6392: "baq", ISO6393: "eus", BCP47: "eu", Script: "Latn"},
+	"fa":      {Name: "Persian", ISO6392: "per", ISO6393: "fas", BCP47: "fa", Script: "Arab"},
+	"fi":      {Name: "Finnish", ISO6392: "fin", ISO6393: "fin", BCP47: "fi", Script: "Latn"},
+	"fj":      {Name: "Fijian", ISO6392: "fij", ISO6393: "fij", BCP47: "fj", Script: "Latn"},
+	"fo":      {Name: "Faroese", ISO6392: "fao", ISO6393: "fao", BCP47: "fo", Script: "Latn"},
+	"fr":      {Name: "French", ISO6392: "fre", ISO6393: "fra", BCP47: "fr", Script: "Latn"},
+	"fy":      {Name: "Frisian", ISO6392: "fry", ISO6393: "fry", BCP47: "fy", Script: "Latn"},
+	"ga":      {Name: "Irish", ISO6392: "gle", ISO6393: "gle", BCP47: "ga", Script: "Latn"},
+	"gaa":     {Name: "Ga", ISO6392: "gaa", ISO6393: "gaa", BCP47: "gaa", Script: "Latn"},
+	"gd":      {Name: "Scots gaelic", ISO6392: "gla", ISO6393: "gla", BCP47: "gd", Script: "Latn"},
+	"gl":      {Name: "Galician", ISO6392: "glg", ISO6393: "glg", BCP47: "gl", Script: "Latn"},
+	"gn":      {Name: "Guarani", ISO6392: "grn", ISO6393: "grn", BCP47: "gn", Script: "Latn"},
+	"gu":      {Name: "Gujarati", ISO6392: "guj", ISO6393: "guj", BCP47: "gu", Script: "Gujr"},
+	"gv":      {Name: "Manx", ISO6392: "glv", ISO6393: "glv", BCP47: "gv", Script: "Latn"},
+	"ha":      {Name: "Hausa", ISO6392: "hau", ISO6393: "hau", BCP47: "ha", Script: "Latn"},
+	"haw":     {Name: "Hawaiian", ISO6392: "haw", ISO6393: "haw", BCP47: "haw", Script: "Latn"},
+	"hi":      {Name: "Hindi", ISO6392: "hin", ISO6393: "hin", BCP47: "hi", Script: "Deva"},
+	"hmn":     {Name: "Hmong", ISO6392: "hmn", ISO6393: "hmn", BCP47: "hmn", Script: "Latn"},
+	"hr":      {Name: "Croatian", ISO6392: "hrv", ISO6393: "hrv", BCP47: "hr", Script: "Latn"},
+	"ht":      {Name: "Haitian creole", ISO6392: "hat", ISO6393: "hat", BCP47: "ht", Script: "Latn"},
+	"hu":      {Name: "Hungarian", ISO6392: "hun", ISO6393: "hun", BCP47: "hu", Script: "Latn"},
+	"hy":      {Name: "Armenian", ISO6392: "arm", ISO6393: "hye", BCP47: "hy", Script: "Armn"},
+	"ia":      {Name: "Interlingua", ISO6392: "ina", ISO6393: "ina", BCP47: "ia", Script: "Latn"},
+	"id":      {Name: "Indonesian", ISO6392: "ind", ISO6393: "ind", BCP47: "id", Script: "Latn"},
+	"ie":      {Name: "Interlingue", ISO6392: "ile", ISO6393: "ile", BCP47: "ie", Script: "Latn"},
+	"ig":      {Name: "Igbo", ISO6392: "ibo", ISO6393: "ibo", BCP47: "ig", Script: "Latn"},
+	"ik":      {Name: "Inupiak", ISO6392: "ipk", ISO6393: "ipk", BCP47: "ik", Script: "Latn"},
+	"is":      {Name: "Icelandic", ISO6392: "ice", ISO6393: "isl", BCP47: "is", Script: "Latn"},
+	"it":      {Name: "Italian", ISO6392: "ita", ISO6393: "ita", BCP47: "it", Script: "Latn"},
+	"iu":      {Name: "Inuktitut", ISO6392: "iku", ISO6393: "iku", BCP47: "iu", Script: "Cans"},
+	"iw":      {Name: "Hebrew", ISO6392: "heb", ISO6393: "heb", BCP47: "he", Script: "Hebr"},
+	"ja":      {Name: "Japanese", ISO6392: "jpn", ISO6393: "jpn", BCP47: "ja", Script: "Hani"},
+	"jw":      {Name: "Javanese", ISO6392: "jav", ISO6393: "jav", BCP47: "jv", Script: "Latn"},
+	"ka":      {Name: "Georgian", ISO6392: "geo", ISO6393: "kat", BCP47: "ka", Script: "Geor"},
+	"kha":     {Name: "Khasi", ISO6392: "kha", ISO6393: "kha", BCP47: "kha", Script: "Latn"},
+	"kk":      {Name: "Kazakh", ISO6392: "kaz", ISO6393: "kaz", BCP47: "kk", Script: "Latn"},
+	"kl":      {Name: "Greenlandic", ISO6392: "kal", ISO6393: "kal", BCP47: "kl", Script: "Latn"},
+	"km":      {Name: "Khmer", ISO6392: "khm", ISO6393: "khm", BCP47: "km", Script: "Khmr"},
+	"kn":      {Name: "Kannada", ISO6392: "kan", ISO6393: "kan", BCP47: "kn", Script: "Knda"},
+	"ko":      {Name: "Korean", ISO6392: "kor", ISO6393: "kor", BCP47: "ko", Script: "Hani"},
+	"kri":     {Name: "Krio", ISO6392: "cpe", ISO6393: "kri", BCP47: "kri", Script: "Latn"},
+	"ks":      {Name: "Kashmiri", ISO6392: "kas", ISO6393: "kas", BCP47: "ks", Script: "Arab"},
+	"ku":      {Name: "Kurdish", ISO6392: "kur", ISO6393: "kur", BCP47: "ku", Script: "Latn"},
+	"ky":      {Name: "Kyrgyz", ISO6392: "kir", ISO6393: "kir", BCP47: "ky", Script: "Cyrl"},
+	"la":      {Name: "Latin", ISO6392: "lat", ISO6393: "lat", BCP47: "la", Script: "Latn"},
+	"lb":      {Name: "Luxembourgish", ISO6392: "ltz", ISO6393: "ltz", BCP47: "lb", Script: "Latn"},
+	"lg":      {Name: "Ganda", ISO6392: "lug", ISO6393: "lug", BCP47: "lg", Script: "Latn"},
+	"lif":     {Name: "Limbu", ISO6392: "sit", ISO6393: "lif", BCP47: "lif", Script: "Limb"},
+	"ln":      {Name: "Lingala", ISO6392: "lin", ISO6393: "lin", BCP47: "ln", Script: "Latn"},
+	"lo":      {Name: "Laothian", ISO6392: "lao", ISO6393: "lao", BCP47: "lo", Script: "Laoo"},
+	"loz":     {Name: "Lozi", ISO6392: "loz", ISO6393: "loz", BCP47: "loz", Script: "Latn"},
+	"lt":      {Name: "Lithuanian", ISO6392: "lit", ISO6393: "lit", BCP47: "lt", Script: "Latn"},
+	"lua":     {Name: "Luba lulua", ISO6392: "lua", ISO6393: "lua", BCP47: "lua", Script: "Latn"},
+	"luo":     {Name: "Luo kenya and tanzania", ISO6392: "luo", ISO6393: "luo", BCP47: "luo", Script: "Latn"},
+	"lv":      {Name: "Latvian", ISO6392: "lav", ISO6393: "lav", BCP47: "lv", Script: "Latn"},
+	"mfe":     {Name: "Mauritian creole", ISO6392: "cpf", ISO6393: "mfe", BCP47: "mfe", Script: "Latn"},
+	"mg":      {Name: "Malagasy", ISO6392: "mlg", ISO6393: "mlg", BCP47: "mg", Script: "Latn"},
+	"mi":      {Name: "Maori", ISO6392: "mao", ISO6393: "mri", BCP47: "mi", Script: "Latn"},
+	"mk":      {Name: "Macedonian", ISO6392: "mac", ISO6393: "mkd", BCP47: "mk", Script: "Cyrl"},
+	"ml":      {Name: "Malayalam", ISO6392: "mal", ISO6393: "mal", BCP47: "ml", Script: "Mlym"},
+	"mn":      {Name: "Mongolian", ISO6392: "mon", ISO6393: "mon", BCP47: "mn", Script: "Cyrl"},
+	"mr":      {Name: "Marathi", ISO6392: "mar", ISO6393: "mar", BCP47: "mr", Script: "Deva"},
+	"ms":      {Name: "Malay", ISO6392: "may", ISO6393: "msa", BCP47: "ms", Script: "Latn"},
+	"mt":      {Name: "Maltese", ISO6392: "mlt", ISO6393: "mlt", BCP47: "mt", Script: "Latn"},
+	"my":      {Name: "Burmese", ISO6392: "bur", ISO6393: "mya", BCP47: "my", Script: "Latn"},
+	"na":      {Name: "Nauru", ISO6392: "nau", ISO6393: "nau", BCP47: "na", Script: "Latn"},
+	"ne":      {Name: "Nepali", ISO6392: "nep", ISO6393: "nep", BCP47: "ne", Script: "Deva"},
+	"new":     {Name: "Newari", ISO6392: "new", ISO6393: "new", BCP47: "new", Script: "Deva"},
+	"nl":      {Name: "Dutch", ISO6392: "dut", ISO6393: "nld", BCP47: "nl", Script: "Latn"},
+	"nn":      {Name: "Norwegian Nynorsk", ISO6392: "nno", ISO6393: "nno", BCP47: "nn", Script: "Latn"},
+	"no":      {Name: "Norwegian", ISO6392: "nor", ISO6393: "nor", BCP47: "no", Script: "Latn"},
+	"nr":      {Name: "Ndebele", ISO6392: "nbl", ISO6393: "nbl", BCP47: "nr", Script: "Latn"},
+	"nso":     {Name: "Pedi", ISO6392: "nso", ISO6393: "nso", BCP47: "nso", Script: "Latn"},
+	"ny":      {Name: "Nyanja", ISO6392: "nya", ISO6393: "nya", BCP47: "ny", Script: "Latn"},
+	"oc":      {Name: "Occitan", ISO6392: "oci", ISO6393: "oci", BCP47: "oc", Script: "Latn"},
+	"om":      {Name: "Oromo", ISO6392: "orm", ISO6393: "orm", BCP47: "om", Script: "Latn"},
+	"or":      {Name: "Oriya", ISO6392: "ori", ISO6393: "ori", BCP47: "or", Script: "Orya"},
+	"os":      {Name: "Ossetian", ISO6392: "oss", ISO6393: "oss", BCP47: "os", Script: "Cyrl"},
+	"pa":      {Name: "Punjabi", ISO6392: "pan", ISO6393: "pan", BCP47: "pa", Script: "Guru"},
+	"pam":     {Name: "Pampanga", ISO6392: "pam", ISO6393: "pam", BCP47: "pam", Script: "Latn"},
+	"pl":      {Name: "Polish", ISO6392: "pol", ISO6393: "pol", BCP47: "pl", Script: "Latn"},
+	"ps":      {Name: "Pashto", ISO6392: "pus", ISO6393: "pus", BCP47: "ps", Script: "Arab"},
+	"pt":      {Name: "Portuguese", ISO6392: "por", ISO6393: "por", BCP47: "pt", Script: "Latn"},
+	"qu":      {Name: "Quechua", ISO6392: "que", ISO6393: "que", BCP47: "qu", Script: "Latn"},
+	"raj":     {Name: "Rajasthani", ISO6392: "raj", ISO6393: "raj", BCP47: "raj", Script: "Deva"},
+	"rm":      {Name: "Rhaeto romance", ISO6392: "roh", ISO6393: "roh", BCP47: "rm", Script: "Latn"},
+	"rn":      {Name: "Rundi", ISO6392: "run", ISO6393: "run", BCP47: "rn", Script: "Latn"},
+	"ro":      {Name: "Romanian", ISO6392: "rum", ISO6393: "ron", BCP47: "ro", Script: "Latn"},
+	"ru":      {Name: "Russian", ISO6392: "rus", ISO6393: "rus", BCP47: "ru", Script: "Cyrl"},
+	"rw":      {Name: "Kinyarwanda", ISO6392: "kin", ISO6393: "kin", BCP47: "rw", Script: "Latn"},
+	"sa":      {Name: "Sanskrit", ISO6392: "san", ISO6393: "san", BCP47: "sa", Script: "Latn"},
+	"sco":     {Name: "Scots", ISO6392: "sco", ISO6393: "sco", BCP47: "sco", Script: "Latn"},
+	"sd":      {Name: "Sindhi", ISO6392: "snd", ISO6393: "snd", BCP47: "sd", Script: "Arab"},
+	"sg":      {Name: "Sango", ISO6392: "sag", ISO6393: "sag", BCP47: "sg", Script: "Latn"},
+	"si":      {Name: "Sinhalese", ISO6392: "sin", ISO6393: "sin", BCP47: "si", Script: "Sinh"},
+	"sk":      {Name: "Slovak", ISO6392: "slo", ISO6393: "slk", BCP47: "sk", Script: "Latn"},
+	"sl":      {Name: "Slovenian", ISO6392: "slv", ISO6393: "slv", BCP47: "sl", Script: "Latn"},
+	"sm":      {Name: "Samoan", ISO6392: "smo", ISO6393: "smo", BCP47: "sm", Script: "Latn"},
+	"sn":      {Name: "Shona", ISO6392: "sna", ISO6393: "sna", BCP47: "sn", Script: "Latn"},
+	"so":      {Name: "Somali", ISO6392: "som", ISO6393: "som", BCP47: "so", Script: "Latn"},
+	"sq":      {Name: "Albanian", ISO6392: "alb", ISO6393: "sqi", BCP47: "sq", Script: "Latn"},
+	"sr":      {Name: "Serbian", ISO6392: "srp", ISO6393: "srp", BCP47: "sr", Script: "Latn"},
+	"sr-ME":   {Name: "Montenegrin", ISO6392: "cnr", ISO6393: "cnr", BCP47: "sr-ME", Script: "Latn"},
+	"ss":      {Name: "Siswant", ISO6392: "ssw", ISO6393: "ssw", BCP47: "ss", Script: "Latn"},
+	"st":      {Name: "Sesotho", ISO6392: "sot", ISO6393: "sot", BCP47: "st", Script: "Latn"},
+	"su":      {Name: "Sundanese", ISO6392: "sun", ISO6393: "sun", BCP47: "su", Script: "Latn"},
+	"sv":      {Name: "Swedish", ISO6392: "swe", ISO6393: "swe", BCP47: "sv", Script: "Latn"},
+	"sw":      {Name: "Swahili", ISO6392: "swa", ISO6393: "swa", BCP47: "sw", Script: "Latn"},
+	"syr":     {Name: "Syriac", ISO6392: "syr", ISO6393: "syr", BCP47: "syr", Script: "Syrc"},
+	"ta":      {Name: "Tamil", ISO6392: "tam", ISO6393: "tam", BCP47: "ta", Script: "Taml"},
+	"te":      {Name: "Telugu", ISO6392: "tel", ISO6393: "tel", BCP47: "te", Script: "Telu"},
+	"tg":      {Name: "Tajik", ISO6392: "tgk", ISO6393: "tgk", BCP47: "tg", Script: "Cyrl"},
+	"th":      {Name: "Thai", ISO6392: "tha", ISO6393: "tha", BCP47: "th", Script: "Thai"},
+	"ti":      {Name: "Tigrinya", ISO6392: "tir", ISO6393: "tir", BCP47: "ti", Script: "Ethi"},
+	"tk":      {Name: "Turkmen", ISO6392: "tuk", ISO6393: "tuk", BCP47: "tk", Script: "Latn"},
+	"tl":      {Name: "Tagalog", ISO6392: "tgl", ISO6393: "tgl", BCP47: "tl", Script: "Latn"},
+	"tlh":     {Name: "Klingon", ISO6392: "tlh", ISO6393: "tlh", BCP47: "tlh", Script: "Latn"},
+	"tn":      {Name: "Tswana", ISO6392: "tsn", ISO6393: "tsn", BCP47: "tn", Script: "Latn"},
+	"to":      {Name: "Tonga", ISO6392: "ton", ISO6393: "ton", BCP47: "to", Script: "Latn"},
+	"tr":      {Name: "Turkish", ISO6392: "tur", ISO6393: "tur", BCP47: "tr", Script: "Latn"},
+	"ts":      {Name: "Tsonga", ISO6392: "tso", ISO6393: "tso", BCP47: "ts", Script: "Latn"},
+	"tt":      {Name: "Tatar", ISO6392: "tat", ISO6393: "tat", BCP47: "tt", Script: "Latn"},
+	"tum":     {Name: "Tumbuka", ISO6392: "tum", ISO6393: "tum", BCP47: "tum", Script: "Latn"},
+	"tw":      {Name: "Twi", ISO6392: "twi", ISO6393: "twi", BCP47: "tw", Script: "Latn"},
+	"ug":      {Name: "Uighur", ISO6392: "uig", ISO6393: "uig", BCP47: "ug", Script: "Latn"},
+	"uk":      {Name: "Ukrainian", ISO6392: "ukr", ISO6393: "ukr", BCP47: "uk", Script: "Cyrl"},
+	"ur":      {Name: "Urdu", ISO6392: "urd", ISO6393: "urd", BCP47: "ur", Script: "Arab"},
+	"uz":      {Name: "Uzbek", ISO6392: "uzb", ISO6393: "uzb", BCP47: "uz", Script: "Latn"},
+	"ve":      {Name: "Venda", ISO6392: "ven", ISO6393: "ven", BCP47: "ve", Script: "Latn"},
+	"vi":      {Name: "Vietnamese", ISO6392: "vie", ISO6393: "vie", BCP47: "vi", Script: "Latn"},
+	"vo":      {Name: "Volapuk", ISO6392: "vol", ISO6393: "vol", BCP47: "vo", Script: "Latn"},
+	"war":     {Name: "Waray philippines", ISO6392: "war", ISO6393: "war", BCP47: "war", Script: "Latn"},
+	"wo":      {Name: "Wolof", ISO6392: "wol", ISO6393: "wol", BCP47: "wo", Script: "Latn"},
+	"xh":      {Name: "Xhosa", ISO6392: "xho", ISO6393: "xho", BCP47: "xh", Script: "Latn"},
+	"xx-Arab": {Name: "Unknown (Arabic script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Arab", Script: "Arab"},
+	"xx-Armi": {Name: "Unknown (Imperial Aramaic script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Armi", Script: "Armi"},
+	"xx-Armn": {Name: "Unknown (Armenian script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Armn", Script: "Armn"},
+	"xx-Avst": {Name: "Unknown (Avestan script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Avst", Script: "Avst"},
+	"xx-Bali": {Name: "Unknown (Balinese script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Bali", Script: "Bali"},
+	"xx-Bamu": {Name: "Unknown (Bamum script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Bamu", Script: "Bamu"},
+	"xx-Batk": {Name: "Unknown (Batak script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Batk", Script: "Batk"},
+	"xx-Beng": {Name: "Unknown (Bengali script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Beng", Script: "Beng"},
+	"xx-Bopo": {Name: "Unknown (Bopomofo script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Bopo", Script: "Bopo"},
+	"xx-Brah": {Name: "Unknown (Brahmi script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Brah", Script: "Brah"},
+	"xx-Brai": {Name: "Unknown (Braille script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Brai", Script: "Brai"},
+	"xx-Bugi": {Name: "Unknown (Buginese script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Bugi", Script: "Bugi"},
+	"xx-Buhd": {Name: "Unknown (Buhid script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Buhd", Script: "Buhd"},
+	"xx-Cakm": {Name: "Unknown (Chakma script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Cakm", Script: "Cakm"},
+	"xx-Cans": {Name: "Unknown (Canadian Aboriginal script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Cans", Script: "Cans"},
+	"xx-Cari": {Name: "Unknown (Carian script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Cari", Script: "Cari"},
+	"xx-Cham": {Name: "Unknown (Cham script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Cham", Script: "Cham"},
+	"xx-Cher": {Name: "Unknown (Cherokee script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Cher", Script: "Cher"},
+	"xx-Copt": {Name: "Unknown (Coptic script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Copt", Script: "Copt"},
+	"xx-Cprt": {Name: "Unknown (Cypriot script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Cprt", Script: "Cprt"},
+	"xx-Cyrl": {Name: "Unknown (Cyrillic script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Cyrl", Script: "Cyrl"},
+	"xx-Deva": {Name: "Unknown (Devanagari script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Deva", Script: "Deva"},
+	"xx-Dsrt": {Name: "Unknown (Deseret script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Dsrt", Script: "Dsrt"},
+	"xx-Egyp": {Name: "Unknown (Egyptian Hieroglyphs script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Egyp", Script: "Egyp"},
+	"xx-Ethi": {Name: "Unknown (Ethiopic script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Ethi", Script: "Ethi"},
+	"xx-Geor": {Name: "Unknown (Georgian script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Geor", Script: "Geor"},
+	"xx-Glag": {Name: "Unknown (Glagolitic script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Glag", Script: "Glag"},
+	"xx-Goth": {Name: "Unknown (Gothic script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Goth", Script: "Goth"},
+	"xx-Grek": {Name: "Unknown (Greek script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Grek", Script: "Grek"},
+	"xx-Gujr": {Name: "Unknown (Gujarati script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Gujr", Script: "Gujr"},
+	"xx-Guru": {Name: "Unknown (Gurmukhi script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Guru", Script: "Guru"},
+	"xx-Hani": {Name: "Unknown (Han script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Hani", Script: "Hani"},
+	"xx-Hano": {Name: "Unknown (Hanunoo script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Hano", Script: "Hano"},
+	"xx-Hebr": {Name: "Unknown (Hebrew script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Hebr", Script: "Hebr"},
+	"xx-Ital": {Name: "Unknown (Old Italic script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Ital", Script: "Ital"},
+	"xx-Java": {Name: "Unknown (Javanese script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Java", Script: "Java"},
+	"xx-Kali": {Name: "Unknown (Kayah Li script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Kali", Script: "Kali"},
+	"xx-Khar": {Name: "Unknown (Kharoshthi script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Khar", Script: "Khar"},
+	"xx-Khmr": {Name: "Unknown (Khmer script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Khmr", Script: "Khmr"},
+	"xx-Knda": {Name: "Unknown (Kannada script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Knda", Script: "Knda"},
+	"xx-Kthi": {Name: "Unknown (Kaithi script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Kthi", Script: "Kthi"},
+	"xx-Lana": {Name: "Unknown (Tai Tham script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Lana", Script: "Lana"},
+	"xx-Laoo": {Name: "Unknown (Lao script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Laoo", Script: "Laoo"},
+	"xx-Latn": {Name: "Unknown (Latin script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Latn", Script: "Latn"},
+	"xx-Lepc": {Name: "Unknown (Lepcha script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Lepc", Script: "Lepc"},
+	"xx-Limb": {Name: "Unknown (Limbu script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Limb", Script: "Limb"},
+	"xx-Linb": {Name: "Unknown (Linear B script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Linb", Script: "Linb"},
+	"xx-Lisu": {Name: "Unknown (Lisu script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Lisu", Script: "Lisu"},
+	"xx-Lyci": {Name: "Unknown (Lycian script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Lyci", Script: "Lyci"},
+	"xx-Lydi": {Name: "Unknown (Lydian script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Lydi", Script: "Lydi"},
+	"xx-Mand": {Name: "Unknown (Mandaic script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Mand", Script: "Mand"},
+	"xx-Merc": {Name: "Unknown (Meroitic Cursive script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Merc", Script: "Merc"},
+	"xx-Mero": {Name: "Unknown (Meroitic Hieroglyphs script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Mero", Script: "Mero"},
+	"xx-Mlym": {Name: "Unknown (Malayalam script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Mlym", Script: "Mlym"},
+	"xx-Mong": {Name: "Unknown (Mongolian script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Mong", Script: "Mong"},
+	"xx-Mtei": {Name: "Unknown (Meetei Mayek script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Mtei", Script: "Mtei"},
+	"xx-Mymr": {Name: "Unknown (Myanmar script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Mymr", Script: "Mymr"},
+	"xx-Nkoo": {Name: "Unknown (Nko script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Nkoo", Script: "Nkoo"},
+	"xx-Ogam": {Name: "Unknown (Ogham script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Ogam", Script: "Ogam"},
+	"xx-Olck": {Name: "Unknown (Ol Chiki script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Olck", Script: "Olck"},
+	"xx-Orkh": {Name: "Unknown (Old Turkic script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Orkh", Script: "Orkh"},
+	"xx-Orya": {Name: "Unknown (Oriya script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Orya", Script: "Orya"},
+	"xx-Osma": {Name: "Unknown (Osmanya script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Osma", Script: "Osma"},
+	"xx-Phag": {Name: "Unknown (Phags Pa script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Phag", Script: "Phag"},
+	"xx-Phli": {Name: "Unknown (Inscriptional Pahlavi script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Phli", Script: "Phli"},
+	"xx-Phnx": {Name: "Unknown (Phoenician script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Phnx", Script: "Phnx"},
+	"xx-Plrd": {Name: "Unknown (Miao script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Plrd", Script: "Plrd"},
+	"xx-Prti": {Name: "Unknown (Inscriptional Parthian script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Prti", Script: "Prti"},
+	"xx-Rjng": {Name: "Unknown (Rejang script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Rjng", Script: "Rjng"},
+	"xx-Runr": {Name: "Unknown (Runic script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Runr", Script: "Runr"},
+	"xx-Samr": {Name: "Unknown (Samaritan script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Samr", Script: "Samr"},
+	"xx-Sarb": {Name: "Unknown (Old South Arabian script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Sarb", Script: "Sarb"},
+	"xx-Saur": {Name: "Unknown (Saurashtra script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Saur", Script: "Saur"},
+	"xx-Shaw": {Name: "Unknown (Shavian script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Shaw", Script: "Shaw"},
+	"xx-Shrd": {Name: "Unknown (Sharada script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Shrd", Script: "Shrd"},
+	"xx-Sinh": {Name: "Unknown (Sinhala script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Sinh", Script: "Sinh"},
+	"xx-Sora": {Name: "Unknown (Sora Sompeng script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Sora", Script: "Sora"},
+	"xx-Sund": {Name: "Unknown (Sundanese script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Sund", Script: "Sund"},
+	"xx-Sylo": {Name: "Unknown (Syloti Nagri script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Sylo", Script: "Sylo"},
+	"xx-Syrc": {Name: "Unknown (Syriac script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Syrc", Script: "Syrc"},
+	"xx-Tagb": {Name: "Unknown (Tagbanwa script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Tagb", Script: "Tagb"},
+	"xx-Takr": {Name: "Unknown (Takri script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Takr", Script: "Takr"},
+	"xx-Tale": {Name: "Unknown (Tai Le script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Tale", Script: "Tale"},
+	"xx-Talu": {Name: "Unknown (New Tai Lue script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Talu", Script: "Talu"},
+	"xx-Taml": {Name: "Unknown (Tamil script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Taml", Script: "Taml"},
+	"xx-Tavt": {Name: "Unknown (Tai Viet script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Tavt", Script: "Tavt"},
+	"xx-Telu": {Name: "Unknown (Telugu script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Telu", Script: "Telu"},
+	"xx-Tfng": {Name: "Unknown (Tifinagh script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Tfng", Script: "Tfng"},
+	"xx-Tglg": {Name: "Unknown (Tagalog script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Tglg", Script: "Tglg"},
+	"xx-Thaa": {Name: "Unknown (Thaana script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Thaa", Script: "Thaa"},
+	"xx-Thai": {Name: "Unknown (Thai script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Thai", Script: "Thai"},
+	"xx-Tibt": {Name: "Unknown (Tibetan script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Tibt", Script: "Tibt"},
+	"xx-Ugar": {Name: "Unknown (Ugaritic script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Ugar", Script: "Ugar"},
+	"xx-Vaii": {Name: "Unknown (Vai script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Vaii", Script: "Vaii"},
+	"xx-Xpeo": {Name: "Unknown (Old Persian script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Xpeo", Script: "Xpeo"},
+	"xx-Xsux": {Name: "Unknown (Cuneiform script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Xsux", Script: "Xsux"},
+	"xx-Yiii": {Name: "Unknown (Yi script)", ISO6392: "und", ISO6393: "und", BCP47: "und-Yiii", Script: "Yiii"},
+	"yi":      {Name: "Yiddish", ISO6392: "yid", ISO6393: "yid", BCP47: "yi", Script: "Hebr"},
+	"yo":      {Name: "Yoruba", ISO6392: "yor", ISO6393: "yor", BCP47: "yo", Script: "Latn"},
+	"za":      {Name: "Zhuang", ISO6392: "zha", ISO6393: "zha", BCP47: "za", Script: "Latn"},
+	"zh":      {Name: "Chinese", ISO6392: "chi", ISO6393: "zho", BCP47: "zh", Script: "Hani"},
+	"zh-Hant": {Name: "Chinese (Traditional)", ISO6392: "chi", ISO6393: "zho", BCP47: "zh-Hant", Script: "Hani"},
+	"zu":      {Name: "Zulu", ISO6392: "zul", ISO6393: "zul", BCP47: "zu", Script: "Latn"},
+	"zzb":     {Name: "Bork bork bork", ISO6392: "und", ISO6393: "und", BCP47: "und", Script: "Latn"},
+	"zze":     {Name: "Elmer fudd", ISO6392: "und", ISO6393: "und", BCP47: "und", Script: "Latn"},
+	"zzh":     {Name: "Hacker", ISO6392: "und", ISO6393: "und", BCP47: "und", Script: "Latn"},
+	"zzp":     {Name: "Pig latin", ISO6392: "und", ISO6393: "und", BCP47: "und", Script: "Latn"},
+}
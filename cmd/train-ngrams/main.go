@@ -0,0 +1,259 @@
+// train-ngrams trains the n-gram profiles of the pure-Go engine from a directory of
+// labeled texts, and saves them as a model the service loads with NGRAM_MODEL_FILE.
+//
+// Texts are labeled with the code of their language, as the service reports it, in
+// either of two layouts, which can be mixed:
+//
+//	corpus/ru.txt    one text per line
+//	corpus/ru/*      one text per file, e.g. a post
+//
+// Part of the texts of each language is held out of training to report how accurately
+// the model detects them, preprocessed as the service does by default. The saved model
+// is then trained on every text.
+//
+// Usage:
+//
+//	train-ngrams -corpus corpus/ -out ngram_model.json.gz
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/GolosChain/language-detector/detector" // CLD2 language detection
+)
+
+const DEFAULT_MAX_NGRAMS = 2000 // N-grams kept per language, as in data/gen_ngrams.go
+
+var (
+	corpusDir = flag.String("corpus", "", "Directory of labeled texts, required")
+	outPath   = flag.String("out", "ngram_model.json", "Path to save the model to, gzipped if it ends with .gz")
+	maxNgrams = flag.Int("max-ngrams", DEFAULT_MAX_NGRAMS, "N-grams kept per language, all if not positive")
+	holdout   = flag.Float64("holdout", 0.1, "Share of each language's texts held out to measure accuracy, none if 0")
+)
+
+func main() {
+	flag.Parse()
+	if *corpusDir == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *holdout < 0 || *holdout >= 1 {
+		log.Fatal("holdout must be at least 0 and less than 1")
+	}
+
+	corpus, err := readCorpus(*corpusDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(corpus) == 0 {
+		log.Fatal("No labeled texts in " + *corpusDir)
+	}
+
+	if *holdout > 0 {
+		fmt.Println("Accuracy on held out texts, preprocessed as the service does by default:")
+		printAccuracies(os.Stdout, evaluate(corpus, *holdout, *maxNgrams))
+	}
+
+	model := train(corpus, *maxNgrams, nil)
+	if err = detector.SaveNgramModel(model, *outPath); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("Total languages:", len(model.Profiles))
+	fmt.Println("Result saved in " + *outPath)
+}
+
+// readCorpus returns the texts of each language in dir, by language code.
+func readCorpus(dir string) (map[string][]string, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	corpus := make(map[string][]string)
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		if entry.IsDir() {
+			texts, err := readFiles(path)
+			if err != nil {
+				return nil, err
+			}
+			corpus[entry.Name()] = append(corpus[entry.Name()], texts...)
+		} else if filepath.Ext(entry.Name()) == ".txt" {
+			texts, err := readLines(path)
+			if err != nil {
+				return nil, err
+			}
+			code := strings.TrimSuffix(entry.Name(), ".txt")
+			corpus[code] = append(corpus[code], texts...)
+		}
+	}
+	for code, texts := range corpus {
+		if len(texts) == 0 {
+			delete(corpus, code)
+		}
+	}
+	return corpus, nil
+}
+
+// readLines returns the non-empty lines of the file at path.
+func readLines(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 1048576)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, scanner.Err()
+}
+
+// readFiles returns the content of the non-empty files in dir, sorted by name.
+func readFiles(dir string) ([]string, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var texts []string
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		text, err := ioutil.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if trimmed := strings.TrimSpace(string(text)); trimmed != "" {
+			texts = append(texts, trimmed)
+		}
+	}
+	return texts, nil
+}
+
+// isHeldOut returns whether the i-th text of a language is held out of training when
+// holding out share of them, so that held out texts are spread evenly among the others.
+func isHeldOut(i int, share float64) bool {
+	return int(float64(i+1)*share) > int(float64(i)*share)
+}
+
+// train returns a model with the profiles of every language of corpus, keeping
+// maxNgrams n-grams each, trained on the texts skip does not return true for, or all of
+// them if skip is nil.
+func train(corpus map[string][]string, maxNgrams int, skip func(i int) bool) detector.NgramModel {
+	model := detector.NgramModel{Version: detector.NGRAM_MODEL_VERSION, Profiles: make(map[string]detector.NgramProfile)}
+	for code, texts := range corpus {
+		var training []string
+		for i, text := range texts {
+			if skip == nil || !skip(i) {
+				training = append(training, text)
+			}
+		}
+		profile := detector.TrainNgramProfile(training, maxNgrams)
+		if profile.Total > 0 {
+			model.Profiles[code] = profile
+		}
+	}
+	return model
+}
+
+// accuracy is how well a model detects the held out texts of a language.
+type accuracy struct {
+	Code        string
+	HeldOut     int    // Number of texts held out
+	Correct     int    // Number of them detected in Code
+	MistakenFor string // Language the others are most often detected in, "-" if none
+}
+
+// evaluate trains a model without share of the texts of each language of corpus, and
+// returns how accurately it detects them, by language code. Texts are detected as the
+// service does by default, preprocessed with detector.DEFAULT_PREPROCESS.
+func evaluate(corpus map[string][]string, share float64, maxNgrams int) []accuracy {
+	skip := func(i int) bool { return isHeldOut(i, share) }
+	languageDetector := detector.New()
+	languageDetector.Engine = detector.NewNgramEngine(train(corpus, maxNgrams, skip))
+
+	codes := make([]string, 0, len(corpus))
+	for code := range corpus {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	accuracies := make([]accuracy, 0, len(codes))
+	for _, code := range codes {
+		result := accuracy{Code: code}
+		mistakes := make(map[string]int)
+		for i, text := range corpus[code] {
+			if !skip(i) {
+				continue
+			}
+			result.HeldOut++
+			detected, err := languageDetector.Detect(context.Background(), text, detector.Options{})
+			if err != nil {
+				mistakes["invalid"]++
+			} else if detected.Code == code {
+				result.Correct++
+			} else {
+				mistakes[detected.Code]++
+			}
+		}
+		result.MistakenFor = mostFrequent(mistakes)
+		accuracies = append(accuracies, result)
+	}
+	return accuracies
+}
+
+// printAccuracies writes accuracies to w as a table, followed by their total.
+func printAccuracies(w io.Writer, accuracies []accuracy) {
+	table := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(table, "Language\tHeld out\tCorrect\tAccuracy\tMostly mistaken for")
+	total := accuracy{Code: "Total"}
+	for _, language := range accuracies {
+		total.HeldOut += language.HeldOut
+		total.Correct += language.Correct
+		fmt.Fprintf(table, "%s\t%d\t%d\t%s\t%s\n", language.Code, language.HeldOut, language.Correct, language.Percent(), language.MistakenFor)
+	}
+	fmt.Fprintf(table, "%s\t%d\t%d\t%s\t\n", total.Code, total.HeldOut, total.Correct, total.Percent())
+	table.Flush()
+}
+
+// Percent formats the share of held out texts detected correctly, or "-" if none were
+// held out.
+func (a accuracy) Percent() string {
+	if a.HeldOut == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", 100*float64(a.Correct)/float64(a.HeldOut))
+}
+
+// mostFrequent returns the code counts holds most, the first alphabetically among equals,
+// or "-" if counts is empty.
+func mostFrequent(counts map[string]int) string {
+	best := "-"
+	for code, count := range counts {
+		if best == "-" || count > counts[best] || (count == counts[best] && code < best) {
+			best = code
+		}
+	}
+	return best
+}
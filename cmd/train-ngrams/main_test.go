@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/GolosChain/language-detector/detector" // CLD2 language detection
+	"github.com/stretchr/testify/assert"               // Assertion package
+)
+
+// writeCorpus writes files, by path relative to dir, creating their directories.
+func writeCorpus(t *testing.T, dir string, files map[string]string) {
+	for path, content := range files {
+		path = filepath.Join(dir, path)
+		assert.Nil(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.Nil(t, ioutil.WriteFile(path, []byte(content), 0644))
+	}
+}
+
+func TestReadCorpus(t *testing.T) {
+	fmt.Println(">> Testing corpus loading...")
+
+	for _, test := range []struct {
+		name     string
+		files    map[string]string
+		expected map[string][]string
+	}{
+		{"text per line", map[string]string{"ru.txt": "Привет всем\n\n  Добрый день  \n"}, map[string][]string{"ru": {"Привет всем", "Добрый день"}}},
+		{"text per file", map[string]string{"uk/2": "Доброго дня\nусім", "uk/1": "Привіт усім\n"}, map[string][]string{"uk": {"Привіт усім", "Доброго дня\nусім"}}},
+		{"both layouts", map[string]string{"ru.txt": "Привет всем", "ru/post": "Добрый день"}, map[string][]string{"ru": {"Добрый день", "Привет всем"}}},
+		{"ignored files", map[string]string{"ru.txt": "Привет всем", "README.md": "Corpus", ".hidden.txt": "Hidden", "uk/.hidden": "Hidden", "uk/empty": " \n", "be.txt": ""}, map[string][]string{"ru": {"Привет всем"}}},
+	} {
+		dir := t.TempDir()
+		writeCorpus(t, dir, test.files)
+		corpus, err := readCorpus(dir)
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.expected, corpus, test.name)
+	}
+
+	_, err := readCorpus(filepath.Join(t.TempDir(), "missing"))
+	assert.NotNil(t, err)
+}
+
+func TestIsHeldOut(t *testing.T) {
+	fmt.Println(">> Testing the holdout split...")
+
+	for _, test := range []struct {
+		share    float64
+		expected []int
+	}{
+		{0, nil},
+		{0.1, []int{9, 19}},
+		{0.25, []int{3, 7, 11, 15, 19}},
+		{0.5, []int{1, 3, 5, 7, 9, 11, 13, 15, 17, 19}},
+	} {
+		var heldOut []int
+		for i := 0; i < 20; i++ {
+			if isHeldOut(i, test.share) {
+				heldOut = append(heldOut, i)
+			}
+		}
+		assert.Equal(t, test.expected, heldOut, fmt.Sprint("share ", test.share))
+	}
+}
+
+func TestTrainAndEvaluate(t *testing.T) {
+	fmt.Println(">> Testing training and evaluation...")
+
+	// Every other text is held out, each a copy of the text before it
+	russian := []string{"Сегодня мы расскажем о блокчейне", "Сегодня мы расскажем о блокчейне", "Почему это так важно для всех нас", "@golos_ru https://golos.io Почему это так важно для всех нас"}
+	ukrainian := []string{"Сьогодні ми розповімо про блокчейн", "Сьогодні ми розповімо про блокчейн", "Чому це так важливо для всіх нас", "Чому це так важливо для всіх нас"}
+	corpus := map[string][]string{"ru": russian, "uk": ukrainian}
+	accuracies := evaluate(corpus, 0.5, 0)
+	assert.Equal(t, []accuracy{
+		{Code: "ru", HeldOut: 2, Correct: 2, MistakenFor: "-"},
+		{Code: "uk", HeldOut: 2, Correct: 2, MistakenFor: "-"},
+	}, accuracies)
+
+	var output bytes.Buffer
+	printAccuracies(&output, append(accuracies, accuracy{Code: "be", HeldOut: 2, MistakenFor: "ru"}))
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	assert.Equal(t, 5, len(lines))
+	assert.Equal(t, []string{"be", "2", "0", "0.0%", "ru"}, strings.Fields(lines[3]))
+	assert.Equal(t, []string{"Total", "6", "4", "66.7%"}, strings.Fields(lines[4]))
+
+	// The saved model holds every text, and loads as the service does
+	model := train(corpus, 0, nil)
+	assert.Equal(t, detector.NGRAM_MODEL_VERSION, model.Version)
+	assert.Equal(t, detector.TrainNgramProfile(ukrainian, 0), model.Profiles["uk"])
+	path := filepath.Join(t.TempDir(), "ngram_model.json.gz")
+	assert.Nil(t, detector.SaveNgramModel(model, path))
+	loaded, err := detector.LoadNgramModel(path)
+	assert.Nil(t, err)
+	assert.Equal(t, model, loaded)
+
+	// Profiles keep the most frequent n-grams
+	model = train(corpus, 10, func(i int) bool { return i > 0 })
+	assert.Equal(t, 10, len(model.Profiles["ru"].Ngrams))
+	assert.Equal(t, detector.TrainNgramProfile(russian[:1], 10), model.Profiles["ru"])
+}
+
+func TestMostFrequent(t *testing.T) {
+	fmt.Println(">> Testing mistake counting...")
+
+	assert.Equal(t, "-", mostFrequent(map[string]int{}))
+	assert.Equal(t, "uk", mostFrequent(map[string]int{"be": 1, "uk": 3, "bg": 2}))
+	assert.Equal(t, "be", mostFrequent(map[string]int{"uk": 2, "be": 2}))
+}
@@ -0,0 +1,164 @@
+//go:build ignore
+// +build ignore
+
+// gen_codes generates the table of languages CLD2 detects, as data/cld_codes.json and
+// cld_codes.go, from the linked CLD2 library and data/iso639.txt. It is run from the
+// repository root by go generate, which needs libcld2.so in the library path:
+//
+//	$ LD_LIBRARY_PATH=. go generate
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/GolosChain/language-detector/detector" // CLD2 language detection
+)
+
+const (
+	ISO_FILE  = "data/iso639.txt"
+	JSON_FILE = "data/cld_codes.json"
+	GO_FILE   = "cld_codes.go"
+
+	UNKNOWN_PREFIX = "xx-" // Prefix of CLD2's codes for unknown languages of a script
+)
+
+// NAMES overrides names derived from CLD2's declared names that would be ambiguous.
+var NAMES = map[string]string{
+	"zh-Hant": "Chinese (Traditional)",
+	"nn":      "Norwegian Nynorsk",
+}
+
+// language mirrors main.LanguageInfo.
+type language struct {
+	Name    string `json:"name"`
+	ISO6392 string `json:"iso6392"`
+	ISO6393 string `json:"iso6393"`
+	BCP47   string `json:"bcp47"`
+	Script  string `json:"script"`
+}
+
+func main() {
+	isoCodes, err := readISOCodes(ISO_FILE)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	table := make(map[string]language)
+	var missing []string
+	for _, cld2Language := range detector.CLD2Languages() {
+		if len(cld2Language.Scripts) == 0 {
+			continue
+		}
+		code := cld2Language.Code
+		if _, found := table[code]; found {
+			log.Fatal("Duplicate language code: " + code)
+		}
+
+		entry := language{Name: name(cld2Language), Script: cld2Language.Scripts[0]}
+		if iso, found := isoCodes[code]; found {
+			entry.ISO6392, entry.ISO6393, entry.BCP47 = iso[0], iso[1], iso[2]
+		} else if strings.HasPrefix(code, UNKNOWN_PREFIX) {
+			entry.ISO6392, entry.ISO6393, entry.BCP47 = "und", "und", "und-"+entry.Script
+		} else {
+			missing = append(missing, code)
+		}
+		table[code] = entry
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		log.Fatal("Missing from " + ISO_FILE + ": " + strings.Join(missing, ", "))
+	}
+
+	if err := writeJSON(table); err != nil {
+		log.Fatal(err)
+	}
+	if err := writeGo(table); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("Total languages:", len(table))
+	fmt.Println("Result saved in " + JSON_FILE + " and " + GO_FILE)
+}
+
+// name returns the English name of a language, from its declared name in CLD2, e.g.
+// "Scots gaelic" for SCOTS_GAELIC. Unknown languages of a script are named after it.
+func name(cld2Language detector.CLD2Language) string {
+	if name, found := NAMES[cld2Language.Code]; found {
+		return name
+	}
+	words := strings.Replace(strings.TrimPrefix(cld2Language.DeclaredName, "X_"), "_", " ", -1)
+	if strings.HasPrefix(cld2Language.Code, UNKNOWN_PREFIX) {
+		return "Unknown (" + words + " script)"
+	}
+	lower := strings.ToLower(words)
+	return strings.ToUpper(lower[:1]) + lower[1:]
+}
+
+// readISOCodes returns the ISO 639-2, ISO 639-3 and BCP 47 codes of each CLD2 code in
+// path.
+func readISOCodes(path string) (map[string][3]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	codes := make(map[string][3]string)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") || strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("Invalid line in %s: %q", path, line)
+		}
+		codes[fields[0]] = [3]string{fields[1], fields[2], fields[3]}
+	}
+	return codes, scanner.Err()
+}
+
+// writeJSON writes table to JSON_FILE, in the LANG_FILE format.
+func writeJSON(table map[string]language) error {
+	output, err := json.MarshalIndent(table, "", "    ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(JSON_FILE, append(output, '\n'), 0644)
+}
+
+// writeGo writes table to GO_FILE, as the CLD2LanguageTable variable of package main.
+func writeGo(table map[string]language) error {
+	codes := make([]string, 0, len(table))
+	for code := range table {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	var source bytes.Buffer
+	source.WriteString("// Code generated by data/gen_codes.go; DO NOT EDIT.\n\n")
+	source.WriteString("package main\n\n")
+	source.WriteString("// CLD2LanguageTable holds every language CLD2 detects, by code, as in " + JSON_FILE + ".\n")
+	source.WriteString("var CLD2LanguageTable = map[string]LanguageInfo{\n")
+	for _, code := range codes {
+		entry := table[code]
+		fmt.Fprintf(&source, "\t%q: {Name: %q, ISO6392: %q, ISO6393: %q, BCP47: %q, Script: %q},\n",
+			code, entry.Name, entry.ISO6392, entry.ISO6393, entry.BCP47, entry.Script)
+	}
+	source.WriteString("}\n")
+
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(GO_FILE, formatted, 0644)
+}
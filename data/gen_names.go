@@ -0,0 +1,144 @@
+//go:build ignore
+// +build ignore
+
+// gen_names generates the localized names and endonyms of the languages in
+// data/cld_codes.json, as data/language_names.json and language_names.go, from the CLDR
+// data of golang.org/x/text. It is run from the repository root by go generate, after
+// gen_codes.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"sort"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"         // Language tags
+	"golang.org/x/text/language/display" // CLDR display names
+)
+
+const (
+	VERSION = 1 // Format version of JSON_FILE, bump along with main.LANG_NAMES_VERSION
+
+	CODES_FILE = "data/cld_codes.json"
+	JSON_FILE  = "data/language_names.json"
+	GO_FILE    = "language_names.go"
+)
+
+// LOCALES are the display locales names are generated for.
+var LOCALES = []string{"en", "ru", "uk", "be", "kk", "de", "fr", "es", "it", "pt", "pl", "tr", "zh", "ja", "ko"}
+
+type languageNames struct {
+	Version  int                          `json:"version"`
+	Endonyms map[string]string            `json:"endonyms"`
+	Names    map[string]map[string]string `json:"names"`
+}
+
+func main() {
+	codesFile, err := ioutil.ReadFile(CODES_FILE)
+	if err != nil {
+		log.Fatal(err)
+	}
+	codes := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(codesFile, &codes); err != nil {
+		log.Fatal(err)
+	}
+
+	names := languageNames{Version: VERSION, Endonyms: make(map[string]string), Names: make(map[string]map[string]string)}
+	for _, locale := range LOCALES {
+		names.Names[locale] = make(map[string]string)
+	}
+
+	var missing []string
+	for code := range codes {
+		tag, err := language.Parse(code)
+		if err != nil || tag == language.Und {
+			missing = append(missing, code)
+			continue
+		}
+		if endonym := display.Self.Name(tag); endonym != "" {
+			names.Endonyms[code] = capitalize(endonym)
+		}
+		for _, locale := range LOCALES {
+			if name := display.Tags(language.MustParse(locale)).Name(tag); name != "" {
+				names.Names[locale][code] = capitalize(name)
+			}
+		}
+	}
+	sort.Strings(missing)
+
+	output, err := json.MarshalIndent(names, "", "    ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(JSON_FILE, append(output, '\n'), 0644); err != nil {
+		log.Fatal(err)
+	}
+	if err := writeGo(names); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("Languages without names:", missing)
+	fmt.Println("Result saved in " + JSON_FILE + " and " + GO_FILE)
+}
+
+// writeGo writes names to GO_FILE, as the DefaultLanguageNames variable of package main.
+func writeGo(names languageNames) error {
+	var source bytes.Buffer
+	source.WriteString("// Code generated by data/gen_names.go; DO NOT EDIT.\n\n")
+	source.WriteString("package main\n\n")
+	source.WriteString("// DefaultLanguageNames holds the localized language names of " + JSON_FILE + ".\n")
+	source.WriteString("var DefaultLanguageNames = LanguageNamesData{\n")
+	fmt.Fprintf(&source, "Version: %d,\n", names.Version)
+	source.WriteString("Endonyms: ")
+	writeGoMap(&source, names.Endonyms)
+	source.WriteString(",\nNames: map[string]map[string]string{\n")
+	for _, locale := range sortedKeys(names.Names) {
+		fmt.Fprintf(&source, "%q: ", locale)
+		writeGoMap(&source, names.Names[locale])
+		source.WriteString(",\n")
+	}
+	source.WriteString("},\n}\n")
+
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(GO_FILE, formatted, 0644)
+}
+
+// writeGoMap writes m to source as a Go map literal, sorted by key.
+func writeGoMap(source *bytes.Buffer, m map[string]string) {
+	source.WriteString("map[string]string{\n")
+	for _, key := range sortedKeys(m) {
+		fmt.Fprintf(source, "%q: %q,\n", key, m[key])
+	}
+	source.WriteString("}")
+}
+
+// sortedKeys returns the keys of m, which must be a map with string keys, sorted.
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch m := m.(type) {
+	case map[string]string:
+		for key := range m {
+			keys = append(keys, key)
+		}
+	case map[string]map[string]string:
+		for key := range m {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// capitalize upper cases the first letter of name, as in cld_codes.json.
+func capitalize(name string) string {
+	r, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToUpper(r)) + name[size:]
+}
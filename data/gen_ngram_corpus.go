@@ -0,0 +1,205 @@
+//go:build ignore
+// +build ignore
+
+// gen_ngram_corpus extracts the texts the n-gram engine is trained on, data/ngram/, from
+// the gettext translations of the packages installed in LOCALE_DIR, such as coreutils,
+// apt or systemd. Every language of data/ngram/ gets up to MAX_LINES of the translated
+// messages that read as plain sentences, English the messages they are translated from.
+// It is run from the repository root, before go generate:
+//
+//	go run data/gen_ngram_corpus.go [locale dir]
+//
+// The corpus is not extracted by go generate, as the catalogs found depend on the system.
+// Texts the tests detect must not be added to it, so that they measure detection and not
+// memorization.
+package main
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	LOCALE_DIR = "/usr/share/locale"
+	TEXTS_DIR  = "data/ngram"
+
+	MAX_LINES = 1500 // Texts kept per language
+	MIN_WORDS = 5    // Words a message needs to be kept
+	MAX_BYTES = 300  // Length of the longest message kept
+
+	MO_MAGIC = 0x950412de // Magic number of gettext catalogs
+)
+
+// LOCALES are the locales the texts of each language are extracted from, by CLD2 code.
+// English texts are the messages of every other locale's catalogs.
+var LOCALES = map[string]string{
+	"be": "be", "bg": "bg", "cs": "cs", "da": "da", "de": "de", "en": "", "es": "es",
+	"fi": "fi", "fr": "fr", "hu": "hu", "it": "it", "nl": "nl", "no": "nb", "pl": "pl",
+	"pt": "pt", "ro": "ro", "ru": "ru", "sv": "sv", "uk": "uk",
+}
+
+// CYRILLIC are the languages written in Cyrillic, every other one being in Latin script.
+var CYRILLIC = map[string]bool{"be": true, "bg": true, "ru": true, "uk": true}
+
+func main() {
+	localeDir := LOCALE_DIR
+	if len(os.Args) > 1 {
+		localeDir = os.Args[1]
+	}
+
+	english := make(map[string]bool)
+	for code, locale := range LOCALES {
+		if locale == "" {
+			continue
+		}
+		catalogs, err := filepath.Glob(filepath.Join(localeDir, locale, "LC_MESSAGES", "*.mo"))
+		if err != nil {
+			log.Fatal(err)
+		}
+		texts := make(map[string]bool)
+		for _, catalog := range catalogs {
+			messages, err := readCatalog(catalog)
+			if err != nil {
+				log.Fatal(err)
+			}
+			for original, translation := range messages {
+				if text := clean(translation, CYRILLIC[code]); text != "" && text != clean(original, false) {
+					texts[text] = true
+				}
+				if text := clean(original, false); text != "" {
+					english[text] = true
+				}
+			}
+		}
+		if err := writeTexts(code, texts); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := writeTexts("en", english); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("Total languages:", len(LOCALES))
+	fmt.Println("Result saved in " + TEXTS_DIR)
+}
+
+// readCatalog returns the messages of the gettext catalog at path, along with their
+// translation, or its singular form for plural messages.
+func readCatalog(path string) (map[string]string, error) {
+	catalog, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	invalid := errors.New("Invalid gettext catalog: " + path)
+	if len(catalog) < 20 || binary.LittleEndian.Uint32(catalog) != MO_MAGIC {
+		return nil, invalid
+	}
+
+	count := int(binary.LittleEndian.Uint32(catalog[8:]))
+	originals := int(binary.LittleEndian.Uint32(catalog[12:]))
+	translations := int(binary.LittleEndian.Uint32(catalog[16:]))
+	message := func(table int, i int) (string, bool) {
+		entry := table + 8*i
+		if entry < 0 || entry+8 > len(catalog) {
+			return "", false
+		}
+		length := int(binary.LittleEndian.Uint32(catalog[entry:]))
+		offset := int(binary.LittleEndian.Uint32(catalog[entry+4:]))
+		if offset < 0 || length < 0 || offset+length > len(catalog) {
+			return "", false
+		}
+		// Plural forms are separated by NUL bytes, the context by EOT
+		text := string(catalog[offset : offset+length])
+		text = strings.SplitN(text, "\x00", 2)[0]
+		if i := strings.IndexByte(text, '\x04'); i >= 0 {
+			text = text[i+1:]
+		}
+		return text, true
+	}
+
+	messages := make(map[string]string, count)
+	for i := 0; i < count; i++ {
+		original, ok := message(originals, i)
+		if !ok {
+			return nil, invalid
+		}
+		translation, ok := message(translations, i)
+		if !ok {
+			return nil, invalid
+		}
+		if original != "" && translation != "" {
+			messages[original] = translation
+		}
+	}
+	return messages, nil
+}
+
+// clean returns message as a line of plain text, or an empty string if it does not read
+// as a sentence in Latin script, or Cyrillic if cyrillic is set: messages that are not
+// UTF-8, or with format directives, markup, options, paths or accelerator keys, or too
+// few words, are dropped.
+func clean(message string, cyrillic bool) string {
+	text := strings.Join(strings.Fields(message), " ")
+	words := strings.Fields(text)
+	if !utf8.ValidString(text) || len(text) > MAX_BYTES || len(words) < MIN_WORDS || strings.ContainsAny(text, "%{}$<>\\`=|_/@#*[]&~^") {
+		return ""
+	}
+	for _, word := range words {
+		if strings.HasPrefix(word, "-") || strings.HasPrefix(word, ".") {
+			return ""
+		}
+	}
+
+	letters, inScript := 0, 0
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		if (cyrillic && unicode.Is(unicode.Cyrillic, r)) || (!cyrillic && unicode.Is(unicode.Latin, r)) {
+			inScript++
+		}
+	}
+	if letters < 4*len(words) || inScript < letters*9/10 {
+		return ""
+	}
+	return text
+}
+
+// writeTexts writes up to MAX_LINES of texts to the file of language code in TEXTS_DIR,
+// a text per line, picking them by hash so that the same catalogs give the same corpus.
+func writeTexts(code string, texts map[string]bool) error {
+	lines := make([]string, 0, len(texts))
+	for text := range texts {
+		lines = append(lines, text)
+	}
+	hashes := make(map[string]uint32, len(lines))
+	for _, line := range lines {
+		hash := fnv.New32a()
+		hash.Write([]byte(line))
+		hashes[line] = hash.Sum32()
+	}
+	sort.Slice(lines, func(i, j int) bool {
+		if hashes[lines[i]] != hashes[lines[j]] {
+			return hashes[lines[i]] < hashes[lines[j]]
+		}
+		return lines[i] < lines[j]
+	})
+	if len(lines) > MAX_LINES {
+		lines = lines[:MAX_LINES]
+	}
+	if len(lines) == 0 {
+		return errors.New("No texts found for " + code)
+	}
+	fmt.Printf("%s: %d texts\n", code, len(lines))
+	return ioutil.WriteFile(filepath.Join(TEXTS_DIR, code+".txt"), []byte(strings.Join(lines, "\n")+"\n"), 0644)
+}
@@ -0,0 +1,117 @@
+//go:build ignore
+// +build ignore
+
+// gen_ngrams trains the n-gram profiles of the pure-Go engine from the texts in
+// data/ngram/, one file per language named after its CLD2 code, as extracted by
+// gen_ngram_corpus, and writes them to detector/ngram_model.go. It is run from the
+// repository root by go generate.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/GolosChain/language-detector/detector" // CLD2 language detection
+)
+
+const (
+	TEXTS_DIR = "data/ngram"
+	GO_FILE   = "detector/ngram_model.go"
+
+	MAX_NGRAMS      = 2000 // N-grams kept per language
+	NGRAMS_PER_LINE = 8    // N-grams written per line of GO_FILE
+)
+
+func main() {
+	paths, err := filepath.Glob(filepath.Join(TEXTS_DIR, "*.txt"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	model := detector.NgramModel{Version: detector.NGRAM_MODEL_VERSION, Profiles: make(map[string]detector.NgramProfile)}
+	for _, path := range paths {
+		code := strings.TrimSuffix(filepath.Base(path), ".txt")
+		if detector.LanguageFromName(code) != code {
+			log.Fatal("Not a CLD2 language code: " + path)
+		}
+		texts, err := readLines(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		model.Profiles[code] = detector.TrainNgramProfile(texts, MAX_NGRAMS)
+	}
+
+	if err := writeGo(model); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("Total languages:", len(model.Profiles))
+	fmt.Println("Result saved in " + GO_FILE)
+}
+
+// readLines returns the non-empty lines of the file at path.
+func readLines(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, scanner.Err()
+}
+
+// writeGo writes model to GO_FILE, as the DefaultNgramModel variable of package detector.
+func writeGo(model detector.NgramModel) error {
+	codes := make([]string, 0, len(model.Profiles))
+	for code := range model.Profiles {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	var source bytes.Buffer
+	source.WriteString("// Code generated by data/gen_ngrams.go; DO NOT EDIT.\n\n")
+	source.WriteString("package detector\n\n")
+	source.WriteString("// DefaultNgramModel holds the n-gram profiles NGRAM_ENGINE is trained with, from the texts in " + TEXTS_DIR + ".\n")
+	source.WriteString("var DefaultNgramModel = NgramModel{\n")
+	fmt.Fprintf(&source, "Version: %d,\n", model.Version)
+	source.WriteString("Profiles: map[string]NgramProfile{\n")
+	for _, code := range codes {
+		profile := model.Profiles[code]
+		fmt.Fprintf(&source, "%q: {Total: %d, Ngrams: map[string]int{\n", code, profile.Total)
+		ngrams := make([]string, 0, len(profile.Ngrams))
+		for ngram := range profile.Ngrams {
+			ngrams = append(ngrams, ngram)
+		}
+		sort.Strings(ngrams)
+		for i, ngram := range ngrams {
+			fmt.Fprintf(&source, "%q: %d,", ngram, profile.Ngrams[ngram])
+			if (i+1)%NGRAMS_PER_LINE == 0 || i == len(ngrams)-1 {
+				source.WriteString("\n")
+			} else {
+				source.WriteString(" ")
+			}
+		}
+		source.WriteString("}},\n")
+	}
+	source.WriteString("},\n}\n")
+
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(GO_FILE, formatted, 0644)
+}
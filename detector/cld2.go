@@ -0,0 +1,161 @@
+package detector
+
+// #cgo CFLAGS: -I${SRCDIR}/.. -fpic
+// #cgo CXXFLAGS: -I${SRCDIR}/.. -fpic
+// #cgo LDFLAGS: -L${SRCDIR}/..
+// #include <stdlib.h>
+// #include "wrapper.h"
+import "C"
+
+import (
+	"strings"
+	"unsafe"
+)
+
+func init() {
+	RegisterEngine(cld2Engine{})
+}
+
+// cld2Engine is the Engine of the linked CLD2 library.
+type cld2Engine struct{}
+
+func (cld2Engine) Name() string {
+	return CLD2_ENGINE
+}
+
+// Summarize returns the most likely language code for text along with up to three
+// languages found in it, as reported by CLD2's ExtDetectLanguageSummaryCheckUTF8.
+func (cld2Engine) Summarize(text string, hints Hints, isHTML bool, withChunks bool) Summary {
+	cStr := C.CString(text)
+	defer C.free(unsafe.Pointer(cStr))
+	cHints, freeHints := hints.cHints()
+	defer freeHints()
+
+	var cSummary C.language_summary
+	modelLock.RLock()
+	code := C.GoString(C.detect_language_summary(cStr, C.int(len(text)), &cHints, cBool(!isHTML), cBool(withChunks), &cSummary))
+	modelLock.RUnlock()
+	defer C.free(unsafe.Pointer(cSummary.chunks))
+
+	languages := make([]Language, 0, 3)
+	for i := 0; i < 3; i++ {
+		langCode := C.GoString(cSummary.codes[i])
+		if langCode == UNKNOWN_LANGUAGE_CODE {
+			continue
+		}
+		languages = append(languages, Language{
+			Code:            langCode,
+			Percent:         int(cSummary.percents[i]),
+			NormalizedScore: float64(cSummary.normalized_scores[i]),
+		})
+	}
+
+	var chunks []Segment
+	if cSummary.num_chunks > 0 {
+		cChunks := (*[1 << 28]C.result_chunk)(unsafe.Pointer(cSummary.chunks))[:cSummary.num_chunks:cSummary.num_chunks]
+		for _, cChunk := range cChunks {
+			chunks = append(chunks, Segment{
+				Offset: int(cChunk.offset),
+				Length: int(cChunk.bytes),
+				Code:   C.GoString(cChunk.code),
+			})
+		}
+	}
+
+	return Summary{
+		Code:      code,
+		Languages: languages,
+		TextBytes: int(cSummary.text_bytes),
+		Reliable:  cSummary.is_reliable != 0,
+		Chunks:    chunks,
+
+		ValidPrefixBytes: int(cSummary.valid_prefix_bytes),
+	}
+}
+
+// cHints converts hints to the struct passed to wrapper.cc. The returned function
+// frees the C strings and must be called once detection is done.
+func (hints Hints) cHints() (C.detection_hints, func()) {
+	var cHints C.detection_hints
+	var cStrs []*C.char
+	cString := func(value string) *C.char {
+		if value == "" {
+			return nil
+		}
+		cStr := C.CString(value)
+		cStrs = append(cStrs, cStr)
+		return cStr
+	}
+
+	cHints.content_language_hint = cString(hints.ContentLanguage)
+	cHints.tld_hint = cString(strings.ToLower(hints.TLD))
+	cHints.language_hint = cString(hints.Language)
+	cHints.encoding_hint = C.int(UNKNOWN_ENCODING)
+	if encoding, found := Encodings[strings.ToLower(hints.Encoding)]; found {
+		cHints.encoding_hint = C.int(encoding)
+	}
+
+	return cHints, func() {
+		for _, cStr := range cStrs {
+			C.free(unsafe.Pointer(cStr))
+		}
+	}
+}
+
+// LanguageFromName returns the CLD2 language code for a language tag (e.g. "ru" or
+// "ru-RU"), or UNKNOWN_LANGUAGE_CODE if CLD2 does not know it.
+func LanguageFromName(name string) string {
+	cStr := C.CString(name)
+	defer C.free(unsafe.Pointer(cStr))
+	return C.GoString(C.language_from_name(cStr))
+}
+
+// languageScripts returns the scripts CLD2 recognizes the language code in, the most
+// common first, or none for codes CLD2 does not know and UNKNOWN_LANGUAGE_CODE.
+func languageScripts(code string) []script {
+	cStr := C.CString(code)
+	defer C.free(unsafe.Pointer(cStr))
+
+	var cCodes, cNames [4]*C.char
+	count := int(C.language_scripts(cStr, &cCodes[0], &cNames[0]))
+	scripts := make([]script, count)
+	for i := range scripts {
+		scripts[i] = script{code: C.GoString(cCodes[i]), name: C.GoString(cNames[i])}
+	}
+	return scripts
+}
+
+// CLD2Language describes a value of CLD2's Language enum.
+type CLD2Language struct {
+	Code         string   // LanguageCode, e.g. "zh-Hant"
+	Name         string   // LanguageName, e.g. "ChineseT"
+	DeclaredName string   // LanguageDeclaredName, the name of the enum value, e.g. "CHINESE_T"
+	Scripts      []string // ISO 15924 codes of the scripts CLD2 recognizes it in, the most common first
+}
+
+// CLD2Languages returns every value of the Language enum of the linked CLD2 library, in
+// order. Languages without Scripts are never detected, including UNKNOWN_LANGUAGE_CODE.
+func CLD2Languages() []CLD2Language {
+	languages := make([]CLD2Language, int(C.num_languages()))
+	for i := range languages {
+		var info C.language_info
+		C.get_language_info(C.int(i), &info)
+		languages[i] = CLD2Language{
+			Code:         C.GoString(info.code),
+			Name:         C.GoString(info.name),
+			DeclaredName: C.GoString(info.declared_name),
+		}
+		for j := 0; j < int(info.num_scripts); j++ {
+			languages[i].Scripts = append(languages[i].Scripts, C.GoString(info.script_codes[j]))
+		}
+	}
+	return languages
+}
+
+// cBool converts b to an int usable as a C boolean.
+func cBool(b bool) C.int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,171 @@
+//go:build cgo
+// +build cgo
+
+package detector
+
+// Tests of CLD2, which is only linked in builds with cgo.
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert" // Assertion package
+)
+
+func TestMain(m *testing.M) {
+	// CLD2 built with CLD2_DYNAMIC_MODE detects nothing until its model is loaded
+	if IsModelDynamic() {
+		if err := LoadModel(os.Getenv("CLD2_DATA_FILE")); err != nil {
+			fmt.Println("CLD2_DATA_FILE must be set to a CLD2 model to test the cld2_dynamic build: " + err.Error())
+			os.Exit(1)
+		}
+	}
+	os.Exit(m.Run())
+}
+
+func TestCLD2Engine(t *testing.T) {
+	fmt.Println(">> Testing the CLD2 engine...")
+
+	assert.Equal(t, []string{CLD2_ENGINE, ENSEMBLE_ENGINE, NGRAM_ENGINE}, EngineNames())
+	assert.Equal(t, CLD2_ENGINE, DefaultEngine().Name())
+	assert.Equal(t, CLD2_ENGINE, New().Engine.Name())
+
+	// Scripts are those CLD2 finds languages in, whatever the engine
+	ngram, err := GetEngine(NGRAM_ENGINE)
+	assert.Nil(t, err)
+	result, err := New().Detect(context.Background(), "Сегодня мы расскажем о том, как работает блокчейн и почему он так важен для всех нас.", Options{Engine: ngram})
+	assert.Nil(t, err)
+	assert.Equal(t, "Cyrl", result.Script)
+}
+
+func TestDetectMixed(t *testing.T) {
+	fmt.Println(">> Testing Detect with mixed languages and segments...")
+
+	russian := "Сегодня мы расскажем о том, как работает блокчейн и почему он так важен для всех нас. "
+	english := "@golos Today we will talk about how the blockchain works and why it matters so much for all of us."
+	text := russian + english
+
+	result, err := New().Detect(context.Background(), text, Options{Segments: true})
+	assert.Nil(t, err, "detection should not error")
+
+	codes := []string{}
+	for _, language := range result.Languages {
+		codes = append(codes, language.Code)
+	}
+	assert.Contains(t, codes, "ru", "Russian should be among the detected languages")
+	assert.Contains(t, codes, "en", "English should be among the detected languages")
+
+	// Segments should cover the original text, mention included, without gaps
+	end := 0
+	for _, segment := range result.Segments {
+		assert.Equal(t, end, segment.Offset, "segments should be contiguous")
+		end = segment.Offset + segment.Length
+	}
+	assert.Equal(t, len(text), end, "segments should cover the whole text")
+}
+
+func TestDetectAllowedLanguages(t *testing.T) {
+	fmt.Println(">> Testing Detect with allowed languages...")
+
+	russian := "Сегодня мы расскажем о том, как работает блокчейн и почему он так важен для всех нас. "
+	english := "Today we will talk about the blockchain."
+	text := russian + english
+	d := New()
+
+	result, err := d.Detect(context.Background(), text, Options{})
+	assert.Nil(t, err, "detection should not error")
+	assert.Equal(t, "ru", result.Code)
+
+	// The best allowed language replaces CLD2's choice
+	result, err = d.Detect(context.Background(), text, Options{AllowedLanguages: []string{"en", "de"}})
+	assert.Nil(t, err, "detection should not error")
+	assert.Equal(t, "en", result.Code)
+	assert.False(t, result.Reliable, "replaced language should not be reliable")
+	assert.Equal(t, 1, len(result.Languages), "only allowed languages should be returned")
+
+	// Tags are matched by CLD2 code
+	result, err = d.Detect(context.Background(), text, Options{AllowedLanguages: []string{"EN", "ru-RU"}})
+	assert.Nil(t, err, "detection should not error")
+	assert.Equal(t, "ru", result.Code)
+	assert.Equal(t, 2, len(result.Languages), "both allowed languages should be returned")
+
+	// Without any allowed language found, the text is undetermined
+	result, err = d.Detect(context.Background(), text, Options{AllowedLanguages: []string{"de"}})
+	assert.Nil(t, err, "detection should not error")
+	assert.Equal(t, UNDETERMINED_LANGUAGE_CODE, result.Code)
+	assert.False(t, result.TooShort, "text should not be too short")
+	result, err = d.Detect(context.Background(), text, Options{AllowedLanguages: []string{"en"}, MinAllowedPercent: 90})
+	assert.Nil(t, err, "detection should not error")
+	assert.Equal(t, UNDETERMINED_LANGUAGE_CODE, result.Code)
+
+	// Options override the Detector's allowed languages
+	d.AllowedLanguages = []string{"de"}
+	result, err = d.Detect(context.Background(), text, Options{})
+	assert.Nil(t, err, "detection should not error")
+	assert.Equal(t, UNDETERMINED_LANGUAGE_CODE, result.Code)
+	result, err = d.Detect(context.Background(), text, Options{AllowedLanguages: []string{}})
+	assert.Nil(t, err, "detection should not error")
+	assert.Equal(t, "ru", result.Code)
+
+	_, err = d.Detect(context.Background(), text, Options{AllowedLanguages: []string{"ru", "klingon"}})
+	assert.Equal(t, "Unknown allowed language: klingon", err.Error())
+}
+
+func TestDetectScript(t *testing.T) {
+	fmt.Println(">> Testing script detection...")
+
+	// Languages written in a single script always get it
+	assert.Equal(t, "Cyrl", detectScript("Сегодня мы расскажем о блокчейне", "ru"))
+	assert.Equal(t, "Latn", detectScript("Сегодня мы расскажем о блокчейне", "en"))
+
+	// Others get the script most of the text is in
+	assert.Equal(t, "Cyrl", detectScript("Данас ћемо говорити о блокчејну (blockchain)", "sr"))
+	assert.Equal(t, "Latn", detectScript("Danas ćemo govoriti o blokčejnu", "sr"))
+
+	assert.Equal(t, "", detectScript("Сегодня мы расскажем о блокчейне", UNDETERMINED_LANGUAGE_CODE))
+	assert.Equal(t, "", detectScript("Сегодня мы расскажем о блокчейне", UNKNOWN_LANGUAGE_CODE))
+}
+
+func TestCLD2Languages(t *testing.T) {
+	fmt.Println(">> Testing CLD2's language enum...")
+
+	languages := CLD2Languages()
+	assert.True(t, len(languages) > 500, "every enum value should be returned")
+	assert.Equal(t, CLD2Language{Code: "en", Name: "ENGLISH", DeclaredName: "ENGLISH", Scripts: []string{"Latn"}}, languages[0])
+
+	byCode := make(map[string]CLD2Language)
+	for _, language := range languages {
+		if len(language.Scripts) > 0 {
+			byCode[language.Code] = language
+		}
+	}
+	assert.Equal(t, []string{"Cyrl"}, byCode["ru"].Scripts)
+	assert.Equal(t, []string{"Latn", "Cyrl"}, byCode["sr"].Scripts)
+	assert.Equal(t, "CHINESE_T", byCode["zh-Hant"].DeclaredName)
+	_, found := byCode[UNKNOWN_LANGUAGE_CODE]
+	assert.False(t, found, "unknown language should never be detected")
+}
+
+func TestModel(t *testing.T) {
+	fmt.Println(">> Testing CLD2 model loading...")
+
+	assert.True(t, IsModelLoaded())
+	if IsModelDynamic() {
+		// The model loaded from CLD2_DATA_FILE is kept when another fails to load
+		err := LoadModel("missing_cld2_data.bin")
+		assert.NotNil(t, err, "missing model should error")
+		assert.True(t, IsModelLoaded(), "loaded model should be kept")
+		assert.Equal(t, "", Tables())
+	} else {
+		err := LoadModel("cld2_data.bin")
+		assert.Equal(t, "CLD2 is not built with CLD2_DYNAMIC_MODE, its model cannot be loaded", err.Error())
+		assert.True(t, IsModelLoaded(), "compiled in model should be kept")
+
+		// Tests are run against either table variant
+		assert.Contains(t, []string{TABLES_CHROME, TABLES_FULL}, Tables())
+	}
+	assert.True(t, strings.HasPrefix(Version(), "V2.0 - "), "version should hold the tables build date")
+}
@@ -0,0 +1,338 @@
+// Package detector determines the language of texts using CLD2, or other Engines such
+// as a pure-Go n-gram model.
+package detector
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	UNDETERMINED_LANGUAGE_CODE = "und" // BCP 47 code for texts too short to tell their language
+	UNKNOWN_LANGUAGE_CODE      = "un"  // CLD2's code for UNKNOWN_LANGUAGE, for texts in no known language
+)
+
+// Detector detects the language of texts. It is safe for concurrent use.
+type Detector struct {
+	// Engine detects languages when Options do not pick one, DefaultEngine if nil.
+	Engine Engine
+
+	// DefaultFilters are applied to plain text when Options.Filters is nil.
+	DefaultFilters []TextFilter
+
+	// Texts with fewer letters, after preprocessing, or fewer bytes scored by the
+	// engine are left undetermined unless Options say otherwise. Zero disables either
+	// threshold.
+	MinLetters   int
+	MinTextBytes int
+
+	// AllowedLanguages restricts results to these language codes when Options do not
+	// set their own. Allowed languages must make up at least MinAllowedPercent of the
+	// text to be returned.
+	AllowedLanguages  []string
+	MinAllowedPercent int
+}
+
+// Options controls how a single text is detected.
+type Options struct {
+	Engine   Engine // Overrides the Detector's Engine when not nil
+	Hints    Hints
+	IsHTML   bool // Skip markup and use lang attributes as hints, rather than scoring the raw text
+	Segments bool // Also return the language of each span of the text
+
+	// Encoding names the encoding text is in, one of Decoders or AUTO_ENCODING. Text is
+	// transcoded to UTF-8 before anything else, and the encoding is used as encoding
+	// hint unless one is set. When empty, text must already be UTF-8.
+	Encoding string
+
+	// Filters are applied to the text, in order, before detection. When nil, plain
+	// text gets the Detector's DefaultFilters and HTML is left alone; pass an empty
+	// slice to skip preprocessing altogether.
+	Filters []TextFilter
+
+	// MinLetters and MinTextBytes override the Detector's thresholds when positive. A
+	// negative value disables the threshold.
+	MinLetters   int
+	MinTextBytes int
+
+	// AllowedLanguages overrides the Detector's allowed languages when not nil, and an
+	// empty slice allows every language. MinAllowedPercent overrides the Detector's like
+	// the other thresholds.
+	AllowedLanguages  []string
+	MinAllowedPercent int
+}
+
+// Result is the outcome of detecting the language of a text.
+type Result struct {
+	Code      string     // Most likely language code
+	Languages []Language // Up to three languages found in the text
+	TextBytes int        // Number of letter bytes the engine actually scored
+	Reliable  bool       // Whether the engine considers Code reliable
+	Segments  []Segment  // Language of each span of the text, if requested
+	Encoding  string     // Encoding the text was decoded from, if Options.Encoding was set
+	Script    string     // ISO 15924 code of the script Code was found in, empty if undetermined
+	Votes     []Vote     // What each engine found, when detecting with an EnsembleEngine
+
+	// TooShort is set when the text is below the minimum length. Code is then
+	// UNDETERMINED_LANGUAGE_CODE, rather than the engine's guess, and Languages is empty.
+	// Code is also undetermined when none of the allowed languages are found.
+	TooShort bool
+}
+
+// Language is one of the top languages found in a text.
+type Language struct {
+	Code            string
+	Percent         int     // Percentage of the text's letters in this language
+	NormalizedScore float64 // Score relative to normal text in this language
+	Script          string  // ISO 15924 code of the script the language was found in
+}
+
+// Segment is a span of a text in a single language. Consecutive segments cover the
+// whole text that was passed to Detect, or its UTF-8 transcoding if Options.Encoding
+// was set.
+type Segment struct {
+	Offset int // Byte offset of the span
+	Length int // Length of the span in bytes
+	Code   string
+}
+
+// InvalidUTF8Error is returned by Detect for texts that are not valid UTF-8. Engines only
+// accept interchange-valid UTF-8, as CLD2 does, so control characters other than
+// whitespace are rejected as well.
+type InvalidUTF8Error struct {
+	ValidPrefixBytes int // Number of leading bytes of the text that are valid
+}
+
+func (e *InvalidUTF8Error) Error() string {
+	return "Invalid UTF-8 text at byte " + strconv.Itoa(e.ValidPrefixBytes)
+}
+
+// New returns a Detector that detects languages with DefaultEngine and applies
+// DEFAULT_PREPROCESS to plain text.
+func New() *Detector {
+	filters, _ := GetTextFilters(strings.Split(DEFAULT_PREPROCESS, ","))
+	return &Detector{Engine: DefaultEngine(), DefaultFilters: filters}
+}
+
+// Detect determines the language of text. An error is returned if opts holds invalid
+// hints, an unknown encoding or unknown allowed languages, if ctx is done before
+// detection starts, or, as an *InvalidUTF8Error, if the text is not valid UTF-8.
+func (d *Detector) Detect(ctx context.Context, text string, opts Options) (Result, error) {
+	if err := ctx.Err(); err != nil {
+		return Result{}, err
+	}
+
+	var encoding string
+	if opts.Encoding != "" {
+		var err error
+		if text, encoding, err = Transcode(text, opts.Encoding); err != nil {
+			return Result{}, err
+		}
+		if opts.Hints.Encoding == "" {
+			opts.Hints.Encoding = encoding
+		}
+	}
+	if err := opts.Hints.Validate(); err != nil {
+		return Result{}, err
+	}
+	allowed := opts.AllowedLanguages
+	if allowed == nil {
+		allowed = d.AllowedLanguages
+	}
+	allowedSet, err := AllowedLanguageSet(allowed)
+	if err != nil {
+		return Result{}, err
+	}
+
+	filters := opts.Filters
+	if filters == nil && !opts.IsHTML {
+		filters = d.DefaultFilters
+	}
+	preprocessed, offsets := Preprocess(text, filters)
+
+	engine := opts.Engine
+	if engine == nil {
+		engine = d.Engine
+	}
+	if engine == nil {
+		engine = DefaultEngine()
+	}
+
+	if err := ctx.Err(); err != nil {
+		return Result{}, err
+	}
+	summary := engine.Summarize(preprocessed, opts.Hints, opts.IsHTML, opts.Segments)
+	if summary.ValidPrefixBytes < len(preprocessed) {
+		return Result{}, &InvalidUTF8Error{ValidPrefixBytes: offsets.Original(summary.ValidPrefixBytes)}
+	}
+
+	result := Result{
+		Code:      summary.Code,
+		Languages: summary.Languages,
+		TextBytes: summary.TextBytes,
+		Reliable:  summary.Reliable,
+		Encoding:  encoding,
+		Votes:     summary.Votes,
+	}
+
+	// Position segments in the text the caller passed in
+	for _, chunk := range summary.Chunks {
+		offset := offsets.Original(chunk.Offset)
+		length := offsets.Original(chunk.Offset+chunk.Length) - offset
+		if length <= 0 {
+			continue
+		}
+		result.Segments = append(result.Segments, Segment{Offset: offset, Length: length, Code: chunk.Code})
+	}
+
+	// Leave texts with too little to go on undetermined, not counting the letters of markup
+	minLetters := threshold(opts.MinLetters, d.MinLetters)
+	minTextBytes := threshold(opts.MinTextBytes, d.MinTextBytes)
+	letters := preprocessed
+	if opts.IsHTML {
+		letters = htmlTags.ReplaceAllString(preprocessed, " ")
+	}
+	if summary.TextBytes == 0 || summary.TextBytes < minTextBytes || (minLetters > 0 && countLetters(letters) < minLetters) {
+		result.Code = UNDETERMINED_LANGUAGE_CODE
+		result.Languages = nil
+		result.Reliable = false
+		result.TooShort = true
+	}
+
+	// Pick the best of the allowed languages, if restricted
+	if len(allowedSet) > 0 && !result.TooShort {
+		restrictLanguages(&result, allowedSet, threshold(opts.MinAllowedPercent, d.MinAllowedPercent))
+	}
+
+	result.Script = detectScript(preprocessed, result.Code)
+	for i := range result.Languages {
+		result.Languages[i].Script = detectScript(preprocessed, result.Languages[i].Code)
+	}
+
+	return result, nil
+}
+
+// AllowedLanguageSet returns the set of CLD2 codes for language tags, so that e.g.
+// "RU" and "ru-RU" both allow "ru". An error is returned for tags CLD2 does not know.
+func AllowedLanguageSet(tags []string) (map[string]bool, error) {
+	allowed := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		code := canonicalLanguage(strings.TrimSpace(tag))
+		if code == UNKNOWN_LANGUAGE_CODE {
+			return nil, errors.New("Unknown allowed language: " + tag)
+		}
+		allowed[code] = true
+	}
+	return allowed, nil
+}
+
+// canonicalLanguage returns the CLD2 code for tag, trying it as is, lowercased and
+// without its region, as CLD2 only knows a few tags with subtags, such as "zh-Hant".
+func canonicalLanguage(tag string) string {
+	lower := strings.ToLower(tag)
+	primary := lower
+	if i := strings.IndexAny(lower, "-_"); i > 0 {
+		primary = lower[:i]
+	}
+	for _, name := range []string{tag, lower, primary} {
+		if code := LanguageFromName(name); code != UNKNOWN_LANGUAGE_CODE {
+			return code
+		}
+	}
+	return UNKNOWN_LANGUAGE_CODE
+}
+
+// restrictLanguages keeps the languages of result that are allowed and make up at least
+// minPercent of the text, best first, and sets Code to the best of them. When the
+// engine's choice is replaced, the result is no longer reliable.
+func restrictLanguages(result *Result, allowed map[string]bool, minPercent int) {
+	languages := make([]Language, 0, len(result.Languages))
+	for _, language := range result.Languages {
+		if allowed[language.Code] && language.Percent > 0 && language.Percent >= minPercent {
+			languages = append(languages, language)
+		}
+	}
+	sort.Stable(byShare(languages))
+	result.Languages = languages
+
+	if len(languages) == 0 {
+		result.Code = UNDETERMINED_LANGUAGE_CODE
+		result.Reliable = false
+	} else if languages[0].Code != result.Code {
+		result.Code = languages[0].Code
+		result.Reliable = false
+	}
+}
+
+// byShare sorts languages by their share of the text, then by score.
+type byShare []Language
+
+func (l byShare) Len() int      { return len(l) }
+func (l byShare) Swap(i, j int) { l[i], l[j] = l[j], l[i] }
+func (l byShare) Less(i, j int) bool {
+	if l[i].Percent != l[j].Percent {
+		return l[i].Percent > l[j].Percent
+	}
+	return l[i].NormalizedScore > l[j].NormalizedScore
+}
+
+// script is one of the scripts CLD2 recognizes a language in.
+type script struct {
+	code string // ISO 15924 code, e.g. "Cyrl"
+	name string // Unicode script name, e.g. "Cyrillic"
+}
+
+// detectScript returns the ISO 15924 code of the script language code is written in in
+// text. For languages CLD2 recognizes in several scripts, such as Serbian, that is the
+// one with the most letters in text; for undetermined languages it is empty.
+func detectScript(text string, code string) string {
+	scripts := languageScripts(code)
+	if len(scripts) == 0 {
+		return ""
+	}
+	if len(scripts) == 1 {
+		return scripts[0].code
+	}
+
+	best, bestLetters := scripts[0].code, 0
+	for _, script := range scripts {
+		table, found := unicode.Scripts[script.name]
+		if !found {
+			continue
+		}
+		letters := 0
+		for _, r := range text {
+			if unicode.Is(table, r) {
+				letters++
+			}
+		}
+		if letters > bestLetters {
+			best, bestLetters = script.code, letters
+		}
+	}
+	return best
+}
+
+// threshold returns the minimum to apply given the value from Options and the
+// Detector's default.
+func threshold(option int, fallback int) int {
+	if option == 0 {
+		return fallback
+	}
+	return option
+}
+
+// countLetters returns the number of letters in text.
+func countLetters(text string) int {
+	letters := 0
+	for _, r := range text {
+		if unicode.IsLetter(r) {
+			letters++
+		}
+	}
+	return letters
+}
@@ -0,0 +1,510 @@
+package detector
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert" // Assertion package
+)
+
+func TestDetect(t *testing.T) {
+	fmt.Println(">> Testing Detect...")
+
+	result, err := New().Detect(context.Background(), "para poner este importante proyecto en práctica", Options{})
+	assert.Nil(t, err, "detection should not error")
+	assert.Equal(t, "es", result.Code)
+	assert.True(t, len(result.Languages) > 0, "result should contain at least one language")
+	assert.Equal(t, "es", result.Languages[0].Code)
+	assert.True(t, result.Languages[0].Percent > 0, "percent should be positive")
+	assert.True(t, result.TextBytes > 0, "text_bytes should be positive")
+	assert.Equal(t, 0, len(result.Segments), "segments should only be returned when requested")
+}
+
+func TestDetectErrors(t *testing.T) {
+	fmt.Println(">> Testing Detect errors...")
+
+	d := New()
+	_, err := d.Detect(context.Background(), "some text", Options{Hints: Hints{Language: "klingon"}})
+	assert.Equal(t, "Unknown language_hint: klingon", err.Error())
+
+	// Offsets of invalid bytes refer to the text before preprocessing
+	_, err = d.Detect(context.Background(), "@golos \xd1\xe5\xe3\xee\xe4\xed\xff", Options{})
+	assert.Equal(t, &InvalidUTF8Error{ValidPrefixBytes: 7}, err)
+	_, err = d.Detect(context.Background(), "text with a \x01 control character", Options{})
+	assert.Equal(t, "Invalid UTF-8 text at byte 12", err.Error())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = d.Detect(ctx, "some text", Options{})
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestDetectDefaultFilters(t *testing.T) {
+	fmt.Println(">> Testing Detect default filters...")
+
+	code := "```\nfunction main() { return console.log(\"this is some code in the post\"); }\n```\n"
+	text := code + "Сегодня мы расскажем о том, как работает блокчейн."
+
+	d := New()
+	withDefaults, err := d.Detect(context.Background(), text, Options{})
+	assert.Nil(t, err, "detection should not error")
+	withoutFilters, err := d.Detect(context.Background(), text, Options{Filters: []TextFilter{}})
+	assert.Nil(t, err, "detection should not error")
+	assert.Equal(t, "ru", withDefaults.Code)
+	assert.True(t, withDefaults.TextBytes < withoutFilters.TextBytes, "default filters should strip code")
+
+	// HTML is left alone unless filters are given explicitly
+	html, err := d.Detect(context.Background(), "<p>"+text+"</p>", Options{IsHTML: true})
+	assert.Nil(t, err, "detection should not error")
+	filteredHtml, err := d.Detect(context.Background(), "<p>"+text+"</p>", Options{IsHTML: true, Filters: d.DefaultFilters})
+	assert.Nil(t, err, "detection should not error")
+	assert.True(t, filteredHtml.TextBytes < html.TextBytes, "HTML should not be preprocessed by default")
+}
+
+func TestPreprocessOffsets(t *testing.T) {
+	fmt.Println(">> Testing offset mapping of preprocessed text...")
+
+	text := "  RT @user: hello\tthere http://t.co/x  world"
+	filters, err := GetTextFilters([]string{"mentions", "urls"})
+	assert.Nil(t, err, "filters should be registered")
+	stripped, offsets := Preprocess(text, filters)
+	assert.Equal(t, "  RT  : hello\tthere    world", stripped)
+
+	// Every kept word maps back to the same word in the original text
+	for _, word := range []string{"RT", "hello", "there", "world"} {
+		offset := offsets.Original(strings.Index(stripped, word))
+		assert.Equal(t, word, text[offset:offset+len(word)])
+	}
+
+	// Boundaries map to the start and end of the original text
+	assert.Equal(t, 0, offsets.Original(0))
+	assert.Equal(t, len(text), offsets.Original(len(stripped)))
+
+	// Replaced ranges map to the end of the preceding kept text
+	assert.Equal(t, strings.Index(text, "@"), offsets.Original(strings.Index(stripped, "RT")+3))
+}
+
+func TestPreprocessFilters(t *testing.T) {
+	fmt.Println(">> Testing preprocessing filters...")
+
+	tests := []struct {
+		filter   string
+		text     string
+		expected string
+	}{
+		{"code", "before ```go\nfmt.Println()\n``` after `x := 1` end", "before   after   end"},
+		{"code", "before ~~~\nunterminated", "before  "},
+		{"markdown", "look ![cat](http://x/cat.png) at [this post](/@user/post) :smile:", "look   at  this post   "},
+		{"markdown", "text <!-- hidden --> <center>more</center>", "text    more "},
+		{"markdown", "[1]: http://example.com\nbody", " \nbody"},
+		{"urls", "see www.golos.io and https://golos.io/ru now", "see   and   now"},
+		{"emails", "write to user@example.com today", "write to   today"},
+		{"mentions", "thanks @golos-user and user@example.com", "thanks   and user@example.com"},
+		{"hashtags", "#golos is #1 &#39; ## heading", "  is   &#39; ## heading"},
+		{"numbers", "in 2017 about 1,5 or 12:30", "in   about   or  "},
+	}
+
+	for _, test := range tests {
+		filters, err := GetTextFilters([]string{test.filter})
+		assert.Nil(t, err, "filter should be registered")
+		stripped, _ := Preprocess(test.text, filters)
+		assert.Equal(t, test.expected, stripped, test.filter+" should strip "+test.text)
+	}
+
+	_, err := GetTextFilters([]string{"urls", "bogus"})
+	assert.Equal(t, "Unknown preprocess filter: bogus", err.Error())
+}
+
+func TestTranscode(t *testing.T) {
+	fmt.Println(">> Testing Transcode...")
+
+	russian := "Сегодня мы расскажем о том, как работает блокчейн и почему он так важен для всех нас."
+	french := "Nous allons présenter ce projet très intéressant à la communauté dès la semaine prochaine."
+	japanese := "今日はブロックチェーンの仕組みと、それがなぜ私たち全員にとって重要なのかについてお話しします。"
+
+	for _, test := range []struct {
+		encoding string
+		text     string
+	}{
+		{"windows-1251", russian},
+		{"koi8-r", russian},
+		{"cp866", russian},
+		{"iso-8859-5", russian},
+		{"latin1", french},
+		{"windows-1252", french},
+		{"shift_jis", japanese},
+		{"euc-jp", japanese},
+		{"utf-16le", japanese},
+	} {
+		encoded, err := Decoders[test.encoding].NewEncoder().String(test.text)
+		assert.Nil(t, err, "encoding test text should not error")
+
+		decoded, name, err := Transcode(encoded, strings.ToUpper(test.encoding))
+		assert.Nil(t, err, "transcoding should not error")
+		assert.Equal(t, test.text, decoded, "text should be decoded from "+test.encoding)
+		assert.Equal(t, test.encoding, name)
+	}
+
+	_, _, err := Transcode(russian, "utf-7")
+	assert.Equal(t, "Unknown encoding: utf-7", err.Error())
+
+	// Every decoder can be passed on as encoding hint
+	for name := range Decoders {
+		_, found := Encodings[name]
+		assert.True(t, found, name+" should be a known encoding hint")
+	}
+}
+
+func TestGuessEncoding(t *testing.T) {
+	fmt.Println(">> Testing Transcode with automatic encoding detection...")
+
+	russian := "Сегодня мы расскажем о том, как работает блокчейн и почему он так важен для всех нас."
+	french := "Nous allons présenter ce projet très intéressant à la communauté dès la semaine prochaine."
+	japanese := "今日はブロックチェーンの仕組みと、それがなぜ私たち全員にとって重要なのかについてお話しします。"
+
+	for _, test := range []struct {
+		encoding string
+		text     string
+	}{
+		{"windows-1251", russian},
+		{"koi8-r", russian},
+		{"cp866", russian},
+		{"windows-1252", french},
+		{"shift_jis", japanese},
+		{"euc-kr", "오늘 우리는 블록체인이 어떻게 작동하는지에 대해 이야기할 것입니다."},
+		{"gbk", "今天我们将讨论区块链是如何工作的，以及为什么它对我们所有人都如此重要。"},
+	} {
+		encoded, err := Decoders[test.encoding].NewEncoder().String(test.text)
+		assert.Nil(t, err, "encoding test text should not error")
+
+		decoded, name, err := Transcode(encoded, AUTO_ENCODING)
+		assert.Nil(t, err, "transcoding should not error")
+		assert.Equal(t, test.encoding, name, "encoding should be guessed")
+		assert.Equal(t, test.text, decoded, "text should be decoded from "+test.encoding)
+	}
+
+	// Valid UTF-8 is left alone
+	decoded, name, err := Transcode(russian, AUTO_ENCODING)
+	assert.Nil(t, err, "transcoding should not error")
+	assert.Equal(t, russian, decoded)
+	assert.Equal(t, "utf-8", name)
+
+	// Detection reports the encoding and runs on the transcoded text
+	encoded, _ := Decoders["koi8-r"].NewEncoder().String("@golos " + russian)
+	result, err := New().Detect(context.Background(), encoded, Options{Encoding: AUTO_ENCODING, Segments: true})
+	assert.Nil(t, err, "detection should not error")
+	assert.Equal(t, "koi8-r", result.Encoding)
+
+	result, err = New().Detect(context.Background(), russian, Options{})
+	assert.Nil(t, err, "detection should not error")
+	assert.Equal(t, "", result.Encoding, "encoding should only be reported when requested")
+}
+
+func TestDetectTooShort(t *testing.T) {
+	fmt.Println(">> Testing Detect with minimum text lengths...")
+
+	text := "Сегодня мы расскажем о том, как работает блокчейн."
+	d := New()
+
+	// Texts without letters are never determined
+	result, err := d.Detect(context.Background(), "12345 !!!", Options{})
+	assert.Nil(t, err, "detection should not error")
+	assert.True(t, result.TooShort, "text without letters should be too short")
+	assert.Equal(t, UNDETERMINED_LANGUAGE_CODE, result.Code)
+	assert.False(t, result.Reliable, "undetermined text should not be reliable")
+	assert.Equal(t, 0, len(result.Languages), "undetermined text should have no languages")
+
+	result, err = d.Detect(context.Background(), text, Options{})
+	assert.Nil(t, err, "detection should not error")
+	assert.False(t, result.TooShort, "thresholds should be disabled by default")
+
+	// Letters are counted after preprocessing
+	result, err = d.Detect(context.Background(), "@golos_blockchain_community https://golos.io Привет", Options{MinLetters: 7})
+	assert.Nil(t, err, "detection should not error")
+	assert.True(t, result.TooShort, "text should have too few letters")
+	assert.Equal(t, UNDETERMINED_LANGUAGE_CODE, result.Code)
+
+	// The letters of markup are not counted in HTML
+	result, err = d.Detect(context.Background(), `<div class="post-body golos-content">x</div>`, Options{IsHTML: true, MinLetters: 3})
+	assert.Nil(t, err, "detection should not error")
+	assert.True(t, result.TooShort, "markup should not count as letters")
+	result, err = d.Detect(context.Background(), `<div class="post-body">`+text+`</div>`, Options{IsHTML: true, MinLetters: 10})
+	assert.Nil(t, err, "detection should not error")
+	assert.False(t, result.TooShort, "text within markup should count as letters")
+
+	result, err = d.Detect(context.Background(), text, Options{MinTextBytes: 1000})
+	assert.Nil(t, err, "detection should not error")
+	assert.True(t, result.TooShort, "text should have too few text bytes")
+
+	// Options override the Detector's thresholds, and negative values disable them
+	d.MinLetters = 1000
+	result, err = d.Detect(context.Background(), text, Options{})
+	assert.Nil(t, err, "detection should not error")
+	assert.True(t, result.TooShort, "Detector threshold should apply")
+	result, err = d.Detect(context.Background(), text, Options{MinLetters: 10})
+	assert.Nil(t, err, "detection should not error")
+	assert.False(t, result.TooShort, "Options threshold should override the Detector's")
+	result, err = d.Detect(context.Background(), text, Options{MinLetters: -1})
+	assert.Nil(t, err, "detection should not error")
+	assert.False(t, result.TooShort, "negative threshold should disable it")
+}
+
+func TestEngines(t *testing.T) {
+	fmt.Println(">> Testing detection engines...")
+
+	assert.Contains(t, EngineNames(), NGRAM_ENGINE)
+	assert.Contains(t, EngineNames(), ENSEMBLE_ENGINE)
+	assert.Equal(t, DefaultEngine().Name(), New().Engine.Name())
+
+	_, err := GetEngine("klingon")
+	assert.Equal(t, "Unknown engine: klingon", err.Error())
+
+	// Options pick the engine, and a zero Detector uses the default one
+	ngram, err := GetEngine(NGRAM_ENGINE)
+	assert.Nil(t, err)
+	text := "Сегодня мы расскажем о том, как работает блокчейн и почему он так важен для всех нас."
+	for _, d := range []*Detector{New(), &Detector{}} {
+		result, err := d.Detect(context.Background(), text, Options{Engine: ngram})
+		assert.Nil(t, err)
+		assert.Equal(t, "ru", result.Code)
+	}
+}
+
+func TestNgramEngine(t *testing.T) {
+	fmt.Println(">> Testing the n-gram engine...")
+
+	engine := NewNgramEngine(DefaultNgramModel)
+	assert.Contains(t, engine.Languages(), "ru")
+	assert.Contains(t, engine.Languages(), "uk")
+
+	text := "Сьогодні ми розповімо про те, як працює блокчейн і чому він такий важливий для всіх нас."
+	summary := engine.Summarize(text, Hints{}, false, true)
+	assert.Equal(t, "uk", summary.Code)
+	assert.True(t, summary.Reliable)
+	assert.Equal(t, "uk", summary.Languages[0].Code)
+	assert.True(t, summary.Languages[0].Percent >= 95)
+	assert.Equal(t, []Segment{{Offset: 0, Length: len(text), Code: "uk"}}, summary.Chunks)
+
+	// Markup is skipped in HTML
+	summary = engine.Summarize(`<p class="intro">Сегодня мы расскажем о блокчейне</p>`, Hints{}, true, false)
+	assert.Equal(t, "ru", summary.Code)
+	assert.Equal(t, len("Сегоднямырасскажемоблокчейне"), summary.TextBytes)
+
+	// Texts without any known n-gram are in an unknown language
+	summary = engine.Summarize("我能吞下玻璃而不伤身体。", Hints{}, false, false)
+	assert.Equal(t, UNKNOWN_LANGUAGE_CODE, summary.Code)
+	assert.Equal(t, 0, len(summary.Languages))
+
+	// Nothing is detected past invalid UTF-8 or control characters
+	summary = engine.Summarize("Golos: \xd1\xe5\xe3\xee", Hints{}, false, false)
+	assert.Equal(t, 7, summary.ValidPrefixBytes)
+	summary = engine.Summarize("Golos\x00", Hints{}, false, false)
+	assert.Equal(t, 5, summary.ValidPrefixBytes)
+	summary = engine.Summarize("Golos\r\n\tГолос", Hints{}, false, false)
+	assert.Equal(t, len("Golos\r\n\tГолос"), summary.ValidPrefixBytes)
+}
+
+// fixedEngine is an Engine finding the same languages in every text.
+type fixedEngine struct {
+	name     string
+	reliable bool
+	percents map[string]int
+}
+
+func (e fixedEngine) Name() string {
+	return e.name
+}
+
+func (e fixedEngine) Summarize(text string, hints Hints, isHTML bool, withChunks bool) Summary {
+	summary := Summary{Code: UNKNOWN_LANGUAGE_CODE, Reliable: e.reliable, TextBytes: len(text), ValidPrefixBytes: len(text)}
+	for code, percent := range e.percents {
+		summary.Languages = append(summary.Languages, Language{Code: code, Percent: percent, NormalizedScore: float64(percent) / 100})
+	}
+	sort.Sort(byShareThenCode(summary.Languages))
+	if len(summary.Languages) > 0 {
+		summary.Code = summary.Languages[0].Code
+	}
+	return summary
+}
+
+func TestEnsembleEngine(t *testing.T) {
+	fmt.Println(">> Testing the ensemble engine...")
+
+	// By default, every other engine votes with the same weight, in order of name
+	ensemble, err := GetEngine(ENSEMBLE_ENGINE)
+	assert.Nil(t, err)
+	weights := make(map[string]float64)
+	var voters []string
+	for _, name := range EngineNames() {
+		if name != ENSEMBLE_ENGINE {
+			weights[name] = 1
+			voters = append(voters, name)
+		}
+	}
+	assert.Equal(t, weights, ensemble.(*EnsembleEngine).Weights())
+	result, err := New().Detect(context.Background(), "Сьогодні ми розповімо про те, як працює блокчейн і чому він такий важливий для всіх нас.", Options{Engine: ensemble})
+	assert.Nil(t, err)
+	assert.Equal(t, "uk", result.Code)
+	if assert.Equal(t, len(voters), len(result.Votes)) {
+		for i, vote := range result.Votes {
+			assert.Equal(t, voters[i], vote.Engine)
+		}
+	}
+
+	// Weights decide between engines that disagree
+	for _, engine := range []fixedEngine{
+		{name: "ru_only", reliable: true, percents: map[string]int{"ru": 90, "uk": 10}},
+		{name: "uk_only", reliable: true, percents: map[string]int{"uk": 80, "bg": 20}},
+		{name: "none"},
+	} {
+		RegisterEngine(engine)
+		defer delete(engines, engine.name)
+	}
+	ensemble, err = NewEnsembleEngine(map[string]float64{"ru_only": 1, "uk_only": 3})
+	assert.Nil(t, err)
+	summary := ensemble.Summarize("Блокчейн", Hints{}, false, false)
+	assert.Equal(t, "uk", summary.Code)
+	assert.False(t, summary.Reliable, "engines that disagree should not be reliable")
+	assert.Equal(t, []Language{
+		{Code: "uk", Percent: 63, NormalizedScore: 0.625},
+		{Code: "ru", Percent: 23, NormalizedScore: 0.225},
+		{Code: "bg", Percent: 15, NormalizedScore: 0.15},
+	}, summary.Languages)
+	assert.Equal(t, []Vote{
+		{Engine: "ru_only", Weight: 1, Code: "ru", Reliable: true, Languages: []Language{{Code: "ru", Percent: 90, NormalizedScore: 0.9}, {Code: "uk", Percent: 10, NormalizedScore: 0.1}}},
+		{Engine: "uk_only", Weight: 3, Code: "uk", Reliable: true, Languages: []Language{{Code: "uk", Percent: 80, NormalizedScore: 0.8}, {Code: "bg", Percent: 20, NormalizedScore: 0.2}}},
+	}, summary.Votes)
+
+	// Engines finding nothing abstain
+	ensemble, err = NewEnsembleEngine(map[string]float64{"ru_only": 1, "none": 1})
+	assert.Nil(t, err)
+	summary = ensemble.Summarize("Блокчейн", Hints{}, false, false)
+	assert.Equal(t, "ru", summary.Code)
+	assert.True(t, summary.Reliable)
+	assert.Equal(t, 90, summary.Languages[0].Percent)
+	assert.Equal(t, 2, len(summary.Votes))
+	ensemble, err = NewEnsembleEngine(map[string]float64{"none": 1})
+	assert.Nil(t, err)
+	summary = ensemble.Summarize("Блокчейн", Hints{}, false, false)
+	assert.Equal(t, UNKNOWN_LANGUAGE_CODE, summary.Code)
+	assert.Equal(t, 0, len(summary.Languages))
+
+	// Only known engines with positive weights can vote
+	_, err = NewEnsembleEngine(map[string]float64{"klingon": 1})
+	assert.Equal(t, "Unknown engine: klingon", err.Error())
+	_, err = NewEnsembleEngine(map[string]float64{"ru_only": -1})
+	assert.Equal(t, "Invalid weight for engine ru_only: -1", err.Error())
+	_, err = NewEnsembleEngine(map[string]float64{ENSEMBLE_ENGINE: 1})
+	assert.Equal(t, "Ensemble cannot include engine ensemble", err.Error())
+	_, err = NewEnsembleEngine(nil)
+	assert.Equal(t, "Ensemble needs at least one engine", err.Error())
+}
+
+func TestNgramModelFile(t *testing.T) {
+	fmt.Println(">> Testing n-gram model files...")
+
+	dir, err := ioutil.TempDir("", "ngram_model")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	// Models are saved as JSON, gzipped with a .gz extension
+	model := NgramModel{Version: NGRAM_MODEL_VERSION, Profiles: map[string]NgramProfile{
+		"ru": TrainNgramProfile([]string{"Сегодня мы расскажем о блокчейне"}, 0),
+		"uk": TrainNgramProfile([]string{"Сьогодні ми розповімо про блокчейн"}, 0),
+	}}
+	for _, name := range []string{"model.json", "model.json.gz"} {
+		path := filepath.Join(dir, name)
+		assert.Nil(t, SaveNgramModel(model, path))
+		loaded, err := LoadNgramModel(path)
+		assert.Nil(t, err)
+		assert.Equal(t, model, loaded)
+	}
+	assert.Equal(t, "uk", NewNgramEngine(model).Summarize("Сьогодні", Hints{}, false, false).Code)
+
+	// Only valid models of the current version are loaded
+	path := filepath.Join(dir, "invalid.json")
+	for content, message := range map[string]string{
+		`{"version": 0, "profiles": {}}`:                                 "Unsupported n-gram model version 0 in " + path + ", expected 1",
+		`{"version": 1, "profiles": {}}`:                                 "Invalid n-gram model " + path + ": no languages",
+		`{"version": 1, "profiles": {"ru": {"total": 0, "ngrams": {}}}}`: "Invalid n-gram model " + path + ": empty profile for ru",
+	} {
+		assert.Nil(t, ioutil.WriteFile(path, []byte(content), 0644))
+		_, err = LoadNgramModel(path)
+		if assert.NotNil(t, err, content) {
+			assert.Equal(t, message, err.Error())
+		}
+	}
+	assert.Nil(t, ioutil.WriteFile(path, []byte(`{"version": 1, "profiles": []}`), 0644))
+	_, err = LoadNgramModel(path)
+	assert.Contains(t, err.Error(), "Invalid n-gram model "+path+": json: cannot unmarshal array")
+	_, err = LoadNgramModel(filepath.Join(dir, "missing.json"))
+	assert.NotNil(t, err)
+}
+
+func TestTrainNgramProfile(t *testing.T) {
+	fmt.Println(">> Testing n-gram profile training...")
+
+	profile := TrainNgramProfile([]string{"Aba, ab!"}, 0)
+	assert.Equal(t, map[string]int{
+		"a": 3, "b": 2,
+		" a": 2, "ab": 2, "ba": 1, "a ": 1, "b ": 1,
+		" ab": 2, "aba": 1, "ba ": 1, "ab ": 1,
+	}, profile.Ngrams)
+	assert.Equal(t, 17, profile.Total)
+
+	// Only the most frequent n-grams are kept, alphabetically among equals
+	profile = TrainNgramProfile([]string{"Aba, ab!"}, 3)
+	assert.Equal(t, map[string]int{"a": 3, " a": 2, " ab": 2}, profile.Ngrams)
+	assert.Equal(t, 17, profile.Total)
+}
+
+func TestNgramHeldOut(t *testing.T) {
+	fmt.Println(">> Testing the n-gram engine on texts it was not trained with...")
+
+	var training []string
+	paths, err := filepath.Glob("../data/ngram/*.txt")
+	assert.Nil(t, err)
+	for _, path := range paths {
+		texts, err := ioutil.ReadFile(path)
+		assert.Nil(t, err)
+		training = append(training, string(texts))
+	}
+	assert.Equal(t, len(DefaultNgramModel.Profiles), len(training), "every language should have its training texts")
+
+	engine := NewNgramEngine(DefaultNgramModel)
+	for _, test := range []struct {
+		text string
+		code string
+	}{
+		{"Учора ўвечары мы доўга гулялі па старым горадзе і размаўлялі пра кнігі, якія прачыталі летам.", "be"},
+		{"Вчера вечерта дълго се разхождахме из стария град и си говорихме за книгите, които прочетохме през лятото.", "bg"},
+		{"Včera večer jsme se dlouho procházeli starým městem a povídali si o knihách, které jsme v létě přečetli.", "cs"},
+		{"I går aftes gik vi længe rundt i den gamle bydel og snakkede om de bøger, vi havde læst i sommer.", "da"},
+		{"Gestern Abend sind wir lange durch die Altstadt spaziert und haben über die Bücher gesprochen, die wir im Sommer gelesen haben.", "de"},
+		{"Last night we walked around the old town for a long time and talked about the books we had read over the summer.", "en"},
+		{"Anoche paseamos mucho tiempo por el casco antiguo y hablamos de los libros que habíamos leído durante el verano.", "es"},
+		{"Eilen illalla kävelimme pitkään vanhassa kaupungissa ja puhuimme kirjoista, jotka olimme lukeneet kesällä.", "fi"},
+		{"Hier soir, nous nous sommes longtemps promenés dans la vieille ville en parlant des livres que nous avions lus cet été.", "fr"},
+		{"Tegnap este sokáig sétáltunk az óvárosban, és azokról a könyvekről beszélgettünk, amelyeket nyáron olvastunk.", "hu"},
+		{"Ieri sera abbiamo passeggiato a lungo per il centro storico e abbiamo parlato dei libri che avevamo letto durante l'estate.", "it"},
+		{"Gisteravond hebben we lang door de oude binnenstad gewandeld en gepraat over de boeken die we deze zomer hadden gelezen.", "nl"},
+		{"I går kveld gikk vi lenge rundt i gamlebyen og snakket om bøkene vi hadde lest i løpet av sommeren.", "no"},
+		{"Wczoraj wieczorem długo spacerowaliśmy po starym mieście i rozmawialiśmy o książkach, które przeczytaliśmy latem.", "pl"},
+		{"Ontem à noite passeámos muito tempo pela cidade velha e falámos dos livros que tínhamos lido durante o verão.", "pt"},
+		{"Aseară ne-am plimbat mult prin orașul vechi și am vorbit despre cărțile pe care le citiserăm în timpul verii.", "ro"},
+		{"Вчера вечером мы долго гуляли по старому городу и говорили о книгах, которые прочитали летом.", "ru"},
+		{"I går kväll promenerade vi länge i gamla stan och pratade om böckerna vi hade läst under sommaren.", "sv"},
+		{"Учора ввечері ми довго гуляли старим містом і розмовляли про книжки, які прочитали влітку.", "uk"},
+	} {
+		for _, texts := range training {
+			assert.NotContains(t, texts, test.text, "held out texts should not be trained with")
+		}
+		assert.Equal(t, test.code, engine.Summarize(test.text, Hints{}, false, false).Code, test.text)
+	}
+}
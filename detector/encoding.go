@@ -0,0 +1,231 @@
+package detector
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"                    // Character encoding interfaces
+	"golang.org/x/text/encoding/charmap"            // Single byte encodings
+	"golang.org/x/text/encoding/japanese"           // Japanese encodings
+	"golang.org/x/text/encoding/korean"             // Korean encodings
+	"golang.org/x/text/encoding/simplifiedchinese"  // Simplified Chinese encodings
+	"golang.org/x/text/encoding/traditionalchinese" // Traditional Chinese encodings
+	"golang.org/x/text/encoding/unicode/utf32"      // UTF-32 encodings
+
+	unicodeEncoding "golang.org/x/text/encoding/unicode" // UTF-16 encodings
+)
+
+const AUTO_ENCODING = "auto" // Guess the encoding of texts that are not valid UTF-8
+
+// Decoders maps the names in Encodings that have a pure Go decoder to their encoding.
+// Where Go only has a superset of an encoding (e.g. GBK for GB2312), the superset is used.
+var Decoders = map[string]encoding.Encoding{
+	"iso-8859-1":   charmap.ISO8859_1,
+	"latin1":       charmap.ISO8859_1,
+	"iso-8859-2":   charmap.ISO8859_2,
+	"latin2":       charmap.ISO8859_2,
+	"iso-8859-3":   charmap.ISO8859_3,
+	"iso-8859-4":   charmap.ISO8859_4,
+	"iso-8859-5":   charmap.ISO8859_5,
+	"iso-8859-6":   charmap.ISO8859_6,
+	"iso-8859-7":   charmap.ISO8859_7,
+	"iso-8859-8":   charmap.ISO8859_8,
+	"iso-8859-9":   charmap.ISO8859_9,
+	"iso-8859-10":  charmap.ISO8859_10,
+	"euc-jp":       japanese.EUCJP,
+	"shift_jis":    japanese.ShiftJIS,
+	"sjis":         japanese.ShiftJIS,
+	"iso-2022-jp":  japanese.ISO2022JP,
+	"big5":         traditionalchinese.Big5,
+	"gb2312":       simplifiedchinese.GBK,
+	"euc-kr":       korean.EUCKR,
+	"big5-cp950":   traditionalchinese.Big5,
+	"cp932":        japanese.ShiftJIS,
+	"utf-8":        encoding.Nop,
+	"ascii":        encoding.Nop,
+	"koi8-r":       charmap.KOI8R,
+	"windows-1251": charmap.Windows1251,
+	"cp1251":       charmap.Windows1251,
+	"windows-1252": charmap.Windows1252,
+	"cp1252":       charmap.Windows1252,
+	"koi8-u":       charmap.KOI8U,
+	"windows-1250": charmap.Windows1250,
+	"cp1250":       charmap.Windows1250,
+	"iso-8859-15":  charmap.ISO8859_15,
+	"windows-1254": charmap.Windows1254,
+	"cp1254":       charmap.Windows1254,
+	"windows-1257": charmap.Windows1257,
+	"cp1257":       charmap.Windows1257,
+	"iso-8859-11":  charmap.Windows874,
+	"tis-620":      charmap.Windows874,
+	"windows-874":  charmap.Windows874,
+	"cp874":        charmap.Windows874,
+	"windows-1256": charmap.Windows1256,
+	"cp1256":       charmap.Windows1256,
+	"windows-1255": charmap.Windows1255,
+	"cp1255":       charmap.Windows1255,
+	"iso-8859-8-i": charmap.ISO8859_8I,
+	"cp852":        charmap.CodePage852,
+	"windows-1253": charmap.Windows1253,
+	"cp1253":       charmap.Windows1253,
+	"cp866":        charmap.CodePage866,
+	"ibm866":       charmap.CodePage866,
+	"iso-8859-13":  charmap.ISO8859_13,
+	"gbk":          simplifiedchinese.GBK,
+	"gb18030":      simplifiedchinese.GB18030,
+	"big5-hkscs":   traditionalchinese.Big5,
+	"macintosh":    charmap.Macintosh,
+	"utf-16be":     unicodeEncoding.UTF16(unicodeEncoding.BigEndian, unicodeEncoding.IgnoreBOM),
+	"utf-16le":     unicodeEncoding.UTF16(unicodeEncoding.LittleEndian, unicodeEncoding.IgnoreBOM),
+	"utf-32be":     utf32.UTF32(utf32.BigEndian, utf32.IgnoreBOM),
+	"utf-32le":     utf32.UTF32(utf32.LittleEndian, utf32.IgnoreBOM),
+	"hz-gb-2312":   simplifiedchinese.HZGB2312,
+}
+
+// AutoEncodings are the encodings AUTO_ENCODING picks from, in order of preference
+// when several decode a text equally well.
+var AutoEncodings = []string{"windows-1251", "koi8-r", "cp866", "windows-1252", "shift_jis", "euc-kr", "gbk", "big5"}
+
+// Transcode converts text from the named encoding to UTF-8, and returns it along with
+// the name of the encoding it was decoded from. With AUTO_ENCODING, valid UTF-8 is
+// returned as is and anything else is decoded with the most plausible of AutoEncodings.
+func Transcode(text string, encodingName string) (string, string, error) {
+	name := strings.ToLower(encodingName)
+	if name == AUTO_ENCODING {
+		if utf8.ValidString(text) {
+			return text, "utf-8", nil
+		}
+		return guessEncoding(text)
+	}
+
+	decoder, found := Decoders[name]
+	if !found {
+		return "", "", errors.New("Unknown encoding: " + encodingName)
+	}
+	decoded, err := decoder.NewDecoder().String(text)
+	if err != nil {
+		return "", "", errors.New("Unable to decode text as " + encodingName + ": " + err.Error())
+	}
+	return decoded, name, nil
+}
+
+// guessEncoding decodes text with each of AutoEncodings and keeps the result that
+// looks most like natural language.
+func guessEncoding(text string) (string, string, error) {
+	best, bestName, bestScore := "", "", 0
+	for _, name := range AutoEncodings {
+		decoded, err := Decoders[name].NewDecoder().String(text)
+		if err != nil {
+			continue
+		}
+		score := plausibility(decoded)
+		if bestName == "" || score > bestScore {
+			best, bestName, bestScore = decoded, name, score
+		}
+	}
+	if bestName == "" {
+		return "", "", errors.New("Unable to guess the encoding of text")
+	}
+	return best, bestName, nil
+}
+
+// plausibility scores how much decoded text looks like natural language, rather than
+// text decoded with the wrong encoding. Letters count for it, while words that flip
+// case or script halfway, Latin words made up mostly of accented letters, and stray
+// symbols count against it, undecodable bytes and control characters most of all.
+func plausibility(text string) int {
+	score := 0
+	var prev rune                  // Previous letter of the current word
+	var script *unicode.RangeTable // Script of the current word
+	letters, accented := 0, 0      // Letters of the current word, and accented Latin ones among them
+	endWord := func() {
+		if letters >= 3 && accented*2 > letters {
+			score -= 2 * letters
+		}
+		prev, script, letters, accented = 0, nil, 0, 0
+	}
+
+	for _, r := range text {
+		switch {
+		case r == utf8.RuneError || (unicode.IsControl(r) && !unicode.IsSpace(r)):
+			endWord()
+			score -= 10
+		case unicode.IsLetter(r):
+			letterScript := scriptOf(r)
+			score += width(r)
+			if prev != 0 && unicode.IsLower(prev) && unicode.IsUpper(r) {
+				score -= 3
+			}
+			if script != nil && letterScript != nil && letterScript != script {
+				score -= 3
+			}
+			if letterScript == unicode.Latin && r >= utf8.RuneSelf {
+				accented++
+			}
+			if letterScript != nil {
+				script = letterScript
+			}
+			prev = r
+			letters++
+		case unicode.IsMark(r):
+		default:
+			endWord()
+			if unicode.In(r, typography) {
+				score += width(r)
+			} else if r >= utf8.RuneSelf && !unicode.IsSpace(r) && !unicode.IsDigit(r) {
+				score -= 2
+			}
+		}
+	}
+	endWord()
+
+	return score
+}
+
+// width returns how much letter or punctuation r counts towards plausibility. CJK
+// characters are decoded from two bytes or more, so they count double to compare
+// with single byte encodings. Halfwidth katakana are rarely used, but single bytes of
+// other encodings decode to them in Shift-JIS, so they do not count at all.
+func width(r rune) int {
+	switch {
+	case r >= 0xff61 && r <= 0xff9f:
+		return 0
+	case r >= 0x2e80 && r <= 0x9fff, r >= 0xac00 && r <= 0xd7af, r >= 0xf900 && r <= 0xfaff, r >= 0xff01 && r <= 0xff60:
+		return 2 // CJK, kana, Hangul and fullwidth forms
+	case r >= utf8.RuneSelf && !unicode.IsLetter(r):
+		return 0 // Other typography
+	}
+	return 1
+}
+
+// scripts are the scripts plausibility tells apart. Japanese mixes Han and kana within
+// words, so kana are reported as Han.
+var scripts = []*unicode.RangeTable{unicode.Latin, unicode.Cyrillic, unicode.Greek, unicode.Arabic, unicode.Hebrew, unicode.Thai, unicode.Hangul}
+
+// scriptOf returns the script of letter r, or nil if it is not one of scripts.
+func scriptOf(r rune) *unicode.RangeTable {
+	if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana) {
+		return unicode.Han
+	}
+	for _, script := range scripts {
+		if unicode.Is(script, r) {
+			return script
+		}
+	}
+	return nil
+}
+
+// typography holds the non-ASCII punctuation commonly found in natural text.
+var typography = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x00ab, Hi: 0x00ab, Stride: 1}, // «
+		{Lo: 0x00bb, Hi: 0x00bb, Stride: 1}, // »
+		{Lo: 0x2010, Hi: 0x205e, Stride: 1}, // General punctuation: dashes, quotes, ellipsis
+		{Lo: 0x2116, Hi: 0x2116, Stride: 1}, // №
+		{Lo: 0x3000, Hi: 0x303f, Stride: 1}, // CJK punctuation
+		{Lo: 0xff01, Hi: 0xff65, Stride: 1}, // Fullwidth and halfwidth forms
+	},
+	LatinOffset: 2,
+}
@@ -0,0 +1,81 @@
+package detector
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+// Names of the engines this package registers.
+const (
+	CLD2_ENGINE     = "cld2"     // CLD2, through cgo
+	NGRAM_ENGINE    = "ngram"    // Pure-Go n-gram model, see NgramEngine
+	ENSEMBLE_ENGINE = "ensemble" // Weighted vote of the other engines, see EnsembleEngine
+)
+
+// Scoring table variants CLD2 can be built with, see cld2/internal/compile_libs.sh.
+const (
+	TABLES_CHROME = "chrome" // Default tables, for about 80 languages
+	TABLES_FULL   = "full"   // Larger octa tables, for more languages such as Tatar or Bashkir
+)
+
+var engines = make(map[string]Engine)
+
+// Engine is a language detection backend. A Detector transcodes, validates options and
+// preprocesses texts before handing them to its Engine, then applies thresholds and
+// allowed languages to the Summary it gets back.
+type Engine interface {
+	// Name is the name requests select the engine by.
+	Name() string
+	// Summarize scores text. Hints have already been validated, and may be ignored by
+	// engines that have no use for them. HTML text still holds its markup.
+	Summarize(text string, hints Hints, isHTML bool, withChunks bool) Summary
+}
+
+// Summary is the outcome of running an Engine over a single, already preprocessed, text.
+type Summary struct {
+	Code      string     // Most likely language code, UNKNOWN_LANGUAGE_CODE if none
+	Languages []Language // Up to three languages found in the text, best first
+	TextBytes int        // Number of letter bytes scored
+	Reliable  bool
+	Chunks    []Segment // Language of each span of the text, if requested
+	Votes     []Vote    // What each engine of an EnsembleEngine found, nil for other engines
+
+	// ValidPrefixBytes is the number of leading bytes of the text that are valid UTF-8.
+	// When less than the text's length, nothing is detected.
+	ValidPrefixBytes int
+}
+
+// RegisterEngine makes engine selectable by its name, replacing any engine previously
+// registered under that name.
+func RegisterEngine(engine Engine) {
+	engines[engine.Name()] = engine
+}
+
+// GetEngine returns the registered engine with the given name.
+func GetEngine(name string) (Engine, error) {
+	engine, found := engines[strings.TrimSpace(name)]
+	if !found {
+		return nil, errors.New("Unknown engine: " + name)
+	}
+	return engine, nil
+}
+
+// EngineNames returns the names of all registered engines, sorted.
+func EngineNames() []string {
+	names := make([]string, 0, len(engines))
+	for name := range engines {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DefaultEngine returns the engine Detectors use unless told otherwise: CLD2, or the
+// n-gram engine when built without cgo.
+func DefaultEngine() Engine {
+	if engine, found := engines[CLD2_ENGINE]; found {
+		return engine
+	}
+	return engines[NGRAM_ENGINE]
+}
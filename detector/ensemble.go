@@ -0,0 +1,156 @@
+package detector
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+)
+
+func init() {
+	RegisterEngine(&EnsembleEngine{})
+}
+
+// EnsembleEngine is an Engine combining the languages other registered engines find in
+// a text by weighted voting. Each language gets the weighted average of its Percent
+// across engines. Engines that find no language abstain, so that languages only some
+// engines know are not penalized.
+type EnsembleEngine struct {
+	weights map[string]float64 // Weight of each engine by name, every other engine with weight 1 if nil
+}
+
+// Vote is what one of the engines of an EnsembleEngine found in a text.
+type Vote struct {
+	Engine    string
+	Weight    float64
+	Code      string
+	Reliable  bool
+	Languages []Language
+}
+
+// NewEnsembleEngine returns an EnsembleEngine combining the registered engines named in
+// weights. An error is returned for unknown engines, ensembles and weights that are not
+// positive.
+func NewEnsembleEngine(weights map[string]float64) (*EnsembleEngine, error) {
+	if len(weights) == 0 {
+		return nil, errors.New("Ensemble needs at least one engine")
+	}
+	for name, weight := range weights {
+		engine, err := GetEngine(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, isEnsemble := engine.(*EnsembleEngine); isEnsemble {
+			return nil, errors.New("Ensemble cannot include engine " + name)
+		}
+		if weight <= 0 || math.IsInf(weight, 0) || math.IsNaN(weight) {
+			return nil, errors.New("Invalid weight for engine " + name + ": " + strconv.FormatFloat(weight, 'g', -1, 64))
+		}
+	}
+	return &EnsembleEngine{weights: weights}, nil
+}
+
+func (e *EnsembleEngine) Name() string {
+	return ENSEMBLE_ENGINE
+}
+
+// Weights returns the weight of each engine e combines, by name.
+func (e *EnsembleEngine) Weights() map[string]float64 {
+	if e.weights != nil {
+		return e.weights
+	}
+	weights := make(map[string]float64)
+	for name, engine := range engines {
+		if _, isEnsemble := engine.(*EnsembleEngine); !isEnsemble {
+			weights[name] = 1
+		}
+	}
+	return weights
+}
+
+// Summarize runs every engine of e over text, by name, and returns the languages they
+// found by weighted vote, along with their Votes. Engines are looked up when detecting,
+// so that replacing a registered engine also replaces it in ensembles. The result is
+// reliable when every engine that found a language agrees, and one of them finds it
+// reliable.
+func (e *EnsembleEngine) Summarize(text string, hints Hints, isHTML bool, withChunks bool) Summary {
+	weights := e.Weights()
+	names := make([]string, 0, len(weights))
+	for name := range weights {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	summary := Summary{Code: UNKNOWN_LANGUAGE_CODE, ValidPrefixBytes: len(text)}
+	shares := make(map[string]float64)
+	totalWeight := 0.0
+	for _, name := range names {
+		engineSummary := engines[name].Summarize(text, hints, isHTML, withChunks)
+		if engineSummary.ValidPrefixBytes < summary.ValidPrefixBytes {
+			summary.ValidPrefixBytes = engineSummary.ValidPrefixBytes
+		}
+		if engineSummary.TextBytes > summary.TextBytes {
+			summary.TextBytes = engineSummary.TextBytes
+		}
+		if summary.Chunks == nil {
+			summary.Chunks = engineSummary.Chunks
+		}
+
+		weight := weights[name]
+		if len(engineSummary.Languages) > 0 {
+			totalWeight += weight
+		}
+		for _, language := range engineSummary.Languages {
+			shares[language.Code] += weight * float64(language.Percent) / 100
+		}
+		summary.Votes = append(summary.Votes, Vote{
+			Engine:    name,
+			Weight:    weight,
+			Code:      engineSummary.Code,
+			Reliable:  engineSummary.Reliable,
+			Languages: engineSummary.Languages,
+		})
+	}
+	if len(shares) == 0 {
+		return summary
+	}
+
+	languages := make([]Language, 0, len(shares))
+	for code, share := range shares {
+		share /= totalWeight
+		languages = append(languages, Language{Code: code, Percent: int(math.Floor(share*100 + 0.5)), NormalizedScore: share})
+	}
+	sort.Sort(byShareThenCode(languages))
+	for _, language := range languages {
+		if len(summary.Languages) == 3 || language.Percent == 0 {
+			break
+		}
+		summary.Languages = append(summary.Languages, language)
+	}
+	if len(summary.Languages) == 0 {
+		return summary
+	}
+
+	summary.Code = summary.Languages[0].Code
+	for _, vote := range summary.Votes {
+		if vote.Code != UNKNOWN_LANGUAGE_CODE && vote.Code != summary.Code {
+			summary.Reliable = false
+			break
+		}
+		summary.Reliable = summary.Reliable || vote.Reliable
+	}
+	return summary
+}
+
+// byShareThenCode sorts languages by their share of the text, then by code so that
+// ties do not depend on the order engines found them in.
+type byShareThenCode []Language
+
+func (l byShareThenCode) Len() int      { return len(l) }
+func (l byShareThenCode) Swap(i, j int) { l[i], l[j] = l[j], l[i] }
+func (l byShareThenCode) Less(i, j int) bool {
+	if l[i].NormalizedScore != l[j].NormalizedScore {
+		return l[i].NormalizedScore > l[j].NormalizedScore
+	}
+	return l[i].Code < l[j].Code
+}
@@ -0,0 +1,128 @@
+package detector
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	MAX_CONTENT_LANGUAGE_TAGS = 5 // CLD2 ignores Content-Language hints with more tags
+	MAX_TLD_HINT_LENGTH       = 3 // CLD2 ignores TLD hints longer than this
+
+	UNKNOWN_ENCODING = 23 // CLD2's UNKNOWN_ENCODING, passed when no encoding_hint is set
+)
+
+// Hints holds the optional priors a client can send along with a text.
+// Empty fields are not passed on to CLD2.
+type Hints struct {
+	ContentLanguage string // Content-Language header value, e.g. "mi,en"
+	TLD             string // Last element of the hostname, e.g. "id"
+	Language        string // Declared language tag, e.g. "it"
+	Encoding        string // Name of the text's original encoding, e.g. "shift_jis"
+}
+
+// Encodings maps encoding names accepted in requests to CLD2's Encoding enum
+// values from cld2/public/encodings.h.
+var Encodings = map[string]int{
+	"iso-8859-1":   0,
+	"latin1":       0,
+	"iso-8859-2":   1,
+	"latin2":       1,
+	"iso-8859-3":   2,
+	"iso-8859-4":   3,
+	"iso-8859-5":   4,
+	"iso-8859-6":   5,
+	"iso-8859-7":   6,
+	"iso-8859-8":   7,
+	"iso-8859-9":   8,
+	"iso-8859-10":  9,
+	"euc-jp":       10,
+	"shift_jis":    11,
+	"sjis":         11,
+	"iso-2022-jp":  12,
+	"big5":         13,
+	"gb2312":       14,
+	"euc-kr":       16,
+	"big5-cp950":   20,
+	"cp932":        21,
+	"utf-8":        22,
+	"ascii":        24,
+	"koi8-r":       25,
+	"windows-1251": 26,
+	"cp1251":       26,
+	"windows-1252": 27,
+	"cp1252":       27,
+	"koi8-u":       28,
+	"windows-1250": 29,
+	"cp1250":       29,
+	"iso-8859-15":  30,
+	"windows-1254": 31,
+	"cp1254":       31,
+	"windows-1257": 32,
+	"cp1257":       32,
+	"iso-8859-11":  33,
+	"tis-620":      33,
+	"windows-874":  34,
+	"cp874":        34,
+	"windows-1256": 35,
+	"cp1256":       35,
+	"windows-1255": 36,
+	"cp1255":       36,
+	"iso-8859-8-i": 37,
+	"cp852":        39,
+	"windows-1253": 41,
+	"cp1253":       41,
+	"cp866":        42,
+	"ibm866":       42,
+	"iso-8859-13":  43,
+	"iso-2022-kr":  44,
+	"gbk":          45,
+	"gb18030":      46,
+	"big5-hkscs":   47,
+	"iso-2022-cn":  48,
+	"macintosh":    53,
+	"utf-7":        54,
+	"utf-16be":     57,
+	"utf-16le":     58,
+	"utf-32be":     59,
+	"utf-32le":     60,
+	"hz-gb-2312":   62,
+}
+
+// Validate checks that every hint set on hints is one CLD2 understands.
+func (hints Hints) Validate() error {
+	if hints.ContentLanguage != "" {
+		tags := strings.Split(hints.ContentLanguage, ",")
+		if len(tags) > MAX_CONTENT_LANGUAGE_TAGS {
+			return errors.New("Too many content_language_hint tags")
+		}
+		for _, tag := range tags {
+			if LanguageFromName(strings.TrimSpace(tag)) == UNKNOWN_LANGUAGE_CODE {
+				return errors.New("Unknown content_language_hint tag: " + strings.TrimSpace(tag))
+			}
+		}
+	}
+
+	if hints.TLD != "" {
+		if len(hints.TLD) > MAX_TLD_HINT_LENGTH || strings.IndexFunc(hints.TLD, isNotTLDLetter) != -1 {
+			return errors.New("Invalid tld_hint: " + hints.TLD)
+		}
+	}
+
+	if hints.Language != "" && LanguageFromName(hints.Language) == UNKNOWN_LANGUAGE_CODE {
+		return errors.New("Unknown language_hint: " + hints.Language)
+	}
+
+	if hints.Encoding != "" {
+		if _, found := Encodings[strings.ToLower(hints.Encoding)]; !found {
+			return errors.New("Unknown encoding_hint: " + hints.Encoding)
+		}
+	}
+
+	return nil
+}
+
+// isNotTLDLetter reports whether r cannot appear in a top-level domain hint.
+func isNotTLDLetter(r rune) bool {
+	return (r < 'a' || r > 'z') && (r < 'A' || r > 'Z')
+}
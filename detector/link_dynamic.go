@@ -0,0 +1,14 @@
+//go:build cld2_dynamic
+// +build cld2_dynamic
+
+package detector
+
+// Link against CLD2 built with CLD2_DYNAMIC_MODE, which detects nothing until a model is
+// loaded with LoadModel.
+
+// #cgo CXXFLAGS: -DCLD2_DYNAMIC_MODE
+// #cgo LDFLAGS: -lcld2_dynamic
+import "C"
+
+// linkedTables is empty, the tables are those of the loaded model.
+const linkedTables = ""
@@ -0,0 +1,12 @@
+//go:build cld2_full && !cld2_dynamic
+// +build cld2_full,!cld2_dynamic
+
+package detector
+
+// Link against CLD2 with its full scoring tables compiled in, built as libcld2_full.so by
+// cld2/internal/compile_libs.sh.
+
+// #cgo LDFLAGS: -lcld2_full
+import "C"
+
+const linkedTables = TABLES_FULL
@@ -0,0 +1,11 @@
+//go:build !cld2_dynamic && !cld2_full
+// +build !cld2_dynamic,!cld2_full
+
+package detector
+
+// Link against CLD2 with its default scoring tables compiled in, those of Chrome.
+
+// #cgo LDFLAGS: -lcld2
+import "C"
+
+const linkedTables = TABLES_CHROME
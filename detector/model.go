@@ -0,0 +1,80 @@
+package detector
+
+// #include <stdlib.h>
+// #include "wrapper.h"
+import "C"
+
+import (
+	"errors"
+	"io/ioutil"
+	"sync"
+	"unsafe"
+)
+
+var (
+	// modelLock is held for reading while CLD2 scores texts, and for writing while its
+	// model is replaced, so that detections in progress finish with the model they
+	// started with.
+	modelLock sync.RWMutex
+
+	// modelData is the C copy of the loaded model, which CLD2 reads from until the next
+	// one is loaded.
+	modelData unsafe.Pointer
+)
+
+// LoadModel replaces CLD2's model with the dynamic data file at path, as written by
+// cld2_dynamic_data_tool --dump. The current model is kept if path cannot be loaded.
+// It waits for detections in progress, and new ones wait for it. An error is returned
+// unless CLD2 was built with CLD2_DYNAMIC_MODE (the cld2_dynamic build tag).
+func LoadModel(path string) error {
+	if !IsModelDynamic() {
+		return errors.New("CLD2 is not built with CLD2_DYNAMIC_MODE, its model cannot be loaded")
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return errors.New("Invalid CLD2 model: " + path + " is empty")
+	}
+	cData := C.CBytes(data)
+
+	modelLock.Lock()
+	defer modelLock.Unlock()
+	if C.load_data(cData, C.uint(len(data))) == 0 {
+		C.free(cData)
+		return errors.New("Invalid CLD2 model: " + path)
+	}
+	C.free(modelData)
+	modelData = cData
+	return nil
+}
+
+// IsModelLoaded returns whether CLD2 has a model to detect languages with. That is
+// always the case unless it was built with CLD2_DYNAMIC_MODE.
+func IsModelLoaded() bool {
+	modelLock.RLock()
+	defer modelLock.RUnlock()
+	return C.is_data_loaded() != 0
+}
+
+// IsModelDynamic returns whether CLD2 was built with CLD2_DYNAMIC_MODE, and so needs a
+// model loaded with LoadModel.
+func IsModelDynamic() bool {
+	return C.is_data_dynamic() != 0
+}
+
+// Tables returns the scoring table variant CLD2 was linked with, TABLES_CHROME unless
+// built with the cld2_full tag. It is empty when CLD2 was built with CLD2_DYNAMIC_MODE,
+// since the tables are those of the loaded model.
+func Tables() string {
+	return linkedTables
+}
+
+// Version returns CLD2's DetectLanguageVersion, which holds the build date of its scoring
+// tables, e.g. "V2.0 - 20141016". It is empty while no model is loaded.
+func Version() string {
+	modelLock.RLock()
+	defer modelLock.RUnlock()
+	return C.GoString(C.detect_language_version())
+}
@@ -0,0 +1,297 @@
+package detector
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	NGRAM_MODEL_VERSION = 1 // Format version of NgramModel
+	MAX_NGRAM_LENGTH    = 3 // Longest n-grams counted, in letters
+
+	// NgramEngine results are reliable when the best language is at least this likely.
+	NGRAM_RELIABLE_PROBABILITY = 0.95
+)
+
+// htmlTags matches the markup skipped in HTML text, by NgramEngine and when counting its
+// letters.
+var htmlTags = regexp.MustCompile(`(?s)<!--.*?-->|<(script|style)\b.*?</(script|style)\s*>|<[^>]*>|&#?\w+;`)
+
+func init() {
+	RegisterEngine(NewNgramEngine(DefaultNgramModel))
+}
+
+// NgramModel holds the character n-gram profiles of the languages an NgramEngine
+// detects, by language code.
+type NgramModel struct {
+	Version  int                     `json:"version"`
+	Profiles map[string]NgramProfile `json:"profiles"`
+}
+
+// NgramProfile counts the n-grams of a language's training text. N-grams are taken from
+// lowercased words padded with a space on either side, so that " th" only counts at the
+// start of words.
+type NgramProfile struct {
+	Total  int            `json:"total"`  // Number of n-grams in the training text
+	Ngrams map[string]int `json:"ngrams"` // Count of each n-gram kept, the most frequent
+}
+
+// NgramEngine is a pure-Go Engine scoring texts with naive Bayes over the character
+// n-grams of their words. It ignores hints. Percent and NormalizedScore of the languages
+// it finds are both their probability, and the whole text is a single segment.
+type NgramEngine struct {
+	languages []string             // Codes of the profiles, sorted
+	logProbs  map[string][]float64 // Log probability of each known n-gram, by language index
+	unseen    []float64            // Log probability of n-grams a language's profile lacks
+}
+
+// NewNgramEngine returns an NgramEngine detecting the languages of model.
+func NewNgramEngine(model NgramModel) *NgramEngine {
+	engine := &NgramEngine{logProbs: make(map[string][]float64)}
+	for code := range model.Profiles {
+		engine.languages = append(engine.languages, code)
+	}
+	sort.Strings(engine.languages)
+
+	// Smooth counts over every n-gram known to the model, so that n-grams missing from
+	// a profile do not rule its language out
+	for _, code := range engine.languages {
+		for ngram := range model.Profiles[code].Ngrams {
+			engine.logProbs[ngram] = nil
+		}
+	}
+	vocabulary := float64(len(engine.logProbs))
+	engine.unseen = make([]float64, len(engine.languages))
+	for i, code := range engine.languages {
+		engine.unseen[i] = -math.Log(float64(model.Profiles[code].Total) + vocabulary)
+	}
+	for ngram := range engine.logProbs {
+		logProbs := make([]float64, len(engine.languages))
+		for i, code := range engine.languages {
+			logProbs[i] = math.Log(float64(model.Profiles[code].Ngrams[ngram]+1)) + engine.unseen[i]
+		}
+		engine.logProbs[ngram] = logProbs
+	}
+	return engine
+}
+
+func (e *NgramEngine) Name() string {
+	return NGRAM_ENGINE
+}
+
+// Languages returns the codes of the languages e detects, sorted.
+func (e *NgramEngine) Languages() []string {
+	return e.languages
+}
+
+// Summarize returns the languages most likely to have produced the n-grams of text.
+// N-grams no profile knows are ignored, and texts without any known n-gram are in an
+// unknown language.
+func (e *NgramEngine) Summarize(text string, hints Hints, isHTML bool, withChunks bool) Summary {
+	summary := Summary{Code: UNKNOWN_LANGUAGE_CODE, ValidPrefixBytes: validPrefixBytes(text)}
+	if summary.ValidPrefixBytes < len(text) {
+		return summary
+	}
+	scored := text
+	if isHTML {
+		scored = htmlTags.ReplaceAllString(text, " ")
+	}
+
+	scores := make([]float64, len(e.languages))
+	known := 0
+	summary.TextBytes = countNgrams(scored, func(ngram string) {
+		if logProbs, found := e.logProbs[ngram]; found {
+			known++
+			for i, logProb := range logProbs {
+				scores[i] += logProb
+			}
+		}
+	})
+	if known == 0 {
+		return summary
+	}
+
+	languages := make([]Language, len(e.languages))
+	best := math.Inf(-1)
+	for _, score := range scores {
+		best = math.Max(best, score)
+	}
+	total := 0.0
+	for i, code := range e.languages {
+		probability := math.Exp(scores[i] - best)
+		languages[i] = Language{Code: code, NormalizedScore: probability}
+		total += probability
+	}
+	for i := range languages {
+		languages[i].NormalizedScore /= total
+		languages[i].Percent = int(math.Floor(languages[i].NormalizedScore*100 + 0.5))
+	}
+	sort.Stable(byShare(languages))
+
+	summary.Code = languages[0].Code
+	summary.Reliable = languages[0].NormalizedScore >= NGRAM_RELIABLE_PROBABILITY
+	for _, language := range languages {
+		if len(summary.Languages) == 3 || language.Percent == 0 {
+			break
+		}
+		summary.Languages = append(summary.Languages, language)
+	}
+	if withChunks {
+		summary.Chunks = []Segment{{Offset: 0, Length: len(text), Code: summary.Code}}
+	}
+	return summary
+}
+
+// LoadNgramModel reads the NgramModel saved at path by SaveNgramModel. An error is
+// returned for models of another format version, and for models without languages or
+// with empty profiles.
+func LoadNgramModel(path string) (NgramModel, error) {
+	var model NgramModel
+	file, err := os.Open(path)
+	if err != nil {
+		return model, err
+	}
+	defer file.Close()
+
+	var reader io.Reader = file
+	if strings.HasSuffix(path, ".gz") {
+		gzipReader, err := gzip.NewReader(file)
+		if err != nil {
+			return model, errors.New("Invalid n-gram model " + path + ": " + err.Error())
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	}
+	if err = json.NewDecoder(reader).Decode(&model); err != nil {
+		return model, errors.New("Invalid n-gram model " + path + ": " + err.Error())
+	}
+
+	if model.Version != NGRAM_MODEL_VERSION {
+		return model, errors.New("Unsupported n-gram model version " + strconv.Itoa(model.Version) + " in " + path + ", expected " + strconv.Itoa(NGRAM_MODEL_VERSION))
+	}
+	if len(model.Profiles) == 0 {
+		return model, errors.New("Invalid n-gram model " + path + ": no languages")
+	}
+	for code, profile := range model.Profiles {
+		if profile.Total <= 0 || len(profile.Ngrams) == 0 {
+			return model, errors.New("Invalid n-gram model " + path + ": empty profile for " + code)
+		}
+	}
+	return model, nil
+}
+
+// SaveNgramModel writes model to path as compact JSON, gzipped if path ends with .gz.
+func SaveNgramModel(model NgramModel, path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	var writer io.WriteCloser = file
+	if strings.HasSuffix(path, ".gz") {
+		writer = gzip.NewWriter(file)
+	}
+	err = json.NewEncoder(writer).Encode(model)
+	if writer != file {
+		if closeErr := writer.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// TrainNgramProfile counts the n-grams of texts, all in the same language, keeping the
+// maxNgrams most frequent of them, or all if maxNgrams is not positive.
+func TrainNgramProfile(texts []string, maxNgrams int) NgramProfile {
+	profile := NgramProfile{Ngrams: make(map[string]int)}
+	for _, text := range texts {
+		countNgrams(text, func(ngram string) {
+			profile.Ngrams[ngram]++
+			profile.Total++
+		})
+	}
+	if maxNgrams <= 0 || len(profile.Ngrams) <= maxNgrams {
+		return profile
+	}
+
+	ngrams := make([]string, 0, len(profile.Ngrams))
+	for ngram := range profile.Ngrams {
+		ngrams = append(ngrams, ngram)
+	}
+	sort.Sort(byCount{ngrams, profile.Ngrams})
+	for _, ngram := range ngrams[maxNgrams:] {
+		delete(profile.Ngrams, ngram)
+	}
+	return profile
+}
+
+// byCount sorts n-grams by decreasing count, then alphabetically.
+type byCount struct {
+	ngrams []string
+	counts map[string]int
+}
+
+func (b byCount) Len() int      { return len(b.ngrams) }
+func (b byCount) Swap(i, j int) { b.ngrams[i], b.ngrams[j] = b.ngrams[j], b.ngrams[i] }
+func (b byCount) Less(i, j int) bool {
+	if ci, cj := b.counts[b.ngrams[i]], b.counts[b.ngrams[j]]; ci != cj {
+		return ci > cj
+	}
+	return b.ngrams[i] < b.ngrams[j]
+}
+
+// countNgrams calls f with each n-gram of the words of text, as counted in an
+// NgramProfile, and returns the number of bytes of letters in them.
+func countNgrams(text string, f func(ngram string)) int {
+	letterBytes := 0
+	word := []rune{' '}
+	for _, r := range text + " " {
+		if unicode.IsLetter(r) || unicode.Is(unicode.Mn, r) {
+			word = append(word, unicode.ToLower(r))
+			letterBytes += utf8.RuneLen(r)
+			continue
+		}
+		if len(word) == 1 {
+			continue
+		}
+		word = append(word, ' ')
+		for n := 1; n <= MAX_NGRAM_LENGTH; n++ {
+			for i := 0; i+n <= len(word); i++ {
+				if n > 1 || word[i] != ' ' {
+					f(string(word[i : i+n]))
+				}
+			}
+		}
+		word = word[:1]
+	}
+	return letterBytes
+}
+
+// validPrefixBytes returns the number of leading bytes of text that are interchange-valid
+// UTF-8, as CLD2 requires: valid UTF-8 without control characters other than whitespace.
+func validPrefixBytes(text string) int {
+	for i, r := range text {
+		if r == utf8.RuneError {
+			if _, size := utf8.DecodeRuneInString(text[i:]); size <= 1 {
+				return i
+			}
+		}
+		if unicode.IsControl(r) && !unicode.IsSpace(r) {
+			return i
+		}
+	}
+	return len(text)
+}
@@ -0,0 +1,4798 @@
+// Code generated by data/gen_ngrams.go; DO NOT EDIT.
+
+package detector
+
+// DefaultNgramModel holds the n-gram profiles NGRAM_ENGINE is trained with, from the texts in data/ngram.
+var DefaultNgramModel = NgramModel{
+	Version: 1,
+	Profiles: map[string]NgramProfile{
+		"be": {Total: 62506, Ngrams: map[string]int{
+			" a": 3, " a ": 2, " d": 10, " dn": 6, " e": 9, " e ": 3, " el": 3, " g": 2,
+			" h": 2, " n": 4, " nt": 2, " p": 11, " pc": 2, " pe": 5, " po": 2, " s": 7,
+			" sd": 2, " so": 3, " t": 2, " u": 13, " u ": 3, " ui": 3, " un": 2, " ut": 3,
+			" а": 314, " аб": 64, " ад": 69, " аж": 3, " ак": 12, " ал": 10, " ан": 5, " ап": 18,
+			" ар": 3, " ас": 11, " ат": 26, " аў": 91, " б": 50, " б ": 2, " ба": 10, " бо": 9,
+			" бу": 2, " бы": 14, " бя": 3, " бі": 7, " в": 146, " ва": 4, " ве": 3, " во": 17,
+			" ву": 7, " вы": 92, " вя": 8, " ві": 14, " г": 31, " га": 5, " гр": 2, " гэ": 23,
+			" д": 259, " да": 84, " дв": 6, " дз": 7, " дл": 134, " до": 5, " др": 4, " ды": 16,
+			" дэ": 2, " е": 10, " ек": 6, " еў": 4, " ж": 3, " з": 228, " з ": 44, " за": 97,
+			" зб": 2, " зв": 7, " зг": 2, " зл": 8, " зм": 26, " зн": 33, " зр": 2, " зы": 2,
+			" зь": 4, " й": 4, " й ": 4, " к": 170, " ка": 108, " кл": 23, " кн": 8, " ко": 9,
+			" кр": 6, " кі": 14, " л": 25, " ла": 19, " лу": 2, " лю": 2, " лі": 2, " м": 73,
+			" ма": 24, " ме": 24, " мн": 2, " мо": 14, " му": 2, " мэ": 3, " мя": 2, " мі": 2,
+			" н": 264, " на": 101, " не": 128, " но": 5, " ну": 5, " ня": 20, " ні": 4, " о": 2,
+			" п": 490, " па": 231, " пе": 76, " по": 8, " пр": 152, " пс": 7, " пу": 6, " пэ": 2,
+			" пі": 6, " р": 68, " ра": 30, " ро": 2, " ры": 4, " рэ": 32, " с": 228, " са": 10,
+			" св": 3, " се": 27, " ск": 17, " сл": 11, " сн": 12, " со": 4, " сп": 20, " ст": 26,
+			" су": 5, " сх": 7, " сы": 2, " сь": 4, " сэ": 2, " сі": 74, " т": 77, " та": 28,
+			" то": 10, " тр": 11, " ты": 7, " тэ": 21, " у": 44, " у ": 19, " ув": 6, " уж": 2,
+			" уз": 2, " ук": 4, " ум": 2, " ус": 5, " ф": 49, " фа": 42, " фр": 6, " х": 21,
+			" ха": 16, " хе": 2, " хі": 3, " ц": 16, " ці": 12, " ч": 23, " ча": 18, " чы": 5,
+			" ш": 20, " ша": 2, " шл": 10, " шм": 3, " шт": 3, " э": 9, " эк": 3, " эл": 4,
+			" ю": 9, " ют": 8, " я": 46, " яг": 3, " як": 35, " ям": 2, " яп": 2, " яў": 3,
+			" і": 76, " і ": 33, " ід": 5, " іе": 3, " ім": 5, " ін": 26, " іс": 2, " ў": 83,
+			" ў ": 39, " ўв": 9, " ўд": 14, " ўж": 3, " ўз": 2, " ўк": 7, " ўл": 2, " ўс": 4,
+			" ўт": 2, "a": 5, "a ": 2, "b": 3, "bu": 2, "bus": 2, "c": 12, "c ": 3,
+			"ck": 3, "cks": 3, "cp": 2, "cp ": 2, "cr": 2, "cre": 2, "d": 14, "d ": 4,
+			"dn": 6, "dns": 6, "e": 23, "e ": 7, "ec": 2, "ec ": 2, "el": 4, "elf": 3,
+			"em": 5, "em ": 5, "f": 6, "f ": 5, "g": 4, "h": 4, "i": 10, "i ": 2,
+			"in": 2, "int": 2, "ix": 4, "ix ": 4, "k": 3, "ks": 3, "ksv": 3, "l": 12,
+			"l ": 3, "lf": 3, "lf ": 3, "ll": 3, "ll ": 2, "m": 10, "m ": 7, "n": 17,
+			"ni": 2, "nix": 2, "ns": 6, "ns ": 5, "nt": 5, "nt ": 2, "ntp": 2, "o": 7,
+			"oc": 3, "ock": 3, "os": 2, "osi": 2, "p": 18, "p ": 7, "pc": 2, "pcr": 2,
+			"pe": 5, "pem": 5, "po": 2, "pos": 2, "r": 8, "r ": 2, "re": 3, "re ": 2,
+			"s": 27, "s ": 9, "sd": 2, "sd ": 2, "si": 2, "six": 2, "so": 3, "soc": 3,
+			"ss": 2, "sv": 4, "sv ": 4, "t": 14, "t ": 3, "tc": 2, "te": 2, "tf": 2,
+			"tf ": 2, "tp": 3, "tp ": 3, "u": 19, "u ": 3, "ui": 4, "uin": 2, "un": 2,
+			"uni": 2, "ur": 2, "us": 2, "us ": 2, "ut": 3, "utf": 2, "v": 5, "v ": 4,
+			"x": 5, "x ": 4, "а": 3341, "а ": 506, "аб": 205, "аб ": 31, "аба": 18, "абе": 2,
+			"абл": 13, "абм": 5, "абн": 4, "або": 28, "абр": 10, "абс": 2, "абу": 89, "абі": 2,
+			"ав": 138, "ава": 62, "аве": 20, "аво": 10, "аву": 2, "авы": 17, "авя": 11, "аві": 16,
+			"аг": 143, "ага": 37, "агл": 3, "аго": 23, "агр": 54, "агч": 22, "агі": 2, "ад": 228,
+			"ад ": 18, "ада": 18, "адв": 3, "адд": 2, "адж": 26, "адз": 25, "адк": 31, "адл": 8,
+			"адм": 3, "адн": 14, "адо": 5, "адп": 6, "адр": 12, "адс": 4, "адт": 23, "аду": 8,
+			"адч": 9, "адш": 6, "ады": 7, "ае": 66, "ае ": 55, "аем": 2, "аец": 9, "аж": 5,
+			"ажа": 2, "ажы": 3, "аз": 130, "аз ": 6, "аза": 35, "азб": 4, "азв": 63, "азе": 3,
+			"азл": 2, "азн": 3, "азо": 5, "азу": 3, "азі": 4, "ай": 98, "ай ": 41, "айк": 3,
+			"айл": 41, "айн": 3, "айс": 3, "айт": 6, "ак": 114, "ак ": 17, "ака": 51, "акл": 2,
+			"ако": 3, "акр": 14, "акт": 10, "аку": 7, "акі": 6, "ал": 200, "ал ": 6, "ала": 24,
+			"але": 20, "ало": 21, "алу": 9, "алы": 2, "аль": 74, "аля": 3, "алё": 3, "алі": 35,
+			"ам": 202, "ам ": 43, "ама": 36, "аме": 13, "амл": 3, "амо": 4, "амп": 14, "аму": 8,
+			"амы": 29, "амэ": 2, "амя": 6, "амі": 44, "ан": 227, "ан ": 3, "ана": 46, "анг": 3,
+			"анд": 8, "ане": 4, "анн": 40, "ано": 7, "анс": 14, "ант": 19, "ану": 2, "анц": 12,
+			"аны": 41, "ань": 16, "анё": 2, "ані": 8, "ап": 67, "апа": 6, "апе": 7, "апо": 2,
+			"апр": 13, "апу": 19, "апы": 5, "апі": 15, "ар": 101, "ар ": 5, "ара": 25, "арг": 2,
+			"арк": 2, "арм": 2, "аро": 11, "арт": 8, "ары": 31, "арэ": 11, "ас": 132, "ас ": 13,
+			"аса": 4, "асе": 2, "аск": 4, "асл": 12, "асн": 6, "асо": 5, "аст": 28, "асу": 10,
+			"асц": 13, "асы": 12, "ась": 4, "ася": 16, "ат": 166, "ат ": 4, "ата": 16, "атк": 4,
+			"атн": 12, "атр": 120, "атс": 2, "аты": 5, "ах": 22, "ах ": 7, "ахо": 14, "ац": 215,
+			"аца": 6, "ацо": 20, "ацу": 3, "ацц": 3, "ацы": 106, "аць": 72, "ацэ": 2, "ач": 66,
+			"ача": 24, "ачн": 2, "ачы": 19, "ачэ": 21, "аш": 49, "аша": 2, "ашк": 27, "ашч": 2,
+			"ашы": 14, "аю": 27, "аюз": 2, "аюц": 24, "ая": 57, "ая ": 53, "аяў": 3, "аі": 4,
+			"аіс": 2, "аў": 170, "аў ": 45, "аўв": 2, "аўд": 4, "аўж": 2, "аўк": 2, "аўл": 10,
+			"аўн": 4, "аўс": 4, "аўт": 95, "аўц": 2, "б": 335, "б ": 33, "ба": 47, "ба ": 11,
+			"бав": 9, "баз": 4, "бай": 6, "бал": 6, "бам": 2, "бар": 6, "баў": 2, "бе": 3,
+			"бел": 2, "бл": 23, "бла": 5, "бло": 7, "блі": 11, "бм": 5, "бме": 5, "бн": 7,
+			"бна": 5, "бны": 2, "бо": 48, "бо ": 29, "бод": 3, "бой": 2, "бол": 6, "боп": 2,
+			"бор": 2, "боч": 2, "бр": 26, "бра": 22, "бры": 2, "брэ": 2, "бс": 2, "бу": 102,
+			"буе": 88, "бук": 2, "бут": 8, "бы": 23, "бы ": 9, "был": 2, "быц": 11, "бя": 4,
+			"бяг": 2, "бі": 12, "біб": 4, "бір": 2, "біт": 2, "біц": 2, "в": 546, "ва": 205,
+			"ва ": 33, "ваб": 2, "вав": 2, "ваг": 4, "вад": 7, "вае": 18, "важ": 2, "вай": 8,
+			"вал": 10, "вам": 3, "ван": 42, "вар": 12, "ват": 2, "вах": 6, "вац": 33, "ваш": 2,
+			"ваю": 8, "вая": 10, "ве": 45, "ве ": 6, "вед": 8, "вер": 18, "вес": 12, "во": 66,
+			"воб": 13, "вод": 13, "вол": 34, "вос": 2, "ву": 18, "ву ": 4, "вуз": 7, "вук": 3,
+			"вую": 4, "вы": 148, "вы ": 32, "выб": 6, "выв": 11, "выд": 7, "выз": 30, "вый": 3,
+			"вык": 26, "вым": 8, "выр": 4, "вых": 6, "выч": 3, "выя": 6, "вя": 25, "вяд": 2,
+			"вяз": 11, "вял": 3, "вяр": 6, "вят": 2, "ві": 36, "від": 3, "віл": 11, "він": 3,
+			"вір": 11, "віц": 4, "г": 225, "г ": 3, "га": 62, "га ": 41, "гад": 8, "гал": 4,
+			"гах": 2, "гац": 4, "гв": 2, "гва": 2, "ге": 2, "ген": 2, "гл": 6, "глі": 5,
+			"гн": 7, "гна": 4, "гну": 2, "го": 28, "го ": 25, "гр": 56, "гра": 43, "гру": 13,
+			"гу": 6, "гуч": 2, "гч": 22, "гчы": 22, "гэ": 23, "гэт": 23, "гі": 7, "гіс": 4,
+			"д": 629, "д ": 33, "да": 149, "да ": 20, "дав": 9, "дад": 2, "даз": 31, "дак": 10,
+			"дал": 30, "дам": 7, "дан": 16, "дап": 4, "дар": 3, "дас": 5, "дат": 2, "дац": 3,
+			"дач": 2, "даў": 3, "дв": 11, "два": 5, "дву": 2, "двы": 3, "дд": 4, "дда": 2,
+			"ддз": 2, "дж": 35, "джа": 28, "джы": 6, "дз": 54, "дзв": 2, "дзе": 22, "дзь": 2,
+			"дзя": 6, "дзі": 22, "дк": 32, "дка": 9, "дкл": 4, "дкр": 4, "дку": 4, "дкі": 10,
+			"дл": 142, "длу": 5, "для": 134, "длі": 2, "дм": 3, "дн": 27, "дна": 9, "дно": 4,
+			"дны": 6, "днё": 4, "дні": 3, "до": 18, "дов": 2, "док": 4, "доў": 8, "дп": 6,
+			"дпа": 3, "дпр": 3, "др": 16, "дра": 12, "дру": 4, "дс": 4, "дсу": 4, "дт": 23,
+			"дта": 5, "дтр": 18, "ду": 21, "ду ": 20, "дч": 10, "дча": 7, "дчы": 3, "дш": 6,
+			"дша": 6, "ды": 27, "ды ": 8, "дыс": 9, "дыя": 6, "дэ": 8, "дэн": 5, "е": 751,
+			"е ": 221, "еа": 18, "еаб": 6, "еан": 10, "ев": 2, "ед": 11, "еда": 6, "едн": 3,
+			"еж": 16, "ежа": 13, "ежн": 2, "ез": 4, "еза": 3, "ей": 9, "ей ": 3, "ейс": 6,
+			"ек": 16, "ека": 4, "ект": 6, "екц": 6, "ел": 12, "ел ": 2, "ела": 2, "еле": 2,
+			"ель": 6, "ем": 38, "ем ": 6, "ема": 25, "еме": 5, "емы": 2, "ен": 81, "ена": 13,
+			"ене": 2, "енн": 26, "ент": 14, "ены": 16, "ень": 3, "еню": 2, "еня": 4, "ер": 136,
+			"ер ": 6, "ера": 99, "ерв": 7, "ерд": 2, "ерк": 4, "ерс": 4, "ерт": 2, "еры": 7,
+			"ерэ": 2, "ес": 32, "есн": 3, "ест": 3, "есц": 22, "ет": 20, "ета": 7, "етк": 5,
+			"етр": 4, "етц": 2, "ец": 106, "ец ": 3, "ецц": 100, "еч": 9, "еча": 8, "еш": 2,
+			"ешч": 2, "ея": 5, "еян": 5, "еў": 12, "еўд": 7, "еўр": 4, "ж": 108, "жа": 55,
+			"жа ": 11, "жав": 5, "жам": 4, "жан": 3, "жах": 3, "жац": 17, "жаю": 12, "жб": 11,
+			"жба": 4, "жбы": 7, "жн": 6, "жна": 3, "жны": 2, "жо": 4, "жо ": 2, "жор": 2,
+			"жы": 30, "жыв": 2, "жым": 15, "жын": 7, "жыц": 5, "з": 501, "з ": 52, "за": 146,
+			"за ": 22, "заб": 6, "зав": 4, "заг": 16, "зад": 9, "зак": 14, "зал": 2, "зам": 5,
+			"зан": 14, "зап": 32, "зар": 3, "зат": 4, "зац": 5, "зач": 3, "заў": 3, "зб": 6,
+			"збл": 2, "збо": 4, "зв": 73, "зва": 18, "зве": 5, "зво": 31, "зву": 4, "звы": 14,
+			"зг": 2, "зге": 2, "зе": 29, "зе ": 10, "зел": 5, "зен": 7, "зер": 2, "зея": 5,
+			"зк": 14, "зко": 9, "зкі": 5, "зл": 15, "зла": 5, "злу": 7, "злі": 2, "зм": 27,
+			"зме": 15, "змя": 12, "зн": 72, "зна": 65, "зно": 5, "зо": 7, "зон": 5, "зор": 2,
+			"зр": 2, "зра": 2, "зс": 6, "зск": 6, "зу": 3, "зу ": 2, "зч": 2, "зчы": 2,
+			"зы": 2, "зых": 2, "зь": 7, "зьд": 2, "зьм": 2, "зя": 7, "зя ": 3, "зял": 2,
+			"зяц": 2, "зі": 28, "зін": 16, "зір": 3, "зіц": 7, "й": 162, "й ": 68, "йд": 5,
+			"йдз": 5, "йк": 3, "йко": 3, "йл": 41, "йл ": 16, "йла": 22, "йле": 2, "йн": 20,
+			"йна": 3, "йне": 15, "йны": 2, "йс": 18, "йск": 12, "йсц": 4, "йт": 6, "йта": 6,
+			"к": 820, "к ": 39, "ка": 431, "ка ": 60, "каб": 23, "кав": 17, "каг": 2, "кад": 31,
+			"кае": 12, "каз": 8, "кай": 8, "кал": 37, "кам": 36, "кан": 38, "кар": 24, "кас": 5,
+			"кат": 13, "кац": 92, "кая": 13, "каў": 10, "ке": 5, "кет": 4, "кл": 62, "кла": 9,
+			"клю": 47, "клі": 6, "кн": 9, "кно": 9, "ко": 52, "ков": 19, "ког": 2, "код": 5,
+			"кое": 2, "кой": 3, "кол": 5, "кон": 11, "коў": 2, "кр": 27, "кра": 3, "кру": 4,
+			"кры": 14, "крэ": 5, "кс": 11, "кс ": 2, "кса": 2, "ксп": 2, "кст": 2, "ксі": 2,
+			"кт": 21, "кт ": 2, "кта": 4, "кту": 2, "кты": 12, "ку": 59, "ку ": 46, "куе": 2,
+			"кум": 7, "кур": 2, "кц": 7, "кцы": 7, "кі": 95, "кі ": 57, "кім": 3, "кін": 3,
+			"кір": 16, "кіх": 2, "кія": 14, "л": 757, "л ": 25, "ла": 106, "ла ": 17, "лав": 2,
+			"лад": 28, "лай": 2, "лак": 26, "лам": 7, "лас": 13, "лаў": 10, "ле": 41, "ле ": 4,
+			"леж": 4, "лей": 2, "лек": 3, "лем": 4, "лен": 22, "лк": 23, "лка": 19, "лкі": 3,
+			"ло": 33, "лог": 2, "лон": 13, "лос": 13, "лоў": 3, "лу": 37, "лу ": 4, "луж": 12,
+			"луч": 19, "лы": 8, "лы ": 6, "ль": 129, "ль ": 7, "льб": 7, "льг": 2, "льк": 18,
+			"льн": 84, "льс": 3, "льш": 6, "лю": 51, "люб": 2, "лют": 2, "люч": 47, "ля": 177,
+			"ля ": 142, "ляв": 5, "ляд": 5, "ляе": 5, "лял": 3, "лям": 2, "лях": 10, "ляц": 3,
+			"лё": 3, "лёг": 2, "лі": 122, "лі ": 18, "лів": 22, "ліг": 4, "ліз": 2, "лій": 3,
+			"лік": 20, "лін": 3, "ліс": 3, "ліф": 3, "ліц": 30, "ліч": 9, "лія": 4, "м": 652,
+			"м ": 107, "ма": 148, "ма ": 29, "маг": 24, "мае": 4, "мак": 2, "мал": 4, "мам": 28,
+			"ман": 16, "мар": 6, "мат": 7, "мац": 9, "мач": 2, "маш": 11, "маю": 2, "мв": 11,
+			"мва": 11, "ме": 95, "ме ": 17, "меж": 12, "мен": 33, "мер": 4, "мес": 16, "мет": 8,
+			"меш": 2, "мл": 24, "мле": 3, "млі": 21, "мн": 12, "мна": 3, "мно": 3, "мны": 5,
+			"мо": 21, "мов": 2, "мож": 12, "моф": 2, "мп": 17, "мп ": 8, "мпа": 4, "мпі": 3,
+			"му": 23, "му ": 19, "мус": 4, "мы": 62, "мы ": 42, "мыл": 17, "мэ": 6, "мэн": 2,
+			"мэт": 3, "мя": 25, "мя ": 2, "мян": 4, "мяс": 4, "мяц": 3, "мяш": 12, "мі": 101,
+			"мі ": 92, "мін": 7, "н": 1442, "н ": 19, "на": 347, "на ": 64, "нав": 2, "наг": 25,
+			"над": 8, "нае": 14, "наз": 36, "най": 23, "нак": 18, "нал": 30, "нам": 6, "нан": 5,
+			"нап": 2, "нас": 19, "нац": 2, "нач": 49, "наш": 2, "ная": 30, "наў": 8, "нг": 4,
+			"нгл": 2, "нд": 11, "нда": 7, "нды": 2, "не": 203, "не ": 106, "неа": 7, "нев": 2,
+			"нед": 3, "нез": 3, "нек": 4, "нел": 2, "нем": 25, "нен": 16, "нер": 20, "нес": 2,
+			"нец": 3, "неч": 8, "нк": 18, "нка": 7, "нку": 6, "нкі": 4, "нн": 136, "нна": 3,
+			"нне": 27, "нны": 4, "нню": 9, "ння": 84, "нні": 9, "но": 38, "нов": 6, "ной": 6,
+			"нол": 2, "ноп": 8, "нос": 10, "ноў": 2, "нс": 20, "нса": 8, "нск": 7, "нсы": 2,
+			"нт": 129, "нт ": 9, "нта": 6, "нты": 94, "нтэ": 18, "ну": 44, "ну ": 18, "нул": 3,
+			"нум": 4, "нут": 3, "нуц": 6, "ную": 9, "нф": 2, "нх": 2, "нхр": 2, "нц": 12,
+			"нца": 4, "нцу": 6, "нцы": 2, "нч": 10, "нчы": 9, "нш": 18, "ншы": 17, "ны": 174,
+			"ны ": 70, "ным": 53, "ных": 25, "ныя": 26, "нь": 27, "нь ": 6, "ньн": 19, "ню": 13,
+			"ню ": 12, "ня": 127, "ня ": 94, "няй": 8, "ням": 3, "няп": 12, "няц": 2, "няў": 7,
+			"нё": 6, "нёв": 6, "ні": 79, "ні ": 18, "ніз": 2, "нік": 34, "нім": 3, "ніц": 15,
+			"ніч": 6, "о": 460, "о ": 62, "об": 14, "обр": 13, "ов": 39, "ова": 18, "ове": 3,
+			"ову": 3, "овы": 15, "ог": 10, "ога": 9, "од": 48, "од ": 12, "ода": 3, "одд": 2,
+			"одж": 2, "одз": 9, "одн": 6, "оду": 12, "ое": 3, "ое ": 2, "ож": 13, "ожа": 11,
+			"ожн": 2, "ой": 13, "ой ": 8, "ойд": 5, "ок": 12, "ок ": 4, "оке": 4, "окс": 2,
+			"ол": 70, "ола": 2, "оле": 3, "олы": 4, "оль": 32, "олі": 26, "ом": 4, "ом ": 2,
+			"он": 35, "он ": 3, "она": 3, "оне": 6, "онк": 6, "онс": 2, "ону": 3, "онч": 10,
+			"оп": 13, "опа": 2, "опк": 8, "ор": 28, "ор ": 2, "ора": 2, "орс": 2, "орт": 2,
+			"оры": 17, "ос": 29, "осн": 6, "ост": 6, "ося": 13, "от": 3, "отн": 2, "оф": 2,
+			"офа": 2, "оч": 4, "очн": 2, "очы": 2, "ош": 6, "ошу": 5, "оў": 50, "оў ": 7,
+			"оўв": 13, "оўг": 3, "оўн": 25, "оўт": 2, "п": 676, "п ": 15, "па": 268, "па ": 11,
+			"пав": 13, "пад": 44, "паз": 9, "пак": 3, "пал": 3, "пам": 29, "пар": 20, "пас": 29,
+			"пат": 92, "пач": 6, "паш": 2, "паў": 5, "пе": 86, "пей": 4, "пер": 80, "пк": 8,
+			"пку": 8, "пн": 7, "пна": 6, "по": 19, "пон": 2, "пор": 2, "пош": 6, "поў": 6,
+			"пр": 179, "пра": 132, "про": 3, "пры": 42, "пс": 7, "псе": 7, "пу": 29, "пуб": 3,
+			"пус": 19, "пуш": 5, "пы": 22, "пын": 16, "пыт": 5, "пэ": 2, "пэў": 2, "пі": 31,
+			"під": 5, "піл": 6, "піс": 17, "р": 918, "р ": 16, "ра": 509, "ра ": 20, "раб": 94,
+			"рав": 49, "раг": 47, "рад": 15, "раз": 41, "рак": 4, "рал": 15, "рам": 56, "ран": 27,
+			"рап": 7, "рас": 29, "рат": 5, "рах": 7, "рац": 40, "рач": 4, "раш": 30, "раў": 17,
+			"рв": 9, "рва": 2, "рве": 5, "рг": 2, "рд": 3, "рк": 6, "рка": 3, "ркт": 2,
+			"рл": 2, "рм": 11, "рма": 4, "рмі": 6, "рн": 5, "рну": 4, "ро": 29, "род": 10,
+			"рок": 2, "рол": 7, "рон": 2, "рот": 2, "роў": 3, "рс": 19, "рс ": 3, "рск": 2,
+			"рсу": 3, "рсы": 4, "рсі": 5, "рт": 24, "рт ": 2, "рта": 3, "ртн": 3, "рту": 14,
+			"рты": 2, "ру": 34, "ру ": 2, "руг": 2, "руз": 11, "рук": 6, "рум": 6, "руп": 2,
+			"рут": 4, "рх": 2, "рц": 2, "рш": 2, "ры": 175, "ры ": 28, "рыб": 8, "рыв": 3,
+			"рыз": 4, "рыл": 6, "рым": 45, "рын": 6, "рып": 10, "рыс": 30, "рыт": 12, "рыц": 11,
+			"рыя": 5, "рыі": 3, "рэ": 66, "рэа": 2, "рэб": 12, "рэг": 3, "рэж": 15, "рэз": 4,
+			"рэк": 2, "рэн": 8, "рэо": 5, "рэс": 10, "рэч": 2, "с": 716, "с ": 30, "са": 33,
+			"са ": 10, "сав": 5, "сам": 7, "сац": 4, "св": 5, "се": 41, "се ": 6, "сеа": 11,
+			"сек": 3, "сер": 7, "сет": 7, "сеў": 7, "ск": 75, "ск ": 3, "ска": 43, "ско": 10,
+			"ску": 8, "скі": 8, "сл": 26, "сло": 3, "слу": 12, "сля": 11, "сн": 31, "сна": 11,
+			"сно": 3, "сну": 15, "со": 11, "сов": 6, "сок": 4, "сп": 27, "спа": 14, "спу": 3,
+			"спы": 6, "сс": 3, "ст": 165, "ста": 43, "ств": 11, "стк": 4, "сто": 12, "стр": 18,
+			"сту": 8, "сты": 8, "стэ": 60, "су": 48, "су ": 17, "сум": 4, "сур": 10, "сут": 14,
+			"сх": 7, "схе": 7, "сц": 51, "сца": 8, "сць": 9, "сці": 26, "сы": 20, "сы ": 8,
+			"сыл": 10, "сь": 12, "сьп": 4, "сьц": 6, "ся": 42, "ся ": 30, "сяр": 9, "сі": 84,
+			"сіг": 3, "сім": 10, "сіс": 59, "сію": 3, "т": 843, "т ": 22, "та": 172, "та ": 29,
+			"тав": 9, "таг": 22, "тад": 4, "тае": 3, "тай": 3, "так": 11, "тал": 28, "там": 9,
+			"тан": 17, "тар": 19, "тац": 3, "таў": 11, "тв": 15, "тва": 13, "тк": 17, "тка": 7,
+			"тко": 3, "тку": 3, "ткі": 4, "тн": 35, "тна": 7, "тны": 16, "тня": 8, "тні": 4,
+			"то": 31, "то ": 3, "тол": 9, "тор": 16, "тр": 174, "тра": 99, "тру": 9, "тры": 51,
+			"трэ": 12, "тс": 3, "ту": 32, "ту ": 7, "туа": 11, "туп": 8, "ты": 144, "ты ": 15,
+			"тым": 5, "тып": 6, "тыф": 93, "тыч": 9, "тыя": 4, "тыў": 9, "тэ": 191, "тэй": 15,
+			"тэк": 8, "тэм": 60, "тэн": 86, "тэр": 13, "тэч": 5, "у": 515, "у ": 163, "уа": 11,
+			"уал": 11, "уб": 3, "убл": 3, "ув": 7, "уво": 3, "уг": 3, "уе": 92, "уе ": 5,
+			"уец": 87, "уж": 14, "ужб": 11, "уз": 26, "узк": 5, "узл": 5, "узс": 6, "ук": 22,
+			"ука": 5, "укл": 3, "уку": 9, "ул": 9, "уля": 3, "ум": 26, "ума": 5, "уме": 16,
+			"ун": 3, "уп": 12, "упн": 7, "ур": 16, "урс": 12, "ус": 29, "усе": 3, "уск": 11,
+			"уст": 6, "усц": 4, "ут": 29, "ута": 10, "утк": 3, "утн": 14, "уц": 7, "уць": 5,
+			"уч": 21, "уча": 4, "учы": 6, "учэ": 11, "уш": 6, "ушч": 5, "ую": 14, "ую ": 14,
+			"ф": 156, "фа": 48, "фа ": 3, "фай": 41, "фар": 3, "фр": 7, "фра": 7, "фі": 98,
+			"фік": 94, "фіч": 3, "х": 107, "х ": 54, "ха": 19, "хан": 6, "хат": 8, "хе": 9,
+			"хем": 7, "хо": 19, "ход": 17, "хі": 4, "ц": 704, "ц ": 3, "ца": 136, "ца ": 119,
+			"цав": 10, "цаў": 4, "це": 10, "це ": 9, "цо": 22, "цоў": 20, "цу": 9, "цу ": 3,
+			"цуз": 6, "цц": 123, "цца": 117, "цця": 3, "ццё": 3, "цы": 128, "цы ": 11, "цый": 6,
+			"цыя": 95, "цыі": 13, "ць": 212, "ць ": 212, "ця": 7, "цяв": 3, "цё": 3, "ці": 45,
+			"ці ": 33, "ців": 4, "ціц": 6, "ч": 278, "ч ": 6, "ча": 100, "ча ": 5, "чае": 12,
+			"чай": 4, "чак": 14, "чал": 6, "чан": 23, "час": 19, "чат": 4, "чаю": 3, "чк": 5,
+			"чн": 21, "чна": 12, "чны": 9, "чо": 6, "чоў": 3, "чы": 81, "чы ": 7, "чым": 24,
+			"чын": 8, "чыт": 7, "чыц": 23, "чыў": 8, "чэ": 57, "чэн": 57, "ш": 131, "ш ": 6,
+			"ша": 14, "шаб": 7, "шк": 27, "шка": 26, "шл": 11, "шля": 10, "шм": 4, "шма": 4,
+			"шт": 3, "што": 3, "шу": 6, "шук": 6, "шч": 22, "шча": 19, "шчэ": 3, "шы": 33,
+			"шым": 13, "шын": 12, "ы": 1079, "ы ": 238, "ыб": 15, "ыба": 4, "ыбу": 8, "ыв": 18,
+			"ыва": 12, "ыве": 5, "ыд": 8, "ыда": 7, "ыз": 34, "ызн": 34, "ый": 10, "ый ": 4,
+			"ыйс": 4, "ык": 30, "ыка": 5, "ыкл": 23, "ыл": 38, "ыла": 12, "ылк": 21, "ым": 163,
+			"ым ": 48, "ыма": 44, "ымл": 21, "ымі": 45, "ын": 51, "ын ": 4, "ына": 6, "ыне": 12,
+			"ынк": 4, "ыны": 10, "ыні": 11, "ып": 17, "ып ": 6, "ыпы": 9, "ыр": 5, "ыра": 4,
+			"ыс": 43, "ыск": 9, "ыст": 22, "ысу": 10, "ыт": 25, "ыт ": 3, "ыта": 12, "ыто": 4,
+			"ыты": 5, "ыф": 95, "ыфі": 93, "ых": 37, "ых ": 33, "ыхо": 3, "ыц": 50, "ыцц": 7,
+			"ыць": 43, "ыч": 14, "ыча": 3, "ычн": 10, "ыю": 3, "ыю ": 3, "ыя": 145, "ыя ": 132,
+			"ыял": 3, "ыяп": 5, "ыі": 17, "ыі ": 17, "ыў": 20, "ыўн": 9, "ыўс": 7, "ь": 387,
+			"ь ": 225, "ьб": 9, "ьбо": 7, "ьк": 18, "ька": 5, "ькі": 13, "ьм": 3, "ьн": 103,
+			"ьна": 24, "ьне": 6, "ьны": 35, "ьня": 9, "ьні": 29, "ьп": 4, "ьпі": 4, "ьс": 3,
+			"ьск": 3, "ьц": 6, "ьці": 3, "ьш": 6, "ьш ": 5, "ья": 3, "э": 371, "эб": 12,
+			"эба": 10, "эг": 5, "эгі": 3, "эж": 15, "эжы": 15, "эз": 4, "эй": 16, "эйн": 15,
+			"эк": 14, "эка": 4, "экс": 7, "эл": 4, "эле": 4, "эм": 61, "эме": 13, "эмн": 7,
+			"эму": 11, "эмы": 28, "эн": 160, "энн": 62, "энт": 93, "энь": 4, "эо": 5, "эол": 5,
+			"эр": 15, "эрм": 6, "эры": 4, "эс": 15, "эсп": 3, "эсу": 8, "эт": 29, "эта": 16,
+			"эты": 10, "эч": 7, "эчк": 5, "ю": 125, "ю ": 36, "ют": 10, "юта": 8, "юц": 25,
+			"юцц": 10, "юць": 15, "юч": 48, "юч ": 6, "юча": 8, "ючо": 4, "ючы": 7, "ючэ": 22,
+			"я": 691, "я ": 473, "яв": 8, "ява": 3, "яві": 3, "яг": 8, "ягн": 3, "яго": 3,
+			"яд": 7, "ядо": 6, "яе": 5, "яец": 4, "яз": 12, "язк": 9, "яй": 8, "яй ": 8,
+			"як": 35, "як ": 8, "яко": 6, "які": 18, "ял": 11, "яль": 3, "ялі": 6, "ям": 9,
+			"ямі": 4, "ян": 12, "янн": 3, "яні": 3, "яп": 20, "япа": 5, "япо": 5, "япр": 9,
+			"яр": 16, "ярн": 4, "яро": 9, "яс": 7, "ясц": 4, "ят": 8, "ятэ": 4, "ях": 10,
+			"ях ": 8, "яц": 12, "яцы": 3, "яць": 6, "яш": 13, "яшч": 13, "яў": 15, "яў ": 7,
+			"яўл": 4, "яўн": 3, "ё": 15, "ё ": 3, "ёв": 6, "ёва": 3, "ёвы": 3, "і": 842,
+			"і ": 275, "іб": 5, "ібл": 4, "ів": 27, "іва": 26, "іг": 8, "іга": 4, "ігн": 3,
+			"ід": 13, "ідж": 5, "ідэ": 5, "іе": 4, "іер": 3, "із": 4, "іза": 4, "ій": 4,
+			"ійс": 4, "ік": 148, "ік ": 7, "іка": 128, "іко": 5, "іку": 5, "іл": 17, "іль": 12,
+			"іля": 3, "ім": 22, "ім ": 6, "імв": 10, "імп": 3, "ін": 63, "ін ": 6, "іна": 8,
+			"інд": 3, "інк": 7, "інн": 5, "інт": 3, "іну": 3, "інш": 18, "ір": 36, "іра": 20,
+			"ірт": 11, "іс": 88, "іс ": 8, "іса": 5, "існ": 4, "іст": 65, "ісу": 5, "іт": 4,
+			"іф": 3, "іфі": 3, "іх": 5, "іх ": 5, "іц": 65, "іце": 3, "іцы": 3, "іць": 59,
+			"іч": 20, "іча": 6, "ічн": 5, "ічы": 5, "ію": 4, "ію ": 4, "ія": 20, "ія ": 14,
+			"іят": 5, "ў": 356, "ў ": 102, "ўв": 24, "ўва": 20, "ўво": 3, "ўг": 3, "ўд": 26,
+			"ўда": 21, "ўдн": 4, "ўж": 5, "ўжы": 5, "ўк": 11, "ўка": 3, "ўкл": 5, "ўкі": 3,
+			"ўл": 17, "ўле": 7, "ўля": 8, "ўн": 45, "ўна": 14, "ўну": 5, "ўны": 22, "ўр": 4,
+			"ўра": 4, "ўс": 16, "ўст": 3, "ўся": 11, "ўт": 99, "ўта": 5, "ўтв": 4, "ўтэ": 86,
+		}},
+		"bg": {Total: 217216, Ngrams: map[string]int{
+			" a": 15, " b": 9, " c": 11, " d": 8, " e": 15, " eo": 6, " f": 12, " f ": 6,
+			" g": 32, " gi": 23, " h": 25, " he": 19, " ht": 6, " i": 12, " ip": 6, " l": 10,
+			" m": 8, " n": 9, " nu": 6, " p": 9, " q": 8, " q ": 6, " r": 11, " s": 23,
+			" st": 10, " t": 7, " u": 11, " w": 9, " а": 216, " а ": 16, " ав": 21, " ад": 27,
+			" ак": 35, " ал": 13, " ан": 20, " ар": 60, " ат": 14, " б": 312, " ба": 23, " бе": 108,
+			" би": 24, " бл": 9, " бр": 31, " бу": 16, " бъ": 95, " в": 590, " в ": 176, " ва": 16,
+			" ве": 53, " ви": 40, " вк": 19, " вл": 12, " вм": 10, " вн": 7, " вр": 61, " вс": 57,
+			" вх": 50, " въ": 77, " г": 150, " ге": 10, " гл": 7, " гн": 9, " го": 18, " гр": 101,
+			" д": 759, " да": 387, " дв": 33, " де": 31, " ди": 74, " до": 145, " др": 21, " ду": 10,
+			" дъ": 56, " е": 299, " е ": 200, " ед": 46, " ек": 9, " ел": 9, " ет": 26, " ж": 13,
+			" жу": 13, " з": 739, " за": 701, " зн": 38, " и": 1098, " и ": 132, " иг": 11, " ид": 49,
+			" из": 548, " ил": 69, " им": 114, " ин": 147, " ис": 20, " к": 567, " ка": 61, " ке": 6,
+			" кл": 110, " ко": 271, " кр": 43, " къ": 66, " кю": 6, " л": 79, " ла": 10, " ли": 41,
+			" ло": 25, " м": 404, " ма": 61, " ме": 41, " мн": 35, " мо": 211, " му": 10, " мъ": 23,
+			" мя": 16, " н": 2033, " на": 1353, " не": 537, " ни": 51, " но": 46, " ну": 19, " ня": 27,
+			" о": 665, " об": 172, " ог": 12, " ок": 11, " оп": 108, " ос": 25, " от": 312, " оч": 13,
+			" п": 1470, " па": 117, " пе": 11, " пи": 6, " пл": 11, " по": 556, " пр": 701, " пъ": 62,
+			" р": 305, " ра": 165, " ре": 123, " ро": 7, " с": 1251, " с ": 190, " са": 128, " св": 21,
+			" се": 250, " си": 73, " ск": 14, " сл": 102, " см": 11, " со": 21, " сп": 54, " ср": 11,
+			" ст": 150, " су": 13, " съ": 206, " т": 281, " та": 35, " тв": 10, " те": 52, " ти": 6,
+			" то": 95, " тр": 51, " тъ": 26, " у": 183, " уд": 10, " ук": 102, " ун": 8, " уп": 18,
+			" ус": 38, " ф": 320, " фа": 253, " фи": 11, " фл": 8, " фо": 31, " фу": 11, " х": 51,
+			" хо": 6, " хр": 44, " ц": 27, " це": 13, " ци": 8, " ч": 101, " ча": 28, " че": 40,
+			" чи": 9, " чр": 21, " ш": 36, " ша": 19, " ши": 12, " щ": 25, " ще": 22, "a": 65,
+			"a ": 6, "ad": 22, "ad ": 19, "al": 8, "ap": 7, "at": 7, "at ": 6, "b": 15,
+			"b ": 6, "c": 29, "c ": 12, "d": 46, "d ": 32, "e": 67, "e ": 12, "ea": 20,
+			"ead": 19, "eo": 6, "et": 7, "f": 25, "f ": 14, "g": 49, "g ": 6, "gi": 25,
+			"git": 23, "h": 35, "he": 21, "hea": 19, "ht": 6, "htt": 6, "i": 64, "in": 10,
+			"ip": 7, "it": 26, "it ": 25, "k": 7, "l": 43, "l ": 18, "ll": 6, "ll ": 6,
+			"lo": 7, "m": 26, "m ": 10, "ma": 8, "n": 32, "n ": 12, "nt": 6, "nu": 7,
+			"nul": 6, "o": 36, "p": 43, "p ": 20, "q": 8, "q ": 6, "r": 38, "r ": 8,
+			"re": 6, "s": 48, "s ": 13, "st": 12, "t": 99, "t ": 49, "ta": 7, "tp": 8,
+			"tp ": 7, "tt": 7, "ttp": 6, "u": 27, "ul": 6, "w": 9, "x": 12, "x ": 10,
+			"̀": 54, "̀н": 35, "̀ни": 34, "̀ф": 14, "̀фа": 14, "а": 9161, "а ": 3826, "а̀": 18,
+			"а̀ф": 14, "аб": 121, "аба": 6, "абл": 29, "або": 70, "абс": 9, "ав": 504, "ава": 235,
+			"аве": 20, "ави": 109, "авк": 6, "авл": 17, "авн": 21, "авт": 20, "авъ": 19, "авя": 55,
+			"аг": 50, "ага": 24, "аги": 6, "агл": 10, "ад": 223, "ада": 86, "адв": 6, "аде": 73,
+			"ади": 9, "адр": 26, "адъ": 7, "ае": 8, "аж": 30, "ажд": 6, "аже": 19, "аз": 338,
+			"аз ": 9, "аза": 95, "азб": 10, "азв": 31, "азд": 36, "азе": 11, "ази": 30, "азл": 35,
+			"азм": 19, "азн": 21, "азо": 12, "азп": 6, "азш": 12, "ай": 292, "ай ": 13, "айл": 253,
+			"айт": 24, "ак": 261, "ак ": 29, "ака": 7, "акв": 42, "аке": 85, "акл": 6, "ако": 34,
+			"акс": 14, "акт": 28, "акъ": 10, "ал": 265, "ал ": 29, "ала": 14, "але": 52, "али": 68,
+			"алк": 6, "алн": 62, "ало": 10, "ам": 164, "ама": 28, "аме": 38, "ами": 25, "амо": 62,
+			"ан": 1469, "ан ": 34, "ана": 27, "анг": 11, "анд": 134, "ане": 883, "ани": 245, "анн": 36,
+			"ано": 60, "анс": 18, "ант": 11, "ап": 132, "апа": 36, "апи": 65, "апк": 7, "апр": 11,
+			"ар": 267, "ара": 21, "арг": 21, "аре": 15, "ари": 20, "арк": 6, "аро": 11, "арс": 6,
+			"арт": 102, "арх": 33, "арч": 8, "аря": 18, "ас": 130, "асв": 13, "аск": 20, "асн": 6,
+			"асо": 12, "аст": 55, "ася": 11, "ат": 721, "ат ": 136, "ата": 281, "атв": 19, "ате": 100,
+			"ати": 65, "атк": 11, "атн": 7, "ато": 65, "атр": 13, "ату": 6, "атъ": 9, "атя": 7,
+			"аф": 13, "ах": 22, "ахн": 8, "ац": 113, "аци": 113, "ач": 64, "ача": 40, "аче": 6,
+			"ачи": 17, "аш": 27, "аше": 7, "аши": 18, "ащ": 79, "ащ ": 20, "аща": 16, "ащи": 30,
+			"ащо": 12, "ая": 18, "ая ": 11, "аяв": 7, "б": 965, "ба": 107, "ба ": 6, "бав": 54,
+			"баз": 31, "бай": 6, "бв": 55, "бва": 45, "бви": 10, "бе": 211, "без": 94, "бек": 81,
+			"бел": 11, "бен": 6, "бер": 8, "би": 77, "биб": 12, "бир": 13, "бит": 42, "бл": 62,
+			"бли": 23, "бло": 29, "бн": 40, "бна": 6, "бно": 30, "бо": 99, "бор": 11, "бот": 69,
+			"бр": 88, "бра": 38, "бре": 8, "бро": 36, "бс": 12, "бск": 7, "бу": 39, "бук": 6,
+			"бут": 12, "буф": 11, "бх": 47, "бхо": 45, "бщ": 26, "бще": 18, "бщи": 7, "бъ": 95,
+			"бъд": 91, "в": 3011, "в ": 220, "ва": 1057, "ва ": 249, "вай": 12, "вал": 21, "ван": 616,
+			"вар": 27, "ват": 78, "ваш": 10, "ващ": 38, "ве": 530, "ве ": 126, "вед": 16, "веж": 142,
+			"вей": 6, "вел": 6, "вен": 52, "вер": 82, "вет": 70, "веч": 26, "ви": 282, "ви ": 74,
+			"вив": 11, "вид": 25, "вие": 8, "вил": 45, "вин": 6, "вир": 9, "вис": 18, "вит": 11,
+			"виш": 36, "вия": 25, "вк": 39, "вка": 19, "вкл": 17, "вл": 46, "вле": 20, "вли": 13,
+			"вля": 13, "вм": 25, "вме": 23, "вн": 56, "вна": 21, "вни": 13, "вно": 13, "вня": 8,
+			"во": 220, "во ": 111, "вод": 10, "вои": 11, "вол": 32, "вон": 8, "вор": 25, "вот": 12,
+			"вп": 12, "впа": 9, "вр": 88, "вра": 11, "вре": 53, "връ": 22, "вс": 57, "все": 13,
+			"вси": 36, "вся": 8, "вт": 29, "вто": 27, "ву": 17, "вув": 16, "вх": 50, "вхо": 50,
+			"въ": 180, "във": 25, "въз": 35, "вън": 13, "вър": 99, "вът": 8, "вя": 91, "вяв": 34,
+			"вян": 44, "вят": 9, "г": 506, "г ": 14, "га": 65, "га ": 14, "ган": 18, "гар": 7,
+			"гат": 22, "гв": 7, "гва": 6, "ге": 10, "ген": 7, "ги": 35, "ги ": 12, "гир": 6,
+			"гл": 61, "гла": 11, "гле": 17, "гли": 11, "гля": 16, "гн": 31, "гна": 7, "гне": 11,
+			"гно": 11, "го": 61, "го ": 14, "гов": 14, "гое": 8, "гол": 8, "гор": 13, "гр": 183,
+			"гра": 105, "гре": 63, "гру": 12, "гу": 37, "гум": 21, "гур": 12, "д": 2661, "д ": 157,
+			"да": 1034, "да ": 375, "дав": 182, "дад": 60, "дак": 12, "дал": 34, "дан": 198, "дар": 89,
+			"дат": 57, "дач": 16, "дащ": 9, "дб": 8, "дба": 8, "дв": 53, "два": 23, "дво": 20,
+			"дд": 48, "ддъ": 38, "де": 426, "де ": 81, "дей": 11, "дек": 63, "дел": 65, "дем": 7,
+			"ден": 178, "дес": 7, "ди": 247, "ди ": 40, "диа": 7, "дим": 39, "дин": 37, "дир": 69,
+			"дис": 7, "дит": 16, "диц": 11, "дия": 10, "дм": 21, "дмо": 20, "дн": 76, "дна": 11,
+			"дни": 42, "дно": 19, "до": 225, "до ": 26, "доб": 51, "дов": 36, "док": 12, "доп": 12,
+			"дор": 17, "дос": 52, "дот": 13, "дп": 11, "дпи": 9, "др": 88, "дра": 13, "дре": 36,
+			"дро": 12, "дру": 20, "дс": 14, "дск": 10, "ду": 58, "ду ": 14, "дул": 20, "дум": 6,
+			"дуп": 12, "дх": 12, "дхо": 10, "дъ": 155, "дъл": 26, "дър": 121, "дя": 16, "дящ": 10,
+			"е": 6885, "е ": 2861, "ѐ": 35, "ѐн": 35, "еб": 54, "еба": 14, "еби": 31, "ебр": 7,
+			"ев": 44, "ева": 11, "еви": 9, "евъ": 9, "ег": 49, "еги": 8, "егл": 27, "ед": 360,
+			"ед ": 52, "еда": 23, "едб": 8, "едв": 12, "еде": 64, "еди": 69, "едн": 41, "едо": 59,
+			"еду": 12, "еж": 219, "ежд": 182, "ежи": 20, "ежк": 7, "ез": 179, "ез ": 111, "еза": 24,
+			"езд": 8, "ези": 12, "езу": 8, "еи": 17, "еиз": 10, "ей": 33, "ейн": 9, "ейс": 13,
+			"ек": 303, "ека": 12, "еки": 18, "екр": 6, "екс": 79, "ект": 148, "еку": 30, "ел": 275,
+			"ел ": 48, "еле": 45, "ели": 71, "елн": 49, "елт": 6, "еля": 52, "ем": 152, "ема": 59,
+			"еме": 69, "еми": 6, "емо": 8, "ен": 860, "ен ": 241, "ена": 77, "ене": 75, "ени": 245,
+			"енл": 9, "енн": 13, "ено": 69, "енс": 7, "ент": 105, "ену": 7, "еня": 10, "ео": 57,
+			"еоб": 42, "еоч": 8, "еп": 71, "епа": 6, "епо": 10, "епр": 46, "ер": 240, "ер ": 29,
+			"ера": 32, "ерв": 17, "ере": 11, "ери": 37, "ерк": 29, "ерм": 9, "ерн": 11, "ерс": 40,
+			"еря": 8, "ес": 241, "ес ": 15, "еса": 8, "есв": 6, "есе": 11, "еси": 18, "еск": 21,
+			"есл": 17, "есн": 7, "ест": 111, "есу": 8, "есъ": 18, "ет": 530, "ет ": 39, "ета": 26,
+			"ете": 112, "ети": 109, "етн": 16, "ето": 208, "еу": 48, "еус": 48, "еф": 18, "ефи": 13,
+			"ех": 22, "ех ": 15, "ец": 8, "еци": 6, "еч": 73, "еча": 9, "ече": 58, "еш": 117,
+			"еше": 10, "ешк": 57, "ешн": 39, "ещ": 11, "ж": 635, "жа": 101, "жа ": 37, "жав": 10,
+			"жан": 28, "жат": 20, "жд": 203, "жда": 177, "жде": 11, "жду": 14, "же": 227, "же ": 173,
+			"жен": 24, "жес": 14, "жет": 15, "жи": 53, "жи ": 13, "жим": 25, "жин": 6, "жит": 7,
+			"жк": 12, "жка": 8, "жн": 21, "жно": 18, "жу": 13, "жур": 13, "з": 2113, "з ": 130,
+			"за": 842, "за ": 441, "заб": 9, "зав": 36, "заг": 10, "зад": 89, "зак": 7, "зам": 15,
+			"зан": 29, "зап": 87, "зар": 13, "зат": 80, "зац": 7, "защ": 7, "зая": 7, "зб": 33,
+			"зби": 8, "збо": 10, "збр": 8, "зв": 311, "зва": 104, "зве": 142, "звл": 12, "зво": 24,
+			"звъ": 26, "зг": 11, "згр": 6, "зд": 103, "зда": 59, "зде": 33, "здо": 6, "зе": 18,
+			"зен": 12, "зи": 101, "зи ": 40, "зир": 28, "зис": 15, "зич": 8, "зк": 30, "зка": 12,
+			"зки": 8, "зкл": 10, "зл": 40, "зли": 38, "зм": 42, "зме": 18, "змо": 21, "зн": 77,
+			"зна": 56, "зни": 12, "зно": 8, "зо": 32, "зоб": 7, "зов": 7, "зон": 7, "зп": 91,
+			"зпо": 44, "зпр": 8, "зпъ": 36, "зр": 16, "зра": 10, "зс": 11, "зст": 10, "зт": 90,
+			"зте": 26, "зтл": 13, "зто": 12, "зтр": 38, "зу": 11, "зул": 8, "зх": 61, "зхо": 61,
+			"зч": 47, "зча": 9, "зчи": 37, "зш": 12, "зши": 12, "и": 5777, "и ": 1390, "иа": 42,
+			"иал": 20, "иан": 10, "иап": 6, "иб": 27, "ибл": 12, "ибу": 12, "ив": 190, "ив ": 10,
+			"ива": 112, "иви": 12, "ивк": 9, "ивн": 18, "иво": 20, "иг": 43, "игн": 17, "игу": 12,
+			"ид": 83, "ид ": 15, "ида": 7, "иде": 51, "ие": 163, "ие ": 96, "иет": 60, "иж": 10,
+			"ижи": 9, "из": 599, "из ": 9, "иза": 9, "изб": 23, "изв": 187, "изг": 10, "изи": 32,
+			"изк": 10, "изн": 7, "изо": 10, "изп": 85, "изр": 12, "изт": 85, "изх": 61, "изч": 47,
+			"ии": 68, "ии ": 45, "иит": 23, "ий": 17, "ийс": 17, "ик": 181, "ик ": 13, "ика": 92,
+			"ике": 24, "ики": 14, "ико": 11, "икс": 12, "икт": 10, "ил": 219, "ила": 24, "иле": 27,
+			"или": 117, "илн": 17, "ило": 18, "илт": 9, "им": 257, "им ": 27, "има": 64, "имв": 10,
+			"име": 98, "ими": 27, "имо": 24, "ин": 297, "ин ": 26, "ина": 66, "инд": 65, "ине": 8,
+			"ини": 33, "инс": 20, "инт": 26, "ину": 12, "инф": 33, "ио": 31, "ион": 15, "иот": 12,
+			"ип": 43, "ипс": 32, "ир": 344, "ира": 249, "ире": 73, "иро": 7, "ирт": 7, "ис": 272,
+			"ис ": 25, "иса": 29, "исв": 15, "иси": 21, "иск": 31, "исл": 18, "ист": 94, "исъ": 22,
+			"ит": 579, "ит ": 12, "ита": 26, "ите": 446, "ити": 8, "итк": 9, "ито": 67, "иф": 66,
+			"ифи": 55, "ифр": 11, "иц": 60, "ица": 22, "ици": 37, "ич": 161, "ича": 25, "иче": 22,
+			"ичи": 9, "ичк": 36, "ичн": 68, "иш": 50, "ише": 8, "иши": 24, "ищ": 74, "ища": 28,
+			"ище": 39, "ищо": 7, "ия": 504, "ия ": 296, "ият": 208, "й": 441, "й ": 41, "йк": 12,
+			"йки": 10, "йл": 253, "йл ": 87, "йла": 18, "йло": 138, "йлъ": 10, "йн": 31, "йне": 9,
+			"йно": 20, "йс": 43, "йск": 18, "йст": 22, "йт": 44, "йте": 17, "йто": 24, "к": 2131,
+			"к ": 66, "ка": 641, "ка ": 281, "каж": 13, "каз": 104, "как": 19, "кал": 35, "кан": 25,
+			"кас": 10, "кат": 99, "кац": 34, "кач": 13, "кв": 83, "ква": 65, "кви": 10, "кво": 7,
+			"ке": 121, "кет": 108, "ки": 164, "ки ": 126, "кир": 7, "кит": 26, "кл": 153, "кла": 48,
+			"кло": 53, "клю": 47, "ко": 367, "ко ": 54, "ков": 14, "ког": 12, "код": 26, "кое": 7,
+			"кои": 52, "кой": 24, "кол": 14, "ком": 59, "кон": 53, "коп": 18, "кор": 11, "коя": 12,
+			"кр": 84, "кра": 33, "кри": 28, "кръ": 23, "кс": 106, "кс ": 24, "кса": 43, "кси": 16,
+			"кст": 12, "кт": 187, "кт ": 22, "кта": 10, "кти": 82, "кто": 64, "ку": 41, "кущ": 22,
+			"кц": 19, "кци": 19, "къ": 91, "къв": 8, "към": 61, "къс": 8, "кът": 9, "л": 2021,
+			"л ": 175, "ла": 171, "ла ": 48, "лав": 48, "лаг": 28, "лас": 21, "лат": 15, "лб": 13,
+			"лбо": 9, "ле": 307, "лев": 15, "лед": 88, "леж": 14, "лем": 15, "лен": 111, "лет": 12,
+			"леч": 28, "лж": 17, "лжи": 13, "лз": 61, "лзв": 60, "ли": 479, "ли ": 139, "лив": 48,
+			"лиз": 23, "лий": 11, "лик": 49, "лио": 12, "лип": 33, "лир": 16, "лит": 50, "лич": 26,
+			"лищ": 44, "лк": 11, "лн": 201, "лна": 40, "лне": 20, "лни": 73, "лно": 59, "лня": 9,
+			"ло": 303, "ло ": 10, "лов": 147, "лож": 24, "лок": 32, "лон": 77, "лс": 13, "лск": 12,
+			"лт": 28, "лта": 14, "лу": 37, "луч": 24, "лъ": 17, "лът": 13, "лю": 55, "люч": 47,
+			"ля": 119, "ля ": 15, "ляв": 38, "лян": 38, "лят": 14, "м": 1517, "м ": 100, "ма": 371,
+			"ма ": 117, "мак": 17, "мал": 23, "ман": 47, "мар": 11, "мас": 8, "мат": 76, "мах": 16,
+			"мац": 33, "маш": 17, "мв": 10, "мво": 10, "ме": 429, "ме ": 61, "мѐ": 34, "мед": 10,
+			"меж": 14, "мен": 161, "мер": 35, "мес": 64, "мет": 46, "ми": 87, "ми ": 40, "мин": 15,
+			"мис": 9, "мит": 9, "мн": 40, "мно": 36, "мо": 364, "мо ": 71, "мод": 22, "мож": 198,
+			"мом": 14, "мон": 16, "мос": 17, "мот": 7, "мощ": 7, "мп": 10, "му": 15, "му ": 10,
+			"мъ": 30, "мър": 23, "мя": 45, "мян": 29, "мяс": 16, "н": 6141, "н ": 348, "на": 1832,
+			"на ": 1478, "наб": 7, "над": 10, "нак": 21, "нал": 53, "нам": 9, "нап": 29, "нас": 39,
+			"нат": 109, "нац": 20, "нач": 31, "нг": 18, "нгл": 11, "нд": 203, "нда": 116, "нде": 63,
+			"нди": 18, "не": 1684, "не ": 1240, "неб": 8, "нев": 8, "нед": 12, "нез": 19, "неи": 9,
+			"нен": 60, "нео": 49, "неп": 59, "нер": 17, "нес": 37, "нет": 114, "неу": 42, "ни": 991,
+			"ни ": 271, "нив": 23, "ние": 137, "низ": 11, "ник": 29, "нил": 46, "ним": 9, "нир": 19,
+			"нит": 166, "ниц": 31, "нич": 14, "нищ": 8, "ния": 225, "нк": 13, "нкц": 11, "нл": 9,
+			"нли": 9, "нн": 60, "нни": 44, "нно": 13, "но": 577, "но ": 291, "нов": 88, "ног": 23,
+			"нож": 14, "ном": 13, "нор": 13, "нос": 47, "нот": 80, "нс": 47, "нск": 27, "нст": 18,
+			"нт": 187, "нт ": 24, "нта": 21, "нте": 39, "нти": 85, "нтр": 14, "ну": 43, "нув": 7,
+			"нуд": 9, "нул": 20, "нф": 49, "нфл": 10, "нфо": 33, "нъ": 7, "ня": 59, "няв": 21,
+			"няк": 7, "ням": 20, "о": 5200, "о ": 1168, "об": 331, "оба": 54, "обв": 16, "обе": 91,
+			"обн": 40, "обр": 39, "обх": 47, "общ": 26, "ов": 469, "ова": 80, "ове": 250, "ови": 42,
+			"ово": 26, "овр": 14, "овт": 8, "овя": 32, "ог": 111, "ога": 17, "ого": 24, "огр": 66,
+			"од": 468, "од ": 87, "ода": 127, "одд": 48, "оде": 21, "оди": 53, "одм": 19, "одн": 24,
+			"одп": 9, "одр": 30, "оду": 20, "одъ": 8, "одя": 9, "ое": 23, "оез": 8, "ож": 248,
+			"ожд": 12, "оже": 202, "ожи": 9, "ожн": 19, "оз": 59, "озв": 21, "ози": 27, "озн": 9,
+			"ои": 70, "оит": 48, "оич": 11, "ой": 98, "ой ": 28, "ойк": 12, "ойн": 21, "ойс": 13,
+			"ойт": 18, "ок": 109, "ок ": 11, "ока": 58, "око": 13, "оку": 7, "ол": 187, "ола": 9,
+			"оле": 20, "олз": 61, "олн": 20, "оло": 11, "олу": 25, "оля": 25, "ом": 194, "ома": 59,
+			"оме": 97, "омо": 9, "омп": 10, "омя": 14, "он": 196, "он ": 36, "она": 25, "оне": 15,
+			"они": 36, "онн": 9, "онт": 44, "онф": 16, "оп": 159, "опе": 11, "опи": 33, "опр": 22,
+			"опу": 9, "опц": 63, "опъ": 8, "ор": 297, "ор ": 30, "ора": 22, "оре": 39, "ори": 112,
+			"орм": 63, "орс": 8, "ос": 201, "осв": 7, "оси": 10, "осл": 16, "осн": 8, "осо": 23,
+			"ост": 131, "от": 600, "от ": 180, "ота": 9, "отб": 9, "отв": 34, "отг": 10, "отд": 27,
+			"оте": 15, "оти": 8, "отк": 37, "отм": 15, "отн": 51, "ото": 147, "отп": 9, "отр": 38,
+			"отс": 7, "оц": 15, "оце": 13, "оч": 116, "оча": 26, "очв": 9, "оче": 34, "очи": 18,
+			"очн": 25, "ощ": 17, "оя": 46, "оя ": 8, "оян": 16, "оят": 12, "п": 2208, "п ": 9,
+			"па": 191, "па ": 7, "пад": 11, "паз": 20, "пак": 84, "пам": 12, "пап": 8, "пар": 20,
+			"пас": 20, "пе": 91, "пер": 16, "пех": 15, "печ": 9, "пеш": 34, "пи": 158, "пир": 25,
+			"пис": 98, "пит": 12, "пиш": 8, "пк": 29, "пка": 22, "пки": 7, "пл": 13, "пли": 11,
+			"по": 644, "по ": 66, "пов": 34, "под": 236, "поз": 32, "пок": 17, "пол": 103, "пом": 9,
+			"пон": 7, "пор": 31, "пос": 40, "пот": 49, "пр": 828, "пра": 125, "пре": 249, "при": 210,
+			"про": 238, "пс": 37, "псв": 30, "пт": 7, "пу": 17, "пус": 10, "пц": 63, "пци": 63,
+			"пъ": 114, "пъл": 56, "пър": 17, "път": 41, "р": 3549, "р ": 74, "ра": 919, "ра ": 55,
+			"ра̀": 14, "раб": 75, "рав": 86, "рад": 15, "раж": 14, "раз": 164, "рай": 12, "рак": 15,
+			"рам": 60, "ран": 284, "рат": 62, "раф": 13, "рац": 12, "ращ": 16, "рая": 7, "рв": 91,
+			"рва": 16, "рво": 46, "рвъ": 16, "рг": 22, "ргу": 21, "рд": 17, "ре": 761, "ре ": 8,
+			"реб": 46, "рег": 20, "ред": 180, "реж": 47, "рез": 47, "реи": 8, "рек": 82, "рем": 74,
+			"рен": 52, "реп": 11, "рес": 58, "реф": 12, "реш": 74, "рж": 80, "ржа": 80, "рз": 18,
+			"рзв": 8, "ри": 501, "ри ": 167, "риа": 8, "риб": 14, "рив": 44, "риг": 7, "рие": 10,
+			"рии": 26, "рил": 36, "рин": 15, "рип": 7, "рир": 36, "рис": 14, "рит": 43, "рич": 13,
+			"рия": 45, "рк": 36, "рка": 28, "рм": 76, "рма": 64, "рми": 9, "рн": 38, "рна": 19,
+			"рни": 7, "рно": 8, "ро": 373, "роб": 21, "ров": 44, "рог": 52, "род": 9, "рой": 52,
+			"рол": 22, "ром": 83, "рос": 10, "рот": 7, "роц": 13, "роч": 21, "роя": 12, "рп": 7,
+			"рс": 95, "рсе": 21, "рси": 45, "рск": 19, "рт": 144, "ртв": 23, "рти": 16, "ртн": 88,
+			"рту": 11, "ру": 42, "руг": 18, "руп": 12, "рх": 45, "рхи": 33, "рху": 7, "рч": 8,
+			"рче": 8, "рш": 37, "ршв": 23, "рши": 12, "ръ": 74, "ръж": 7, "ръз": 19, "ръп": 21,
+			"рът": 13, "ря": 79, "ряб": 39, "ряв": 10, "рян": 21, "с": 2714, "с ": 280, "са": 217,
+			"са ": 127, "сам": 63, "сан": 21, "св": 92, "сва": 59, "све": 12, "сво": 8, "свъ": 13,
+			"се": 301, "се ": 238, "сев": 7, "сек": 14, "сен": 28, "си": 231, "си ": 19, "сив": 7,
+			"сиг": 11, "сии": 12, "сим": 39, "син": 7, "сир": 9, "сис": 40, "сит": 17, "сич": 37,
+			"сия": 27, "ск": 200, "ска": 128, "скв": 30, "ски": 24, "ско": 11, "сл": 164, "сле": 96,
+			"сли": 40, "сло": 12, "слу": 9, "см": 17, "сн": 33, "сна": 8, "сно": 13, "со": 61,
+			"соч": 37, "сп": 115, "спе": 56, "спи": 36, "спо": 14, "ср": 22, "сре": 15, "ст": 674,
+			"ст ": 42, "ста": 182, "ств": 114, "сте": 60, "сти": 87, "стн": 8, "сто": 92, "стр": 64,
+			"стт": 10, "стъ": 15, "су": 21, "сум": 11, "сур": 9, "съ": 255, "съв": 24, "съд": 40,
+			"съз": 55, "сък": 18, "съо": 26, "сър": 16, "със": 31, "сът": 16, "същ": 19, "ся": 20,
+			"сяк": 8, "сян": 10, "т": 4535, "т ": 661, "та": 776, "та ": 493, "тав": 29, "таз": 15,
+			"так": 18, "тал": 14, "тан": 134, "тар": 23, "тат": 29, "тб": 9, "тв": 204, "тва": 71,
+			"тве": 16, "тви": 37, "тво": 43, "твр": 11, "тву": 16, "твъ": 10, "тг": 10, "тго": 9,
+			"тд": 27, "тда": 25, "те": 883, "те ": 505, "тег": 23, "тей": 9, "тек": 45, "тел": 166,
+			"тем": 42, "тен": 38, "тер": 37, "теч": 7, "ти": 502, "ти ": 153, "тив": 16, "тиж": 8,
+			"тик": 29, "тим": 25, "тин": 10, "тир": 72, "тис": 7, "тит": 52, "тиф": 53, "тич": 42,
+			"тищ": 22, "тк": 60, "тка": 17, "тки": 11, "тко": 7, "ткр": 19, "тл": 13, "тла": 13,
+			"тм": 17, "тме": 14, "тн": 177, "тна": 26, "тни": 79, "тно": 72, "то": 812, "то ": 495,
+			"тов": 65, "тоз": 22, "той": 17, "ток": 26, "том": 16, "тор": 105, "тот": 8, "точ": 27,
+			"тоя": 18, "тп": 9, "тр": 233, "тра": 24, "тре": 42, "три": 65, "тро": 49, "тря": 49,
+			"тс": 12, "тст": 7, "тт": 15, "тта": 15, "ту": 29, "туа": 11, "тур": 10, "тъ": 69,
+			"тъп": 12, "тър": 31, "тът": 13, "тя": 13, "тяв": 7, "у": 678, "у ": 37, "уа": 12,
+			"уал": 9, "уб": 8, "убл": 8, "ув": 31, "ува": 29, "уг": 28, "уга": 10, "уги": 11,
+			"уд": 22, "уди": 10, "удо": 9, "уе": 8, "уер": 7, "ук": 115, "ука": 103, "ул": 59,
+			"ула": 9, "уле": 13, "ули": 15, "улт": 8, "ум": 50, "ума": 8, "уме": 27, "уми": 11,
+			"ун": 26, "унк": 12, "уп": 44, "упа": 8, "упр": 28, "ур": 52, "ура": 11, "урн": 18,
+			"урс": 16, "ус": 101, "уск": 7, "усл": 10, "усп": 53, "уст": 22, "ут": 14, "ути": 9,
+			"уф": 11, "уфе": 11, "уч": 27, "уча": 12, "уче": 10, "ущ": 22, "уща": 10, "ущи": 8,
+			"ф": 501, "фа": 268, "фа ": 14, "фай": 253, "фе": 19, "фер": 13, "фи": 89, "фик": 61,
+			"фил": 10, "фл": 18, "фла": 8, "фли": 10, "фо": 66, "фор": 61, "фр": 14, "фри": 8,
+			"фу": 11, "фун": 11, "х": 327, "х ": 20, "ха": 14, "ха ": 7, "хв": 13, "хва": 8,
+			"хи": 33, "хив": 31, "хн": 9, "хна": 7, "хо": 175, "ход": 155, "хож": 11, "хр": 48,
+			"хра": 46, "ху": 7, "ху ": 7, "ц": 313, "ца": 25, "ца ": 15, "цат": 8, "це": 27,
+			"цел": 13, "цес": 13, "ци": 253, "ци ": 27, "циа": 20, "ции": 29, "цио": 13, "цит": 10,
+			"циф": 7, "ция": 141, "ч": 662, "ч ": 9, "ча": 153, "чав": 23, "чак": 30, "чал": 24,
+			"чан": 22, "час": 28, "чат": 16, "чв": 31, "чва": 31, "че": 194, "че ": 50, "чен": 83,
+			"чес": 12, "чет": 43, "чи": 100, "чи ": 17, "чин": 19, "чис": 36, "чит": 23, "чк": 40,
+			"чки": 33, "чн": 105, "чна": 28, "чни": 28, "чно": 48, "чр": 21, "чре": 21, "ш": 296,
+			"ш ": 8, "ша": 35, "шаб": 21, "шав": 7, "шв": 26, "шва": 23, "ше": 31, "ше ": 12,
+			"шен": 14, "ши": 81, "ши ": 29, "шин": 18, "шир": 20, "шк": 59, "шка": 47, "шки": 12,
+			"шн": 48, "шна": 9, "шни": 9, "шно": 30, "щ": 296, "щ ": 27, "ща": 66, "ща ": 35,
+			"щан": 7, "щат": 22, "ще": 103, "ще ": 59, "щен": 20, "щес": 16, "щет": 7, "щи": 57,
+			"щи ": 32, "щит": 10, "щия": 15, "щн": 9, "щна": 8, "що": 32, "що ": 17, "щот": 15,
+			"ъ": 1100, "ъв": 57, "ъв ": 27, "ъвм": 15, "ъвп": 9, "ъд": 133, "ъда": 22, "ъде": 71,
+			"ъдъ": 40, "ъж": 9, "ъз": 109, "ъзд": 55, "ъзк": 19, "ъзм": 21, "ъзс": 10, "ък": 33,
+			"ък ": 12, "ъка": 13, "ъл": 89, "ълб": 9, "ълж": 16, "ълн": 53, "ъм": 64, "ъм ": 62,
+			"ън": 13, "ън ": 10, "ъо": 26, "ъоб": 17, "ъот": 9, "ъп": 33, "ъп ": 8, "ъпк": 22,
+			"ър": 315, "ър ": 11, "ъра": 7, "ърв": 72, "ърд": 10, "ърж": 80, "ърз": 18, "ърс": 24,
+			"ърт": 26, "ърх": 12, "ърш": 37, "ъс": 41, "ъс ": 17, "ъст": 17, "ът": 134, "ът ": 99,
+			"ъти": 24, "ъч": 7, "ъщ": 24, "ъще": 17, "ю": 63, "юч": 47, "ючв": 21, "юче": 10,
+			"я": 1026, "я ": 348, "яб": 39, "ябв": 39, "яв": 129, "ява": 111, "яво": 8, "яз": 7,
+			"як": 15, "яко": 9, "ям": 25, "яма": 20, "ян": 158, "яна": 30, "яне": 110, "яни": 17,
+			"яс": 21, "яст": 16, "ят": 252, "ят ": 97, "ята": 141, "ято": 10, "ящ": 18, "ящи": 9,
+		}},
+		"cs": {Total: 214700, Ngrams: map[string]int{
+			" a": 463, " a ": 118, " ab": 22, " ad": 61, " ak": 27, " al": 52, " an": 22, " ap": 47,
+			" ar": 57, " as": 11, " at": 11, " au": 21, " b": 335, " ba": 77, " be": 49, " bi": 20,
+			" bu": 36, " by": 61, " bý": 50, " bě": 13, " c": 327, " ca": 27, " ce": 61, " ch": 155,
+			" co": 26, " cí": 14, " d": 427, " da": 78, " de": 38, " di": 26, " dl": 17, " do": 148,
+			" dr": 14, " dv": 20, " dé": 15, " dů": 29, " e": 63, " ex": 22, " f": 84, " fi": 14,
+			" fo": 38, " fr": 11, " fu": 12, " g": 53, " ge": 13, " gs": 13, " h": 141, " ha": 13,
+			" he": 21, " hl": 38, " ho": 36, " i": 181, " i ": 15, " id": 34, " ig": 14, " im": 24,
+			" in": 69, " j": 491, " ja": 56, " je": 299, " ji": 35, " jm": 37, " js": 53, " k": 618,
+			" k ": 46, " ka": 34, " kd": 27, " ke": 19, " kl": 221, " ko": 126, " kr": 19, " kt": 60,
+			" kv": 12, " kó": 45, " l": 145, " le": 17, " li": 48, " lo": 51, " m": 380, " ma": 61,
+			" me": 54, " mi": 15, " mn": 11, " mo": 63, " mr": 33, " mu": 30, " má": 26, " mé": 19,
+			" mí": 15, " mě": 12, " mů": 28, " n": 1201, " n ": 12, " na": 315, " ne": 682, " ni": 12,
+			" no": 22, " nu": 26, " ná": 102, " ně": 22, " o": 517, " o ": 39, " ob": 108, " oc": 12,
+			" od": 128, " om": 15, " op": 47, " os": 22, " ot": 22, " ov": 87, " p": 1642, " pa": 59,
+			" pe": 12, " pi": 11, " pl": 30, " po": 605, " pr": 455, " př": 431, " q": 14, " qw": 13,
+			" r": 206, " re": 82, " ro": 89, " s": 1336, " s ": 126, " sa": 12, " sc": 18, " sd": 12,
+			" se": 287, " sh": 26, " si": 38, " sk": 36, " sl": 50, " sm": 23, " so": 268, " sp": 87,
+			" st": 180, " su": 15, " sv": 18, " sy": 100, " t": 314, " ta": 55, " te": 59, " tl": 14,
+			" to": 67, " tr": 17, " ty": 33, " tř": 22, " u": 293, " u ": 18, " uk": 32, " um": 13,
+			" ur": 42, " us": 18, " uv": 22, " už": 84, " v": 976, " v ": 159, " va": 35, " ve": 115,
+			" vi": 14, " vl": 30, " vo": 18, " vr": 14, " vs": 39, " vy": 312, " vz": 30, " ví": 27,
+			" vý": 114, " vě": 11, " vš": 31, " w": 34, " wi": 12, " x": 15, " z": 643, " z ": 33,
+			" za": 206, " zd": 38, " ze": 29, " zj": 15, " zk": 15, " zm": 37, " zn": 93, " zo": 17,
+			" zp": 57, " zá": 56, " zí": 21, " ú": 71, " úd": 11, " úr": 39, " č": 157, " ča": 33,
+			" če": 14, " čt": 25, " čá": 25, " čí": 49, " ř": 82, " ře": 34, " řá": 36, " š": 38,
+			" ši": 14, " ší": 11, " ž": 85, " že": 24, " žá": 53, "a": 4361, "a ": 801, "ab": 72,
+			"aby": 21, "abá": 13, "ac": 210, "ace": 61, "ach": 19, "aci": 38, "aco": 18, "ací": 59,
+			"ad": 284, "ada": 48, "ado": 77, "adr": 63, "adu": 16, "adá": 30, "ag": 15, "ah": 86,
+			"ah ": 11, "ahr": 17, "ahu": 42, "ai": 20, "ain": 14, "aj": 67, "ajt": 13, "ají": 27,
+			"ak": 215, "ak ": 51, "akc": 11, "ako": 47, "akt": 28, "aky": 11, "aká": 21, "aků": 14,
+			"al": 313, "al ": 30, "ala": 20, "ale": 56, "ali": 37, "alo": 38, "alt": 23, "alí": 57,
+			"alš": 16, "am": 174, "am ": 35, "ame": 34, "ami": 32, "amu": 13, "amě": 22, "an": 315,
+			"and": 41, "ang": 16, "ani": 19, "ano": 17, "aná": 22, "ané": 52, "aní": 19, "aný": 72,
+			"aně": 12, "ap": 109, "apl": 30, "apo": 11, "aps": 18, "ar": 247, "ara": 15, "arc": 33,
+			"ard": 41, "are": 12, "arg": 20, "aro": 33, "ars": 17, "art": 26, "aru": 12, "as": 187,
+			"asn": 20, "aso": 11, "ast": 99, "at": 593, "at ": 226, "ata": 44, "ate": 120, "ati": 41,
+			"atn": 75, "ato": 35, "atr": 11, "atu": 12, "au": 25, "aut": 22, "av": 179, "ava": 11,
+			"ave": 52, "avi": 39, "avo": 14, "avu": 13, "aví": 14, "az": 221, "az ": 49, "aze": 40,
+			"azo": 21, "azu": 30, "azy": 33, "ač": 111, "ač ": 14, "ače": 20, "ačn": 28, "ačí": 14,
+			"aď": 11, "aďa": 11, "ař": 26, "aři": 13, "aří": 13, "aš": 23, "až": 30, "ažd": 16,
+			"b": 1333, "b ": 16, "ba": 177, "ba ": 84, "baj": 13, "bal": 68, "be": 82, "ber": 11,
+			"bez": 51, "bi": 38, "bin": 17, "bl": 34, "blo": 13, "blé": 11, "bn": 42, "bno": 19,
+			"bná": 11, "bo": 364, "bo ": 109, "bor": 209, "bov": 17, "br": 75, "bra": 55, "brá": 13,
+			"bs": 68, "bsa": 47, "bu": 77, "bud": 36, "but": 11, "by": 177, "by ": 55, "byl": 111,
+			"bá": 15, "báz": 13, "bí": 32, "bí ": 18, "bý": 54, "být": 50, "bě": 51, "bě ": 19,
+			"běh": 13, "c": 1462, "c ": 77, "ca": 45, "cap": 16, "ce": 268, "ce ": 149, "cen": 19,
+			"cer": 46, "ces": 38, "ch": 507, "ch ": 188, "cha": 18, "chi": 27, "chn": 22, "cho": 60,
+			"chr": 12, "chy": 128, "chá": 13, "ci": 119, "ci ": 73, "cit": 17, "ck": 115, "ck ": 19,
+			"cke": 12, "cké": 43, "cký": 14, "co": 57, "cov": 22, "cr": 19, "cs": 12, "ct": 23,
+			"cí": 177, "cí ": 111, "cíc": 17, "cíh": 11, "cíl": 15, "cím": 23, "d": 2136, "d ": 153,
+			"da": 249, "da ": 11, "daj": 22, "dal": 22, "dan": 35, "dar": 37, "dat": 91, "dař": 13,
+			"dd": 11, "de": 217, "de ": 50, "def": 11, "dek": 18, "den": 46, "dep": 20, "des": 19,
+			"di": 87, "dia": 11, "din": 15, "dis": 15, "diu": 11, "dk": 72, "dka": 22, "dku": 19,
+			"dky": 13, "dl": 43, "dle": 15, "dlo": 21, "dm": 13, "dn": 250, "dno": 65, "dná": 14,
+			"dné": 40, "dní": 65, "dný": 39, "do": 300, "do ": 63, "dob": 22, "dok": 25, "dom": 12,
+			"dos": 25, "dou": 14, "dov": 109, "dp": 137, "dpi": 41, "dpo": 89, "dr": 133, "dre": 61,
+			"dro": 43, "dru": 15, "drž": 11, "ds": 26, "dst": 18, "du": 78, "du ": 36, "duj": 25,
+			"dv": 39, "dvo": 29, "dy": 41, "dy ": 23, "dyž": 17, "dá": 86, "dá ": 14, "dál": 11,
+			"dán": 36, "dáv": 20, "dé": 23, "dél": 12, "dí": 45, "dí ": 23, "díl": 16, "dě": 40,
+			"děl": 21, "dř": 14, "dů": 35, "dův": 28, "e": 5397, "e ": 1444, "ea": 19, "eam": 12,
+			"eb": 223, "eba": 14, "ebo": 113, "ebu": 11, "eby": 60, "ec": 135, "ec ": 38, "ech": 59,
+			"ed": 236, "ed ": 19, "ede": 47, "edk": 12, "edn": 47, "edo": 22, "edu": 13, "edá": 15,
+			"ee": 14, "ef": 18, "eg": 18, "eh": 18, "ej": 93, "ejn": 32, "ejs": 16, "ek": 137,
+			"ek ": 61, "ekt": 11, "eká": 26, "el": 369, "el ": 37, "ele": 48, "elh": 26, "eli": 39,
+			"ell": 12, "eln": 46, "els": 19, "elz": 89, "em": 186, "em ": 118, "eme": 13, "emo": 15,
+			"emů": 12, "en": 865, "en ": 97, "ena": 53, "eno": 62, "ent": 105, "eny": 25, "ená": 19,
+			"ené": 56, "ení": 342, "ený": 46, "eo": 27, "eoč": 11, "ep": 153, "epi": 12, "epl": 46,
+			"epo": 37, "eps": 15, "epí": 22, "er": 399, "er ": 42, "era": 23, "ern": 29, "ero": 18,
+			"ert": 63, "eru": 28, "erv": 38, "erz": 31, "eré": 38, "erý": 28, "es": 348, "es ": 43,
+			"esa": 40, "ese": 16, "esk": 23, "esl": 23, "esn": 11, "eso": 23, "esp": 12, "est": 41,
+			"esu": 22, "esy": 16, "esá": 42, "et": 174, "et ": 30, "eta": 14, "ete": 26, "etr": 19,
+			"etě": 32, "eu": 14, "ev": 90, "ev ": 33, "evo": 14, "ex": 58, "exi": 13, "ext": 29,
+			"ez": 183, "ez ": 35, "eze": 57, "ezi": 17, "ezn": 31, "ezp": 17, "eč": 65, "ečk": 15,
+			"ečn": 43, "eň": 17, "eň ": 17, "eř": 16, "eře": 13, "eš": 16, "ež": 44, "ež ": 17,
+			"eži": 21, "f": 306, "f ": 17, "fi": 115, "fic": 12, "fig": 12, "fik": 60, "fil": 12,
+			"fin": 12, "fo": 66, "for": 57, "fr": 26, "fro": 16, "ft": 30, "ft ": 12, "ftw": 17,
+			"fu": 13, "fun": 12, "g": 282, "g ": 23, "ge": 29, "gen": 11, "gi": 18, "gl": 11,
+			"gli": 11, "gn": 44, "gno": 16, "gná": 18, "gp": 17, "gp ": 14, "gr": 44, "gra": 27,
+			"gs": 16, "gu": 39, "gum": 19, "gur": 11, "h": 1186, "h ": 213, "ha": 61, "hal": 23,
+			"han": 17, "he": 55, "hel": 16, "hem": 11, "hes": 17, "hi": 49, "hif": 11, "hiv": 24,
+			"hl": 101, "hla": 34, "hle": 23, "hlá": 24, "hn": 29, "hny": 16, "ho": 343, "ho ": 172,
+			"hod": 70, "hot": 13, "hov": 34, "hoz": 34, "hr": 55, "hra": 21, "hro": 14, "hrá": 13,
+			"ht": 13, "hu": 52, "hu ": 11, "huj": 40, "hy": 143, "hyb": 128, "há": 24, "hé": 16,
+			"i": 2541, "i ": 495, "ia": 23, "ib": 35, "ibu": 13, "ic": 152, "ice": 22, "ich": 11,
+			"ici": 28, "ick": 72, "id": 81, "id ": 29, "ide": 24, "ie": 22, "ie ": 12, "if": 97,
+			"ifi": 65, "ifr": 14, "ift": 11, "ig": 61, "ign": 35, "igu": 15, "ih": 26, "ihl": 20,
+			"ij": 25, "ija": 11, "ik": 147, "ik ": 11, "ika": 46, "iko": 30, "iká": 46, "il": 88,
+			"il ": 20, "ile": 14, "ili": 11, "ilo": 19, "im": 98, "imo": 18, "imp": 27, "imu": 11,
+			"imá": 12, "in": 257, "in ": 19, "ina": 19, "ind": 17, "inf": 20, "ini": 12, "ino": 19,
+			"ins": 38, "int": 23, "inu": 25, "iny": 12, "iná": 17, "io": 15, "ip": 55, "ip ": 11,
+			"ipo": 13, "ir": 27, "irt": 12, "is": 195, "is ": 25, "isk": 35, "iso": 21, "ist": 45,
+			"isu": 30, "it": 324, "it ": 154, "ite": 42, "iti": 12, "itn": 14, "ito": 11, "itu": 11,
+			"itá": 11, "ití": 16, "itř": 11, "iu": 13, "iv": 142, "iv ": 12, "iva": 79, "ivn": 16,
+			"iz": 47, "iza": 22, "izo": 16, "ič": 20, "ičk": 11, "iš": 48, "iš ": 32, "išt": 11,
+			"iž": 21, "iž ": 14, "j": 1012, "j ": 18, "ja": 70, "jak": 46, "je": 491, "je ": 348,
+			"jed": 47, "jej": 14, "jen": 44, "ji": 77, "ji ": 13, "jin": 17, "jis": 14, "již": 14,
+			"jm": 47, "jme": 20, "jmé": 25, "jn": 42, "jný": 14, "jo": 28, "jov": 28, "js": 70,
+			"jso": 53, "jst": 12, "jt": 40, "jte": 27, "jí": 96, "jí ": 27, "jíc": 60, "k": 2181,
+			"k ": 206, "ka": 301, "ka ": 90, "kac": 42, "kam": 11, "kar": 12, "kat": 13, "kaz": 98,
+			"kaž": 16, "kc": 24, "kce": 14, "kd": 31, "kdy": 23, "ke": 65, "ke ": 13, "kem": 22,
+			"ket": 18, "kl": 260, "kla": 14, "klá": 113, "klí": 122, "kn": 30, "knu": 16, "ko": 358,
+			"ko ": 42, "kol": 12, "kom": 39, "kon": 122, "kos": 16, "kou": 32, "kov": 57, "koz": 12,
+			"kr": 49, "kri": 16, "kro": 12, "kt": 121, "kte": 72, "ktu": 32, "ku": 169, "ku ": 84,
+			"kud": 20, "kuj": 12, "kum": 11, "kup": 16, "kus": 11, "kv": 15, "ky": 139, "ky ": 125,
+			"kyt": 13, "ká": 151, "ká ": 24, "kál": 19, "kát": 47, "káv": 22, "káz": 24, "ké": 120,
+			"ké ": 108, "kó": 50, "kód": 50, "ký": 35, "ký ": 14, "kýc": 12, "ků": 39, "ků ": 36,
+			"l": 2428, "l ": 223, "la": 258, "la ": 63, "lac": 11, "lad": 17, "las": 27, "lat": 85,
+			"lav": 19, "lb": 14, "le": 311, "le ": 83, "led": 63, "lem": 28, "len": 55, "lev": 12,
+			"lez": 32, "lh": 26, "lha": 22, "li": 321, "li ": 57, "lic": 47, "lik": 58, "lin": 16,
+			"lit": 42, "liz": 24, "liš": 34, "lk": 26, "lka": 12, "ll": 19, "ln": 126, "lná": 11,
+			"lné": 23, "lní": 54, "lný": 22, "lně": 12, "lo": 357, "lo ": 94, "loc": 22, "lok": 34,
+			"lou": 27, "lov": 68, "lož": 76, "ls": 42, "lsk": 30, "lt": 34, "lt ": 11, "lu": 78,
+			"lu ": 33, "luj": 17, "luž": 14, "ly": 34, "ly ": 33, "lz": 97, "lze": 97, "lá": 172,
+			"lád": 16, "lán": 18, "láv": 99, "láš": 25, "lé": 27, "lém": 12, "lí": 186, "lík": 45,
+			"líč": 134, "lš": 21, "lší": 19, "lů": 14, "lů ": 12, "m": 1743, "m ": 412, "ma": 146,
+			"mac": 29, "mal": 14, "mat": 25, "maz": 13, "maď": 11, "mb": 13, "me": 199, "men": 80,
+			"mer": 15, "met": 39, "mez": 40, "mi": 120, "mi ": 82, "min": 15, "mk": 27, "mka": 17,
+			"mn": 20, "mno": 12, "mo": 145, "moc": 22, "moh": 18, "mov": 27, "mož": 47, "mp": 64,
+			"mpl": 21, "mpr": 20, "mr": 34, "mrt": 33, "mt": 15, "mto": 13, "mu": 138, "mu ": 96,
+			"mus": 32, "my": 27, "my ": 17, "má": 74, "má ": 30, "mát": 27, "mé": 57, "méd": 17,
+			"mén": 34, "mí": 55, "mí ": 16, "mín": 13, "mís": 15, "mě": 113, "měn": 56, "měr": 16,
+			"mět": 20, "mů": 45, "můž": 40, "n": 4901, "n ": 228, "na": 617, "na ": 257, "nah": 14,
+			"nai": 14, "nak": 66, "nal": 38, "nam": 48, "nas": 62, "nat": 17, "nač": 47, "nc": 43,
+			"nci": 12, "nd": 68, "nda": 39, "ndi": 12, "ne": 772, "ne ": 26, "neb": 181, "nec": 20,
+			"ned": 29, "nej": 23, "nel": 98, "nem": 36, "nen": 102, "neo": 22, "nep": 87, "ner": 20,
+			"nes": 24, "neu": 12, "nev": 11, "nez": 23, "než": 18, "nf": 37, "nfi": 14, "nfo": 20,
+			"ng": 32, "ni": 135, "ni ": 30, "nic": 23, "nik": 11, "nit": 36, "nk": 29, "nn": 33,
+			"nné": 15, "no": 444, "no ": 164, "nor": 21, "nos": 64, "not": 36, "nou": 57, "nov": 70,
+			"ns": 57, "nst": 32, "nt": 188, "nt ": 30, "nte": 41, "nti": 25, "nto": 31, "ntr": 27,
+			"nu": 146, "nu ": 35, "nul": 23, "nut": 54, "nux": 13, "ny": 104, "ny ": 98, "ná": 300,
+			"ná ": 110, "nál": 29, "nám": 30, "nár": 11, "nás": 19, "náv": 34, "náz": 52, "né": 319,
+			"né ": 247, "néh": 50, "ném": 21, "ní": 888, "ní ": 746, "níc": 26, "níh": 47, "ník": 18,
+			"ním": 47, "ný": 270, "ný ": 197, "nýc": 40, "ným": 33, "nč": 40, "nče": 16, "nči": 11,
+			"ně": 134, "ně ": 69, "něk": 13, "něn": 32, "o": 5793, "o ": 1024, "ob": 209, "obe": 14,
+			"obl": 14, "obn": 27, "obr": 39, "obs": 54, "obě": 16, "oc": 103, "oce": 26, "ock": 29,
+			"ocí": 19, "od": 436, "od ": 20, "oda": 11, "odd": 11, "ode": 41, "odk": 28, "odl": 19,
+			"odn": 70, "odo": 13, "odp": 122, "odr": 15, "ods": 18, "odu": 18, "of": 24, "oft": 18,
+			"og": 27, "ogr": 22, "oh": 67, "ohl": 20, "oho": 31, "oj": 99, "oje": 41, "ojo": 27,
+			"ok": 129, "oko": 29, "oku": 43, "oká": 21, "ol": 200, "ola": 15, "olb": 11, "ole": 44,
+			"oli": 40, "oln": 11, "olo": 38, "olu": 19, "om": 158, "oma": 16, "ome": 17, "omo": 23,
+			"omp": 31, "omu": 11, "omé": 11, "omě": 14, "on": 187, "onc": 13, "one": 16, "onf": 16,
+			"ont": 49, "onč": 37, "op": 87, "ope": 26, "opr": 12, "or": 472, "or ": 97, "ore": 12,
+			"ori": 11, "orm": 53, "oro": 50, "ort": 21, "oru": 99, "ory": 42, "orů": 44, "os": 248,
+			"osl": 23, "ost": 153, "osí": 23, "ot": 150, "ota": 15, "ote": 14, "oti": 12, "oto": 53,
+			"oty": 13, "ou": 623, "ou ": 189, "oub": 209, "oud": 19, "ouh": 16, "oup": 11, "out": 18,
+			"ouz": 32, "ouč": 16, "ouš": 11, "ouž": 74, "ov": 1022, "ova": 224, "ove": 35, "ovi": 12,
+			"ovk": 14, "ovn": 59, "ovo": 59, "ovu": 14, "ová": 277, "ové": 89, "oví": 17, "ový": 94,
+			"ově": 113, "oz": 173, "oze": 16, "ozi": 11, "ozn": 29, "ozo": 13, "ozí": 31, "ozš": 13,
+			"oč": 71, "oče": 40, "očí": 12, "oř": 64, "oře": 35, "oři": 15, "oš": 22, "ošk": 12,
+			"ož": 160, "oža": 18, "ože": 36, "oži": 17, "ožk": 29, "ožn": 48, "p": 2854, "p ": 126,
+			"pa": 93, "pak": 12, "pam": 20, "par": 14, "pat": 21, "pd": 11, "pe": 96, "pec": 15,
+			"pen": 14, "per": 25, "peč": 17, "pg": 19, "pgp": 14, "pi": 140, "pin": 19, "pis": 96,
+			"pk": 13, "pl": 141, "pla": 71, "pli": 49, "pn": 64, "pnu": 18, "pní": 15, "po": 854,
+			"po ": 21, "pod": 175, "poj": 46, "pok": 35, "pol": 52, "pom": 23, "pon": 15, "por": 90,
+			"pos": 48, "pot": 14, "pou": 107, "pov": 78, "poz": 48, "poč": 29, "poš": 14, "pož": 23,
+			"pr": 558, "pra": 53, "pri": 28, "pro": 367, "prv": 16, "prá": 80, "ps": 64, "ps ": 18,
+			"psa": 21, "psá": 17, "pt": 27, "pt ": 15, "pu": 59, "pu ": 36, "pus": 13, "pí": 56,
+			"pín": 24, "píš": 25, "pě": 17, "př": 448, "pře": 149, "při": 157, "pří": 135, "pů": 19,
+			"q": 18, "qw": 13, "qwe": 13, "r": 2852, "r ": 196, "ra": 308, "ra ": 14, "rac": 41,
+			"ram": 34, "ran": 62, "rat": 24, "rav": 33, "raz": 58, "rač": 12, "rc": 38, "rch": 27,
+			"rd": 45, "rdn": 35, "re": 219, "re ": 15, "rea": 14, "res": 92, "rež": 19, "rg": 23,
+			"rgu": 19, "ri": 136, "rib": 13, "rim": 26, "rip": 12, "rit": 30, "rk": 22, "rl": 31,
+			"rl ": 31, "rm": 67, "rma": 21, "rmá": 26, "rn": 64, "rna": 12, "rní": 28, "ro": 772,
+			"ro ": 180, "rob": 26, "roc": 24, "rog": 19, "roj": 42, "rol": 25, "rom": 30, "ros": 54,
+			"rot": 19, "rou": 38, "rov": 199, "roz": 59, "rs": 29, "rsk": 21, "rt": 166, "rti": 42,
+			"rtu": 26, "rtv": 33, "rty": 14, "ru": 185, "ru ": 122, "ruj": 14, "ruš": 12, "rv": 61,
+			"rve": 36, "ry": 74, "ry ": 61, "rz": 40, "rze": 21, "rzi": 13, "rá": 165, "rá ": 15,
+			"rán": 22, "rát": 28, "ráv": 55, "ráz": 32, "ré": 45, "ré ": 31, "rý": 34, "rý ": 27,
+			"rč": 25, "rče": 14, "rů": 63, "rů ": 49, "rž": 12, "s": 3215, "s ": 272, "sa": 147,
+			"sa ": 36, "sah": 52, "sam": 22, "sat": 20, "sc": 30, "sch": 15, "sd": 12, "se": 350,
+			"se ": 167, "sel": 52, "ser": 41, "sez": 28, "sh": 42, "she": 15, "si": 53, "sig": 33,
+			"sk": 227, "ska": 27, "skn": 17, "sko": 22, "sku": 29, "sky": 14, "ské": 68, "ský": 15,
+			"sl": 173, "sla": 26, "sle": 46, "slo": 58, "slu": 24, "sm": 37, "smě": 12, "sn": 41,
+			"so": 407, "sob": 24, "soc": 12, "sof": 18, "sou": 296, "sov": 45, "sp": 132, "spe": 13,
+			"spo": 54, "spr": 16, "spu": 17, "sr": 12, "ss": 26, "st": 840, "st ": 58, "sta": 254,
+			"ste": 36, "sti": 86, "stn": 35, "sto": 24, "str": 84, "stu": 109, "sté": 73, "stí": 14,
+			"stř": 21, "su": 81, "su ": 44, "sv": 32, "sva": 12, "sy": 124, "sy ": 24, "sym": 13,
+			"syn": 14, "sys": 73, "sá": 61, "sán": 16, "sář": 30, "sí": 76, "sí ": 22, "síl": 15,
+			"sím": 21, "sít": 14, "sů": 18, "sů ": 16, "t": 3743, "t ": 754, "ta": 420, "ta ": 69,
+			"tab": 23, "tak": 15, "tal": 32, "tan": 42, "tar": 38, "tat": 45, "tav": 96, "te": 551,
+			"te ": 121, "tej": 18, "tek": 17, "tel": 131, "tem": 13, "ten": 51, "ter": 106, "tev": 12,
+			"tex": 24, "teč": 38, "ti": 281, "ti ": 71, "tic": 29, "tif": 55, "tis": 25, "tit": 25,
+			"tiv": 19, "tk": 33, "tl": 22, "tm": 14, "tn": 160, "tno": 16, "tná": 13, "tné": 32,
+			"tní": 52, "tný": 29, "to": 328, "to ": 122, "toh": 13, "tom": 32, "tor": 39, "tot": 16,
+			"tov": 62, "tp": 16, "tp ": 12, "tr": 175, "tra": 33, "tre": 13, "tri": 25, "tro": 57,
+			"ts": 12, "tt": 20, "tu": 270, "tu ": 88, "tua": 17, "tuj": 21, "tup": 98, "tuá": 13,
+			"tv": 109, "tvo": 50, "tvá": 13, "tvý": 30, "tw": 18, "twa": 17, "ty": 111, "ty ": 66,
+			"typ": 32, "tá": 34, "té": 103, "té ": 16, "tém": 75, "tí": 93, "tí ": 76, "tím": 12,
+			"tě": 79, "tě ": 18, "těn": 15, "těz": 32, "tř": 66, "tře": 43, "tří": 12, "tů": 20,
+			"tů ": 19, "u": 2480, "u ": 881, "ua": 18, "ual": 14, "ub": 218, "ubo": 210, "uc": 20,
+			"ud": 84, "ud ": 26, "ude": 32, "uh": 31, "uj": 186, "uje": 118, "ují": 53, "uk": 46,
+			"uka": 13, "uko": 15, "ul": 53, "ulo": 26, "um": 82, "um ": 21, "ume": 34, "umí": 14,
+			"un": 44, "up": 149, "up ": 39, "upi": 20, "upn": 31, "upu": 24, "ur": 86, "ura": 14,
+			"url": 15, "urč": 25, "us": 92, "us ": 14, "usp": 12, "ust": 20, "usí": 30, "ut": 128,
+			"ut ": 21, "ute": 16, "uto": 23, "utí": 32, "uv": 34, "ux": 13, "uz": 43, "uze": 29,
+			"uá": 15, "uál": 15, "uč": 28, "uš": 30, "ušt": 13, "už": 178, "užb": 12, "uži": 120,
+			"uží": 34, "v": 3073, "v ": 228, "va": 432, "va ": 22, "vac": 30, "val": 18, "van": 90,
+			"var": 29, "vat": 202, "vač": 13, "ve": 377, "ve ": 47, "ved": 18, "vel": 27, "ven": 58,
+			"ver": 78, "ves": 101, "veň": 17, "veř": 13, "vi": 82, "vit": 21, "vič": 14, "vk": 28,
+			"vl": 37, "vla": 21, "vn": 118, "vni": 30, "vná": 14, "vní": 35, "vně": 21, "vo": 232,
+			"vod": 21, "vol": 87, "vou": 23, "vov": 13, "voř": 46, "vr": 46, "vra": 18, "vrá": 15,
+			"vs": 42, "vst": 40, "vu": 52, "vu ": 44, "vy": 347, "vy ": 24, "vyb": 31, "vyh": 14,
+			"vyn": 12, "vyp": 84, "vys": 12, "vyt": 56, "vyž": 71, "vz": 30, "vzo": 16, "vá": 354,
+			"vá ": 41, "ván": 278, "vář": 13, "vé": 102, "vé ": 67, "véh": 18, "ví": 66, "ví ": 15,
+			"víc": 25, "víd": 16, "vý": 246, "vý ": 61, "výc": 61, "vým": 33, "výp": 12, "výr": 25,
+			"výs": 42, "vě": 162, "vě ": 14, "věd": 15, "věr": 18, "věř": 92, "vř": 15, "vš": 33,
+			"vše": 32, "vů": 17, "w": 93, "wa": 31, "war": 27, "we": 13, "wer": 13, "wi": 13,
+			"win": 12, "x": 117, "x ": 24, "xi": 18, "xis": 12, "xt": 30, "xt ": 14, "y": 1393,
+			"y ": 582, "yb": 159, "yba": 66, "ybn": 13, "ybo": 15, "ybr": 16, "ybí": 21, "yc": 12,
+			"yh": 23, "yho": 16, "yk": 12, "yl": 117, "yl ": 40, "yla": 20, "ylo": 40, "ym": 19,
+			"yn": 30, "yp": 121, "yp ": 16, "ypn": 20, "yps": 20, "ypí": 26, "ys": 90, "yst": 75,
+			"yt": 82, "ytv": 56, "yž": 88, "yž ": 17, "yža": 69, "z": 1703, "z ": 130, "za": 284,
+			"zac": 26, "zad": 71, "zak": 23, "zam": 12, "zan": 12, "zap": 20, "zat": 25, "zav": 15,
+			"zač": 15, "zař": 12, "zb": 15, "zd": 84, "zdn": 25, "zdr": 29, "ze": 368, "ze ": 175,
+			"zec": 22, "zen": 107, "zer": 15, "zev": 30, "zi": 65, "zi ": 23, "zj": 15, "zji": 15,
+			"zk": 24, "zko": 13, "zm": 43, "změ": 37, "zn": 187, "zna": 126, "zno": 12, "zná": 28,
+			"zo": 89, "zob": 14, "zor": 22, "zov": 48, "zp": 77, "zpe": 17, "zpr": 38, "zs": 12,
+			"zu": 40, "zu ": 28, "zv": 36, "zy": 34, "zy ": 25, "zá": 66, "záp": 13, "zí": 70,
+			"zí ": 37, "zís": 21, "zš": 13, "zší": 13, "á": 1688, "á ": 254, "ác": 21, "ách": 12,
+			"ád": 128, "áda": 14, "ádk": 30, "ádn": 51, "áh": 13, "ál": 98, "ál ": 13, "áln": 49,
+			"ám": 47, "ám ": 15, "ámk": 17, "án": 411, "án ": 53, "ána": 26, "áno": 97, "ány": 26,
+			"ání": 192, "áp": 17, "ár": 36, "árn": 17, "ás": 48, "ásl": 12, "ást": 22, "át": 133,
+			"át ": 45, "áto": 21, "átu": 27, "áv": 246, "áva": 40, "áve": 102, "ávn": 14, "ávr": 16,
+			"ává": 31, "áz": 140, "áza": 24, "ázd": 25, "áze": 41, "ázv": 22, "ář": 52, "áře": 28,
+			"áš": 29, "áše": 18, "é": 818, "é ": 492, "éd": 20, "édi": 17, "éh": 94, "ého": 94,
+			"él": 12, "élk": 12, "ém": 140, "ém ": 47, "émo": 12, "ému": 61, "én": 34, "éno": 16,
+			"í": 2245, "í ": 1175, "íc": 144, "íce": 24, "ích": 52, "ící": 67, "íd": 29, "ída": 14,
+			"íh": 62, "ího": 62, "ík": 123, "íka": 65, "íky": 22, "íků": 12, "íl": 83, "íle": 17,
+			"íli": 36, "ím": 118, "ím ": 89, "ími": 14, "ín": 42, "ína": 20, "íp": 12, "ír": 21,
+			"ís": 98, "ísk": 21, "ísl": 36, "íst": 29, "ít": 87, "ít ": 36, "íte": 15, "ítk": 13,
+			"ív": 27, "íva": 12, "íz": 29, "íze": 17, "íč": 135, "íč ": 50, "íče": 60, "íř": 24,
+			"íře": 12, "íš": 26, "íše": 26, "ó": 54, "ód": 52, "ód ": 30, "ódo": 16, "ú": 75,
+			"úr": 39, "úro": 39, "ý": 677, "ý ": 327, "ýc": 123, "ých": 121, "ým": 84, "ým ": 51,
+			"ými": 33, "ýp": 12, "ýr": 25, "ýra": 18, "ýs": 42, "ýst": 36, "ýt": 50, "ýt ": 50,
+			"č": 699, "č ": 64, "ča": 53, "čas": 50, "če": 193, "če ": 67, "ček": 36, "čen": 54,
+			"čet": 19, "či": 57, "či ": 18, "čit": 18, "čk": 41, "čn": 77, "čno": 12, "čné": 13,
+			"ční": 30, "čt": 35, "čte": 19, "čá": 39, "čás": 21, "čí": 92, "čí ": 14, "čís": 50,
+			"čít": 22, "čů": 19, "čů ": 19, "ď": 18, "ě": 596, "ě ": 128, "ěd": 16, "ěh": 13,
+			"ěj": 17, "ěk": 15, "ěl": 31, "ěn": 107, "ěn ": 13, "ěnn": 12, "ění": 26, "ěr": 41,
+			"ěry": 15, "ět": 47, "ěti": 16, "ěz": 32, "ěze": 19, "ěř": 92, "ěře": 64, "ěřo": 20,
+			"ň": 32, "ň ": 23, "ř": 917, "ř ": 25, "řa": 24, "řaz": 20, "ře": 394, "ře ": 22,
+			"řeb": 21, "řed": 64, "řej": 19, "řek": 13, "řen": 132, "řep": 29, "řes": 20, "řet": 44,
+			"ři": 196, "ři ": 85, "řid": 14, "řih": 20, "řij": 13, "řil": 15, "řip": 16, "řit": 20,
+			"řo": 20, "řov": 20, "řá": 41, "řád": 41, "ří": 189, "ří ": 12, "řík": 58, "říl": 32,
+			"říp": 12, "říz": 26, "š": 344, "š ": 36, "še": 98, "še ": 37, "šec": 24, "šen": 29,
+			"ši": 33, "šif": 15, "šk": 21, "ško": 12, "št": 43, "ště": 24, "ší": 72, "ší ": 42,
+			"šíř": 24, "ů": 314, "ů ": 190, "ům": 14, "ům ": 12, "ůs": 19, "ův": 35, "ůvě": 22,
+			"ůž": 40, "ůže": 40, "ž": 689, "ž ": 61, "ža": 87, "žad": 87, "žb": 14, "žd": 21,
+			"že": 130, "že ": 71, "žen": 41, "ži": 165, "žim": 19, "žit": 46, "živ": 81, "žk": 30,
+			"žka": 12, "žn": 55, "žné": 29, "žá": 59, "žád": 58, "ží": 49, "žít": 17, "žív": 21,
+		}},
+		"da": {Total: 228806, Ngrams: map[string]int{
+			" a": 1136, " ac": 6, " ad": 110, " af": 316, " ag": 8, " ak": 24, " al": 88, " an": 192,
+			" ap": 34, " ar": 63, " as": 11, " at": 257, " au": 16, " b": 466, " ba": 20, " be": 104,
+			" bi": 25, " bl": 89, " bo": 18, " br": 168, " bu": 12, " by": 16, " c": 113, " ca": 17,
+			" cd": 14, " ce": 17, " co": 28, " cr": 6, " ct": 12, " d": 894, " d ": 6, " da": 69,
+			" de": 605, " di": 49, " dn": 6, " do": 24, " dp": 7, " du": 64, " dv": 16, " dy": 9,
+			" dø": 30, " e": 1115, " ef": 22, " eg": 7, " ej": 6, " ek": 38, " el": 155, " en": 359,
+			" er": 342, " es": 6, " et": 141, " eu": 8, " ev": 6, " ex": 8, " f": 1109, " fa": 18,
+			" fe": 107, " fi": 208, " fj": 38, " fl": 51, " fo": 483, " fr": 107, " fu": 35, " fø": 41,
+			" g": 305, " ga": 16, " ge": 67, " gi": 32, " go": 87, " gr": 35, " gs": 10, " gy": 19,
+			" gæ": 6, " gø": 8, " h": 329, " ha": 76, " he": 55, " hi": 9, " hj": 15, " ho": 15,
+			" ht": 6, " hu": 11, " hv": 76, " hå": 28, " hæ": 18, " hø": 18, " i": 1055, " i ": 178,
+			" id": 44, " ig": 15, " ik": 410, " im": 22, " in": 367, " ip": 6, " is": 6, " j": 18,
+			" k": 704, " ka": 196, " ke": 15, " ki": 21, " kl": 7, " ko": 203, " kr": 105, " ku": 115,
+			" kø": 29, " l": 297, " la": 42, " le": 17, " li": 76, " lo": 71, " lu": 6, " lå": 8,
+			" læ": 53, " lø": 8, " m": 575, " ma": 92, " me": 347, " mi": 46, " mm": 6, " mo": 24,
+			" mu": 20, " må": 27, " n": 418, " n ": 14, " na": 45, " ne": 43, " ni": 38, " no": 55,
+			" nu": 62, " ny": 22, " nå": 28, " nø": 108, " o": 438, " ob": 14, " of": 16, " og": 139,
+			" om": 54, " op": 132, " or": 10, " ou": 9, " ov": 52, " p": 544, " pa": 129, " pe": 19,
+			" pi": 18, " pl": 20, " po": 31, " pr": 149, " pu": 9, " på": 150, " r": 203, " ra": 7,
+			" re": 146, " ro": 30, " s": 1141, " sa": 61, " se": 98, " si": 76, " sk": 209, " sl": 40,
+			" sm": 6, " so": 172, " sp": 43, " st": 226, " su": 29, " sv": 6, " sy": 95, " så": 25,
+			" sæ": 24, " sø": 13, " t": 751, " ta": 112, " te": 62, " ti": 394, " tj": 17, " to": 32,
+			" tr": 63, " ty": 44, " u": 506, " u ": 8, " ub": 34, " ud": 182, " ug": 49, " uk": 11,
+			" un": 141, " up": 8, " ur": 12, " us": 22, " uv": 10, " v": 457, " va": 50, " ve": 168,
+			" vi": 127, " vm": 9, " væ": 96, " w": 36, " we": 8, " wi": 11, " wo": 6, " x": 11,
+			" x ": 7, " y": 10, " z": 9, " å": 20, " åb": 15, " æ": 35, " æn": 31, " é": 16,
+			" én": 14, " ø": 20, " ød": 7, "a": 4232, "a ": 179, "ab": 54, "aba": 8, "abe": 20,
+			"abi": 8, "abs": 6, "ac": 32, "ace": 8, "aci": 6, "ad": 205, "ad ": 25, "ada": 8,
+			"ade": 27, "adg": 31, "adm": 9, "adr": 33, "ads": 10, "adt": 17, "adv": 37, "ae": 17,
+			"aen": 6, "aer": 6, "af": 355, "af ": 224, "afb": 10, "afh": 14, "afi": 14, "afk": 9,
+			"afs": 55, "aft": 14, "afv": 6, "ag": 153, "ag ": 29, "age": 88, "agg": 7, "agt": 11,
+			"ai": 29, "ain": 24, "ak": 188, "ak ": 11, "aka": 6, "akk": 99, "aks": 11, "akt": 50,
+			"al": 553, "al ": 172, "alb": 6, "ald": 36, "ale": 37, "alg": 46, "ali": 24, "all": 112,
+			"alo": 34, "als": 12, "alt": 45, "am": 186, "am ": 49, "ame": 25, "ami": 13, "aml": 9,
+			"amm": 77, "an": 713, "an ": 163, "ana": 10, "and": 234, "anf": 8, "ang": 190, "ank": 7,
+			"ans": 39, "ant": 31, "ap": 80, "app": 46, "aps": 11, "apt": 13, "ar": 384, "ar ": 66,
+			"ara": 30, "arb": 12, "ard": 54, "are": 37, "arg": 24, "ari": 13, "ark": 43, "ars": 36,
+			"art": 53, "as": 173, "ase": 28, "ask": 11, "ass": 23, "ast": 89, "at": 718, "at ": 304,
+			"ata": 115, "atc": 9, "ate": 42, "atf": 6, "ati": 138, "ato": 37, "att": 17, "atu": 38,
+			"au": 66, "au ": 36, "aut": 15, "av": 128, "ave": 30, "avn": 89, "ay": 8, "ayo": 7,
+			"b": 866, "b ": 27, "ba": 72, "bag": 30, "bar": 12, "bas": 23, "be": 169, "beg": 25,
+			"beh": 27, "bej": 11, "bel": 22, "ber": 11, "bes": 36, "bet": 16, "bi": 65, "bib": 7,
+			"bil": 19, "bin": 21, "bis": 10, "bj": 8, "bje": 6, "bl": 138, "bla": 7, "ble": 74,
+			"bli": 46, "blo": 9, "bn": 15, "bne": 12, "bo": 40, "bog": 15, "bol": 16, "br": 208,
+			"bre": 7, "bru": 170, "bry": 26, "bs": 10, "bu": 61, "bud": 6, "buf": 8, "bum": 6,
+			"bun": 13, "bus": 6, "but": 10, "by": 28, "by ": 6, "byt": 18, "c": 429, "c ": 36,
+			"ca": 34, "cap": 11, "cas": 7, "cc": 10, "cce": 7, "cd": 15, "cd ": 13, "ce": 132,
+			"ce ": 15, "cen": 13, "cer": 71, "ces": 26, "ch": 25, "che": 10, "ci": 51, "cia": 6,
+			"cie": 7, "cif": 18, "ck": 37, "ck ": 20, "cks": 10, "co": 46, "cod": 6, "com": 8,
+			"con": 24, "cr": 15, "ct": 15, "ctr": 12, "d": 3934, "d ": 612, "da": 200, "da ": 16,
+			"dag": 7, "dan": 9, "dar": 51, "dat": 112, "db": 12, "dby": 7, "dc": 7, "dd": 43,
+			"dda": 23, "dde": 16, "de": 1686, "de ": 385, "dea": 16, "deb": 6, "ded": 14, "def": 9,
+			"deh": 40, "dek": 8, "del": 198, "dem": 8, "den": 305, "deo": 13, "der": 427, "des": 54,
+			"det": 195, "df": 27, "dfi": 6, "dfø": 17, "dg": 49, "dga": 34, "dgi": 8, "dh": 16,
+			"dho": 11, "di": 247, "di ": 18, "die": 42, "dig": 110, "dik": 9, "din": 18, "dir": 10,
+			"dis": 23, "dit": 8, "dj": 13, "dje": 13, "dk": 80, "dke": 77, "dl": 85, "dle": 19,
+			"dli": 35, "dlæ": 6, "dlø": 19, "dm": 15, "dmi": 12, "dn": 35, "dni": 13, "dns": 6,
+			"dnu": 10, "do": 81, "do ": 23, "doe": 26, "dok": 6, "dom": 10, "dou": 6, "dp": 18,
+			"dpa": 7, "dpk": 6, "dr": 122, "dra": 6, "dre": 92, "dri": 15, "ds": 206, "ds ": 11,
+			"dse": 12, "dsk": 58, "dsn": 7, "dsp": 9, "dss": 9, "dst": 76, "dt": 186, "dt ": 83,
+			"dta": 25, "dte": 56, "dtr": 21, "du": 73, "du ": 60, "dv": 70, "dva": 45, "dvi": 10,
+			"dvo": 8, "dy": 13, "dyn": 8, "dø": 31, "død": 30, "e": 11292, "e ": 2577, "ea": 94,
+			"eak": 16, "eam": 18, "eau": 41, "eb": 27, "eb ": 8, "ec": 30, "eci": 21, "ed": 573,
+			"ed ": 293, "edd": 11, "ede": 157, "edi": 41, "edj": 13, "edl": 10, "eds": 24, "ee": 14,
+			"ef": 64, "efa": 6, "efe": 11, "efi": 15, "eft": 21, "eg": 171, "eg ": 9, "ege": 14,
+			"egg": 6, "egi": 14, "egm": 6, "egn": 78, "egr": 16, "egu": 9, "egy": 10, "eh": 82,
+			"eha": 8, "eho": 65, "ei": 8, "ej": 132, "ejd": 11, "eje": 9, "ejl": 106, "ek": 175,
+			"ek ": 9, "eka": 20, "ekk": 6, "eko": 8, "eks": 67, "ekt": 37, "eku": 8, "ekv": 8,
+			"el": 770, "el ": 101, "ela": 30, "ele": 33, "elf": 8, "eli": 81, "ell": 206, "elo": 22,
+			"els": 221, "elt": 32, "elv": 13, "em": 281, "em ": 42, "ema": 22, "eme": 69, "emh": 6,
+			"eml": 10, "emm": 35, "emo": 10, "emr": 23, "ems": 12, "emt": 29, "emæ": 6, "en": 1753,
+			"en ": 859, "ena": 15, "enc": 14, "end": 255, "ene": 48, "eng": 63, "enh": 17, "eni": 10,
+			"enk": 11, "enl": 40, "enn": 85, "ens": 106, "ent": 211, "enu": 11, "eo": 31, "eoe": 6,
+			"eom": 8, "ep": 27, "epa": 8, "epu": 6, "er": 2782, "er ": 1653, "era": 38, "erb": 10,
+			"erd": 7, "ere": 462, "erf": 12, "erh": 15, "eri": 156, "erk": 8, "erm": 10, "ern": 103,
+			"ero": 9, "err": 7, "ers": 157, "ert": 56, "erv": 52, "eré": 6, "es": 552, "es ": 306,
+			"ese": 10, "esk": 35, "eso": 6, "esp": 19, "ess": 69, "est": 79, "esu": 6, "et": 1031,
+			"et ": 808, "eta": 28, "ete": 17, "eti": 17, "etn": 8, "eto": 6, "etr": 17, "ets": 14,
+			"ett": 85, "etu": 9, "etv": 7, "ety": 10, "eu": 10, "eur": 7, "ev": 81, "ev ": 30,
+			"eve": 47, "ex": 14, "f": 1978, "f ": 247, "fa": 31, "fal": 8, "fan": 11, "fb": 10,
+			"fbr": 9, "fe": 156, "fej": 106, "fem": 7, "fen": 7, "fer": 27, "ff": 42, "ffe": 22,
+			"ffi": 12, "fh": 15, "fhæ": 14, "fi": 353, "fic": 28, "fig": 13, "fik": 41, "fil": 214,
+			"fin": 41, "fir": 6, "fj": 38, "fje": 38, "fk": 9, "fko": 9, "fl": 62, "fla": 16,
+			"fle": 24, "fly": 15, "fo": 545, "fo ": 15, "for": 526, "fr": 131, "fra": 86, "fre": 27,
+			"fri": 18, "fs": 59, "fsl": 38, "fsn": 10, "ft": 109, "ft ": 30, "fte": 43, "ftr": 11,
+			"ftv": 6, "ftw": 13, "fu": 44, "ful": 16, "fun": 28, "fv": 6, "fy": 10, "fø": 92,
+			"føj": 15, "føl": 24, "før": 51, "g": 2782, "g ": 662, "ga": 73, "gan": 52, "gat": 10,
+			"gd": 14, "gde": 14, "ge": 726, "ge ": 151, "geh": 8, "gek": 21, "gel": 42, "gem": 9,
+			"gen": 186, "ger": 226, "ges": 24, "get": 50, "gf": 11, "gfr": 11, "gg": 41, "gge": 38,
+			"gh": 28, "ghe": 26, "gi": 186, "gig": 11, "gin": 16, "gis": 18, "giv": 136, "gl": 141,
+			"gle": 135, "gm": 8, "gme": 7, "gn": 145, "gn ": 60, "gna": 18, "gne": 24, "gni": 21,
+			"gno": 13, "go": 99, "god": 79, "gor": 7, "got": 8, "gp": 11, "gp ": 8, "gr": 157,
+			"gr ": 7, "gra": 91, "gre": 9, "gru": 30, "græ": 17, "gs": 162, "gs ": 7, "gsf": 12,
+			"gsk": 24, "gsl": 8, "gss": 15, "gst": 57, "gså": 7, "gt": 140, "gt ": 104, "gte": 23,
+			"gti": 9, "gu": 45, "gul": 6, "gum": 19, "gur": 13, "gv": 7, "gvi": 6, "gy": 79,
+			"gyl": 68, "gyn": 10, "gå": 6, "gæ": 20, "gæn": 14, "gø": 12, "gør": 12, "h": 596,
+			"h ": 21, "ha": 98, "han": 29, "har": 48, "hav": 10, "he": 133, "hed": 61, "hel": 13,
+			"hem": 7, "hen": 39, "her": 7, "hi": 21, "hj": 15, "hje": 9, "hjæ": 6, "ho": 99,
+			"hol": 81, "hov": 11, "ht": 8, "htt": 6, "hu": 12, "huk": 9, "hv": 78, "hve": 18,
+			"hvi": 38, "hvo": 17, "hå": 43, "hån": 41, "hæ": 33, "hæm": 16, "hæn": 16, "hø": 29,
+			"høj": 18, "hør": 10, "i": 4635, "i ": 243, "ia": 29, "ial": 11, "ian": 8, "ib": 31,
+			"ibl": 7, "ibu": 12, "ic": 59, "ica": 6, "ice": 29, "ici": 8, "ico": 6, "id": 186,
+			"id ": 72, "ide": 55, "idi": 11, "idl": 8, "ids": 27, "ie": 84, "ie ": 8, "iel": 11,
+			"ien": 17, "ier": 22, "ies": 12, "iet": 13, "if": 137, "iff": 6, "ifi": 62, "ift": 61,
+			"ig": 411, "ig ": 95, "iga": 7, "ige": 106, "igg": 6, "igh": 26, "ign": 44, "igs": 28,
+			"igt": 75, "igu": 13, "igv": 6, "ik": 516, "ik ": 14, "ika": 35, "ike": 14, "ikk": 433,
+			"iko": 8, "il": 761, "il ": 341, "ilb": 29, "ild": 27, "ile": 106, "ilf": 15, "ilg": 18,
+			"ilh": 10, "ili": 8, "ilk": 6, "ill": 112, "iln": 13, "ils": 44, "ilt": 9, "ilv": 9,
+			"im": 62, "ima": 9, "ime": 18, "imp": 23, "imæ": 7, "in": 1041, "in ": 37, "ina": 19,
+			"ind": 267, "ine": 35, "inf": 31, "ing": 422, "ini": 14, "inj": 37, "ink": 24, "ino": 8,
+			"inp": 11, "ins": 64, "int": 52, "inu": 6, "io": 168, "ion": 156, "iot": 6, "ip": 24,
+			"ir": 43, "ire": 11, "irk": 15, "is": 345, "is ": 82, "ise": 21, "isi": 6, "isk": 125,
+			"isl": 12, "iso": 6, "iss": 14, "ist": 63, "isu": 7, "it": 92, "it ": 26, "ite": 21,
+			"iti": 15, "itu": 6, "iv": 380, "iv ": 63, "ive": 253, "ivn": 37, "ivs": 10, "ix": 9,
+			"ix ": 7, "j": 341, "j ": 12, "jd": 11, "jds": 10, "je": 166, "je ": 29, "jek": 17,
+			"jem": 9, "jen": 16, "jer": 69, "jes": 7, "jl": 106, "jl ": 80, "jla": 6, "jle": 6,
+			"jr": 14, "jre": 13, "jæ": 7, "jæl": 7, "k": 3363, "k ": 251, "ka": 432, "kab": 9,
+			"kal": 163, "kan": 165, "kar": 8, "kat": 67, "ke": 902, "ke ": 516, "ked": 19, "kel": 36,
+			"kem": 16, "ken": 113, "ker": 143, "kes": 8, "ket": 32, "kg": 7, "kg ": 6, "ki": 102,
+			"kif": 24, "kil": 28, "kin": 8, "kit": 6, "kiv": 28, "kk": 585, "kke": 575, "kl": 29,
+			"kla": 16, "kle": 6, "kn": 20, "kni": 12, "ko": 316, "kod": 59, "kol": 12, "kom": 130,
+			"kon": 73, "kop": 7, "kor": 27, "kr": 271, "kra": 7, "kre": 19, "kri": 125, "kro": 12,
+			"kry": 8, "krå": 7, "kræ": 92, "ks": 106, "ks ": 14, "kse": 8, "ksi": 17, "ksp": 7,
+			"kst": 40, "ksv": 9, "kt": 136, "kt ": 24, "kte": 19, "kti": 59, "ktu": 23, "ku": 133,
+			"kum": 7, "kun": 119, "kur": 6, "kv": 11, "kve": 8, "ky": 10, "kæ": 13, "kær": 8,
+			"kø": 31, "kør": 27, "l": 4173, "l ": 784, "la": 212, "lac": 7, "lad": 51, "lag": 41,
+			"lan": 27, "lar": 9, "las": 10, "lat": 40, "lav": 8, "lay": 7, "lb": 40, "lba": 30,
+			"lbu": 6, "ld": 254, "ld ": 29, "lde": 108, "ldi": 72, "lds": 7, "ldt": 24, "le": 873,
+			"le ": 201, "led": 36, "lel": 10, "lem": 80, "len": 61, "ler": 356, "les": 18, "let": 37,
+			"lev": 60, "lf": 31, "lf ": 7, "lfi": 6, "lfø": 13, "lg": 115, "lg ": 16, "lge": 40,
+			"lgf": 11, "lgt": 21, "lgæ": 14, "lh": 12, "lhø": 9, "li": 444, "lic": 13, "lid": 11,
+			"lie": 6, "lig": 194, "lik": 14, "lin": 116, "lio": 6, "lis": 37, "lit": 10, "liv": 23,
+			"lj": 10, "lk": 25, "lke": 9, "lko": 11, "ll": 469, "ll ": 12, "lla": 43, "lle": 367,
+			"lli": 37, "lm": 7, "ln": 22, "lna": 13, "lo": 169, "loc": 22, "log": 62, "lok": 51,
+			"lon": 10, "lov": 6, "lp": 11, "ls": 305, "ls ": 9, "lse": 206, "lsk": 30, "lst": 42,
+			"lsv": 6, "lt": 100, "lt ": 56, "lta": 6, "lte": 17, "lti": 12, "lu": 82, "luk": 14,
+			"lut": 61, "lv": 29, "lv ": 8, "lva": 9, "lve": 6, "ly": 45, "lyk": 11, "lys": 9,
+			"lyt": 16, "lå": 20, "lås": 13, "læ": 71, "læn": 21, "lær": 7, "læs": 40, "lø": 34,
+			"løb": 16, "løs": 14, "m": 2105, "m ": 336, "ma": 256, "ma ": 9, "mac": 7, "mae": 10,
+			"mak": 10, "mal": 18, "man": 73, "map": 13, "mar": 19, "mas": 15, "mat": 76, "mb": 33,
+			"mbi": 10, "mbo": 16, "md": 7, "me": 710, "me ": 45, "med": 224, "mel": 63, "men": 149,
+			"meo": 8, "mer": 112, "mes": 7, "met": 96, "mh": 9, "mi": 90, "mil": 10, "min": 42,
+			"mis": 21, "ml": 29, "mla": 7, "mli": 9, "mm": 255, "mma": 54, "mme": 193, "mn": 9,
+			"mo": 50, "mod": 20, "mon": 13, "mp": 94, "mpl": 14, "mpo": 47, "mpr": 11, "mr": 41,
+			"mre": 7, "mru": 22, "mrå": 11, "ms": 28, "mst": 20, "mt": 40, "mt ": 17, "mte": 7,
+			"mu": 26, "mul": 23, "mv": 10, "mve": 10, "må": 34, "må ": 17, "mål": 10, "mæ": 28,
+			"mæn": 12, "mær": 13, "mø": 7, "møn": 6, "n": 5625, "n ": 1385, "na": 164, "nal": 29,
+			"nam": 12, "nat": 19, "nav": 88, "nc": 24, "nce": 16, "nd": 1014, "nd ": 82, "nda": 52,
+			"ndb": 7, "ndd": 13, "nde": 479, "ndh": 15, "ndi": 15, "ndl": 44, "ndn": 14, "ndo": 48,
+			"ndr": 66, "nds": 71, "ndt": 98, "ne": 546, "ne ": 275, "ned": 23, "nem": 14, "nen": 62,
+			"ner": 80, "nes": 30, "net": 35, "nf": 62, "nfi": 12, "nfo": 33, "nfø": 9, "ng": 733,
+			"ng ": 272, "ngd": 12, "nge": 207, "ngi": 110, "ngl": 15, "ngs": 91, "ngt": 9, "nh": 22,
+			"nhe": 18, "ni": 251, "nic": 7, "nin": 160, "nis": 16, "nit": 18, "niv": 41, "nj": 42,
+			"nje": 37, "nk": 87, "nk ": 7, "nke": 24, "nkl": 7, "nkr": 8, "nkt": 29, "nl": 58,
+			"nli": 52, "nn": 160, "nne": 157, "no": 85, "nog": 26, "non": 6, "nor": 25, "not": 10,
+			"np": 12, "npu": 11, "ns": 272, "ns ": 61, "nse": 19, "nsi": 9, "nsk": 43, "nsn": 8,
+			"nss": 7, "nst": 100, "nt": 386, "nt ": 59, "nta": 49, "nte": 165, "nti": 21, "ntl": 17,
+			"nto": 9, "ntr": 32, "ntu": 18, "nu": 110, "nu ": 18, "nul": 29, "num": 41, "nuv": 8,
+			"nv": 18, "nve": 12, "ny": 26, "ny ": 9, "nye": 8, "nå": 29, "når": 21, "næ": 7,
+			"nær": 6, "nø": 119, "nød": 8, "nøg": 111, "o": 2971, "o ": 83, "oa": 10, "ob": 40,
+			"obj": 8, "obl": 26, "oc": 65, "oce": 20, "oci": 6, "ock": 29, "od": 192, "od ": 15,
+			"odc": 6, "ode": 68, "odk": 77, "odn": 7, "odu": 7, "oe": 40, "oen": 22, "oer": 16,
+			"of": 36, "of ": 6, "off": 17, "oft": 13, "og": 331, "og ": 171, "oge": 25, "ogg": 15,
+			"ogi": 10, "ogl": 11, "ogr": 74, "ogs": 23, "ok": 91, "ok ": 7, "oka": 27, "oke": 24,
+			"okk": 10, "oku": 6, "ol": 178, "ol ": 15, "old": 84, "ole": 7, "oli": 7, "olk": 6,
+			"oll": 20, "olo": 12, "ols": 13, "om": 393, "om ": 182, "oma": 7, "omb": 8, "omm": 80,
+			"omp": 63, "omr": 12, "omv": 8, "omæ": 9, "on": 349, "on ": 98, "one": 94, "onf": 18,
+			"oni": 9, "onl": 6, "onn": 6, "ons": 28, "ont": 67, "onv": 8, "oo": 7, "oot": 6,
+			"op": 188, "op ": 19, "opd": 24, "ope": 22, "opf": 7, "opg": 7, "opi": 9, "opl": 9,
+			"opp": 9, "opr": 45, "ops": 18, "opt": 7, "or": 742, "or ": 371, "ora": 10, "orb": 20,
+			"ord": 25, "ore": 47, "ori": 22, "ork": 14, "orm": 69, "orr": 9, "ors": 27, "ort": 78,
+			"orv": 21, "os": 34, "osi": 7, "ost": 12, "ot": 43, "ot ": 14, "ote": 10, "oto": 6,
+			"ou": 44, "our": 20, "out": 22, "ov": 86, "ove": 70, "ovæ": 11, "ow": 9, "ox": 7,
+			"oxy": 7, "p": 1300, "p ": 54, "pa": 166, "pak": 100, "pan": 7, "par": 27, "pas": 8,
+			"pd": 31, "pda": 27, "pe": 165, "pe ": 60, "pec": 18, "pem": 6, "pen": 19, "per": 47,
+			"pf": 7, "pg": 15, "pi": 42, "pid": 7, "pil": 10, "pin": 7, "pk": 15, "pkg": 7,
+			"pl": 55, "pla": 21, "ple": 16, "pli": 8, "ply": 8, "po": 111, "pol": 8, "pon": 34,
+			"por": 43, "pos": 18, "pp": 76, "ppe": 45, "ppl": 7, "ppo": 13, "pps": 11, "pr": 232,
+			"pre": 43, "pri": 36, "pro": 133, "præ": 14, "ps": 49, "ps ": 16, "pst": 22, "pt": 35,
+			"pt ": 15, "pte": 10, "pu": 66, "pub": 7, "pun": 17, "pur": 11, "put": 24, "på": 152,
+			"på ": 143, "pø": 7, "pør": 7, "q": 6, "r": 6212, "r ": 2229, "ra": 321, "ra ": 73,
+			"rad": 15, "raf": 15, "rak": 20, "ram": 87, "ran": 30, "rap": 9, "ras": 8, "rat": 38,
+			"rb": 44, "rbe": 13, "rbi": 18, "rbu": 7, "rc": 26, "rce": 23, "rd": 137, "rd ": 44,
+			"rde": 16, "rdi": 49, "rds": 6, "re": 1215, "re ": 350, "rea": 27, "red": 70, "ree": 7,
+			"ref": 9, "reg": 40, "rek": 20, "rel": 60, "rem": 22, "ren": 139, "rep": 11, "rer": 112,
+			"res": 125, "ret": 200, "rev": 11, "rf": 15, "rg": 55, "rg ": 7, "rgt": 11, "rgu": 19,
+			"rh": 24, "rhe": 14, "ri": 434, "ri ": 19, "ria": 7, "rib": 14, "rie": 9, "rif": 54,
+			"rig": 14, "rim": 21, "rin": 150, "ris": 24, "rit": 15, "riv": 86, "rk": 99, "rk ": 6,
+			"rke": 40, "rki": 31, "rl": 45, "rl ": 23, "rli": 14, "rm": 91, "rm ": 9, "rma": 56,
+			"rmb": 7, "rn": 111, "rn ": 20, "rna": 13, "rne": 60, "rnø": 6, "ro": 243, "rob": 19,
+			"roc": 18, "rod": 14, "rog": 82, "rol": 33, "rom": 14, "ron": 8, "roo": 6, "rop": 10,
+			"rov": 11, "rox": 7, "rp": 7, "rr": 37, "rre": 30, "rs": 253, "rs ": 18, "rse": 48,
+			"rsi": 38, "rsk": 55, "rso": 9, "rst": 64, "rsø": 10, "rt": 213, "rt ": 76, "rte": 55,
+			"rti": 26, "rts": 13, "rtu": 10, "ru": 248, "rug": 168, "ruk": 12, "rum": 24, "run": 14,
+			"rup": 17, "rv": 78, "rva": 14, "rve": 50, "rvå": 6, "ry": 83, "ryd": 30, "ryk": 40,
+			"ryp": 9, "rå": 24, "råd": 14, "rås": 7, "ræ": 134, "ræf": 10, "ræk": 6, "ræn": 16,
+			"ræv": 92, "ré": 11, "rér": 11, "rø": 32, "røm": 21, "rør": 6, "s": 4156, "s ": 611,
+			"sa": 89, "sag": 6, "sam": 49, "san": 10, "sat": 6, "sb": 12, "sc": 10, "sd": 6,
+			"se": 575, "se ": 212, "sek": 29, "sel": 63, "sen": 70, "ser": 118, "ses": 48, "set": 9,
+			"sf": 31, "sfi": 12, "sfu": 7, "sh": 11, "si": 191, "sid": 23, "sig": 30, "sik": 27,
+			"sim": 7, "sin": 21, "sio": 35, "sis": 27, "sit": 13, "sk": 623, "sk ": 134, "ska": 134,
+			"ske": 110, "ski": 43, "sko": 37, "skr": 142, "sky": 7, "skæ": 8, "sl": 111, "sla": 9,
+			"sle": 11, "slo": 7, "slu": 61, "sly": 10, "slå": 11, "sm": 19, "sma": 10, "sn": 63,
+			"sni": 45, "snu": 8, "so": 234, "soc": 16, "sof": 13, "sok": 11, "som": 136, "son": 10,
+			"sop": 7, "sor": 9, "sou": 20, "sp": 89, "spe": 19, "spo": 12, "spr": 17, "spu": 19,
+			"spø": 7, "ss": 147, "sse": 71, "ssi": 13, "ssk": 7, "sso": 24, "sst": 25, "st": 1020,
+			"st ": 121, "sta": 259, "std": 11, "ste": 253, "sti": 97, "sto": 31, "str": 151, "sty": 11,
+			"stø": 59, "su": 58, "sue": 10, "sum": 7, "sun": 12, "sv": 28, "sv ": 9, "sva": 11,
+			"sy": 110, "sym": 24, "syn": 17, "sys": 66, "så": 33, "så ": 24, "sæ": 41, "sæt": 38,
+			"sø": 25, "søg": 23, "t": 5521, "t ": 1825, "ta": 623, "ta ": 48, "tab": 24, "tad": 10,
+			"tag": 49, "tai": 24, "tal": 145, "tam": 7, "tan": 86, "tar": 47, "tas": 84, "tat": 64,
+			"tav": 14, "tc": 18, "tch": 10, "td": 12, "te": 1000, "te ": 223, "ted": 27, "teg": 72,
+			"tek": 39, "tel": 22, "tem": 91, "ten": 51, "ter": 325, "tes": 51, "tet": 93, "tf": 12,
+			"tfo": 8, "th": 9, "ti": 821, "ti ": 12, "tid": 56, "tie": 7, "tif": 32, "tig": 16,
+			"tik": 8, "til": 431, "tin": 17, "tio": 121, "tis": 40, "tiv": 59, "tj": 21, "tje": 21,
+			"tl": 23, "tl ": 8, "tli": 10, "tn": 55, "tni": 50, "to": 147, "to ": 14, "tod": 8,
+			"tol": 7, "tom": 29, "top": 17, "tor": 44, "tos": 8, "tp": 22, "tpu": 12, "tr": 333,
+			"tra": 33, "tre": 124, "tri": 23, "trl": 13, "tro": 45, "tru": 19, "try": 42, "trø": 21,
+			"ts": 44, "ts ": 16, "tt": 218, "tte": 177, "tti": 8, "ttr": 9, "tty": 15, "tu": 107,
+			"tu ": 12, "tue": 20, "tur": 36, "tus": 20, "tv": 25, "tvi": 8, "tvæ": 10, "tw": 13,
+			"twa": 13, "ty": 89, "ty ": 13, "tyd": 7, "typ": 45, "tyr": 8, "tys": 12, "tæ": 10,
+			"tø": 64, "tør": 22, "tøt": 38, "u": 1709, "u ": 134, "ua": 12, "ub": 44, "ubl": 7,
+			"ubr": 20, "ubu": 13, "uc": 12, "ud": 208, "ud ": 18, "udd": 11, "ude": 39, "udf": 17,
+			"udl": 18, "udp": 8, "uds": 46, "udt": 28, "udv": 11, "ue": 35, "uel": 30, "uf": 16,
+			"uff": 9, "ug": 225, "ug ": 40, "uge": 120, "ugt": 11, "ugy": 49, "ui": 12, "uk": 48,
+			"uk ": 7, "uke": 7, "ukk": 9, "ukn": 7, "uko": 10, "ukt": 8, "ul": 98, "ul ": 12,
+			"uld": 16, "uli": 18, "ull": 14, "uls": 7, "ult": 10, "um": 120, "um ": 29, "ume": 36,
+			"umm": 29, "ums": 11, "un": 355, "un ": 60, "und": 165, "uni": 11, "unk": 28, "unn": 64,
+			"unt": 17, "up": 37, "upp": 21, "ur": 110, "ur ": 19, "ura": 7, "urc": 21, "ure": 14,
+			"urg": 11, "url": 9, "us": 63, "us ": 35, "ust": 9, "ut": 145, "ut ": 46, "ute": 8,
+			"uti": 8, "utn": 22, "uto": 12, "utp": 12, "utt": 24, "uv": 23, "uve": 10, "uvæ": 12,
+			"v": 1624, "v ": 127, "va": 146, "val": 68, "var": 64, "ve": 794, "ve ": 81, "vea": 41,
+			"ved": 79, "vel": 24, "ven": 112, "ver": 251, "ves": 99, "vet": 97, "vi": 210, "vid": 28,
+			"vil": 34, "vir": 19, "vis": 103, "vm": 9, "vn": 129, "vn ": 56, "vne": 52, "vni": 15,
+			"vo": 36, "vol": 7, "vor": 28, "vs": 14, "væ": 140, "væl": 24, "vær": 116, "w": 76,
+			"wa": 22, "war": 18, "we": 14, "web": 7, "wi": 12, "win": 10, "wn": 8, "x": 54,
+			"x ": 28, "xy": 7, "y": 530, "y ": 56, "yd": 47, "yde": 35, "ye": 9, "yk": 53,
+			"yk ": 33, "ykk": 18, "yl": 74, "yld": 73, "ym": 26, "ymb": 16, "yn": 39, "yna": 8,
+			"ynd": 14, "ynk": 7, "yo": 7, "you": 7, "yp": 54, "ype": 43, "ypt": 9, "yr": 12,
+			"ys": 94, "ysk": 11, "ysn": 8, "yst": 67, "yt": 44, "yt ": 11, "yte": 13, "ytt": 16,
+			"z": 16, "å": 384, "å ": 199, "åb": 16, "åbn": 13, "åd": 25, "åde": 21, "åe": 8,
+			"ål": 13, "ål ": 7, "ån": 41, "ånd": 41, "år": 35, "år ": 24, "ås": 30, "ås ": 9,
+			"åst": 8, "æ": 551, "æf": 10, "æfe": 8, "æk": 7, "æl": 47, "æld": 10, "ælg": 24,
+			"æm": 18, "æmm": 16, "æn": 117, "ænd": 38, "æne": 10, "æng": 47, "æns": 16, "ær": 156,
+			"ærd": 46, "ære": 59, "ærk": 17, "ærm": 8, "ært": 16, "æs": 48, "æse": 20, "æsn": 16,
+			"æt": 39, "æt ": 19, "ætt": 14, "æv": 94, "æve": 92, "é": 47, "én": 14, "én ": 14,
+			"ér": 30, "ér ": 29, "ø": 512, "øb": 18, "øb ": 8, "ød": 50, "øde": 39, "øg": 139,
+			"øge": 13, "øgl": 111, "øgn": 9, "øj": 39, "øje": 22, "øjr": 12, "øl": 24, "ølg": 23,
+			"øm": 21, "øm ": 12, "ømm": 9, "øn": 10, "øns": 9, "ør": 142, "ør ": 29, "øre": 54,
+			"ørg": 9, "ørr": 19, "ørs": 24, "øs": 15, "øse": 12, "øt": 38, "øtt": 38, "øv": 8,
+		}},
+		"de": {Total: 246455, Ngrams: map[string]int{
+			" a": 1123, " ab": 76, " ad": 17, " ak": 96, " al": 124, " am": 8, " an": 296, " ap": 17,
+			" ar": 88, " as": 10, " at": 9, " au": 372, " b": 567, " ba": 21, " be": 388, " bi": 78,
+			" bl": 12, " br": 33, " bu": 8, " by": 10, " c": 176, " ca": 13, " ch": 13, " cl": 10,
+			" co": 108, " cr": 15, " d": 1570, " da": 377, " de": 687, " di": 422, " do": 16, " dr": 15,
+			" du": 33, " e": 947, " e ": 6, " eb": 12, " ec": 6, " ei": 452, " el": 11, " em": 12,
+			" en": 123, " er": 211, " es": 57, " ex": 47, " f": 476, " fa": 29, " fe": 149, " fi": 31,
+			" fo": 57, " fr": 14, " fu": 11, " fü": 171, " g": 425, " ga": 10, " ge": 267, " gi": 44,
+			" gl": 13, " gr": 37, " gs": 11, " gü": 29, " h": 179, " ha": 60, " he": 36, " hi": 48,
+			" ho": 12, " ht": 10, " hä": 6, " i": 642, " id": 26, " ig": 16, " ih": 31, " im": 70,
+			" in": 281, " ip": 6, " is": 205, " j": 22, " je": 18, " k": 513, " ka": 111, " kd": 9,
+			" ke": 153, " kl": 10, " ko": 186, " kr": 6, " kö": 28, " l": 228, " la": 23, " le": 91,
+			" li": 45, " lo": 43, " lä": 10, " lö": 10, " m": 410, " ma": 52, " me": 109, " mi": 103,
+			" mo": 32, " mu": 43, " mö": 36, " mü": 25, " n": 706, " n ": 12, " na": 100, " ne": 47,
+			" ni": 388, " no": 58, " nu": 95, " o": 295, " ob": 52, " oc": 6, " od": 101, " of": 10,
+			" oh": 27, " op": 62, " or": 22, " p": 365, " pa": 170, " pe": 11, " pf": 17, " pi": 8,
+			" pl": 13, " po": 11, " pr": 98, " pu": 19, " q": 29, " qu": 22, " qw": 6, " r": 248,
+			" ra": 7, " re": 192, " ri": 10, " ro": 13, " rü": 12, " s": 985, " sa": 10, " sc": 169,
+			" se": 133, " sh": 16, " si": 267, " so": 81, " sp": 61, " ss": 7, " st": 143, " su": 36,
+			" sy": 51, " t": 201, " ta": 78, " te": 43, " ti": 13, " to": 13, " tr": 29, " ty": 8,
+			" u": 414, " um": 68, " un": 291, " up": 13, " ur": 20, " us": 11, " v": 513, " ve": 260,
+			" vi": 17, " vo": 224, " w": 610, " wa": 39, " we": 279, " wi": 151, " wo": 8, " wu": 78,
+			" wä": 38, " x": 9, " z": 467, " ze": 111, " zi": 24, " zu": 294, " zw": 29, " ä": 37,
+			" än": 32, " ö": 10, " öf": 10, " ü": 64, " üb": 64, "a": 4155, "a ": 54, "ab": 245,
+			"ab ": 10, "abb": 11, "abe": 160, "abf": 7, "abg": 14, "abl": 8, "abs": 14, "ac": 144,
+			"ace": 6, "ach": 101, "ack": 34, "ad": 105, "ad ": 28, "ada": 11, "ade": 36, "adr": 19,
+			"af": 22, "aft": 15, "ag": 84, "ag ": 18, "age": 36, "agi": 6, "ags": 11, "ah": 40,
+			"ahi": 8, "ahl": 21, "ahr": 8, "ai": 32, "ail": 9, "ain": 22, "ak": 180, "ake": 54,
+			"akt": 103, "akz": 18, "al": 463, "al ": 43, "ala": 6, "alb": 30, "ale": 52, "alg": 6,
+			"ali": 60, "all": 85, "als": 64, "alt": 103, "am": 203, "am ": 31, "ame": 108, "ami": 7,
+			"amm": 48, "an": 679, "an ": 30, "ana": 7, "anc": 28, "and": 160, "anf": 18, "ang": 137,
+			"anh": 8, "ani": 7, "ank": 27, "anl": 7, "anm": 12, "ann": 112, "ano": 6, "ans": 17,
+			"ant": 18, "anw": 26, "anz": 52, "ap": 39, "ap ": 7, "aph": 7, "api": 7, "app": 9,
+			"ar": 403, "ar ": 32, "ara": 22, "arb": 37, "arc": 44, "ard": 50, "are": 44, "arf": 12,
+			"arg": 18, "ari": 22, "ark": 9, "arn": 15, "ars": 11, "art": 76, "as": 335, "as ": 100,
+			"asc": 8, "ase": 14, "ash": 9, "asi": 11, "ask": 6, "ass": 83, "ast": 101, "at": 605,
+			"at ": 66, "ata": 7, "atc": 27, "ate": 315, "ati": 98, "ato": 10, "ats": 7, "att": 31,
+			"atu": 35, "au": 495, "aub": 30, "auc": 13, "aue": 13, "auf": 144, "aum": 6, "aup": 17,
+			"aus": 219, "aut": 22, "auß": 22, "ax": 12, "axi": 6, "b": 1605, "b ": 62, "ba": 103,
+			"ban": 27, "bar": 40, "bas": 23, "bb": 14, "bbi": 6, "be": 923, "be ": 76, "bea": 17,
+			"bed": 16, "bee": 14, "bef": 45, "beg": 17, "beh": 9, "bei": 137, "bek": 11, "bel": 20,
+			"ben": 308, "ber": 159, "bes": 52, "bet": 13, "bew": 10, "bez": 11, "bf": 7, "bfr": 7,
+			"bg": 14, "bge": 14, "bi": 132, "bib": 11, "bil": 21, "bin": 52, "bis": 6, "bit": 34,
+			"bj": 43, "bje": 43, "bl": 54, "ble": 18, "bli": 18, "blo": 11, "bm": 14, "bmo": 13,
+			"bo": 20, "bol": 10, "br": 59, "bra": 36, "bre": 16, "bs": 28, "bst": 11, "bt": 28,
+			"bt ": 27, "bu": 61, "bun": 33, "but": 9, "by": 13, "byt": 11, "bz": 6, "c": 2189,
+			"c ": 40, "ca": 31, "cac": 9, "cal": 7, "ce": 24, "ce ": 18, "ch": 1716, "ch ": 260,
+			"cha": 39, "chb": 8, "che": 374, "chf": 9, "chi": 75, "chk": 6, "chl": 185, "chn": 87,
+			"chr": 95, "chs": 30, "cht": 505, "chu": 7, "chz": 8, "chä": 11, "ci": 19, "cip": 13,
+			"ck": 165, "ck ": 45, "cke": 54, "ckg": 16, "cks": 16, "ckt": 12, "cl": 13, "cli": 8,
+			"co": 120, "cod": 10, "com": 65, "con": 29, "cr": 21, "crl": 9, "cs": 11, "csp": 6,
+			"ct": 9, "d": 3459, "d ": 416, "da": 516, "da ": 6, "dar": 71, "das": 104, "dat": 305,
+			"dau": 16, "db": 8, "dc": 7, "dc ": 6, "de": 1645, "de ": 135, "dea": 12, "deb": 13,
+			"dec": 6, "ded": 12, "dei": 9, "dek": 7, "del": 7, "dem": 64, "den": 485, "der": 623,
+			"des": 181, "det": 48, "dex": 26, "di": 516, "die": 395, "dif": 12, "dig": 54, "din": 6,
+			"dir": 16, "dis": 13, "diu": 6, "dl": 16, "dn": 30, "dne": 21, "do": 41, "dom": 7,
+			"dow": 13, "dp": 8, "dr": 61, "dre": 22, "dru": 16, "drü": 16, "ds": 26, "ds ": 10,
+			"dsc": 9, "dt": 6, "du": 131, "dul": 17, "dun": 50, "dur": 30, "dus": 25, "e": 13528,
+			"e ": 2027, "ea": 80, "eac": 6, "ead": 13, "eak": 13, "eal": 13, "eam": 19, "ear": 7,
+			"eb": 233, "eba": 11, "ebe": 180, "ebi": 6, "ebr": 10, "ebu": 12, "ec": 113, "ech": 74,
+			"eck": 18, "ect": 6, "ed": 121, "ed ": 14, "eda": 23, "ede": 57, "edi": 14, "ee": 56,
+			"ee ": 6, "eed": 6, "een": 14, "eer": 28, "ef": 146, "efe": 62, "efi": 11, "efo": 9,
+			"efu": 30, "efü": 18, "eg": 215, "ege": 110, "egi": 61, "egr": 7, "egt": 10, "egu": 13,
+			"eh": 245, "eha": 7, "ehe": 38, "ehl": 151, "ehr": 32, "ei": 1564, "ei ": 136, "eib": 53,
+			"eic": 190, "eid": 7, "eie": 72, "eif": 8, "eig": 60, "eil": 76, "eim": 81, "ein": 687,
+			"eis": 37, "eit": 145, "ek": 109, "ek ": 8, "eka": 10, "eko": 6, "ekt": 68, "el": 531,
+			"el ": 106, "ela": 16, "elb": 9, "elc": 8, "eld": 32, "ele": 37, "elf": 7, "eli": 13,
+			"ell": 186, "eln": 24, "elp": 6, "els": 19, "elt": 34, "elu": 9, "elö": 10, "em": 277,
+			"em ": 157, "ema": 13, "emb": 10, "eme": 33, "emo": 19, "emp": 25, "ems": 8, "en": 3205,
+			"en ": 2262, "ena": 10, "enb": 31, "end": 259, "ene": 84, "enf": 18, "eng": 22, "enk": 14,
+			"enn": 75, "ens": 79, "ent": 212, "enu": 58, "enz": 35, "enö": 16, "enü": 8, "eo": 21,
+			"eor": 12, "ep": 54, "epa": 6, "epo": 37, "er": 3006, "er ": 972, "era": 56, "erb": 53,
+			"erd": 183, "ere": 289, "erf": 64, "erg": 55, "erh": 49, "eri": 29, "erk": 26, "erl": 69,
+			"erm": 24, "ern": 137, "ero": 9, "erp": 14, "err": 40, "ers": 239, "ert": 304, "eru": 122,
+			"erv": 53, "erw": 108, "erz": 98, "erä": 10, "es": 831, "es ": 440, "esc": 67, "ese": 157,
+			"esk": 6, "esp": 19, "ess": 60, "est": 70, "et": 372, "et ": 128, "eta": 28, "etc": 13,
+			"ete": 73, "eti": 7, "etr": 16, "ets": 13, "ett": 15, "etz": 62, "eu": 85, "eue": 39,
+			"eug": 21, "eut": 17, "ev": 15, "ew": 39, "ewa": 6, "ewe": 12, "ewä": 11, "ex": 96,
+			"ex ": 21, "exe": 6, "exi": 15, "exp": 11, "ext": 34, "ez": 44, "eze": 12, "ezi": 26,
+			"eß": 18, "eße": 14, "eä": 12, "eän": 12, "f": 1436, "f ": 131, "fa": 89, "fac": 12,
+			"fad": 19, "fal": 26, "fan": 8, "fas": 13, "fe": 324, "fe ": 6, "feh": 149, "fel": 8,
+			"fen": 51, "fer": 70, "fes": 26, "fet": 12, "ff": 61, "ff ": 16, "ffe": 20, "ffn": 7,
+			"ffs": 11, "fg": 19, "fge": 14, "fi": 138, "fig": 22, "fik": 34, "fil": 18, "fin": 22,
+			"fis": 13, "fiz": 18, "fl": 37, "fli": 15, "flo": 6, "flö": 8, "fn": 9, "fne": 6,
+			"fo": 163, "fo ": 6, "fol": 29, "for": 119, "fot": 6, "fr": 37, "fra": 18, "fre": 12,
+			"fs": 23, "fse": 6, "ft": 50, "ft ": 15, "fte": 12, "ftw": 18, "fu": 63, "fun": 62,
+			"fz": 13, "fze": 9, "fä": 13, "fäl": 6, "fü": 257, "füg": 39, "füh": 55, "für": 159,
+			"g": 2351, "g ": 411, "ga": 144, "gab": 116, "gan": 13, "gar": 9, "gb": 16, "gba": 16,
+			"ge": 1071, "ge ": 109, "geb": 164, "ged": 7, "gef": 61, "geg": 80, "geh": 28, "gek": 7,
+			"gel": 51, "gem": 25, "gen": 274, "geo": 14, "gep": 7, "ger": 57, "ges": 115, "get": 15,
+			"gew": 28, "gez": 8, "geä": 12, "gh": 6, "gi": 136, "gib": 10, "gig": 6, "gin": 23,
+			"gis": 27, "git": 63, "gl": 65, "gle": 14, "gli": 48, "gn": 69, "gna": 30, "gne": 6,
+			"gni": 10, "gno": 21, "go": 12, "gor": 8, "gp": 10, "gr": 94, "gra": 32, "gre": 8,
+			"gri": 16, "gro": 10, "gru": 13, "grö": 9, "gs": 90, "gs ": 20, "gss": 8, "gst": 10,
+			"gsv": 8, "gsz": 9, "gt": 70, "gt ": 53, "gte": 16, "gu": 72, "gul": 9, "gum": 16,
+			"gun": 22, "gur": 20, "gü": 65, "gül": 65, "h": 2607, "h ": 297, "ha": 201, "hab": 13,
+			"haf": 16, "hal": 86, "han": 36, "hat": 20, "hau": 17, "hb": 9, "hba": 6, "he": 505,
+			"he ": 79, "hea": 12, "hec": 11, "hei": 25, "hek": 11, "hel": 18, "hem": 8, "hen": 193,
+			"her": 101, "hes": 36, "hf": 9, "hfü": 6, "hi": 142, "hie": 37, "hil": 6, "hin": 41,
+			"hir": 9, "his": 9, "hiv": 33, "hk": 6, "hl": 388, "hl ": 49, "hla": 10, "hle": 123,
+			"hlg": 6, "hli": 22, "hlo": 11, "hls": 6, "hlt": 27, "hlu": 9, "hlü": 123, "hm": 17,
+			"hme": 9, "hmu": 6, "hn": 119, "hne": 57, "hni": 54, "ho": 29, "hol": 11, "hr": 245,
+			"hr ": 20, "hre": 149, "hri": 30, "hro": 7, "hrt": 13, "hru": 12, "hrä": 7, "hs": 30,
+			"hs ": 17, "hse": 7, "ht": 521, "ht ": 434, "hte": 49, "hti": 7, "htl": 9, "hts": 8,
+			"htt": 10, "hu": 11, "hz": 8, "hze": 6, "hä": 45, "häd": 7, "häl": 18, "hän": 15,
+			"hö": 7, "i": 6096, "i ": 157, "ia": 41, "iab": 6, "ial": 20, "ian": 7, "ib": 90,
+			"ibe": 39, "ibl": 10, "ibt": 10, "ibu": 22, "ic": 795, "ich": 770, "ick": 14, "id": 66,
+			"id ": 20, "ide": 36, "ie": 1109, "ie ": 423, "ieb": 22, "ied": 30, "ieg": 9, "ieh": 12,
+			"iel": 45, "ien": 103, "ier": 318, "ies": 122, "ieß": 18, "if": 103, "iff": 28, "ifi": 61,
+			"ig": 351, "ig ": 62, "ige": 147, "ign": 63, "igt": 29, "igu": 34, "ih": 35, "ihr": 29,
+			"ik": 48, "ika": 36, "ikt": 8, "il": 146, "il ": 22, "ild": 19, "ile": 73, "ili": 11,
+			"ilt": 11, "im": 226, "im ": 125, "ima": 14, "ime": 10, "imi": 35, "imm": 23, "imp": 15,
+			"in": 1296, "in ": 352, "ina": 35, "inc": 13, "ind": 142, "ine": 364, "inf": 34, "ing": 88,
+			"inh": 17, "ini": 37, "ink": 25, "inm": 9, "inn": 21, "ins": 55, "int": 35, "inu": 8,
+			"inw": 15, "inz": 24, "inä": 14, "io": 219, "ion": 202, "iot": 11, "ip": 33, "ipa": 13,
+			"ir": 134, "ird": 91, "ire": 16, "irm": 12, "is": 561, "is ": 63, "isa": 6, "isc": 112,
+			"ise": 15, "isi": 46, "iss": 12, "ist": 290, "it": 539, "it ": 204, "ite": 65, "ith": 10,
+			"iti": 62, "ito": 36, "its": 56, "itt": 59, "itu": 12, "itz": 8, "itä": 11, "iu": 7,
+			"ium": 7, "iv": 93, "iv ": 25, "ive": 30, "ivi": 30, "ivs": 6, "ix": 8, "ix ": 6,
+			"iz": 35, "ize": 7, "izi": 27, "j": 71, "je": 65, "jed": 17, "jek": 43, "k": 1249,
+			"k ": 93, "ka": 196, "kal": 25, "kan": 112, "kar": 7, "kat": 38, "kd": 12, "kdc": 6,
+			"ke": 316, "ke ": 18, "kei": 141, "ken": 54, "ker": 8, "ket": 86, "kg": 20, "kga": 11,
+			"ki": 14, "kie": 8, "kl": 25, "kla": 6, "kle": 6, "klo": 7, "kn": 8, "ko": 219,
+			"kod": 9, "kol": 6, "kom": 60, "kon": 121, "kop": 12, "kor": 6, "kr": 18, "kri": 7,
+			"ks": 28, "ks ": 8, "ksv": 7, "kt": 228, "kt ": 42, "kte": 36, "kti": 63, "kto": 7,
+			"ktu": 58, "ktw": 8, "ku": 14, "kun": 7, "kz": 18, "kze": 18, "kö": 28, "kön": 27,
+			"l": 2805, "l ": 316, "la": 136, "lad": 18, "lag": 15, "lan": 13, "lar": 7, "las": 9,
+			"lat": 21, "lau": 43, "lb": 41, "lb ": 27, "lbs": 7, "lc": 9, "lch": 8, "ld": 55,
+			"ld ": 11, "lde": 25, "lds": 10, "ldu": 6, "le": 631, "le ": 156, "lee": 26, "leg": 53,
+			"lei": 32, "lem": 16, "len": 165, "ler": 100, "les": 56, "let": 13, "lf": 11, "lg": 49,
+			"lge": 39, "lgo": 6, "li": 358, "lic": 117, "lie": 64, "lig": 7, "lik": 8, "lin": 37,
+			"lio": 11, "lis": 88, "liz": 10, "ll": 346, "ll ": 48, "lle": 158, "llg": 7, "lli": 25,
+			"llo": 7, "lls": 24, "llt": 42, "llu": 20, "ln": 26, "ln ": 21, "lo": 99, "loa": 6,
+			"loc": 17, "log": 9, "lok": 24, "lon": 8, "los": 15, "low": 6, "lp": 11, "ls": 127,
+			"ls ": 82, "lsc": 17, "lst": 16, "lt": 314, "lt ": 103, "lta": 19, "lte": 105, "lti": 73,
+			"lu": 57, "lug": 8, "lun": 40, "lus": 7, "lv": 6, "lve": 6, "lä": 29, "län": 8,
+			"lär": 8, "läs": 6, "lö": 32, "lös": 28, "lü": 124, "lüs": 122, "m": 1893, "m ": 491,
+			"ma": 185, "ma ": 9, "mai": 12, "mal": 34, "man": 15, "mar": 11, "mas": 13, "mat": 63,
+			"max": 7, "mb": 36, "mbe": 6, "mbi": 10, "mbl": 7, "mbo": 10, "me": 403, "me ": 56,
+			"med": 8, "meh": 28, "mei": 14, "mel": 22, "men": 154, "mer": 74, "met": 42, "mf": 8,
+			"mfo": 6, "mg": 17, "mge": 16, "mi": 230, "mie": 33, "min": 12, "mit": 174, "ml": 7,
+			"mm": 182, "mma": 16, "mme": 81, "mmi": 62, "mmt": 8, "mo": 71, "mod": 46, "mot": 17,
+			"mp": 72, "mpf": 9, "mpi": 6, "mpl": 10, "mpo": 32, "mpr": 7, "ms": 23, "ms ": 14,
+			"mt": 16, "mt ": 7, "mu": 58, "mus": 46, "mw": 7, "mwa": 7, "mä": 9, "mö": 38,
+			"mög": 34, "mü": 25, "müs": 24, "n": 8120, "n ": 3104, "na": 237, "nac": 56, "nal": 26,
+			"nam": 90, "nan": 8, "nat": 38, "nb": 43, "nba": 27, "nbe": 10, "nc": 50, "nch": 31,
+			"nci": 14, "nd": 782, "nd ": 212, "nda": 57, "nde": 379, "ndi": 44, "ndl": 10, "ndo": 19,
+			"ndt": 6, "ndu": 42, "ne": 784, "ne ": 270, "neg": 6, "nel": 8, "nem": 39, "nen": 236,
+			"ner": 102, "nes": 31, "net": 33, "neu": 47, "nf": 109, "nfa": 13, "nfi": 20, "nfl": 8,
+			"nfo": 40, "nfr": 6, "nfü": 9, "ng": 720, "ng ": 287, "nga": 61, "nge": 242, "ngi": 6,
+			"ngl": 11, "ngr": 7, "ngs": 59, "ngü": 36, "nh": 29, "nha": 24, "ni": 535, "nic": 385,
+			"nie": 46, "nis": 67, "nit": 21, "nk": 95, "nk ": 28, "nke": 21, "nko": 7, "nkt": 25,
+			"nl": 19, "nle": 7, "nm": 22, "nma": 10, "nme": 11, "nn": 307, "nn ": 133, "nne": 46,
+			"nnt": 104, "nnu": 17, "no": 94, "no ": 6, "noc": 18, "non": 6, "nor": 28, "not": 24,
+			"ns": 184, "ns ": 25, "nsa": 10, "nsc": 19, "nsi": 8, "nsp": 16, "nst": 77, "nsw": 8,
+			"nt": 539, "nt ": 61, "nta": 26, "nte": 262, "ntf": 33, "nth": 34, "nti": 24, "ntl": 11,
+			"ntr": 22, "nts": 22, "ntt": 12, "ntw": 17, "nu": 215, "nul": 13, "num": 26, "nun": 44,
+			"nur": 52, "nut": 66, "nux": 7, "nv": 30, "nve": 19, "nvo": 9, "nw": 43, "nwe": 39,
+			"nz": 120, "nz ": 13, "nza": 13, "nze": 54, "nzi": 7, "nzu": 27, "nä": 14, "när": 14,
+			"nö": 16, "nöt": 16, "nü": 16, "o": 2165, "o ": 49, "oa": 7, "oad": 6, "ob": 68,
+			"ob ": 10, "obj": 43, "oc": 67, "och": 22, "ock": 32, "ocs": 6, "od": 179, "ode": 112,
+			"odi": 15, "odu": 46, "of": 36, "off": 9, "oft": 18, "og": 26, "ogi": 6, "ogr": 16,
+			"oh": 30, "ohn": 27, "ok": 40, "oka": 24, "oko": 6, "ol": 118, "ole": 11, "olg": 29,
+			"oll": 52, "om": 178, "om ": 32, "oma": 12, "omb": 10, "omm": 86, "omp": 31, "on": 543,
+			"on ": 244, "ona": 11, "one": 80, "onf": 33, "oni": 24, "onn": 71, "ons": 20, "ont": 38,
+			"onv": 8, "oo": 14, "op": 108, "op ": 8, "ope": 19, "opf": 9, "opp": 8, "opt": 52,
+			"or": 441, "or ": 29, "ora": 7, "ord": 72, "ore": 19, "org": 19, "orh": 18, "ori": 46,
+			"orm": 75, "ors": 8, "ort": 88, "ory": 26, "orä": 13, "os": 74, "os ": 11, "ose": 7,
+			"osi": 40, "oss": 11, "ot": 77, "ote": 22, "oth": 12, "oto": 12, "otw": 16, "ou": 26,
+			"out": 15, "ov": 12, "ow": 24, "ows": 12, "ox": 8, "oxy": 7, "oz": 24, "oze": 23,
+			"oß": 10, "p": 1026, "p ": 71, "pa": 221, "pac": 29, "pak": 52, "pal": 24, "par": 25,
+			"pas": 54, "pat": 28, "pc": 7, "pe": 136, "pei": 35, "pel": 9, "pen": 10, "per": 46,
+			"pez": 21, "pf": 43, "pfa": 23, "pg": 10, "ph": 11, "pi": 39, "pi ": 7, "pie": 14,
+			"pk": 7, "pl": 34, "pla": 11, "ple": 9, "pli": 8, "po": 93, "pon": 15, "por": 32,
+			"pos": 41, "pp": 24, "ppe": 9, "pps": 7, "pr": 169, "pre": 12, "pri": 35, "pro": 66,
+			"pru": 8, "prü": 37, "ps": 23, "pst": 14, "pt": 84, "pt ": 10, "pti": 57, "pts": 14,
+			"pu": 33, "pun": 10, "pus": 9, "q": 42, "qu": 32, "que": 24, "r": 5702, "r ": 1333,
+			"ra": 250, "rab": 7, "rac": 14, "rad": 13, "rag": 29, "rah": 8, "rak": 7, "ral": 9,
+			"ram": 30, "ran": 47, "rap": 7, "rar": 10, "rat": 28, "rau": 26, "rb": 94, "rbe": 49,
+			"rbi": 23, "rc": 84, "rce": 9, "rch": 68, "rd": 484, "rd ": 113, "rda": 16, "rde": 304,
+			"rdi": 9, "rdn": 26, "re": 900, "re ": 112, "rea": 36, "reb": 12, "rec": 57, "ree": 10,
+			"ref": 29, "reg": 35, "rei": 140, "rek": 20, "rem": 27, "ren": 275, "rep": 38, "rer": 40,
+			"res": 47, "rf": 86, "rf ": 12, "rfa": 13, "rfo": 29, "rfü": 19, "rg": 108, "rg ": 19,
+			"rga": 7, "rge": 55, "rgu": 16, "rh": 67, "rha": 50, "rhe": 11, "ri": 262, "ria": 9,
+			"rib": 9, "ric": 43, "rie": 64, "rif": 16, "rig": 17, "rim": 10, "rin": 19, "ris": 20,
+			"rit": 29, "rk": 45, "rke": 12, "rki": 9, "rl": 93, "rl ": 19, "rla": 38, "rli": 25,
+			"rm": 113, "rm ": 15, "rma": 58, "rme": 7, "rmi": 16, "rn": 154, "rn ": 41, "rna": 21,
+			"rne": 49, "rnt": 14, "rnu": 18, "ro": 135, "ro ": 7, "rog": 12, "rol": 9, "rom": 13,
+			"ron": 10, "rot": 8, "rox": 7, "roz": 19, "roß": 10, "rp": 25, "rpr": 17, "rr": 49,
+			"rre": 21, "rrt": 9, "rru": 9, "rs": 275, "rs ": 23, "rsa": 7, "rsc": 63, "rse": 17,
+			"rsi": 37, "rsp": 18, "rst": 92, "rsu": 12, "rt": 504, "rt ": 253, "rte": 145, "rti": 55,
+			"rtr": 11, "rts": 10, "rtu": 8, "ru": 198, "ruc": 17, "ruf": 18, "run": 143, "rup": 7,
+			"rv": 53, "rve": 45, "rvi": 7, "rw": 108, "rwa": 23, "rwe": 79, "ry": 32, "ry ": 29,
+			"rz": 109, "rze": 81, "rzu": 7, "rzw": 15, "rä": 44, "räg": 8, "rän": 11, "rär": 13,
+			"rö": 11, "röß": 9, "rü": 85, "rüc": 47, "rüf": 32, "s": 4834, "s ": 1068, "sa": 79,
+			"sam": 42, "san": 9, "sb": 12, "sc": 545, "sch": 528, "sd": 28, "sda": 9, "sdr": 16,
+			"se": 600, "se ": 72, "seh": 8, "sei": 41, "sel": 142, "sem": 23, "sen": 136, "ser": 90,
+			"ses": 20, "set": 52, "sf": 28, "sfü": 18, "sg": 98, "sga": 44, "sge": 53, "sh": 44,
+			"sh ": 22, "she": 13, "si": 435, "sic": 38, "sie": 177, "sig": 48, "sin": 61, "sio": 40,
+			"sis": 9, "sit": 52, "sk": 23, "sl": 14, "sm": 10, "sn": 8, "so": 101, "soc": 20,
+			"sof": 20, "sol": 24, "son": 8, "sor": 12, "sp": 154, "sp ": 10, "spa": 13, "spe": 80,
+			"spi": 8, "spr": 33, "sr": 13, "sre": 7, "ss": 382, "ss ": 58, "ssc": 9, "sse": 212,
+			"ssi": 13, "sst": 14, "ssu": 7, "ssw": 39, "st": 932, "st ": 241, "sta": 144, "ste": 316,
+			"sti": 36, "stl": 8, "sto": 16, "str": 61, "sts": 14, "stä": 19, "stü": 46, "su": 71,
+			"sub": 15, "suc": 32, "sun": 13, "sv": 33, "sv ": 9, "sve": 20, "sw": 63, "swe": 11,
+			"swo": 35, "swü": 7, "sy": 58, "sym": 10, "syn": 10, "sys": 37, "sz": 21, "sze": 10,
+			"sä": 11, "sät": 11, "t": 5603, "t ": 1777, "ta": 330, "ta ": 9, "tab": 18, "tad": 11,
+			"tag": 19, "tai": 18, "tal": 24, "tan": 63, "tar": 24, "tas": 90, "tat": 39, "tc": 47,
+			"tch": 41, "td": 13, "tda": 7, "te": 1605, "te ": 428, "tei": 231, "tel": 117, "tem": 54,
+			"ten": 369, "ter": 261, "tes": 53, "tet": 52, "tex": 16, "tf": 41, "tfe": 33, "tg": 12,
+			"tge": 8, "th": 76, "tha": 17, "the": 21, "thm": 10, "thä": 18, "ti": 527, "tia": 14,
+			"tic": 9, "tie": 56, "tif": 38, "tig": 109, "tim": 50, "tio": 160, "tis": 24, "tiv": 48,
+			"tl": 34, "tle": 9, "tli": 21, "tn": 13, "tna": 12, "to": 111, "to ": 7, "tok": 10,
+			"tom": 7, "top": 14, "tor": 61, "tp": 18, "tp ": 10, "tr": 157, "tra": 42, "tre": 37,
+			"trg": 15, "tri": 27, "tro": 18, "tru": 8, "trä": 7, "ts": 168, "ts ": 71, "tsc": 29,
+			"tsp": 16, "tst": 20, "tsv": 16, "tt": 140, "tt ": 15, "tta": 17, "tte": 76, "ttp": 10,
+			"ttr": 7, "tu": 129, "tua": 30, "tue": 28, "tun": 20, "tur": 33, "tus": 7, "tw": 65,
+			"twa": 20, "twe": 31, "two": 10, "ty": 30, "ty ": 10, "typ": 20, "tz": 210, "tz ": 7,
+			"tze": 75, "tzl": 13, "tzt": 79, "tzu": 26, "tä": 33, "tän": 17, "tät": 14, "tü": 50,
+			"tüt": 45, "u": 2649, "u ": 116, "ua": 42, "ual": 33, "ub": 53, "ube": 10, "ubm": 13,
+			"ubt": 18, "uc": 70, "uch": 52, "uck": 16, "ue": 114, "ue ": 15, "uel": 51, "uen": 21,
+			"uer": 17, "ues": 7, "uf": 190, "uf ": 83, "ufe": 24, "uff": 7, "ufg": 19, "ufl": 16,
+			"uft": 7, "ufü": 15, "ug": 77, "ug ": 10, "uge": 33, "ugr": 12, "ugt": 13, "ui": 7,
+			"ul": 62, "ul ": 7, "ule": 12, "ull": 15, "ulä": 12, "um": 203, "um ": 110, "ume": 26,
+			"umg": 17, "umm": 24, "ums": 10, "un": 775, "unb": 8, "und": 146, "une": 10, "ung": 439,
+			"uni": 8, "unk": 22, "unt": 111, "unv": 16, "up": 47, "ups": 8, "upt": 17, "ur": 302,
+			"ur ": 103, "ura": 15, "urc": 35, "urd": 76, "ure": 9, "uri": 12, "url": 13, "urs": 8,
+			"urü": 18, "us": 389, "us ": 87, "usa": 30, "usc": 13, "usd": 15, "use": 11, "usf": 18,
+			"usg": 97, "ush": 12, "uss": 44, "ust": 26, "usw": 10, "usz": 7, "usä": 10, "ut": 141,
+			"ut ": 16, "ute": 21, "uth": 10, "uto": 13, "utz": 67, "ux": 7, "ux ": 7, "uz": 8,
+			"uß": 25, "uße": 23, "v": 770, "v ": 47, "va": 11, "var": 8, "ve": 398, "ve ": 17,
+			"ven": 9, "ver": 367, "vi": 59, "vie": 43, "vo": 241, "vol": 19, "vom": 19, "von": 130,
+			"vor": 73, "w": 1046, "w ": 9, "wa": 101, "wan": 10, "war": 74, "we": 495, "wec": 11,
+			"weg": 9, "wei": 70, "wel": 8, "wen": 140, "wer": 248, "wi": 189, "wid": 12, "wie": 34,
+			"win": 20, "wir": 99, "wis": 19, "wo": 60, "wor": 55, "ws": 14, "ws ": 14, "wu": 83,
+			"wur": 78, "wä": 57, "wäh": 53, "wö": 8, "wör": 7, "wü": 14, "wür": 9, "x": 152,
+			"x ": 55, "xe": 7, "xi": 21, "xis": 13, "xp": 12, "xpo": 8, "xt": 34, "xt ": 13,
+			"xte": 9, "xtr": 9, "xy": 7, "xy ": 7, "y": 174, "y ": 59, "ym": 13, "ymb": 10,
+			"yn": 11, "yp": 23, "yp ": 16, "ys": 40, "yst": 36, "yt": 11, "yte": 11, "z": 1092,
+			"z ": 30, "za": 20, "zah": 20, "ze": 414, "ze ": 8, "zei": 227, "zen": 69, "zer": 58,
+			"zes": 18, "zeu": 21, "zi": 90, "zie": 57, "zif": 23, "zl": 13, "zli": 13, "zt": 79,
+			"zt ": 64, "zte": 15, "zu": 378, "zu ": 107, "zuf": 20, "zug": 41, "zum": 61, "zun": 23,
+			"zur": 54, "zus": 51, "zw": 56, "zwe": 24, "zwi": 25, "ß": 71, "ß ": 14, "ße": 48,
+			"ße ": 9, "ßen": 13, "ßer": 25, "ä": 310, "äd": 8, "ädi": 7, "äg": 12, "äge": 8,
+			"äh": 57, "ähl": 29, "ähr": 24, "äl": 26, "ält": 21, "än": 102, "änd": 67, "äng": 25,
+			"änk": 7, "är": 43, "äre": 34, "ät": 36, "ät ": 11, "ätz": 11, "äu": 8, "ö": 156,
+			"öc": 8, "öf": 15, "öff": 15, "ög": 35, "ögl": 34, "ön": 27, "önn": 27, "ör": 13,
+			"ört": 10, "ös": 28, "ösc": 15, "ösu": 7, "öt": 16, "öti": 16, "öß": 9, "öße": 9,
+			"ü": 714, "üb": 70, "übe": 66, "üc": 48, "ück": 47, "üf": 32, "üfe": 9, "üfu": 15,
+			"üg": 41, "ügb": 16, "üge": 15, "ügt": 7, "üh": 55, "ühr": 55, "ül": 67, "ült": 65,
+			"ün": 16, "ür": 175, "ür ": 159, "ürd": 9, "üs": 146, "üss": 145, "üt": 50, "ütz": 49,
+		}},
+		"en": {Total: 187896, Ngrams: map[string]int{
+			" a": 995, " a ": 176, " ab": 23, " ac": 39, " ad": 79, " af": 20, " ag": 8, " al": 112,
+			" am": 5, " an": 190, " ap": 56, " ar": 129, " as": 54, " at": 38, " au": 46, " av": 16,
+			" b": 400, " ba": 51, " be": 148, " bi": 37, " bl": 17, " bo": 20, " br": 39, " bu": 30,
+			" by": 50, " c": 746, " c ": 7, " ca": 133, " cc": 7, " cd": 6, " ce": 16, " ch": 99,
+			" cl": 34, " co": 366, " cp": 4, " cr": 49, " cu": 15, " d": 529, " d ": 6, " da": 46,
+			" de": 184, " di": 137, " dn": 4, " do": 103, " ds": 5, " du": 21, " dw": 4, " dy": 9,
+			" e": 414, " e ": 5, " ea": 13, " ed": 6, " ei": 5, " el": 18, " em": 31, " en": 105,
+			" eq": 6, " er": 69, " es": 4, " eu": 4, " ev": 12, " ex": 131, " f": 683, " fa": 72,
+			" fe": 11, " fi": 237, " fl": 30, " fo": 239, " fr": 66, " fs": 5, " fu": 18, " g": 162,
+			" ga": 6, " ge": 37, " gi": 47, " gl": 4, " gn": 7, " go": 13, " gp": 6, " gr": 26,
+			" gs": 6, " h": 186, " h ": 4, " ha": 92, " he": 37, " hi": 17, " ho": 15, " ht": 4,
+			" hu": 11, " i": 907, " id": 18, " if": 31, " ig": 16, " im": 44, " in": 566, " ir": 5,
+			" is": 176, " it": 42, " j": 13, " ju": 5, " k": 121, " ka": 6, " ke": 98, " l": 290,
+			" la": 25, " le": 33, " li": 121, " lo": 96, " ls": 4, " m": 424, " m ": 4, " ma": 136,
+			" me": 100, " mi": 33, " mo": 72, " mu": 70, " n": 584, " n ": 4, " na": 80, " ne": 65,
+			" no": 375, " nu": 55, " o": 716, " o ": 5, " ob": 49, " oc": 8, " of": 227, " ol": 8,
+			" on": 129, " op": 77, " or": 103, " ot": 11, " ou": 64, " ov": 22, " ow": 5, " p": 494,
+			" pa": 161, " pc": 10, " pe": 25, " ph": 8, " pi": 16, " pl": 41, " po": 55, " pr": 151,
+			" pu": 18, " q": 16, " qu": 12, " r": 554, " r ": 5, " ra": 30, " rd": 4, " re": 466,
+			" ro": 11, " ru": 25, " s": 1018, " s ": 25, " sa": 24, " sc": 25, " se": 229, " sh": 91,
+			" si": 78, " sk": 8, " sl": 7, " so": 76, " sp": 77, " sr": 4, " ss": 7, " st": 162,
+			" su": 120, " sw": 4, " sy": 76, " t": 1172, " t ": 43, " ta": 45, " te": 32, " th": 544,
+			" ti": 25, " tl": 5, " to": 349, " tp": 5, " tr": 67, " tu": 5, " tw": 8, " ty": 40,
+			" u": 357, " ub": 4, " ui": 4, " un": 151, " up": 46, " ur": 9, " us": 136, " ut": 4,
+			" v": 155, " v ": 8, " va": 64, " ve": 49, " vi": 17, " vo": 6, " w": 373, " wa": 63,
+			" we": 16, " wh": 82, " wi": 140, " wo": 32, " wr": 38, " x": 8, " x ": 4, " y": 45,
+			" ye": 8, " yo": 36, " z": 14, " ze": 11, "a": 4272, "a ": 270, "ab": 152, "ab ": 8,
+			"aba": 8, "abi": 4, "abl": 100, "abo": 8, "abs": 17, "ac": 260, "acc": 17, "ace": 30,
+			"ach": 47, "ack": 106, "act": 54, "ad": 235, "ad ": 87, "ada": 18, "add": 71, "ade": 22,
+			"adi": 9, "adm": 7, "ads": 4, "ady": 12, "ae": 4, "aem": 4, "af": 23, "aft": 19,
+			"ag": 122, "ag ": 12, "aga": 4, "age": 86, "ags": 12, "ai": 130, "ail": 73, "ain": 46,
+			"ak": 21, "ak ": 6, "ake": 11, "al": 510, "al ": 150, "ala": 7, "ale": 5, "alf": 7,
+			"ali": 111, "all": 111, "alr": 12, "als": 19, "alt": 24, "alu": 46, "am": 204, "am ": 40,
+			"ama": 4, "ame": 128, "ami": 14, "amp": 10, "an": 602, "an ": 151, "ana": 12, "anc": 43,
+			"and": 183, "ane": 5, "ang": 67, "ani": 7, "ann": 57, "ano": 13, "ans": 9, "ant": 18,
+			"any": 27, "ap": 91, "ap ": 6, "aph": 8, "api": 4, "app": 46, "aps": 11, "apt": 6,
+			"ar": 459, "ar ": 19, "ara": 46, "arb": 6, "arc": 55, "ard": 34, "are": 78, "arf": 4,
+			"arg": 34, "ari": 34, "ark": 18, "arm": 14, "arn": 26, "arr": 5, "ars": 12, "art": 34,
+			"ary": 36, "as": 267, "as ": 93, "ase": 62, "ash": 18, "asi": 4, "ask": 10, "asn": 4,
+			"ass": 50, "ast": 23, "at": 687, "at ": 68, "ata": 48, "atc": 41, "ate": 197, "ath": 20,
+			"ati": 229, "ato": 9, "ats": 4, "att": 22, "atu": 43, "au": 100, "aul": 40, "aus": 10,
+			"aut": 41, "av": 48, "ava": 13, "ave": 27, "avi": 4, "avo": 4, "ax": 18, "ax ": 11,
+			"ay": 56, "ay ": 46, "ayo": 6, "b": 888, "b ": 28, "ba": 77, "bac": 19, "bad": 15,
+			"bal": 4, "bas": 29, "bc": 6, "bd": 4, "be": 208, "be ": 97, "bec": 7, "bee": 11,
+			"bef": 15, "bel": 7, "ber": 56, "bet": 6, "bi": 66, "bid": 4, "bin": 22, "bit": 27,
+			"bj": 47, "bje": 47, "bk": 4, "bke": 4, "bl": 154, "ble": 118, "bli": 15, "blo": 15,
+			"bm": 14, "bmo": 13, "bo": 73, "boa": 4, "bol": 36, "boo": 7, "bor": 4, "bot": 5,
+			"bou": 8, "bp": 4, "br": 52, "bra": 48, "bre": 4, "bs": 32, "bs ": 10, "bso": 12,
+			"bst": 4, "bu": 55, "bug": 10, "bui": 6, "bun": 11, "but": 20, "by": 56, "by ": 37,
+			"byt": 16, "c": 2331, "c ": 123, "ca": 319, "cac": 27, "cal": 54, "can": 91, "cap": 10,
+			"car": 7, "cas": 6, "cat": 111, "cau": 9, "cc": 31, "cca": 5, "cce": 13, "cco": 5,
+			"ccu": 5, "cd": 8, "ce": 184, "ce ": 99, "ced": 6, "cee": 4, "cei": 4, "cem": 7,
+			"cep": 8, "cer": 16, "ces": 36, "ch": 312, "ch ": 98, "cha": 68, "che": 75, "chi": 57,
+			"cho": 8, "ci": 98, "cia": 8, "cie": 8, "cif": 55, "cim": 4, "cip": 9, "ck": 185,
+			"ck ": 75, "cka": 30, "cke": 41, "cki": 8, "cko": 6, "cks": 18, "cl": 56, "cla": 7,
+			"cle": 9, "cli": 5, "clo": 19, "clu": 15, "co": 453, "cod": 37, "cog": 6, "col": 15,
+			"com": 152, "con": 130, "cop": 13, "cor": 40, "cou": 53, "cp": 4, "cr": 102, "cra": 13,
+			"cre": 57, "cri": 15, "cry": 11, "cs": 10, "cs ": 5, "csp": 4, "ct": 383, "ct ": 66,
+			"cta": 6, "cte": 60, "cti": 153, "cto": 42, "cts": 26, "ctu": 24, "cu": 45, "cum": 5,
+			"cur": 23, "cut": 15, "cy": 9, "cy ": 7, "d": 2427, "d ": 1160, "da": 125, "dab": 4,
+			"dae": 4, "dar": 19, "dat": 88, "dd": 79, "dd ": 19, "dde": 10, "ddi": 10, "ddr": 38,
+			"de": 450, "de ": 92, "dea": 16, "deb": 10, "dec": 11, "ded": 39, "def": 58, "del": 23,
+			"dem": 10, "den": 28, "dep": 10, "der": 45, "des": 42, "det": 10, "dev": 10, "dex": 43,
+			"dh": 4, "di": 254, "dia": 25, "dic": 14, "did": 7, "dif": 31, "din": 39, "dir": 60,
+			"dis": 50, "dit": 22, "dl": 33, "dle": 20, "dli": 5, "dly": 5, "dm": 7, "dmi": 5,
+			"dn": 11, "dn ": 7, "dns": 4, "do": 115, "do ": 28, "doe": 46, "dom": 8, "don": 13,
+			"dow": 10, "dp": 4, "dr": 45, "dre": 37, "ds": 44, "ds ": 40, "dt": 4, "du": 49,
+			"duc": 10, "dul": 18, "dup": 6, "dur": 11, "dw": 6, "dwa": 5, "dx": 5, "dx ": 5,
+			"dy": 22, "dy ": 13, "dyn": 8, "e": 7224, "e ": 2235, "ea": 253, "ea ": 4, "eac": 11,
+			"ead": 93, "eak": 6, "eal": 17, "eam": 23, "ean": 10, "ear": 15, "eas": 33, "eat": 40,
+			"eb": 21, "eba": 7, "ebu": 9, "ec": 409, "ec ": 8, "eca": 9, "ece": 7, "eci": 67,
+			"eck": 25, "eco": 30, "ecr": 8, "ect": 230, "ecu": 20, "ed": 656, "ed ": 580, "ede": 22,
+			"edi": 41, "eds": 4, "ee": 84, "ee ": 28, "eed": 17, "eek": 6, "een": 20, "eep": 7,
+			"eer": 4, "ef": 117, "ef ": 6, "efa": 40, "efe": 16, "efi": 24, "efo": 17, "efs": 5,
+			"eg": 124, "ega": 11, "ege": 5, "egi": 82, "egm": 9, "egy": 6, "ei": 29, "ein": 7,
+			"eir": 11, "eit": 5, "eiv": 4, "ek": 7, "ek ": 7, "el": 190, "el ": 29, "ela": 20,
+			"eld": 22, "ele": 42, "elf": 10, "eli": 9, "ell": 6, "elo": 30, "elp": 9, "ely": 10,
+			"em": 200, "em ": 38, "ema": 13, "emb": 9, "eme": 34, "emo": 52, "emp": 44, "ems": 5,
+			"en": 518, "en ": 86, "ena": 18, "enc": 53, "end": 53, "ene": 19, "eng": 16, "enp": 4,
+			"ens": 21, "ent": 232, "enu": 5, "env": 4, "eo": 11, "ep": 82, "ep ": 8, "epa": 11,
+			"epe": 6, "epl": 7, "epo": 27, "epr": 4, "ept": 8, "epu": 7, "eq": 71, "equ": 70,
+			"er": 934, "er ": 351, "era": 66, "erb": 5, "ere": 55, "erf": 13, "erg": 27, "eri": 19,
+			"erl": 8, "erm": 24, "ern": 47, "ero": 17, "erp": 14, "err": 88, "ers": 112, "ert": 31,
+			"erv": 44, "ery": 6, "es": 605, "es ": 321, "esc": 12, "ese": 23, "esk": 5, "esn": 10,
+			"eso": 9, "esp": 15, "ess": 136, "est": 58, "esu": 8, "et": 268, "et ": 113, "eta": 27,
+			"etc": 9, "ete": 42, "eth": 5, "eti": 11, "etr": 10, "ets": 15, "ett": 15, "etu": 9,
+			"etw": 6, "eu": 6, "eur": 5, "ev": 62, "eve": 34, "evi": 19, "evo": 5, "ew": 41,
+			"ew ": 25, "ewa": 5, "ewr": 4, "ex": 203, "ex ": 39, "exa": 5, "exc": 9, "exe": 22,
+			"exi": 29, "exp": 55, "ext": 44, "ey": 95, "ey ": 57, "eyb": 4, "eys": 26, "f": 1443,
+			"f ": 274, "fa": 119, "fac": 10, "fai": 53, "fal": 7, "fas": 4, "fau": 40, "fe": 52,
+			"fer": 31, "fet": 10, "ff": 78, "ff ": 25, "ffe": 17, "ffi": 16, "ffs": 18, "fi": 364,
+			"fic": 29, "fie": 61, "fig": 19, "fil": 179, "fin": 39, "fir": 12, "fix": 22, "fl": 45,
+			"fla": 15, "fli": 6, "flo": 19, "flu": 4, "fo": 313, "fo ": 22, "fol": 21, "for": 243,
+			"fou": 26, "fp": 5, "fr": 69, "fre": 7, "fro": 58, "fs": 29, "fse": 19, "ft": 50,
+			"ft ": 11, "fte": 21, "ftw": 17, "fu": 23, "ful": 9, "fun": 11, "fy": 19, "fy ": 15,
+			"fyi": 4, "g": 1205, "g ": 434, "ga": 23, "gai": 4, "gar": 5, "gat": 10, "ge": 246,
+			"ge ": 109, "ged": 24, "gen": 21, "ger": 15, "ges": 43, "get": 29, "gg": 17, "gge": 11,
+			"gh": 19, "gh ": 7, "ght": 8, "gi": 156, "gin": 23, "gis": 77, "git": 25, "giv": 22,
+			"gl": 26, "gle": 11, "gli": 11, "gm": 11, "gme": 11, "gn": 77, "gn ": 4, "gna": 23,
+			"gne": 15, "gni": 9, "gno": 19, "gnu": 4, "go": 23, "go ": 5, "goo": 4, "got": 9,
+			"gp": 10, "gp ": 7, "gr": 64, "gr ": 7, "gra": 32, "gre": 10, "gro": 13, "gs": 38,
+			"gs ": 32, "gst": 4, "gt": 8, "gth": 7, "gu": 43, "gue": 4, "gum": 16, "gur": 12,
+			"gy": 6, "gy ": 6, "h": 1543, "h ": 284, "ha": 217, "hal": 8, "han": 77, "har": 34,
+			"has": 44, "hat": 16, "hav": 24, "he": 610, "he ": 403, "hea": 21, "hec": 25, "hed": 7,
+			"hei": 12, "hel": 14, "hem": 10, "hen": 47, "her": 52, "hes": 12, "hi": 242, "hi ": 5,
+			"hib": 9, "hic": 15, "hif": 10, "hig": 4, "hil": 34, "hin": 35, "his": 83, "hit": 17,
+			"hiv": 24, "hm": 4, "ho": 111, "hon": 6, "hoo": 8, "hor": 9, "hos": 9, "hot": 4,
+			"hou": 32, "how": 38, "hr": 14, "hra": 4, "hre": 8, "hs": 9, "hs ": 9, "ht": 12,
+			"ht ": 8, "hu": 21, "hum": 8, "hun": 8, "hy": 4, "i": 4589, "i ": 25, "ia": 108,
+			"ia ": 9, "iab": 4, "ial": 37, "ian": 26, "iar": 4, "ias": 4, "iat": 24, "ib": 57,
+			"ib ": 5, "ibi": 10, "ibl": 13, "ibr": 13, "ibu": 8, "ic": 227, "ic ": 65, "ica": 85,
+			"ice": 19, "ich": 13, "ici": 10, "ick": 9, "ico": 7, "ict": 14, "icy": 4, "id": 163,
+			"id ": 89, "ida": 6, "idd": 4, "ide": 50, "idi": 5, "ie": 131, "ied": 42, "iel": 21,
+			"ien": 13, "ier": 7, "ies": 35, "iev": 6, "if": 155, "if ": 27, "iff": 27, "ifi": 64,
+			"ift": 10, "ify": 19, "ig": 123, "ig ": 8, "igg": 7, "igh": 13, "igi": 10, "ign": 64,
+			"igu": 18, "ik": 5, "ike": 5, "il": 346, "il ": 8, "ila": 14, "ild": 8, "ile": 257,
+			"ili": 10, "ill": 27, "ils": 4, "ilt": 5, "ilu": 4, "ily": 8, "im": 104, "ima": 10,
+			"ime": 28, "imi": 13, "imm": 18, "imp": 28, "in": 1277, "in ": 254, "ina": 39, "inc": 35,
+			"ind": 84, "ine": 73, "inf": 43, "ing": 395, "inh": 5, "ini": 33, "ink": 14, "inp": 23,
+			"ins": 105, "int": 103, "inu": 7, "inv": 58, "io": 474, "ion": 455, "iou": 9, "ip": 56,
+			"ip ": 10, "ipa": 8, "ipe": 5, "ipl": 12, "ipp": 4, "ipt": 11, "ir": 157, "ir ": 25,
+			"ira": 4, "ire": 99, "irs": 11, "irt": 8, "is": 514, "is ": 246, "isa": 17, "isc": 8,
+			"ise": 6, "ish": 15, "isi": 9, "isk": 5, "iso": 6, "isp": 16, "iss": 26, "ist": 153,
+			"it": 486, "it ": 148, "itc": 4, "ite": 68, "ith": 117, "iti": 57, "ito": 28, "its": 22,
+			"itt": 12, "itu": 5, "ity": 20, "iv": 98, "iva": 5, "ive": 90, "ix": 30, "ix ": 23,
+			"ixe": 4, "iz": 52, "ize": 48, "j": 67, "je": 52, "jec": 51, "jo": 4, "ju": 6,
+			"k": 473, "k ": 133, "ka": 40, "kag": 30, "ke": 176, "ke ": 9, "ked": 25, "kee": 5,
+			"ken": 4, "ker": 10, "kes": 4, "ket": 24, "key": 91, "ki": 40, "kin": 27, "kip": 8,
+			"kn": 19, "kno": 19, "ko": 7, "kou": 6, "ks": 28, "ks ": 13, "ksu": 9, "ksv": 4,
+			"kt": 9, "kto": 5, "ktr": 4, "l": 2463, "l ": 331, "la": 155, "lab": 18, "lac": 14,
+			"lag": 14, "lan": 9, "lap": 5, "lar": 10, "las": 12, "lat": 37, "lau": 4, "lay": 22,
+			"ld": 105, "ld ": 88, "lde": 5, "ldn": 5, "lds": 4, "le": 607, "le ": 320, "lea": 34,
+			"lec": 18, "led": 70, "lel": 4, "lem": 24, "len": 21, "ler": 6, "les": 69, "let": 24,
+			"lev": 10, "lf": 17, "lf ": 11, "lfo": 6, "lg": 4, "li": 361, "lia": 10, "lib": 19,
+			"lic": 50, "lid": 70, "lie": 16, "lif": 4, "lig": 9, "lik": 5, "lim": 14, "lin": 70,
+			"lis": 54, "lit": 19, "liz": 19, "ll": 184, "ll ": 73, "lla": 5, "lle": 22, "lli": 8,
+			"llo": 54, "lly": 19, "lm": 4, "lo": 260, "loa": 34, "lob": 8, "loc": 93, "log": 15,
+			"lon": 22, "loo": 13, "los": 10, "low": 55, "lp": 9, "lp ": 8, "lr": 12, "lre": 12,
+			"ls": 56, "ls ": 39, "lse": 4, "lso": 9, "lt": 105, "lt ": 52, "lte": 18, "ltg": 5,
+			"lti": 23, "lu": 95, "lud": 12, "lue": 45, "lug": 5, "lum": 5, "lur": 4, "lus": 9,
+			"lut": 12, "lv": 5, "lve": 4, "ly": 137, "ly ": 132, "m": 1647, "m ": 202, "ma": 274,
+			"ma ": 8, "mac": 9, "mag": 5, "mai": 13, "mak": 10, "mal": 20, "man": 59, "mar": 25,
+			"mas": 16, "mat": 81, "max": 5, "may": 19, "mb": 105, "mb ": 5, "mbe": 48, "mbi": 10,
+			"mbl": 4, "mbo": 37, "me": 401, "me ": 123, "med": 37, "mem": 11, "men": 82, "mer": 39,
+			"mes": 65, "met": 38, "mi": 133, "mic": 9, "mig": 5, "mil": 5, "min": 23, "mis": 24,
+			"mit": 63, "ml": 5, "ml ": 4, "mm": 108, "mma": 37, "mme": 24, "mmi": 41, "mmo": 5,
+			"mn": 4, "mo": 156, "moc": 6, "mod": 48, "mon": 20, "mor": 21, "mot": 14, "mou": 14,
+			"mov": 28, "mp": 149, "mp ": 13, "mpa": 19, "mpi": 6, "mpl": 30, "mpo": 36, "mpr": 7,
+			"mpt": 33, "ms": 17, "ms ": 13, "mt": 5, "mu": 78, "mul": 26, "mus": 47, "mv": 5,
+			"n": 4466, "n ": 1026, "na": 280, "na ": 4, "nab": 23, "nag": 7, "nal": 58, "nam": 110,
+			"nar": 12, "nat": 59, "nc": 159, "nca": 6, "nce": 32, "nch": 39, "nci": 12, "ncl": 12,
+			"nco": 24, "ncr": 13, "nct": 15, "nd": 382, "nd ": 212, "nda": 22, "nde": 70, "ndi": 28,
+			"ndl": 24, "ndo": 7, "nds": 17, "ne": 273, "ne ": 92, "nec": 11, "ned": 29, "nee": 13,
+			"neg": 7, "nel": 4, "nen": 14, "ner": 29, "nes": 15, "net": 11, "new": 29, "nex": 11,
+			"nf": 73, "nfi": 21, "nfl": 4, "nfo": 42, "ng": 504, "ng ": 385, "nge": 63, "ngl": 20,
+			"ngo": 5, "ngs": 16, "ngt": 7, "nh": 6, "nhi": 5, "ni": 122, "nia": 5, "nic": 7,
+			"nin": 47, "nis": 8, "nit": 40, "nix": 4, "niz": 6, "nk": 41, "nk ": 16, "nkn": 16,
+			"nks": 4, "nl": 76, "nli": 4, "nlo": 8, "nly": 62, "nm": 13, "nme": 11, "nn": 74,
+			"nne": 12, "nni": 7, "nno": 54, "no": 490, "no ": 65, "nom": 4, "non": 40, "nor": 28,
+			"not": 322, "now": 21, "np": 34, "npr": 5, "npu": 22, "nr": 5, "ns": 256, "ns ": 95,
+			"nse": 17, "nsi": 24, "nst": 92, "nsu": 12, "nt": 456, "nt ": 161, "nta": 40, "nte": 82,
+			"nti": 60, "ntl": 9, "nto": 18, "ntr": 47, "nts": 30, "nu": 80, "nu ": 8, "num": 53,
+			"nv": 71, "nva": 54, "nve": 9, "nw": 7, "nwi": 6, "ny": 30, "ny ": 25, "nz": 5,
+			"o": 4528, "o ": 529, "oa": 40, "oad": 22, "oat": 14, "ob": 65, "obj": 46, "obl": 5,
+			"oc": 162, "oc ": 8, "oca": 59, "oce": 21, "ock": 43, "oco": 8, "ocr": 6, "ocs": 5,
+			"od": 104, "od ": 8, "ode": 57, "odi": 9, "odu": 25, "oe": 46, "oes": 46, "of": 250,
+			"of ": 198, "off": 33, "oft": 18, "og": 42, "og ": 8, "ogn": 6, "ogr": 17, "oi": 30,
+			"oid": 6, "oin": 23, "ok": 13, "oke": 5, "ol": 138, "ol ": 38, "old": 13, "oli": 6,
+			"oll": 27, "olo": 5, "ols": 17, "olu": 17, "olv": 5, "om": 248, "om ": 65, "oma": 12,
+			"omb": 8, "ome": 12, "omm": 85, "omp": 60, "on": 862, "on ": 445, "ona": 30, "ond": 18,
+			"one": 66, "onf": 29, "ong": 22, "oni": 10, "onl": 60, "onn": 8, "ons": 101, "ont": 50,
+			"onv": 9, "oo": 60, "oo ": 15, "ook": 6, "ool": 7, "oop": 6, "oos": 8, "oot": 12,
+			"op": 126, "op ": 15, "ope": 56, "opr": 5, "opt": 27, "opy": 10, "or": 779, "or ": 328,
+			"ora": 15, "orc": 5, "ord": 52, "ore": 64, "ori": 29, "ork": 22, "orm": 67, "orr": 19,
+			"ors": 16, "ort": 95, "ory": 54, "os": 95, "os ": 12, "ose": 23, "osi": 34, "oss": 9,
+			"ost": 12, "ot": 401, "ot ": 320, "ote": 30, "oth": 32, "oto": 9, "ou": 316, "ou ": 29,
+			"oug": 5, "oul": 58, "oun": 52, "oup": 12, "our": 40, "ous": 21, "out": 98, "ov": 70,
+			"ove": 52, "ovi": 16, "ow": 143, "ow ": 69, "owe": 22, "owi": 15, "own": 32, "p": 1644,
+			"p ": 107, "pa": 229, "pac": 75, "pal": 8, "par": 54, "pas": 33, "pat": 42, "pc": 19,
+			"pc ": 12, "pd": 27, "pda": 27, "pe": 249, "pe ": 45, "pea": 5, "pec": 89, "ped": 5,
+			"pen": 20, "per": 70, "pg": 7, "ph": 22, "ph ": 5, "pho": 5, "pi": 43, "pi ": 6,
+			"pic": 7, "pin": 10, "pip": 5, "pir": 7, "pl": 161, "pla": 32, "ple": 60, "pli": 39,
+			"plt": 6, "plu": 7, "ply": 14, "pm": 9, "pm ": 6, "po": 199, "poi": 16, "pol": 8,
+			"pon": 22, "poo": 5, "por": 89, "pos": 48, "pow": 6, "pp": 117, "ppe": 8, "ppl": 39,
+			"ppo": 56, "ppr": 6, "pps": 5, "pr": 205, "pre": 78, "pri": 36, "pro": 90, "ps": 34,
+			"ps ": 20, "pst": 13, "pt": 108, "pt ": 28, "pte": 12, "pti": 43, "pty": 20, "pu": 91,
+			"pub": 14, "put": 60, "py": 11, "py ": 6, "q": 92, "qu": 86, "qua": 9, "que": 24,
+			"qui": 48, "quo": 5, "r": 4080, "r ": 761, "ra": 325, "rab": 7, "rac": 45, "rag": 6,
+			"ral": 16, "ram": 34, "ran": 96, "rap": 9, "rar": 24, "ras": 6, "rat": 70, "rb": 16,
+			"rbe": 5, "rc": 92, "rc ": 7, "rce": 34, "rch": 49, "rd": 92, "rd ": 62, "rde": 13,
+			"rds": 7, "re": 1108, "re ": 205, "rea": 132, "reb": 5, "rec": 85, "red": 101, "ree": 26,
+			"ref": 38, "reg": 87, "rei": 5, "rel": 46, "rem": 39, "ren": 40, "rep": 50, "req": 59,
+			"res": 131, "ret": 27, "rev": 23, "rew": 5, "rf": 17, "rfl": 6, "rg": 62, "rge": 40,
+			"rgu": 16, "ri": 267, "ria": 18, "rib": 9, "ric": 13, "rid": 8, "rie": 37, "rig": 20,
+			"rin": 71, "rip": 15, "ris": 6, "rit": 47, "rk": 42, "rk ": 14, "rke": 9, "rki": 11,
+			"rl": 21, "rl ": 10, "rla": 5, "rm": 112, "rm ": 18, "rma": 55, "rme": 10, "rmi": 18,
+			"rn": 86, "rn ": 19, "rna": 35, "rne": 5, "rni": 24, "ro": 298, "ro ": 10, "rob": 6,
+			"roc": 22, "rod": 7, "rog": 16, "rol": 12, "rom": 65, "ron": 11, "roo": 6, "rop": 10,
+			"ror": 66, "ros": 5, "rot": 11, "rou": 20, "rov": 15, "rp": 19, "rpr": 9, "rr": 129,
+			"rra": 5, "rre": 29, "rri": 8, "rro": 70, "rru": 8, "rry": 5, "rs": 156, "rs ": 83,
+			"rse": 11, "rsi": 46, "rst": 12, "rt": 169, "rt ": 72, "rta": 5, "rte": 39, "rth": 5,
+			"rti": 25, "rts": 7, "rtu": 5, "rty": 9, "ru": 115, "ruc": 65, "run": 24, "rup": 9,
+			"rus": 12, "rv": 44, "rve": 36, "rvi": 8, "rw": 7, "ry": 138, "ry ": 122, "ryp": 11,
+			"s": 3894, "s ": 1303, "sa": 85, "sa ": 6, "sab": 14, "sag": 32, "sam": 13, "sc": 50,
+			"sca": 18, "scr": 21, "se": 548, "se ": 166, "sea": 10, "sec": 66, "sed": 45, "see": 14,
+			"seg": 10, "sel": 22, "sen": 22, "seq": 6, "ser": 76, "ses": 25, "set": 74, "sh": 137,
+			"sh ": 36, "sha": 18, "shi": 15, "sho": 58, "si": 300, "sib": 10, "sid": 18, "sig": 40,
+			"sim": 8, "sin": 59, "sio": 84, "sit": 38, "siz": 23, "sk": 29, "sk ": 11, "ski": 10,
+			"skt": 5, "sl": 25, "sl ": 8, "sla": 8, "sm": 8, "sn": 16, "sn ": 14, "so": 122,
+			"so ": 14, "soc": 14, "sof": 17, "sol": 18, "som": 6, "son": 8, "sor": 14, "sou": 30,
+			"sp": 122, "spa": 18, "spe": 64, "spl": 19, "spo": 11, "sr": 5, "ss": 234, "ss ": 59,
+			"ssa": 32, "sse": 20, "ssi": 74, "ssl": 5, "ssp": 5, "ssu": 10, "ssw": 23, "st": 621,
+			"st ": 138, "sta": 119, "std": 9, "ste": 148, "sti": 35, "sto": 26, "str": 129, "sts": 5,
+			"stu": 5, "su": 161, "sub": 35, "suf": 11, "sul": 5, "sum": 21, "sup": 69, "sv": 5,
+			"sw": 27, "swo": 21, "sy": 83, "sym": 43, "syn": 11, "sys": 27, "t": 5328, "t ": 1367,
+			"ta": 314, "ta ": 39, "tab": 45, "tac": 8, "tad": 15, "tag": 15, "tai": 31, "tal": 29,
+			"tam": 10, "tan": 24, "tar": 37, "tas": 6, "tat": 41, "tax": 10, "tc": 55, "tch": 53,
+			"td": 10, "tdi": 5, "te": 842, "te ": 227, "tea": 10, "tec": 18, "ted": 157, "teg": 12,
+			"tel": 6, "tem": 56, "ten": 47, "ter": 254, "tes": 34, "tex": 13, "tf": 7, "tg": 7,
+			"tgr": 5, "th": 765, "th ": 129, "tha": 32, "the": 460, "thi": 95, "tho": 23, "thr": 10,
+			"ths": 8, "thu": 6, "ti": 693, "ti ": 7, "tia": 30, "tib": 8, "tic": 51, "tif": 18,
+			"til": 9, "tim": 27, "tin": 114, "tio": 369, "tip": 13, "tiv": 40, "tl": 20, "tls": 6,
+			"tly": 8, "tn": 6, "tna": 6, "to": 507, "to ": 341, "toc": 11, "tom": 8, "too": 17,
+			"top": 11, "tor": 102, "tp": 47, "tp ": 6, "tpm": 5, "tpu": 32, "tr": 272, "tra": 48,
+			"tre": 43, "tri": 57, "tro": 16, "tru": 81, "try": 26, "ts": 121, "ts ": 109, "tsi": 8,
+			"tt": 58, "tte": 32, "tti": 13, "tu": 102, "tua": 8, "tur": 57, "tus": 15, "tw": 31,
+			"twa": 17, "two": 10, "ty": 99, "ty ": 52, "typ": 46, "u": 1834, "u ": 48, "ua": 29,
+			"ual": 15, "ub": 56, "ubl": 13, "ubm": 14, "ubs": 8, "uc": 84, "uce": 7, "uct": 67,
+			"ud": 18, "ude": 12, "ue": 85, "ue ": 47, "uen": 6, "ues": 27, "uf": 12, "uff": 12,
+			"ug": 22, "ug ": 7, "ugh": 5, "ugi": 5, "ui": 66, "uil": 6, "uir": 45, "ul": 170,
+			"ul ": 5, "ula": 8, "uld": 58, "ule": 20, "ull": 11, "ult": 67, "um": 121, "um ": 18,
+			"umb": 49, "ume": 37, "ump": 6, "un": 269, "un ": 15, "una": 27, "unc": 24, "und": 43,
+			"une": 10, "uni": 27, "unk": 24, "unl": 7, "unm": 7, "unn": 7, "unp": 7, "uns": 12,
+			"unt": 40, "unw": 6, "uo": 7, "uot": 5, "up": 150, "up ": 23, "upd": 27, "upe": 5,
+			"upl": 9, "upp": 65, "ups": 11, "upt": 8, "ur": 170, "ur ": 10, "ura": 9, "urc": 30,
+			"ure": 57, "uri": 19, "url": 8, "urn": 10, "urr": 16, "us": 265, "us ": 43, "usa": 6,
+			"use": 128, "usi": 16, "ust": 56, "ut": 257, "ut ": 122, "uta": 8, "ute": 25, "uth": 27,
+			"uti": 14, "uto": 17, "utp": 32, "uts": 7, "v": 570, "v ": 18, "va": 142, "vai": 13,
+			"val": 116, "var": 7, "vat": 5, "ve": 305, "ve ": 120, "vec": 6, "ved": 10, "vel": 14,
+			"ven": 26, "ver": 122, "ves": 6, "vi": 70, "vic": 15, "vid": 17, "vie": 5, "vin": 6,
+			"vio": 11, "vir": 7, "vis": 6, "vo": 20, "voi": 5, "vol": 5, "w": 650, "w ": 95,
+			"wa": 101, "wan": 5, "war": 65, "was": 15, "way": 11, "we": 49, "wed": 16, "wer": 16,
+			"wh": 82, "whe": 30, "whi": 49, "wi": 169, "wil": 19, "win": 27, "wit": 113, "wn": 33,
+			"wn ": 25, "wnl": 5, "wo": 64, "wo ": 8, "wor": 51, "wr": 46, "wri": 37, "wro": 6,
+			"x": 279, "x ": 91, "xa": 8, "xc": 10, "xcl": 5, "xe": 26, "xec": 16, "xed": 8,
+			"xi": 35, "xin": 5, "xis": 12, "xit": 13, "xp": 56, "xpe": 27, "xpi": 7, "xpo": 7,
+			"xpr": 12, "xt": 45, "xt ": 13, "xte": 20, "xtr": 11, "y": 815, "y ": 526, "ye": 8,
+			"yet": 7, "yi": 11, "yin": 11, "ym": 45, "ymb": 36, "yn": 20, "yna": 8, "ynt": 10,
+			"yo": 43, "you": 41, "yp": 59, "ype": 47, "ypt": 11, "ys": 59, "ys ": 28, "yst": 27,
+			"yt": 22, "yte": 16, "z": 79, "za": 7, "ze": 64, "ze ": 42, "zed": 7, "zer": 14,
+		}},
+		"es": {Total: 208446, Ngrams: map[string]int{
+			" a": 761, " a ": 100, " ab": 22, " ac": 62, " ad": 50, " ag": 12, " aj": 4, " al": 138,
+			" an": 49, " ap": 43, " ar": 154, " as": 19, " at": 16, " au": 63, " av": 9, " añ": 8,
+			" b": 189, " ba": 43, " bi": 52, " bl": 28, " bo": 12, " br": 6, " bu": 18, " by": 7,
+			" bú": 12, " c": 991, " c ": 7, " ca": 180, " ce": 37, " ch": 8, " ci": 16, " cl": 56,
+			" co": 554, " cr": 56, " ct": 6, " cu": 33, " cá": 7, " có": 24, " d": 1805, " d ": 5,
+			" da": 52, " de": 1532, " di": 145, " dn": 4, " do": 35, " dp": 4, " du": 14, " e": 1558,
+			" e ": 4, " ed": 11, " ej": 35, " el": 447, " em": 25, " en": 387, " eq": 12, " er": 56,
+			" es": 452, " et": 7, " ex": 109, " f": 392, " fa": 68, " fe": 13, " fi": 131, " fl": 27,
+			" fo": 52, " fp": 6, " fr": 18, " fs": 4, " fu": 68, " g": 129, " ga": 6, " ge": 20,
+			" gi": 22, " gl": 9, " gn": 10, " gp": 5, " gr": 31, " gs": 4, " gu": 9, " h": 166,
+			" ha": 120, " he": 16, " hi": 13, " ho": 8, " hu": 4, " i": 448, " ic": 5, " id": 20,
+			" ig": 8, " il": 7, " im": 50, " in": 333, " ir": 8, " is": 4, " iz": 6, " j": 13,
+			" ju": 4, " k": 8, " ke": 4, " l": 822, " la": 443, " le": 38, " li": 77, " ll": 17,
+			" lo": 180, " lu": 13, " lí": 40, " m": 396, " ma": 95, " me": 67, " mi": 29, " mo": 105,
+			" mu": 35, " má": 40, " mú": 8, " n": 740, " na": 8, " ne": 52, " ni": 43, " no": 557,
+			" nu": 38, " nú": 36, " o": 316, " o ": 74, " ob": 56, " oc": 16, " of": 9, " om": 10,
+			" op": 99, " or": 33, " ot": 13, " p": 862, " pa": 311, " pc": 12, " pe": 70, " pi": 16,
+			" pl": 8, " po": 114, " pr": 173, " ps": 4, " pu": 140, " pú": 5, " q": 103, " qu": 103,
+			" r": 519, " r ": 7, " ra": 55, " re": 426, " ro": 10, " ru": 16, " s": 1005, " s ": 5,
+			" sa": 50, " sc": 7, " se": 533, " sh": 10, " si": 137, " so": 99, " sp": 8, " st": 25,
+			" su": 63, " sy": 4, " sí": 29, " só": 22, " t": 346, " ta": 83, " te": 66, " th": 6,
+			" ti": 71, " tl": 5, " to": 36, " tr": 58, " tu": 13, " u": 495, " u ": 9, " ub": 9,
+			" un": 351, " up": 10, " ur": 7, " us": 81, " ut": 23, " v": 193, " va": 76, " ve": 57,
+			" vi": 19, " vo": 4, " vá": 27, " ví": 5, " w": 19, " wo": 5, " x": 16, " x ": 8,
+			" y": 81, " y ": 68, " ya": 13, " z": 12, " ze": 5, " zw": 4, " á": 25, " ár": 22,
+			" é": 4, " í": 40, " ín": 40, " ó": 11, " ór": 10, " ú": 15, " úl": 5, " ún": 5,
+			" út": 5, "a": 6836, "a ": 1978, "ab": 183, "aba": 51, "abe": 18, "abi": 10, "abl": 75,
+			"abo": 4, "abr": 10, "abs": 10, "ac": 406, "acc": 10, "ace": 27, "ach": 10, "aci": 233,
+			"ack": 18, "act": 75, "ací": 18, "ad": 652, "ad ": 45, "ada": 161, "ade": 39, "adi": 11,
+			"adm": 29, "ado": 350, "adu": 6, "ae": 13, "ae ": 4, "aer": 8, "ag": 54, "ag ": 7,
+			"aga": 4, "age": 19, "agm": 5, "agr": 8, "ags": 4, "ai": 8, "ail": 5, "aj": 63,
+			"aja": 6, "aje": 24, "ajo": 27, "aju": 4, "ak": 8, "ak ": 5, "al": 602, "al ": 224,
+			"ala": 29, "alc": 7, "ale": 45, "alg": 10, "ali": 124, "all": 59, "alm": 19, "alo": 46,
+			"alt": 28, "am": 266, "am ": 19, "ama": 75, "amb": 59, "ame": 35, "ami": 58, "amp": 12,
+			"an": 371, "an ": 38, "ana": 14, "anc": 24, "and": 114, "ane": 8, "ang": 11, "ani": 18,
+			"ano": 13, "ans": 7, "ant": 100, "anu": 4, "anz": 10, "ap": 93, "ap ": 7, "apa": 20,
+			"ape": 5, "aph": 9, "api": 4, "apl": 24, "app": 10, "apt": 5, "apu": 4, "aq": 62,
+			"aqu": 62, "ar": 1151, "ar ": 489, "ara": 215, "arc": 153, "ard": 15, "are": 22, "arg": 42,
+			"ari": 64, "arj": 6, "aro": 8, "arp": 7, "arq": 12, "arr": 5, "ars": 34, "art": 40,
+			"ará": 27, "as": 416, "as ": 279, "asa": 10, "ase": 46, "ash": 6, "asi": 30, "aso": 8,
+			"ast": 17, "asu": 10, "at": 187, "at ": 6, "ata": 16, "ate": 18, "ati": 32, "ato": 78,
+			"atr": 21, "au": 82, "aul": 7, "aur": 6, "aus": 18, "aut": 48, "av": 81, "ave": 58,
+			"avi": 9, "avo": 7, "aví": 4, "ax": 6, "ay": 57, "ay ": 37, "ayú": 14, "az": 46,
+			"az ": 11, "aza": 33, "aí": 4, "aíz": 4, "añ": 36, "aña": 9, "año": 27, "b": 1037,
+			"b ": 18, "ba": 123, "ba ": 31, "bac": 6, "baj": 29, "bal": 8, "ban": 4, "bar": 8,
+			"bas": 31, "be": 120, "be ": 57, "bec": 5, "ben": 18, "ber": 23, "bes": 5, "bez": 9,
+			"bi": 185, "bia": 20, "bib": 14, "bic": 27, "bid": 9, "bie": 6, "bil": 9, "bin": 12,
+			"bio": 22, "bir": 25, "bit": 27, "bió": 6, "bj": 38, "bje": 38, "bl": 203, "bla": 29,
+			"ble": 120, "bli": 31, "blo": 22, "bm": 6, "bmó": 6, "bo": 79, "bol": 49, "bor": 24,
+			"br": 140, "bra": 14, "bre": 116, "bri": 7, "bs": 14, "bso": 8, "bt": 16, "bte": 11,
+			"bu": 45, "buc": 11, "bui": 4, "bun": 5, "bus": 8, "but": 5, "by": 10, "byt": 8,
+			"bó": 11, "ból": 11, "bú": 12, "bús": 10, "c": 3457, "c ": 50, "ca": 553, "ca ": 82,
+			"cab": 20, "cac": 63, "cad": 99, "cal": 24, "cam": 55, "can": 22, "cap": 19, "car": 130,
+			"cas": 30, "cat": 7, "cc": 144, "cci": 140, "ce": 246, "ce ": 68, "ced": 10, "cel": 7,
+			"cen": 12, "cep": 7, "cer": 76, "ces": 65, "ch": 267, "ch ": 16, "cha": 16, "che": 104,
+			"chi": 115, "cho": 7, "chu": 4, "ci": 767, "cia": 106, "cib": 8, "cid": 41, "cie": 13,
+			"cif": 44, "cim": 8, "cio": 183, "cip": 6, "cir": 5, "cis": 6, "cit": 6, "ció": 332,
+			"ck": 30, "ck ": 13, "cke": 6, "cl": 122, "cla": 84, "clo": 4, "clu": 27, "co": 707,
+			"co ": 45, "cod": 9, "coi": 13, "col": 16, "com": 209, "con": 341, "cop": 10, "cor": 43,
+			"cos": 13, "cr": 136, "cre": 54, "cri": 60, "crl": 5, "crá": 4, "cró": 5, "cs": 10,
+			"csp": 8, "ct": 216, "ct ": 5, "cta": 29, "cte": 25, "cti": 24, "cto": 61, "ctr": 7,
+			"ctu": 63, "cu": 134, "cua": 26, "cuc": 10, "cue": 23, "cul": 9, "cum": 11, "cup": 5,
+			"cur": 17, "cut": 23, "cá": 7, "cám": 4, "cé": 4, "cí": 24, "cía": 5, "cíf": 6,
+			"cío": 13, "có": 32, "có ": 8, "cód": 20, "d": 3607, "d ": 99, "da": 454, "da ": 237,
+			"dad": 66, "dam": 13, "dan": 7, "dar": 22, "das": 47, "dat": 49, "de": 1814, "de ": 1156,
+			"deb": 84, "dec": 18, "def": 38, "del": 165, "dem": 32, "den": 105, "dep": 21, "der": 13,
+			"des": 141, "det": 24, "dex": 7, "di": 340, "dia": 22, "dic": 61, "did": 11, "die": 12,
+			"dif": 37, "dig": 20, "din": 10, "dio": 7, "dir": 86, "dis": 45, "dit": 9, "diz": 12,
+			"dm": 29, "dmi": 29, "dn": 5, "dns": 5, "do": 758, "do ": 535, "dob": 5, "doc": 10,
+			"don": 5, "dor": 78, "dos": 117, "dp": 5, "dr": 10, "ds": 6, "du": 54, "duc": 20,
+			"duj": 5, "dul": 9, "dur": 9, "dv": 6, "e": 8591, "e ": 2450, "ea": 151, "ea ": 40,
+			"ead": 26, "eal": 14, "eam": 21, "ear": 30, "eas": 13, "eb": 98, "eba": 8, "ebe": 76,
+			"ebi": 7, "ec": 499, "ec ": 7, "eca": 15, "ecc": 96, "ece": 71, "ech": 17, "eci": 65,
+			"ecl": 28, "eco": 24, "ecr": 5, "ect": 99, "ecu": 64, "ecí": 6, "ed": 203, "ed ": 6,
+			"eda": 15, "ede": 128, "edi": 43, "edo": 7, "ee": 31, "ee ": 8, "eer": 11, "ees": 6,
+			"ef": 79, "efa": 8, "efe": 34, "efi": 25, "efs": 6, "eg": 180, "ega": 19, "egi": 98,
+			"egm": 9, "ego": 10, "egu": 33, "ei": 17, "ein": 16, "ej": 43, "eja": 5, "eje": 33,
+			"el": 711, "el ": 599, "ela": 24, "ele": 32, "eli": 17, "ell": 5, "elo": 11, "elt": 9,
+			"em": 187, "ema": 70, "eme": 30, "emi": 9, "emo": 25, "emp": 35, "emá": 7, "en": 1159,
+			"en ": 346, "ena": 33, "enc": 122, "end": 40, "ene": 96, "eni": 26, "enl": 10, "eno": 17,
+			"ens": 41, "ent": 403, "enz": 9, "eo": 23, "eo ": 10, "eon": 5, "ep": 77, "epa": 12,
+			"epe": 12, "epo": 19, "epr": 5, "ept": 5, "epu": 13, "epú": 6, "eq": 40, "equ": 40,
+			"er": 956, "er ": 174, "era": 171, "erc": 7, "erd": 12, "ere": 68, "erf": 12, "erg": 5,
+			"eri": 35, "erm": 46, "ern": 26, "ero": 176, "erp": 6, "err": 69, "ers": 52, "ert": 39,
+			"erv": 28, "erí": 18, "es": 1169, "es ": 452, "esa": 26, "esb": 9, "esc": 83, "esd": 13,
+			"ese": 28, "esh": 6, "esi": 66, "eso": 24, "esp": 159, "est": 280, "esu": 12, "et": 234,
+			"et ": 9, "eta": 60, "etc": 6, "ete": 77, "eti": 17, "eto": 41, "etr": 16, "eu": 24,
+			"eub": 20, "ev": 58, "eva": 10, "eve": 7, "evi": 16, "evo": 22, "ex": 149, "ex ": 10,
+			"exa": 5, "exc": 12, "exi": 24, "exp": 43, "ext": 51, "ey": 6, "ez": 26, "ez ": 11,
+			"eza": 12, "eñ": 17, "eña": 14, "f": 811, "f ": 33, "fa": 88, "fal": 58, "fau": 7,
+			"fav": 7, "faz": 5, "fe": 65, "fec": 17, "fer": 41, "fet": 6, "ff": 31, "ff ": 19,
+			"ffi": 5, "ffs": 6, "fi": 322, "fia": 12, "fic": 200, "fig": 28, "fij": 5, "fil": 11,
+			"fin": 42, "fir": 19, "fl": 39, "fli": 9, "flo": 15, "flu": 11, "fo": 94, "fo ": 7,
+			"for": 81, "fp": 8, "fr": 26, "fra": 19, "fs": 16, "ft": 15, "ftw": 12, "fu": 69,
+			"fue": 29, "fun": 17, "fus": 22, "g": 665, "g ": 42, "ga": 69, "ga ": 11, "gad": 9,
+			"gal": 6, "gan": 5, "gar": 28, "gat": 6, "ge": 64, "ge ": 14, "gen": 38, "gi": 145,
+			"gin": 12, "gis": 88, "git": 27, "gl": 14, "glo": 10, "gm": 15, "gme": 14, "gn": 25,
+			"gno": 14, "gnu": 7, "go": 59, "go ": 36, "gor": 8, "gos": 7, "got": 5, "gp": 8,
+			"gp ": 5, "gr": 63, "gra": 33, "gre": 12, "gru": 6, "grá": 6, "gs": 13, "gs ": 9,
+			"gu": 122, "gua": 8, "gui": 15, "gul": 5, "gum": 18, "gun": 28, "guo": 6, "gur": 38,
+			"gú": 13, "gún": 13, "h": 498, "h ": 45, "ha": 145, "ha ": 36, "hab": 12, "hac": 20,
+			"han": 17, "has": 13, "hay": 39, "he": 122, "he ": 12, "hea": 7, "hel": 5, "her": 87,
+			"hi": 135, "hin": 5, "his": 5, "hiv": 114, "ho": 21, "ho ": 6, "hor": 6, "hu": 15,
+			"hum": 9, "i": 4734, "i ": 37, "ia": 241, "ia ": 83, "iab": 11, "iad": 33, "ial": 33,
+			"ian": 19, "iar": 21, "ias": 26, "iat": 11, "ib": 135, "ibe": 12, "ibi": 34, "ibl": 60,
+			"ibr": 9, "ibu": 17, "ic": 529, "ic ": 6, "ica": 228, "ice": 54, "ich": 86, "ici": 77,
+			"ico": 52, "ict": 8, "icó": 8, "id": 358, "id ": 13, "ida": 153, "ide": 25, "idi": 7,
+			"ido": 155, "ie": 202, "iem": 9, "ien": 144, "ier": 41, "if": 127, "ife": 8, "iff": 14,
+			"ifi": 93, "ifr": 6, "ig": 101, "ige": 9, "igi": 7, "ign": 14, "igo": 20, "igu": 43,
+			"ij": 7, "ijo": 7, "il": 102, "il ": 12, "ila": 8, "ile": 17, "ili": 35, "ill": 12,
+			"ilo": 7, "ilt": 6, "im": 157, "ima": 30, "imb": 11, "ime": 15, "imi": 38, "imo": 7,
+			"imp": 52, "in": 647, "in ": 57, "ina": 73, "inc": 67, "ind": 40, "ine": 24, "inf": 40,
+			"ing": 38, "ini": 63, "ink": 6, "inm": 11, "ino": 17, "inp": 5, "ins": 63, "int": 72,
+			"inu": 8, "inv": 51, "iná": 7, "io": 379, "io ": 124, "iol": 7, "ion": 172, "ior": 9,
+			"ios": 49, "iot": 14, "ip": 90, "ip ": 7, "ipa": 5, "ipc": 10, "ipl": 8, "ipo": 39,
+			"ipt": 5, "ipu": 8, "iq": 7, "iqu": 7, "ir": 228, "ir ": 112, "ira": 6, "ire": 69,
+			"irm": 21, "irt": 6, "is": 305, "is ": 8, "isa": 7, "isc": 5, "isi": 20, "ism": 12,
+			"iso": 15, "isp": 27, "ist": 205, "it": 333, "it ": 77, "ita": 66, "ite": 70, "iti": 36,
+			"itm": 9, "ito": 34, "its": 16, "itu": 18, "iv": 207, "iva": 31, "ive": 18, "ivi": 8,
+			"ivo": 149, "ix": 5, "ix ": 5, "iz": 92, "iza": 80, "izq": 6, "ié": 6, "ién": 5,
+			"ió": 437, "ió ": 8, "ión": 429, "j": 210, "j ": 10, "ja": 21, "ja ": 7, "je": 109,
+			"je ": 21, "jec": 32, "jes": 5, "jet": 45, "jo": 49, "jo ": 42, "ju": 17, "jun": 12,
+			"k": 70, "k ": 27, "ka": 6, "ke": 14, "ket": 5, "ks": 7, "l": 3242, "l ": 907,
+			"la": 754, "la ": 432, "lab": 8, "lac": 14, "lad": 35, "lam": 16, "lan": 12, "lar": 50,
+			"las": 74, "lat": 18, "lav": 58, "laz": 30, "lc": 11, "lca": 5, "ld": 5, "le": 312,
+			"le ": 85, "lec": 57, "lee": 14, "leg": 14, "lem": 33, "len": 11, "les": 68, "let": 16,
+			"lf": 6, "lg": 12, "lgo": 5, "lgu": 5, "li": 410, "lib": 13, "lic": 73, "lid": 121,
+			"lim": 19, "lin": 26, "lio": 14, "lis": 53, "lit": 16, "liz": 62, "ll": 98, "lla": 41,
+			"lle": 5, "llo": 14, "lló": 30, "lm": 21, "lma": 5, "lme": 15, "lo": 419, "lo ": 111,
+			"lob": 12, "loc": 21, "log": 13, "lon": 9, "loq": 19, "lor": 45, "los": 169, "lot": 12,
+			"lp": 6, "ls": 18, "ls ": 11, "lt": 89, "lt ": 11, "lta": 28, "lte": 8, "lti": 23,
+			"lto": 7, "ltr": 5, "lu": 71, "luc": 5, "lug": 10, "lui": 11, "luj": 8, "lum": 9,
+			"lus": 13, "lut": 7, "luy": 5, "lv": 10, "lve": 8, "lá": 5, "lí": 43, "lím": 17,
+			"lín": 24, "ló": 32, "ló ": 31, "m": 1854, "m ": 50, "ma": 405, "ma ": 121, "mac": 36,
+			"mad": 17, "mag": 9, "mal": 19, "man": 41, "map": 9, "mar": 32, "mas": 40, "mat": 32,
+			"may": 15, "mañ": 27, "mb": 207, "mb ": 7, "mbi": 50, "mbl": 12, "mbo": 32, "mbr": 92,
+			"mbó": 11, "me": 289, "me ": 12, "med": 19, "mem": 13, "men": 167, "mer": 54, "met": 17,
+			"mi": 323, "mic": 9, "mid": 8, "mie": 76, "mif": 6, "mil": 7, "min": 46, "mir": 14,
+			"mis": 22, "mit": 127, "mm": 51, "mmi": 47, "mn": 5, "mo": 192, "mo ": 58, "mod": 36,
+			"mon": 16, "mor": 11, "mos": 50, "mot": 7, "mov": 6, "mp": 181, "mpa": 27, "mpi": 15,
+			"mpl": 34, "mpo": 55, "mpr": 35, "mpu": 8, "mu": 40, "mue": 19, "mul": 13, "má": 59,
+			"máq": 6, "más": 24, "mát": 13, "máx": 10, "mé": 8, "mét": 5, "mó": 11, "mód": 8,
+			"mú": 13, "múl": 8, "mún": 5, "n": 4673, "n ": 1274, "na": 378, "na ": 168, "nac": 6,
+			"nad": 46, "nal": 76, "nam": 13, "nan": 7, "nar": 39, "nas": 13, "nat": 5, "nc": 246,
+			"nca": 22, "nce": 8, "nch": 8, "nci": 119, "ncl": 23, "nco": 49, "ncr": 7, "ncu": 10,
+			"nd": 286, "nd ": 5, "nda": 29, "nde": 36, "ndi": 86, "ndo": 121, "ne": 324, "ne ": 32,
+			"nea": 41, "nec": 43, "ned": 6, "neg": 7, "nen": 18, "neo": 8, "ner": 48, "nes": 99,
+			"nex": 10, "nf": 90, "nfi": 40, "nfl": 8, "nfo": 36, "ng": 61, "ng ": 8, "ngi": 7,
+			"ngo": 8, "ngu": 14, "ngú": 12, "ni": 215, "ni ": 6, "nib": 12, "nic": 58, "nid": 48,
+			"nim": 6, "nin": 24, "nio": 7, "nip": 8, "nir": 7, "nit": 8, "niv": 17, "nj": 14,
+			"nju": 9, "nk": 7, "nk ": 5, "nl": 10, "nla": 10, "nm": 12, "nme": 11, "no": 659,
+			"no ": 489, "noc": 16, "nom": 94, "nop": 6, "nor": 16, "nos": 22, "not": 9, "np": 8,
+			"npu": 5, "ns": 150, "ns ": 8, "nsa": 36, "nse": 12, "nsi": 8, "nst": 71, "nsu": 5,
+			"nt": 732, "nt ": 9, "nta": 63, "nte": 282, "nti": 70, "nto": 135, "ntr": 149, "ntá": 5,
+			"nté": 8, "nu": 58, "nua": 8, "nue": 21, "nul": 8, "num": 8, "nv": 61, "nva": 5,
+			"nve": 6, "nvá": 40, "nz": 21, "nza": 15, "ná": 10, "nám": 7, "nó": 10, "nó ": 6,
+			"nú": 40, "núm": 36, "o": 5532, "o ": 2267, "ob": 117, "oba": 18, "obj": 38, "obl": 15,
+			"obr": 23, "obt": 14, "oc": 129, "oca": 39, "oce": 29, "oci": 18, "ock": 7, "ocs": 8,
+			"ocu": 16, "od": 104, "oda": 12, "ode": 6, "odi": 18, "odo": 49, "odu": 18, "of": 33,
+			"off": 14, "oft": 12, "og": 31, "og ": 5, "ogr": 17, "oi": 16, "oin": 16, "oj": 7,
+			"oja": 5, "ol": 153, "ol ": 33, "ola": 19, "ole": 6, "oli": 5, "olo": 58, "olu": 18,
+			"olv": 7, "om": 347, "om ": 9, "oma": 16, "omb": 94, "ome": 12, "omi": 33, "omm": 47,
+			"omo": 39, "omp": 78, "omá": 10, "omú": 5, "on": 635, "on ": 136, "ona": 102, "onc": 8,
+			"ond": 18, "one": 104, "onf": 49, "ong": 7, "oni": 24, "onj": 9, "ono": 18, "ons": 32,
+			"ont": 115, "onv": 8, "oo": 8, "op": 154, "op ": 10, "opc": 31, "ope": 65, "opi": 8,
+			"opo": 25, "oq": 19, "oq ": 13, "oqu": 6, "or": 639, "or ": 234, "ora": 29, "orc": 16,
+			"ord": 33, "ore": 38, "org": 6, "ori": 90, "orm": 71, "orr": 36, "ort": 55, "orz": 11,
+			"os": 744, "os ": 625, "osi": 47, "ost": 59, "ot": 82, "ot ": 8, "ota": 21, "ote": 17,
+			"oth": 6, "oto": 12, "otr": 15, "ou": 9, "ov": 11, "ove": 5, "ow": 7, "ox": 7,
+			"oxy": 7, "p": 1848, "p ": 65, "pa": 411, "pa ": 9, "pac": 40, "pag": 5, "pal": 11,
+			"pan": 5, "paq": 59, "par": 244, "pat": 21, "pc": 58, "pc ": 14, "pci": 43, "pe": 280,
+			"pe ": 5, "pec": 51, "pen": 19, "per": 174, "pet": 15, "pg": 9, "pg ": 6, "ph": 9,
+			"ph ": 9, "pi": 49, "pia": 10, "pid": 9, "pil": 7, "pl": 109, "pla": 32, "ple": 41,
+			"pli": 26, "po": 300, "po ": 52, "pon": 32, "por": 131, "pos": 67, "pp": 10, "pr": 252,
+			"pre": 99, "pri": 43, "pro": 102, "pru": 7, "ps": 25, "ps ": 6, "pst": 15, "pt": 24,
+			"pt ": 9, "pta": 6, "pu": 210, "pud": 35, "pue": 108, "pul": 15, "pun": 10, "pur": 11,
+			"put": 7, "pué": 11, "pú": 11, "púb": 11, "q": 279, "q ": 13, "qu": 265, "que": 192,
+			"qui": 65, "quí": 7, "r": 4963, "r ": 1032, "ra": 890, "ra ": 289, "rab": 49, "rac": 79,
+			"rad": 109, "rae": 13, "rag": 5, "rak": 5, "ral": 20, "ram": 49, "ran": 82, "rap": 9,
+			"rar": 105, "ras": 56, "rat": 8, "rb": 22, "rbo": 19, "rc": 178, "rca": 21, "rce": 5,
+			"rch": 125, "rci": 17, "rco": 6, "rd": 72, "rd ": 10, "rda": 22, "rde": 28, "rdo": 6,
+			"re": 985, "re ": 135, "rea": 87, "rec": 141, "red": 39, "ree": 14, "ref": 38, "reg": 111,
+			"rei": 15, "rel": 27, "rem": 19, "ren": 41, "reo": 8, "rep": 34, "req": 23, "res": 173,
+			"ret": 21, "reu": 21, "rev": 28, "rf": 15, "rfa": 8, "rfi": 5, "rg": 55, "rga": 19,
+			"rge": 8, "rgo": 6, "rgu": 18, "ri": 350, "ria": 36, "rib": 50, "ric": 6, "rid": 22,
+			"rif": 10, "rig": 16, "rim": 30, "rin": 8, "rio": 104, "rip": 15, "rir": 13, "rit": 23,
+			"riv": 7, "riz": 5, "rj": 6, "rje": 6, "rl": 26, "rl ": 15, "rlo": 8, "rm": 144,
+			"rma": 91, "rmi": 45, "rn": 31, "rna": 13, "rno": 14, "ro": 517, "ro ": 235, "rob": 18,
+			"roc": 23, "rod": 18, "rog": 14, "rol": 18, "rom": 12, "ron": 20, "rop": 21, "ror": 49,
+			"ros": 54, "rot": 12, "rox": 7, "rp": 21, "rpe": 7, "rpo": 5, "rpr": 6, "rq": 16,
+			"rqu": 16, "rr": 120, "rra": 18, "rre": 23, "rri": 9, "rro": 54, "rru": 6, "rró": 7,
+			"rs": 100, "rse": 41, "rsi": 30, "rso": 23, "rt": 140, "rt ": 9, "rta": 61, "rte": 28,
+			"rti": 30, "rto": 7, "ru": 90, "ruc": 38, "rue": 9, "run": 5, "rup": 16, "rut": 15,
+			"rv": 29, "rvi": 23, "rz": 12, "rza": 12, "rá": 43, "rá ": 7, "rác": 13, "ráf": 6,
+			"rám": 6, "rí": 25, "ría": 20, "ró": 33, "ró ": 15, "rón": 18, "s": 4288, "s ": 1505,
+			"sa": 191, "sa ": 26, "sac": 9, "sad": 16, "saj": 21, "sal": 45, "sam": 13, "san": 10,
+			"sar": 46, "sb": 12, "sbo": 9, "sc": 110, "sca": 30, "sco": 13, "scr": 60, "sd": 15,
+			"sde": 13, "se": 691, "se ": 397, "sea": 9, "sec": 66, "seg": 37, "sel": 17, "sen": 36,
+			"sep": 13, "ser": 88, "señ": 14, "sh": 31, "sh ": 14, "sha": 8, "si": 374, "si ": 19,
+			"sia": 25, "sib": 19, "sic": 8, "sid": 5, "sig": 17, "sim": 18, "sin": 53, "sio": 22,
+			"sis": 32, "sit": 59, "sió": 84, "sl": 7, "sm": 20, "sma": 8, "smo": 9, "sn": 5,
+			"so": 201, "so ": 55, "sob": 22, "soc": 10, "sof": 12, "sol": 49, "son": 28, "sop": 10,
+			"sor": 5, "sos": 8, "sp": 213, "sp ": 10, "spa": 29, "spe": 101, "spl": 23, "spo": 26,
+			"spu": 20, "sq": 15, "squ": 15, "ss": 11, "st": 697, "st ": 13, "sta": 213, "ste": 84,
+			"sti": 31, "sto": 26, "str": 243, "stá": 79, "su": 114, "su ": 15, "sua": 23, "sub": 17,
+			"sue": 5, "suf": 6, "sul": 10, "sum": 14, "sup": 9, "sus": 7, "sv": 5, "sy": 5,
+			"sí": 29, "sím": 29, "só": 22, "sól": 22, "t": 3360, "t ": 168, "ta": 688, "ta ": 201,
+			"tab": 51, "tac": 19, "tad": 81, "tag": 17, "tal": 47, "tam": 53, "tan": 53, "tar": 114,
+			"tas": 28, "tat": 10, "tau": 6, "tc": 13, "tch": 9, "te": 739, "te ": 279, "tec": 51,
+			"teg": 6, "tel": 5, "tem": 41, "ten": 131, "ter": 113, "tes": 89, "tex": 16, "th": 17,
+			"th ": 5, "thu": 6, "ti": 397, "ti ": 5, "tib": 7, "tic": 68, "tid": 32, "tie": 44,
+			"tif": 25, "tig": 7, "til": 38, "tim": 10, "tin": 27, "tip": 45, "tiq": 5, "tir": 19,
+			"tit": 6, "tiv": 52, "tl": 8, "tls": 5, "tm": 9, "to": 489, "to ": 234, "toc": 5,
+			"tod": 36, "tom": 13, "tor": 77, "tos": 118, "tp": 8, "tp ": 5, "tr": 536, "tra": 233,
+			"tre": 46, "tri": 26, "trl": 6, "tro": 153, "tru": 45, "tró": 21, "ts": 27, "ts ": 25,
+			"tt": 7, "tu": 116, "tu ": 7, "tua": 43, "tub": 6, "tud": 8, "tur": 37, "tw": 13,
+			"twa": 12, "tá": 89, "tá ": 59, "tán": 19, "té": 12, "tén": 5, "tér": 5, "tó": 6,
+			"u": 2046, "u ": 40, "ua": 110, "ual": 48, "uan": 17, "uar": 35, "ub": 55, "ube": 10,
+			"ubi": 27, "ubm": 6, "uc": 91, "ucc": 33, "uci": 36, "uct": 11, "ud": 49, "ud ": 6,
+			"udo": 33, "ue": 418, "ue ": 103, "ueb": 7, "ued": 104, "ueg": 5, "uel": 11, "uen": 25,
+			"uer": 37, "ues": 29, "uet": 64, "uev": 22, "uf": 8, "ufi": 6, "ug": 14, "uga": 11,
+			"ui": 100, "uid": 5, "uie": 35, "uin": 7, "uir": 15, "uit": 14, "uiv": 10, "uj": 18,
+			"uje": 5, "ujo": 12, "ul": 79, "ul ": 6, "ula": 28, "ulo": 12, "ult": 28, "um": 78,
+			"um ": 8, "umb": 7, "ume": 41, "umi": 8, "un": 443, "un ": 209, "una": 128, "unc": 24,
+			"und": 18, "uni": 22, "uno": 9, "unt": 31, "uo": 7, "up": 51, "upe": 8, "upl": 7,
+			"upo": 5, "ups": 11, "upt": 5, "ur": 134, "ura": 94, "uri": 6, "url": 6, "uro": 5,
+			"urr": 5, "urs": 13, "us": 165, "us ": 6, "usa": 55, "usc": 6, "use": 14, "ush": 5,
+			"usi": 26, "uso": 15, "ust": 11, "usu": 23, "ut": 139, "ut ": 8, "uta": 40, "ute": 29,
+			"uti": 27, "uto": 29, "uy": 10, "uye": 6, "uz": 5, "ué": 12, "ués": 11, "uí": 9,
+			"v": 667, "v ": 9, "va": 131, "va ": 26, "vac": 20, "vad": 8, "val": 50, "var": 20,
+			"ve": 166, "ve ": 45, "vec": 9, "vel": 16, "ver": 64, "ves": 19, "vez": 8, "vi": 81,
+			"vic": 5, "vid": 23, "vio": 10, "vir": 6, "vis": 23, "vo": 191, "vo ": 109, "voc": 20,
+			"vol": 6, "vor": 10, "vos": 46, "vu": 5, "vue": 5, "vá": 67, "vál": 67, "ví": 10,
+			"vía": 8, "w": 53, "w ": 6, "wa": 20, "war": 18, "wo": 7, "wor": 6, "x": 207,
+			"x ": 35, "xa": 5, "xc": 13, "xce": 7, "xcl": 5, "xi": 39, "xim": 10, "xis": 16,
+			"xió": 8, "xp": 43, "xpo": 10, "xpr": 24, "xt": 52, "xte": 14, "xto": 13, "xtr": 19,
+			"xy": 7, "xy ": 6, "y": 197, "y ": 128, "ya": 19, "ya ": 12, "ye": 12, "ye ": 6,
+			"yt": 8, "yte": 8, "yú": 14, "yús": 14, "z": 225, "z ": 28, "za": 154, "za ": 24,
+			"zac": 13, "zad": 34, "zam": 25, "zan": 7, "zar": 47, "zc": 6, "zca": 5, "ze": 8,
+			"zer": 6, "zi": 5, "zip": 5, "zo": 6, "zq": 6, "zqu": 6, "á": 317, "á ": 72,
+			"ác": 18, "áct": 15, "áf": 6, "áfi": 6, "ál": 80, "áli": 70, "ám": 18, "áme": 6,
+			"ámi": 7, "án": 28, "án ": 14, "ánd": 11, "áq": 6, "áqu": 6, "ár": 22, "árb": 19,
+			"ás": 28, "ás ": 24, "át": 19, "áti": 19, "áx": 10, "áxi": 10, "é": 61, "é ": 5,
+			"én": 18, "én ": 7, "ént": 8, "ér": 8, "és": 16, "és ": 16, "ét": 8, "í": 195,
+			"ía": 41, "ía ": 30, "ías": 10, "íf": 6, "ífi": 6, "ím": 46, "ímb": 29, "ími": 17,
+			"ín": 67, "índ": 40, "íne": 25, "ío": 14, "ío ": 9, "ñ": 53, "ña": 23, "ña ": 9,
+			"ñad": 8, "ño": 30, "ño ": 26, "ó": 620, "ó ": 82, "ód": 28, "ódi": 20, "ódu": 8,
+			"ól": 34, "óli": 12, "ólo": 22, "ón": 458, "ón ": 442, "óne": 5, "óni": 11, "ór": 10,
+			"órd": 10, "ú": 120, "úb": 11, "úbl": 11, "úl": 13, "últ": 13, "úm": 36, "úme": 36,
+			"ún": 24, "ún ": 19, "úni": 5, "ús": 26, "ús ": 13, "úsq": 10, "út": 5, "úti": 5,
+		}},
+		"fi": {Total: 235063, Ngrams: map[string]int{
+			" a": 549, " a ": 15, " ab": 15, " ai": 47, " aj": 11, " al": 95, " an": 47, " ap": 14,
+			" ar": 122, " as": 90, " au": 7, " av": 67, " b": 91, " b ": 7, " bi": 61, " c": 60,
+			" co": 21, " ct": 18, " d": 136, " d ": 7, " da": 29, " de": 18, " di": 30, " ds": 6,
+			" dy": 22, " e": 920, " ed": 14, " eh": 28, " ei": 567, " el": 26, " en": 86, " ep": 37,
+			" er": 35, " es": 51, " et": 47, " ex": 13, " f": 49, " fi": 9, " fo": 9, " fu": 14,
+			" g": 48, " g ": 8, " gn": 6, " gr": 6, " gs": 9, " h": 173, " ha": 84, " he": 17,
+			" hi": 9, " ho": 7, " hu": 28, " hy": 19, " i": 119, " id": 6, " il": 21, " in": 45,
+			" ir": 6, " is": 6, " j": 415, " ja": 154, " jo": 140, " ju": 14, " jä": 102, " k": 1048,
+			" k ": 8, " ka": 118, " ke": 61, " ki": 80, " ko": 257, " ku": 141, " ky": 17, " kä": 361,
+			" l": 617, " l ": 9, " la": 108, " le": 29, " li": 158, " ll": 7, " lo": 104, " lu": 140,
+			" lä": 24, " lö": 26, " m": 478, " m ": 9, " ma": 41, " me": 86, " mi": 48, " mo": 35,
+			" mu": 172, " my": 10, " mä": 64, " n": 336, " n ": 40, " na": 11, " ne": 24, " ni": 68,
+			" no": 43, " nu": 26, " ny": 10, " nä": 111, " o": 1013, " ob": 13, " od": 22, " oh": 72,
+			" oi": 22, " ol": 355, " om": 24, " on": 285, " op": 61, " os": 60, " ot": 65, " ou": 6,
+			" ov": 19, " p": 552, " pa": 143, " pe": 33, " pi": 81, " pl": 6, " po": 99, " pr": 35,
+			" pu": 66, " py": 21, " pä": 45, " q": 17, " qw": 15, " r": 244, " r ": 17, " ra": 42,
+			" re": 93, " ri": 55, " ry": 15, " s": 870, " s ": 9, " sa": 168, " se": 113, " si": 237,
+			" sk": 9, " so": 50, " st": 16, " su": 120, " sy": 108, " sä": 16, " t": 1182, " t ": 12,
+			" ta": 316, " te": 25, " th": 12, " ti": 223, " tl": 11, " to": 132, " tu": 193, " ty": 82,
+			" tä": 163, " u": 152, " ul": 26, " un": 27, " us": 22, " uu": 49, " v": 929, " va": 463,
+			" ve": 40, " vi": 177, " vo": 174, " vä": 57, " w": 48, " w ": 8, " wi": 14, " wo": 8,
+			" wr": 11, " x": 21, " x ": 17, " y": 184, " yh": 87, " yk": 33, " yl": 32, " yr": 24,
+			" ä": 39, " äl": 35, "a": 7267, "a ": 2187, "aa": 707, "aa ": 231, "aad": 63, "aaj": 15,
+			"aak": 10, "aal": 31, "aam": 44, "aan": 193, "aar": 24, "aat": 67, "aav": 19, "ab": 28,
+			"abi": 8, "abs": 11, "ac": 22, "ack": 9, "ad": 83, "ada": 14, "adi": 61, "ae": 42,
+			"aes": 27, "aet": 14, "af": 6, "afi": 6, "ag": 12, "ah": 54, "ahd": 21, "ahm": 8,
+			"aht": 14, "ai": 700, "ai ": 128, "aie": 6, "aih": 67, "aik": 98, "ail": 6, "aim": 35,
+			"ain": 234, "ais": 99, "ait": 25, "aj": 85, "aja": 26, "aje": 9, "aji": 12, "ajo": 31,
+			"ak": 234, "ak ": 8, "aka": 25, "ake": 73, "aki": 27, "akk": 19, "ako": 15, "aks": 49,
+			"aku": 10, "al": 574, "al ": 8, "ala": 95, "ale": 17, "ali": 117, "alk": 15, "all": 170,
+			"alm": 6, "alt": 37, "alu": 72, "alv": 20, "am": 196, "ama": 56, "ame": 19, "ami": 99,
+			"amm": 12, "an": 634, "an ": 336, "ana": 45, "and": 53, "anh": 16, "ani": 7, "anj": 6,
+			"ank": 7, "ann": 51, "ano": 9, "ans": 63, "ant": 29, "ao": 7, "ap": 71, "apa": 31,
+			"app": 6, "aps": 7, "apt": 7, "apä": 8, "ar": 408, "ara": 42, "arg": 16, "ari": 35,
+			"arj": 7, "ark": 91, "arm": 31, "aro": 43, "art": 18, "arv": 103, "as": 340, "as ": 7,
+			"asa": 33, "ase": 79, "asi": 10, "ask": 33, "aso": 35, "ass": 55, "ast": 76, "asv": 6,
+			"at": 406, "at ": 85, "ata": 92, "ate": 8, "ati": 41, "atk": 10, "ato": 43, "att": 91,
+			"atu": 27, "au": 188, "auh": 10, "auk": 31, "aul": 14, "aus": 67, "aut": 58, "av": 275,
+			"ava": 196, "avi": 34, "avu": 26, "avä": 10, "b": 258, "b ": 34, "ba": 11, "bac": 9,
+			"be": 9, "bi": 74, "bit": 55, "bj": 13, "bje": 12, "bl": 12, "ble": 7, "bo": 67,
+			"bol": 65, "bs": 14, "bso": 10, "bu": 8, "by": 6, "c": 218, "c ": 74, "ca": 11,
+			"cd": 6, "ce": 7, "ch": 6, "ck": 19, "ck ": 9, "cks": 8, "co": 30, "cop": 6,
+			"cor": 7, "cr": 13, "ct": 31, "cti": 9, "ctr": 17, "d": 1151, "d ": 53, "da": 138,
+			"da ": 49, "daa": 12, "das": 10, "dat": 56, "de": 328, "de ": 16, "def": 6, "dek": 39,
+			"del": 63, "dem": 8, "den": 117, "des": 16, "det": 40, "di": 230, "di ": 26, "dia": 29,
+			"die": 6, "dif": 6, "dik": 6, "din": 23, "dir": 27, "dis": 37, "dit": 63, "do": 279,
+			"doi": 6, "dol": 21, "don": 7, "dos": 194, "dot": 44, "dr": 10, "ds": 8, "du": 17,
+			"dx": 7, "dx ": 7, "dy": 32, "dyn": 21, "dä": 11, "dä ": 10, "e": 6861, "e ": 652,
+			"ea": 79, "ea ": 44, "eam": 11, "eb": 11, "eba": 7, "ec": 16, "ect": 10, "ed": 246,
+			"ed ": 8, "ede": 13, "edi": 23, "edo": 189, "edu": 7, "ee": 442, "ee ": 26, "eel": 93,
+			"een": 243, "ees": 37, "eet": 32, "ef": 15, "eg": 22, "ega": 8, "egm": 12, "eh": 64,
+			"ehd": 13, "ehe": 6, "ehk": 6, "eht": 32, "ei": 727, "ei ": 535, "eid": 14, "eih": 8,
+			"eik": 10, "eil": 9, "eim": 10, "ein": 27, "eis": 39, "eit": 52, "eiv": 23, "ej": 37,
+			"eja": 18, "ejä": 19, "ek": 317, "eke": 19, "eki": 118, "ekk": 14, "eko": 12, "eks": 96,
+			"ekt": 37, "ekä": 13, "el": 651, "el ": 8, "ele": 42, "elf": 13, "eli": 24, "elk": 7,
+			"ell": 283, "elm": 115, "elo": 30, "elp": 13, "elt": 35, "elu": 22, "elv": 19, "ely": 23,
+			"elä": 10, "em": 141, "ema": 35, "emb": 8, "eme": 7, "emi": 30, "emm": 20, "emo": 7,
+			"emp": 21, "emä": 6, "en": 1443, "en ": 992, "ena": 20, "end": 9, "ene": 21, "eng": 11,
+			"eni": 13, "enk": 14, "enm": 14, "enn": 160, "eno": 6, "ens": 51, "ent": 110, "enä": 15,
+			"eo": 13, "ep": 44, "epä": 36, "er": 472, "er ": 28, "era": 61, "ere": 7, "eri": 134,
+			"erk": 82, "ero": 42, "erp": 7, "err": 9, "ers": 27, "ert": 41, "eru": 14, "erä": 12,
+			"es": 361, "es ": 8, "ese": 11, "esi": 31, "esk": 17, "ess": 116, "est": 158, "esu": 9,
+			"et": 925, "et ": 109, "eta": 79, "ete": 21, "eti": 30, "etk": 7, "eto": 27, "etr": 12,
+			"ett": 479, "etu": 101, "ety": 12, "etä": 25, "etö": 11, "eu": 46, "eur": 20, "eut": 13,
+			"ev": 70, "eva": 19, "eve": 12, "evi": 14, "evy": 21, "ex": 14, "exi": 6, "ey": 41,
+			"eyd": 14, "eys": 13, "eyt": 11, "eä": 9, "eä ": 8, "f": 143, "f ": 42, "ff": 9,
+			"ff ": 9, "fi": 25, "fil": 8, "fis": 6, "fo": 17, "fo ": 7, "for": 7, "fu": 17,
+			"fun": 17, "g": 172, "g ": 22, "ga": 14, "gat": 8, "ge": 12, "gi": 8, "gl": 12,
+			"gla": 11, "gm": 13, "gme": 12, "gn": 18, "gnu": 11, "go": 12, "gp": 8, "gp ": 7,
+			"gr": 13, "gra": 6, "gs": 14, "gst": 8, "gu": 14, "gum": 13, "h": 1013, "h ": 20,
+			"ha": 112, "ha ": 8, "haa": 20, "hah": 8, "hak": 32, "hal": 28, "han": 9, "hd": 136,
+			"hda": 11, "hde": 63, "hdi": 29, "hdo": 25, "he": 149, "he ": 34, "hee": 59, "hei": 10,
+			"hel": 9, "hen": 9, "het": 12, "hey": 6, "hi": 62, "hih": 7, "hin": 24, "hit": 16,
+			"hj": 81, "hje": 57, "hjä": 13, "hk": 72, "hko": 64, "hkä": 6, "hm": 29, "hmi": 8,
+			"hmo": 8, "hmä": 12, "hn": 7, "hna": 6, "ho": 31, "hoi": 9, "hon": 10, "hor": 9,
+			"ht": 224, "hta": 19, "hte": 87, "hto": 60, "htu": 25, "htä": 21, "hu": 44, "hum": 10,
+			"huo": 28, "hy": 29, "hyp": 8, "hä": 8, "hän": 7, "i": 8181, "i ": 1426, "ia": 259,
+			"ia ": 160, "iaa": 10, "ial": 15, "ian": 48, "ias": 9, "ib": 10, "ic": 15, "ic ": 8,
+			"id": 106, "id ": 9, "ida": 51, "ide": 32, "ie": 362, "ied": 198, "iel": 17, "ien": 82,
+			"ier": 7, "ies": 8, "iet": 41, "if": 12, "ig": 12, "ign": 10, "ih": 101, "ihd": 14,
+			"ihi": 18, "ihn": 6, "iht": 50, "ii": 339, "ii ": 22, "iia": 35, "iid": 11, "iim": 10,
+			"iin": 77, "iip": 10, "iir": 57, "iit": 49, "iiv": 61, "ij": 82, "ija": 22, "ijo": 52,
+			"ijä": 8, "ik": 230, "ika": 79, "ike": 36, "iki": 8, "ikk": 56, "iko": 21, "iks": 7,
+			"iku": 6, "ikä": 10, "il": 332, "ila": 101, "ile": 14, "ili": 11, "ilk": 9, "ill": 112,
+			"ilm": 34, "ilo": 7, "ilu": 17, "ilö": 6, "im": 363, "ima": 28, "ime": 132, "imi": 155,
+			"imm": 28, "imo": 6, "imä": 10, "in": 1093, "in ": 410, "ina": 45, "ind": 40, "ine": 325,
+			"ing": 8, "ini": 16, "ink": 46, "inn": 28, "ino": 10, "ins": 9, "int": 102, "inu": 13,
+			"inv": 11, "inä": 15, "io": 167, "io ": 20, "ioi": 12, "ion": 29, "ioo": 24, "ios": 57,
+			"iot": 11, "ip": 44, "ipa": 7, "ipp": 13, "ipu": 7, "ir": 341, "ire": 29, "irh": 103,
+			"iri": 11, "irj": 104, "irr": 48, "irt": 34, "is": 1372, "is ": 19, "ise": 273, "isi": 101,
+			"isk": 29, "isl": 12, "iso": 8, "iss": 82, "ist": 660, "isu": 37, "isy": 11, "isä": 124,
+			"it": 1163, "it ": 54, "ita": 160, "ite": 187, "iti": 32, "itk": 11, "ito": 21, "its": 69,
+			"itt": 294, "itu": 196, "ity": 48, "itä": 83, "iu": 27, "iuk": 26, "iv": 190, "iva": 18,
+			"ive": 7, "ivi": 102, "ivu": 21, "ivä": 33, "iä": 112, "iä ": 112, "iö": 11, "j": 1190,
+			"ja": 389, "ja ": 238, "jaa": 12, "jae": 13, "jai": 17, "jak": 9, "jal": 11, "jan": 9,
+			"jas": 21, "jat": 26, "jau": 26, "je": 228, "jek": 12, "jel": 56, "jen": 82, "jes": 71,
+			"ji": 40, "jie": 8, "jit": 13, "jiä": 10, "jo": 294, "jo ": 8, "joh": 11, "joi": 109,
+			"joj": 17, "jok": 36, "jol": 9, "jon": 25, "jos": 36, "jot": 30, "ju": 21, "jul": 13,
+			"jä": 212, "jä ": 49, "jäl": 41, "jän": 11, "jär": 70, "jäs": 7, "jät": 23, "jää": 8,
+			"k": 3440, "k ": 36, "ka": 384, "ka ": 54, "kaa": 44, "kah": 13, "kai": 54, "kak": 17,
+			"kal": 39, "kan": 83, "kar": 16, "kas": 17, "kat": 21, "kau": 11, "ke": 356, "ke ": 24,
+			"kea": 52, "kee": 43, "kei": 18, "kej": 12, "kel": 33, "kem": 31, "ken": 26, "ker": 26,
+			"kes": 18, "ket": 47, "keu": 8, "ki": 494, "ki ": 40, "kia": 8, "kie": 14, "kij": 19,
+			"kil": 18, "kin": 39, "kio": 17, "kir": 104, "kis": 156, "kit": 56, "kk": 220, "kka": 27,
+			"kke": 34, "kki": 82, "kko": 43, "kku": 15, "kkä": 15, "ko": 550, "ko ": 54, "koa": 15,
+			"koh": 88, "koi": 33, "koj": 19, "kok": 51, "kol": 14, "kom": 34, "kon": 74, "koo": 66,
+			"kop": 30, "kor": 27, "kos": 23, "kot": 14, "kr": 7, "ks": 364, "ksa": 7, "kse": 108,
+			"ksi": 198, "kso": 16, "kst": 10, "ksu": 15, "ksv": 8, "kt": 57, "kti": 54, "ku": 339,
+			"ku ": 30, "kua": 27, "kuh": 8, "kui": 30, "kul": 20, "kum": 18, "kun": 39, "kuo": 44,
+			"kup": 10, "kur": 23, "kut": 21, "kuu": 15, "kuv": 37, "ky": 154, "ky ": 28, "kyi": 14,
+			"kyj": 10, "kyk": 11, "kyl": 9, "kyn": 15, "kys": 15, "kyt": 12, "kyä": 21, "kä": 460,
+			"kä ": 30, "käi": 14, "käs": 139, "käy": 259, "kää": 15, "kö": 11, "l": 4319, "l ": 73,
+			"la": 533, "la ": 201, "laa": 25, "lad": 9, "lai": 102, "laj": 11, "lal": 8, "lan": 25,
+			"lap": 17, "las": 40, "lat": 36, "lau": 42, "le": 693, "le ": 364, "lee": 55, "lei": 53,
+			"lek": 25, "lel": 23, "lem": 37, "len": 18, "let": 46, "lev": 57, "lf": 14, "lf ": 14,
+			"li": 727, "li ": 50, "lia": 21, "lie": 7, "lii": 72, "lik": 17, "lil": 18, "lim": 18,
+			"lin": 168, "lip": 7, "lis": 167, "lit": 121, "liu": 26, "liv": 11, "lj": 17, "lje": 8,
+			"lk": 120, "lka": 9, "lke": 26, "lki": 26, "lkk": 11, "lko": 25, "lku": 18, "ll": 873,
+			"ll ": 9, "lla": 195, "lle": 277, "lli": 233, "llo": 11, "llu": 36, "lly": 11, "llä": 96,
+			"lm": 163, "lma": 54, "lmi": 27, "lmo": 10, "lmu": 10, "lmä": 56, "lo": 216, "lo ": 10,
+			"loc": 11, "loh": 64, "loi": 12, "lon": 7, "lop": 37, "los": 49, "lp": 14, "ls": 24,
+			"ls ": 11, "lt": 163, "lt ": 21, "lta": 61, "lti": 7, "ltu": 12, "lty": 20, "ltä": 31,
+			"lu": 375, "lu ": 8, "lua": 17, "lue": 74, "luk": 117, "luo": 44, "lus": 38, "lut": 9,
+			"luu": 37, "luv": 13, "lv": 40, "lve": 20, "lvo": 13, "ly": 46, "ly ": 7, "lyt": 12,
+			"lä": 175, "lä ": 135, "läh": 21, "lö": 34, "löy": 27, "m": 2082, "m ": 60, "ma": 279,
+			"ma ": 22, "maa": 48, "mah": 14, "mal": 30, "man": 32, "mas": 31, "mat": 73, "mb": 82,
+			"mb ": 10, "mbl": 7, "mbo": 64, "me": 370, "me ": 10, "med": 17, "mee": 7, "mei": 11,
+			"mel": 10, "men": 132, "mer": 113, "mes": 9, "met": 52, "mi": 502, "mi ": 44, "mia": 12,
+			"mie": 11, "mii": 13, "mil": 11, "min": 101, "mio": 25, "mis": 195, "mit": 41, "miä": 29,
+			"miö": 7, "mm": 75, "mm ": 7, "mma": 15, "mmu": 10, "mmä": 32, "mo": 73, "mod": 9,
+			"moi": 16, "moj": 7, "mon": 23, "mp": 44, "mpi": 26, "mu": 240, "mui": 75, "muk": 17,
+			"muo": 74, "mut": 25, "muu": 38, "my": 13, "myö": 10, "mä": 314, "mä ": 47, "mäi": 22,
+			"mäl": 7, "män": 81, "mäs": 9, "mät": 24, "mää": 113, "n": 5224, "n ": 2609, "na": 207,
+			"na ": 81, "naa": 33, "nai": 33, "nak": 7, "nal": 7, "nan": 10, "nau": 14, "nav": 11,
+			"nc": 10, "nc ": 7, "nd": 105, "nd ": 13, "nde": 32, "ndi": 53, "ne": 516, "ne ": 15,
+			"nee": 31, "neg": 9, "nel": 7, "nem": 12, "nen": 336, "net": 91, "ng": 29, "ng ": 9,
+			"ngl": 11, "nh": 21, "nha": 10, "nhe": 9, "ni": 241, "nia": 7, "nik": 11, "nim": 91,
+			"nin": 13, "nis": 85, "nit": 9, "nj": 11, "nk": 106, "nka": 28, "nki": 32, "nkk": 13,
+			"nko": 7, "nkt": 14, "nm": 16, "nmu": 14, "nn": 366, "nna": 31, "nne": 97, "nni": 94,
+			"nno": 24, "nnu": 83, "nnä": 21, "nnö": 10, "no": 116, "no ": 10, "noa": 8, "noi": 10,
+			"nol": 13, "non": 12, "nor": 8, "nos": 12, "not": 19, "nou": 10, "ns": 133, "nsa": 18,
+			"nsi": 30, "nso": 9, "nss": 54, "nt": 324, "nt ": 11, "nta": 86, "nte": 43, "nti": 67,
+			"nto": 40, "ntt": 30, "ntu": 7, "ntä": 24, "ntö": 8, "nu": 151, "nul": 7, "num": 36,
+			"nus": 74, "nut": 14, "nv": 13, "nvä": 7, "ny": 33, "nyk": 9, "nyt": 22, "nä": 184,
+			"nä ": 19, "näe": 18, "näi": 28, "näp": 88, "näy": 14, "nää": 10, "nö": 10, "o": 4944,
+			"o ": 316, "oa": 97, "oa ": 82, "ob": 16, "obj": 13, "oc": 30, "oc ": 13, "ock": 10,
+			"od": 191, "oda": 24, "ode": 77, "odi": 28, "odo": 54, "oe": 14, "oeh": 9, "of": 10,
+			"oh": 247, "ohd": 38, "ohi": 15, "ohj": 61, "ohk": 64, "oho": 9, "oht": 52, "oi": 759,
+			"oi ": 112, "oid": 49, "oih": 9, "oik": 26, "oil": 11, "oim": 36, "oin": 51, "ois": 139,
+			"oit": 325, "oj": 132, "oja": 72, "oje": 53, "ok": 140, "oka": 34, "okk": 7, "oko": 55,
+			"oks": 13, "oku": 14, "ol": 654, "ola": 13, "ole": 289, "oli": 70, "oll": 188, "olt": 46,
+			"olu": 31, "om": 142, "oma": 28, "ome": 34, "omi": 60, "on": 687, "on ": 484, "ona": 18,
+			"one": 27, "oni": 32, "onn": 40, "ono": 21, "ont": 28, "onä": 14, "oo": 101, "ood": 40,
+			"oon": 48, "op": 166, "op ": 7, "ope": 60, "opi": 25, "opp": 26, "opu": 29, "or": 208,
+			"or ": 12, "ora": 52, "ord": 9, "ore": 18, "ori": 63, "orj": 14, "ork": 7, "ort": 8,
+			"orv": 9, "os": 568, "os ": 42, "osa": 15, "ose": 22, "osi": 24, "osk": 9, "oso": 124,
+			"oss": 66, "ost": 252, "ot": 338, "ot ": 68, "ota": 43, "ote": 87, "oti": 13, "otk": 11,
+			"oto": 47, "ots": 18, "ott": 42, "otu": 8, "ou": 24, "out": 10, "ov": 87, "ova": 36,
+			"ove": 33, "ovi": 17, "ow": 13, "owe": 9, "p": 1377, "p ": 51, "pa": 214, "paa": 13,
+			"pah": 10, "pai": 44, "pak": 48, "pal": 58, "pan": 8, "par": 19, "pau": 8, "pc": 16,
+			"pc ": 11, "pe": 118, "per": 89, "pi": 202, "pi ": 37, "pie": 12, "pil": 12, "pim": 8,
+			"pin": 7, "pio": 10, "pis": 31, "pit": 44, "piv": 7, "piä": 12, "pl": 8, "po": 114,
+			"poi": 73, "pos": 11, "pow": 8, "pp": 177, "ppi": 37, "ppu": 39, "ppä": 89, "pr": 46,
+			"pre": 8, "pro": 30, "ps": 20, "pt": 18, "pt ": 9, "pu": 154, "pui": 10, "pul": 9,
+			"puo": 27, "pur": 23, "pus": 13, "put": 10, "puu": 30, "py": 32, "pys": 15, "pä": 191,
+			"päi": 117, "päo": 22, "päs": 9, "pää": 33, "q": 17, "qw": 15, "qwe": 15, "r": 2277,
+			"r ": 85, "ra": 255, "ra ": 28, "raa": 48, "raj": 26, "rak": 13, "ral": 7, "ram": 8,
+			"ran": 81, "rat": 15, "rau": 13, "rc": 8, "rd": 15, "rd ": 10, "re": 225, "re ": 10,
+			"rea": 14, "rek": 122, "rel": 7, "rem": 12, "res": 14, "ret": 16, "rg": 17, "rgu": 13,
+			"rh": 103, "rhe": 103, "ri": 483, "ri ": 82, "ria": 12, "rii": 23, "rik": 15, "ril": 40,
+			"rin": 46, "rio": 7, "ris": 42, "rit": 150, "riv": 36, "riä": 12, "rj": 196, "rja": 62,
+			"rje": 70, "rjo": 62, "rk": 192, "rka": 12, "rke": 9, "rki": 85, "rkk": 68, "rko": 12,
+			"rl": 21, "rl ": 20, "rm": 39, "rm ": 11, "rma": 7, "rme": 12, "ro": 176, "ro ": 18,
+			"roi": 48, "rol": 8, "rom": 7, "ros": 60, "rot": 12, "rp": 14, "rpc": 8, "rr": 64,
+			"rra": 16, "rro": 41, "rs": 38, "rsi": 29, "rss": 8, "rt": 106, "rta": 29, "rte": 15,
+			"rto": 16, "rtu": 11, "rty": 15, "rtz": 7, "ru": 27, "rus": 14, "rv": 121, "rva": 18,
+			"rvi": 12, "rvo": 91, "ry": 20, "ryh": 17, "rä": 57, "rä ": 18, "räi": 10, "rän": 13,
+			"s": 5611, "s ": 355, "sa": 576, "sa ": 293, "saa": 55, "sai": 12, "sak": 20, "sal": 76,
+			"sam": 26, "san": 28, "sar": 35, "sat": 12, "sau": 12, "sc": 9, "se": 703, "se ": 42,
+			"sec": 10, "sed": 7, "see": 61, "seg": 12, "sei": 8, "sek": 92, "sel": 52, "sem": 11,
+			"sen": 197, "ses": 93, "set": 84, "seu": 22, "sh": 16, "sh ": 8, "si": 786, "si ": 182,
+			"sia": 79, "sie": 10, "sii": 72, "sij": 51, "sik": 16, "sil": 22, "sim": 51, "sin": 34,
+			"sio": 30, "sis": 111, "sit": 67, "siv": 16, "siä": 23, "sk": 247, "ska": 19, "ske": 30,
+			"sko": 15, "sku": 25, "sky": 121, "skä": 29, "sl": 39, "sli": 8, "slo": 7, "slu": 14,
+			"sm": 36, "sme": 7, "smä": 20, "so": 270, "soc": 9, "soi": 126, "sol": 35, "son": 9,
+			"sop": 14, "sor": 12, "sos": 24, "sov": 31, "sp": 31, "spa": 14, "spi": 7, "sr": 21,
+			"sre": 11, "ss": 490, "ssa": 285, "sse": 11, "ssi": 30, "sso": 10, "ssu": 12, "ssä": 135,
+			"st": 1346, "st ": 11, "sta": 304, "ste": 285, "sti": 158, "sto": 283, "str": 10, "stu": 92,
+			"sty": 37, "stä": 154, "stö": 8, "su": 214, "suh": 12, "sul": 8, "suo": 100, "sur": 8,
+			"sut": 13, "suu": 53, "sv": 27, "sv ": 11, "sva": 10, "sy": 138, "sym": 83, "syn": 13,
+			"syy": 10, "syö": 25, "sä": 289, "sä ": 139, "säi": 24, "säk": 7, "säl": 62, "sät": 13,
+			"säy": 11, "sää": 25, "t": 8385, "t ": 498, "ta": 1502, "ta ": 532, "taa": 254, "tae": 28,
+			"tai": 158, "taj": 10, "tak": 37, "tal": 24, "tam": 90, "tan": 13, "tap": 18, "tar": 58,
+			"tas": 67, "tau": 37, "tav": 155, "tc": 8, "te": 1018, "te ": 59, "teb": 7, "tee": 150,
+			"teh": 19, "tei": 41, "tej": 9, "tek": 16, "tel": 195, "tem": 10, "ten": 67, "ter": 106,
+			"tet": 299, "teu": 9, "tey": 18, "th": 13, "thu": 10, "ti": 820, "ti ": 87, "tia": 24,
+			"tie": 247, "tii": 105, "tij": 7, "tik": 7, "til": 91, "tim": 15, "tin": 65, "tio": 74,
+			"tir": 9, "tis": 46, "tit": 24, "tiä": 12, "tk": 55, "tka": 17, "tke": 12, "tki": 18,
+			"tl": 15, "tls": 14, "tm": 11, "to": 712, "to ": 102, "toa": 51, "tod": 66, "toe": 9,
+			"toi": 98, "toj": 60, "tok": 19, "tol": 32, "tom": 28, "ton": 116, "tor": 21, "tos": 32,
+			"tot": 38, "tov": 24, "tp": 12, "tr": 52, "tre": 10, "tri": 14, "trl": 17, "ts": 103,
+			"tsa": 14, "tse": 27, "tsi": 48, "tsu": 7, "tt": 1262, "tta": 288, "tte": 157, "tti": 151,
+			"tto": 23, "ttu": 269, "tty": 93, "ttä": 227, "ttö": 47, "tu": 991, "tu ": 248, "tua": 33,
+			"tue": 78, "tui": 28, "tuj": 24, "tuk": 61, "tul": 66, "tum": 36, "tun": 86, "tuo": 20,
+			"tur": 12, "tus": 200, "tut": 16, "tuu": 58, "tuv": 18, "ty": 345, "ty ": 95, "tyh": 18,
+			"tyi": 12, "tyk": 24, "tym": 15, "tyn": 23, "typ": 11, "tys": 46, "tyv": 8, "tyy": 62,
+			"tyä": 9, "työ": 12, "tz": 7, "tz ": 7, "tä": 807, "tä ": 281, "täe": 14, "täh": 7,
+			"täi": 14, "täj": 45, "täl": 20, "täm": 106, "tän": 11, "täs": 54, "tät": 20, "täv": 40,
+			"täy": 17, "tää": 171, "tö": 133, "tö ": 13, "töi": 8, "töj": 7, "töm": 19, "tön": 22,
+			"tös": 35, "töö": 15, "u": 3419, "u ": 347, "ua": 109, "ua ": 47, "uaa": 13, "ual": 21,
+			"uar": 19, "ub": 10, "ud": 70, "ude": 66, "ue": 153, "ue ": 26, "uee": 28, "uet": 92,
+			"uh": 33, "uhi": 7, "uho": 10, "uht": 10, "ui": 152, "ui ": 30, "uin": 33, "uis": 62,
+			"uit": 13, "uj": 40, "uja": 22, "uje": 12, "uk": 285, "uka": 14, "uke": 41, "uki": 7,
+			"ukk": 22, "uko": 10, "uks": 98, "uku": 87, "ul": 191, "ule": 8, "uli": 14, "ulk": 52,
+			"ull": 17, "ulo": 45, "ulu": 41, "um": 126, "um ": 9, "uma": 10, "umb": 10, "ume": 50,
+			"umi": 25, "umä": 9, "un": 230, "un ": 78, "une": 13, "uni": 8, "unk": 28, "unn": 47,
+			"unt": 28, "unu": 10, "uo": 355, "uo ": 8, "uod": 46, "uoj": 13, "uok": 13, "uol": 71,
+			"uom": 36, "uor": 84, "uot": 77, "up": 17, "ur": 137, "ura": 22, "ure": 21, "uri": 55,
+			"urk": 10, "urs": 10, "urv": 9, "us": 483, "us ": 198, "usa": 31, "use": 40, "usi": 10,
+			"usk": 17, "usl": 10, "usm": 13, "usp": 8, "usr": 9, "uss": 31, "ust": 100, "ut": 287,
+			"ut ": 40, "uta": 19, "ute": 24, "uto": 16, "uts": 7, "utt": 114, "utu": 43, "uu": 307,
+			"uu ": 29, "uua": 16, "uud": 59, "uul": 10, "uun": 29, "uur": 43, "uus": 36, "uut": 75,
+			"uv": 73, "uva": 56, "uvu": 10, "v": 1904, "v ": 20, "va": 822, "va ": 101, "vaa": 114,
+			"vai": 215, "vak": 22, "val": 107, "van": 45, "var": 91, "vas": 34, "vat": 71, "vau": 10,
+			"ve": 123, "vel": 49, "ver": 44, "vey": 11, "vi": 388, "vi ": 25, "via": 20, "vie": 38,
+			"vii": 36, "vil": 24, "vin": 21, "vir": 131, "vis": 47, "vit": 28, "vo": 291, "vo ": 59,
+			"voa": 8, "voi": 184, "vol": 14, "von": 8, "vu": 62, "vuo": 12, "vut": 13, "vuu": 9,
+			"vy": 28, "vyk": 11, "vä": 154, "vä ": 25, "väk": 8, "väl": 55, "vät": 30, "vää": 21,
+			"w": 95, "w ": 14, "wa": 7, "war": 7, "we": 24, "wer": 24, "wi": 20, "win": 18,
+			"wo": 10, "wor": 9, "wr": 11, "wri": 11, "x": 65, "x ": 48, "y": 1538, "y ": 162,
+			"yd": 33, "yde": 23, "ydy": 8, "yh": 129, "yhd": 37, "yhj": 18, "yhm": 17, "yht": 52,
+			"yi": 30, "yis": 15, "yj": 22, "yje": 7, "yjä": 14, "yk": 106, "yko": 9, "yks": 74,
+			"yku": 11, "yky": 10, "yl": 60, "yle": 8, "yli": 24, "yll": 18, "ym": 106, "ym ": 9,
+			"ymb": 64, "ymä": 10, "yn": 106, "yn ": 30, "yna": 21, "ynn": 22, "ynt": 10, "yny": 13,
+			"yp": 66, "ypi": 13, "ypp": 42, "yr": 28, "yri": 27, "ys": 120, "ys ": 30, "ysi": 7,
+			"ysk": 7, "ysl": 7, "ysp": 7, "yss": 17, "yst": 18, "ysä": 10, "yt": 366, "yt ": 28,
+			"yte": 60, "ytk": 10, "ytt": 153, "yty": 23, "ytä": 47, "ytö": 40, "yv": 18, "yvä": 10,
+			"yy": 89, "yy ": 13, "yyd": 8, "yyp": 44, "yys": 11, "yä": 36, "yä ": 36, "yö": 52,
+			"yös": 7, "yöt": 28, "z": 20, "z ": 12, "ä": 3475, "ä ": 1072, "äe": 32, "äen": 26,
+			"äh": 37, "ähd": 10, "äht": 9, "ähä": 7, "äi": 231, "äil": 10, "äim": 75, "äin": 42,
+			"äis": 81, "äiv": 19, "äj": 46, "äji": 17, "äjä": 28, "äk": 40, "äkk": 8, "äks": 13,
+			"äl": 230, "äli": 53, "älj": 8, "älk": 28, "äll": 73, "ält": 29, "älä": 35, "äm": 114,
+			"ämi": 29, "ämä": 82, "än": 231, "än ": 185, "änn": 18, "änt": 9, "äo": 27, "äon": 22,
+			"äp": 99, "äpp": 88, "är": 184, "äri": 66, "ärj": 70, "ärä": 42, "äs": 227, "äse": 7,
+			"äsi": 20, "äsk": 121, "äsm": 14, "äss": 41, "äst": 11, "äsu": 11, "ät": 140, "ät ": 38,
+			"äti": 9, "ätt": 40, "ätu": 7, "ätä": 21, "ätö": 13, "äv": 45, "ävi": 11, "ävä": 32,
+			"äy": 310, "äyn": 22, "äys": 9, "äyt": 269, "ää": 408, "ää ": 156, "ääl": 10, "ään": 103,
+			"äär": 106, "äät": 17, "ö": 269, "ö ": 23, "öi": 10, "öj": 7, "ök": 8, "öm": 19,
+			"ömä": 11, "ön": 51, "ön ": 46, "ös": 50, "öss": 15, "öst": 28, "öt": 32, "öte": 11,
+			"ött": 9, "ötä": 9, "öy": 27, "öyt": 20, "öä": 7, "öä ": 7, "öö": 15, "öön": 15,
+		}},
+		"fr": {Total: 223747, Ngrams: map[string]int{
+			" a": 926, " a ": 77, " ab": 11, " ac": 52, " ad": 44, " af": 65, " ai": 4, " aj": 14,
+			" al": 63, " am": 9, " an": 29, " ap": 87, " ar": 102, " as": 16, " at": 65, " au": 184,
+			" av": 97, " b": 172, " ba": 28, " be": 4, " bi": 52, " bl": 16, " bo": 18, " br": 34,
+			" bu": 9, " c": 949, " c ": 9, " ca": 88, " ce": 109, " ch": 140, " ci": 7, " cl": 52,
+			" co": 476, " cp": 7, " cr": 47, " ct": 7, " d": 2319, " d ": 282, " da": 114, " de": 1292,
+			" di": 82, " do": 109, " dr": 11, " du": 163, " dy": 12, " dé": 237, " dû": 4, " e": 785,
+			" ef": 6, " el": 12, " em": 30, " en": 260, " er": 64, " es": 183, " et": 100, " ex": 122,
+			" f": 411, " fa": 35, " fe": 16, " fi": 216, " fl": 17, " fo": 86, " fr": 8, " fu": 25,
+			" g": 139, " ga": 9, " ge": 16, " gi": 21, " gn": 8, " go": 4, " gp": 9, " gr": 34,
+			" gs": 5, " gé": 22, " h": 67, " ha": 16, " he": 11, " hi": 8, " ho": 26, " i": 651,
+			" ic": 7, " id": 27, " ig": 28, " il": 19, " im": 172, " in": 375, " ip": 6, " is": 4,
+			" it": 5, " j": 33, " jo": 28, " k": 6, " l": 1590, " l ": 273, " la": 375, " le": 714,
+			" li": 141, " lo": 72, " lu": 6, " m": 404, " ma": 102, " me": 54, " mi": 36, " mo": 121,
+			" mu": 23, " mé": 36, " mê": 17, " n": 539, " n ": 110, " ne": 130, " ni": 23, " no": 219,
+			" nu": 39, " né": 10, " o": 299, " o ": 5, " ob": 53, " oc": 15, " of": 4, " om": 5,
+			" on": 12, " op": 80, " or": 22, " ou": 88, " où": 6, " p": 1082, " pa": 445, " pc": 8,
+			" pe": 87, " ph": 8, " pi": 18, " pl": 49, " po": 286, " pr": 154, " pu": 19, " pé": 4,
+			" q": 98, " qu": 92, " r": 506, " r ": 9, " ra": 23, " re": 276, " ri": 4, " ro": 7,
+			" ré": 173, " s": 901, " s ": 15, " sa": 55, " sc": 23, " se": 163, " sh": 12, " si": 87,
+			" so": 154, " sp": 54, " sr": 4, " st": 54, " su": 157, " sy": 78, " sé": 39, " t": 378,
+			" t ": 4, " ta": 55, " te": 59, " th": 15, " tl": 7, " to": 53, " tr": 104, " tu": 6,
+			" ty": 42, " té": 10, " tê": 15, " u": 487, " un": 358, " up": 7, " ur": 7, " ut": 106,
+			" v": 322, " v ": 5, " va": 97, " ve": 84, " vi": 45, " vo": 59, " vé": 19, " w": 28,
+			" w ": 4, " wa": 10, " wi": 7, " x": 11, " x ": 9, " y": 7, " y ": 5, " z": 21,
+			" za": 6, " zé": 11, " à": 165, " à ": 165, " é": 231, " éc": 77, " éd": 16, " ég": 5,
+			" él": 20, " ém": 11, " én": 7, " ép": 7, " ét": 81, " év": 6, " ê": 83, " êt": 83,
+			"a": 4632, "a ": 500, "ab": 96, "aba": 6, "abi": 4, "abl": 73, "abs": 6, "abu": 4,
+			"ac": 208, "acc": 24, "ace": 47, "ach": 21, "aci": 11, "ack": 10, "act": 79, "acu": 4,
+			"acé": 5, "ad": 91, "ad ": 10, "add": 7, "ado": 5, "adr": 55, "af": 66, "aff": 65,
+			"ag": 178, "aga": 4, "age": 152, "agi": 5, "agu": 4, "agé": 5, "ah": 4, "ai": 254,
+			"ai ": 5, "aie": 6, "ail": 47, "ain": 24, "air": 86, "ais": 41, "ait": 41, "aj": 17,
+			"ajo": 13, "al": 406, "al ": 42, "ala": 44, "alc": 5, "ale": 84, "alg": 5, "ali": 126,
+			"all": 53, "alo": 13, "alt": 16, "aly": 8, "am": 92, "am ": 14, "ame": 9, "ami": 17,
+			"amm": 7, "amo": 9, "amp": 19, "amè": 14, "an": 618, "an ": 16, "ana": 9, "anc": 66,
+			"and": 133, "ang": 36, "ani": 14, "ann": 11, "anq": 12, "ans": 133, "ant": 173, "anu": 6,
+			"ap": 146, "ap ": 8, "apa": 9, "aph": 12, "api": 5, "apo": 4, "app": 75, "apr": 19,
+			"apt": 9, "aq": 77, "aqu": 77, "ar": 457, "ar ": 105, "ara": 56, "arb": 12, "arc": 47,
+			"ard": 26, "are": 9, "arg": 73, "ari": 20, "arm": 17, "arq": 9, "arr": 33, "art": 41,
+			"as": 311, "as ": 228, "asa": 4, "ase": 24, "asq": 5, "ass": 34, "ast": 4, "asé": 5,
+			"at": 626, "at ": 91, "ata": 10, "atc": 8, "ate": 84, "ati": 295, "ato": 5, "ats": 16,
+			"att": 71, "atu": 28, "até": 12, "au": 302, "au ": 87, "auc": 49, "aus": 11, "aut": 109,
+			"auv": 14, "aux": 22, "av": 122, "ava": 25, "ave": 86, "avo": 8, "ax": 16, "axe": 9,
+			"axi": 5, "ay": 9, "aî": 24, "aîn": 21, "b": 708, "b ": 15, "ba": 51, "bal": 5,
+			"ban": 7, "bar": 11, "bas": 23, "bc": 5, "be": 17, "bes": 7, "bi": 74, "bib": 11,
+			"bil": 7, "bin": 15, "bit": 33, "bj": 34, "bje": 34, "bl": 294, "ble": 233, "bli": 32,
+			"blo": 15, "blè": 9, "bo": 69, "bog": 9, "bol": 33, "bor": 9, "bou": 9, "br": 75,
+			"bra": 32, "bre": 37, "bs": 15, "bso": 7, "bt": 11, "bte": 10, "bu": 36, "bul": 5,
+			"bus": 5, "but": 17, "c": 2910, "c ": 155, "ca": 304, "cab": 5, "cac": 11, "cal": 59,
+			"can": 7, "cap": 13, "car": 48, "cas": 7, "cat": 142, "cc": 32, "cce": 11, "ccè": 8,
+			"ce": 337, "ce ": 141, "cec": 5, "cel": 8, "cem": 16, "cen": 7, "cep": 14, "cer": 42,
+			"ces": 68, "cet": 29, "ch": 627, "ch ": 18, "cha": 125, "che": 219, "chi": 237, "cho": 16,
+			"ché": 7, "ci": 122, "ci ": 11, "cia": 9, "cib": 5, "cie": 13, "cif": 46, "cin": 10,
+			"cis": 5, "cit": 11, "cié": 5, "ck": 27, "ck ": 8, "cke": 8, "cl": 82, "cla": 7,
+			"cle": 43, "clu": 21, "clé": 9, "co": 593, "cod": 48, "col": 18, "com": 181, "con": 209,
+			"cop": 14, "cor": 77, "cou": 40, "cp": 7, "cpu": 5, "cr": 128, "cra": 12, "cri": 52,
+			"cro": 10, "crè": 6, "cré": 40, "ct": 329, "ct ": 14, "cte": 43, "cti": 178, "ctr": 9,
+			"cts": 7, "ctu": 43, "ctè": 24, "cté": 8, "cu": 122, "cul": 11, "cum": 10, "cun": 41,
+			"cup": 15, "cur": 13, "cut": 31, "cè": 8, "cès": 8, "cé": 21, "cé ": 6, "céd": 7,
+			"cée": 5, "d": 3202, "d ": 369, "da": 205, "dag": 6, "dan": 140, "dar": 19, "dat": 31,
+			"dd": 7, "de": 1605, "de ": 1158, "dem": 25, "den": 34, "dep": 19, "der": 22, "des": 248,
+			"deu": 16, "dev": 22, "dex": 47, "di": 229, "dia": 39, "dic": 8, "dif": 67, "dig": 5,
+			"diq": 20, "dir": 14, "dis": 36, "dit": 31, "dl": 5, "dn": 6, "do": 131, "doc": 7,
+			"doi": 45, "dom": 5, "don": 54, "dou": 11, "dow": 7, "dr": 86, "dr ": 5, "dre": 67,
+			"dro": 10, "ds": 8, "ds ": 5, "du": 227, "du ": 176, "duc": 5, "due": 12, "dui": 9,
+			"dul": 7, "dup": 9, "dur": 5, "dy": 13, "dyn": 12, "dè": 6, "dèl": 6, "dé": 286,
+			"dé ": 14, "déb": 25, "déc": 32, "dée": 9, "déf": 57, "déj": 12, "dél": 6, "dém": 19,
+			"déo": 5, "dép": 58, "dér": 5, "dés": 18, "dét": 22, "e": 10064, "e ": 4262, "ea": 66,
+			"ead": 11, "eam": 11, "eau": 41, "eb": 17, "eba": 8, "ec": 307, "ec ": 98, "ech": 15,
+			"eci": 5, "eco": 20, "ecr": 6, "ect": 154, "ed": 24, "ed ": 7, "edé": 10, "ee": 6,
+			"ef": 49, "ef ": 28, "eff": 8, "efs": 9, "eg": 103, "egi": 89, "egm": 8, "ei": 21,
+			"eil": 5, "ein": 13, "el": 174, "el ": 52, "ela": 18, "elf": 5, "eli": 12, "ell": 49,
+			"elo": 20, "els": 6, "em": 317, "ema": 24, "emb": 19, "eme": 169, "emi": 36, "emp": 57,
+			"en": 927, "en ": 162, "ena": 11, "enc": 74, "end": 80, "ene": 6, "eni": 26, "enn": 6,
+			"eno": 7, "enr": 20, "ens": 30, "ent": 463, "enu": 13, "env": 23, "eo": 10, "eof": 9,
+			"ep": 54, "epo": 5, "ept": 13, "epu": 19, "eq": 36, "equ": 36, "er": 1163, "er ": 675,
+			"era": 18, "erc": 16, "erf": 5, "erm": 55, "ern": 33, "ero": 7, "erp": 8, "err": 78,
+			"ers": 123, "ert": 99, "erv": 34, "es": 1513, "es ": 1092, "esc": 11, "esp": 42, "ess": 158,
+			"est": 202, "et": 333, "et ": 171, "eta": 7, "eti": 9, "eto": 6, "ets": 52, "ett": 70,
+			"eu": 380, "eu ": 8, "eui": 14, "eul": 46, "eur": 245, "eut": 27, "euv": 14, "eux": 24,
+			"ev": 31, "eve": 15, "evr": 11, "ex": 198, "ex ": 43, "exa": 11, "exc": 15, "exe": 8,
+			"exi": 17, "exp": 34, "ext": 37, "exé": 32, "ez": 58, "ez ": 58, "f": 1142, "f ": 79,
+			"fa": 68, "fac": 9, "fai": 14, "fan": 6, "fau": 32, "fe": 26, "fec": 5, "fer": 13,
+			"ff": 130, "ff ": 15, "ffi": 71, "ffr": 12, "ffé": 15, "fi": 522, "fia": 21, "fic": 336,
+			"fie": 31, "fig": 23, "fil": 17, "fin": 45, "fiq": 14, "fis": 5, "fix": 5, "fié": 23,
+			"fl": 32, "fli": 12, "flo": 6, "flu": 13, "fo": 141, "fo ": 15, "foi": 6, "fon": 19,
+			"for": 81, "fou": 18, "fr": 21, "fre": 10, "fré": 5, "fs": 30, "fs ": 25, "fu": 27,
+			"fus": 27, "fé": 53, "fér": 53, "g": 855, "g ": 22, "ga": 37, "gag": 9, "gal": 8,
+			"gar": 5, "gat": 5, "gau": 6, "ge": 257, "ge ": 173, "gem": 14, "gen": 7, "ger": 25,
+			"ges": 34, "gi": 144, "gin": 18, "gio": 6, "gis": 89, "git": 21, "gl": 17, "gla": 6,
+			"gle": 6, "gm": 9, "gme": 8, "gn": 137, "gna": 31, "gne": 60, "gno": 31, "gnu": 7,
+			"gné": 7, "go": 16, "gor": 7, "gp": 11, "gp ": 6, "gr": 57, "gra": 35, "gro": 13,
+			"gs": 9, "gu": 86, "gue": 26, "gul": 14, "gum": 21, "gur": 19, "gé": 36, "gée": 8,
+			"gén": 15, "gér": 7, "h": 820, "h ": 26, "ha": 146, "hag": 7, "ham": 15, "han": 30,
+			"haq": 9, "har": 47, "haî": 21, "he": 273, "he ": 65, "hea": 6, "hec": 28, "hel": 7,
+			"hem": 28, "hen": 22, "her": 82, "hes": 25, "hi": 249, "hie": 183, "hif": 12, "hin": 10,
+			"hit": 12, "hiv": 22, "hm": 6, "ho": 57, "hoi": 7, "hon": 5, "hor": 28, "hou": 9,
+			"hr": 10, "hu": 13, "hum": 8, "hè": 15, "hèq": 12, "hé": 14, "hér": 6, "i": 5429,
+			"i ": 130, "ia": 113, "ia ": 11, "iab": 8, "iai": 5, "ial": 21, "ian": 28, "ias": 6,
+			"iat": 28, "ib": 182, "ibi": 7, "ibl": 157, "ibu": 10, "ic": 414, "ica": 128, "ice": 5,
+			"ich": 250, "ici": 15, "id": 129, "id ": 11, "ida": 13, "ide": 93, "idé": 6, "ie": 428,
+			"ie ": 77, "iel": 20, "ien": 48, "ier": 241, "ieu": 31, "if": 246, "if ": 15, "iff": 45,
+			"ifi": 168, "ifs": 14, "ig": 189, "ige": 11, "igi": 15, "ign": 126, "igu": 24, "il": 261,
+			"il ": 39, "ila": 9, "ile": 9, "ili": 118, "ill": 59, "ils": 6, "ilt": 12, "im": 240,
+			"ima": 10, "ime": 19, "imi": 27, "imm": 26, "imp": 141, "imu": 8, "in": 673, "in ": 40,
+			"ina": 54, "inc": 61, "ind": 94, "ine": 63, "inf": 41, "ing": 5, "ini": 51, "ins": 90,
+			"int": 87, "inu": 11, "inv": 57, "iné": 13, "io": 678, "ion": 661, "iot": 11, "ip": 54,
+			"ipe": 9, "ipl": 13, "ipt": 14, "iq": 146, "iqu": 146, "ir": 315, "ir ": 106, "ire": 171,
+			"irg": 12, "iro": 5, "iré": 5, "is": 586, "is ": 111, "isa": 57, "ise": 124, "isi": 24,
+			"iso": 7, "isp": 13, "iss": 20, "ist": 170, "isé": 49, "it": 408, "it ": 130, "ita": 10,
+			"ite": 82, "ith": 6, "iti": 69, "its": 37, "itt": 5, "itu": 16, "ité": 45, "iv": 148,
+			"iva": 16, "ive": 98, "ivi": 17, "ivé": 13, "ix": 12, "ixe": 5, "iz": 9, "ize": 9,
+			"iè": 19, "ièr": 17, "ié": 42, "ié ": 12, "iée": 10, "iés": 10, "iét": 7, "j": 109,
+			"je": 44, "jec": 6, "jet": 37, "jo": 44, "jou": 44, "jà": 12, "jà ": 12, "k": 62,
+			"k ": 21, "ka": 6, "ke": 12, "ks": 5, "l": 3563, "l ": 455, "la": 522, "la ": 357,
+			"lab": 23, "lac": 24, "lag": 30, "lai": 19, "lan": 22, "lar": 5, "lat": 31, "lc": 5,
+			"ld": 6, "le": 1377, "le ": 728, "lec": 47, "lef": 35, "lem": 49, "ler": 16, "les": 417,
+			"let": 6, "leu": 52, "lez": 12, "lf": 8, "lg": 6, "li": 534, "lia": 9, "lib": 7,
+			"lic": 38, "lid": 71, "lie": 24, "lig": 49, "lim": 21, "lin": 14, "lio": 11, "liq": 40,
+			"lir": 23, "lis": 192, "lit": 26, "ll": 174, "ll ": 13, "lle": 113, "llo": 11, "llé": 19,
+			"lo": 157, "lob": 5, "loc": 48, "log": 8, "loi": 5, "lon": 35, "lor": 36, "lot": 7,
+			"ls": 24, "ls ": 24, "lt": 70, "lt ": 6, "lta": 13, "lte": 11, "lti": 22, "ltr": 11,
+			"lu": 95, "lu ": 5, "lum": 8, "lur": 8, "lus": 45, "lut": 7, "lux": 11, "ly": 12,
+			"lys": 7, "lè": 25, "lèm": 9, "lèt": 9, "lé": 77, "lé ": 21, "léc": 10, "lée": 10,
+			"lém": 25, "lés": 8, "m": 2012, "m ": 108, "ma": 270, "mac": 9, "mag": 15, "mai": 19,
+			"mal": 14, "man": 86, "map": 5, "mar": 27, "mas": 6, "mat": 65, "mau": 10, "max": 5,
+			"mb": 92, "mb ": 9, "mbi": 6, "mbl": 12, "mbo": 34, "mbr": 28, "me": 461, "me ": 101,
+			"mem": 6, "men": 251, "mer": 25, "mes": 39, "met": 38, "mi": 205, "mie": 9, "min": 67,
+			"miq": 12, "mis": 43, "mit": 48, "mm": 157, "mma": 43, "mme": 42, "mmi": 32, "mmu": 7,
+			"mmé": 30, "mn": 5, "mo": 159, "mod": 70, "moi": 24, "mon": 15, "mor": 15, "mot": 21,
+			"mp": 299, "mp ": 13, "mpa": 24, "mpi": 10, "mpl": 40, "mpo": 148, "mpr": 23, "mps": 13,
+			"mpt": 8, "mpu": 9, "mpê": 9, "ms": 26, "ms ": 24, "mt": 5, "mt ": 5, "mu": 49,
+			"mul": 30, "mum": 5, "mut": 8, "mv": 6, "mè": 17, "mèt": 14, "mé": 125, "mé ": 12,
+			"méd": 36, "mée": 7, "mém": 20, "mér": 32, "mét": 16, "mê": 17, "mêm": 17, "n": 4833,
+			"n ": 1151, "na": 152, "nai": 19, "nal": 37, "nam": 11, "nan": 12, "nat": 58, "nc": 226,
+			"nca": 6, "nce": 77, "nch": 33, "nci": 7, "ncl": 11, "nco": 61, "ncr": 6, "nct": 18,
+			"ncé": 5, "nd": 357, "nd ": 29, "nda": 59, "nde": 130, "ndi": 43, "ndo": 14, "ndr": 13,
+			"ndu": 39, "ndé": 25, "ne": 534, "ne ": 407, "nel": 15, "nem": 16, "ner": 40, "nes": 28,
+			"neu": 7, "nex": 5, "nf": 90, "nfi": 30, "nfl": 13, "nfo": 40, "ng": 68, "ng ": 6,
+			"nge": 19, "ngl": 5, "ngr": 5, "ngu": 21, "ni": 184, "ni ": 21, "nib": 10, "nie": 12,
+			"niq": 14, "nir": 37, "nis": 13, "nit": 30, "niv": 24, "niè": 7, "nk": 7, "nn": 162,
+			"nna": 13, "nne": 64, "nnu": 24, "nné": 56, "no": 263, "nom": 114, "non": 77, "nor": 32,
+			"not": 13, "nou": 16, "nq": 13, "nqu": 12, "nr": 21, "nre": 20, "ns": 418, "ns ": 285,
+			"nse": 11, "nsi": 13, "nst": 84, "nsé": 7, "nt": 869, "nt ": 431, "nta": 43, "nte": 149,
+			"nti": 85, "ntr": 79, "nts": 62, "nté": 13, "nu": 107, "nu ": 26, "nue": 18, "nul": 15,
+			"num": 37, "nv": 87, "nva": 46, "nve": 13, "nvi": 6, "nvo": 21, "nw": 5, "né": 109,
+			"né ": 17, "née": 59, "nég": 6, "nér": 15, "o": 3883, "o ": 72, "oa": 8, "oat": 5,
+			"ob": 70, "obj": 34, "obl": 15, "obs": 5, "obt": 10, "oc": 135, "oc ": 10, "oca": 46,
+			"oce": 16, "och": 5, "oci": 7, "ock": 14, "oco": 9, "oct": 11, "ocu": 7, "od": 129,
+			"oda": 6, "ode": 62, "odi": 34, "odu": 15, "odè": 6, "odé": 6, "of": 22, "of ": 11,
+			"off": 6, "og": 28, "oga": 8, "ogi": 5, "ogr": 7, "oi": 191, "oi ": 10, "oin": 29,
+			"oir": 63, "ois": 21, "oit": 48, "oiv": 12, "ol": 91, "ole": 37, "oli": 11, "olo": 10,
+			"olu": 18, "om": 334, "om ": 59, "oma": 7, "omb": 28, "ome": 5, "omi": 9, "omm": 122,
+			"omp": 79, "oms": 23, "on": 1207, "on ": 600, "onc": 22, "ond": 45, "onf": 47, "ong": 25,
+			"oni": 18, "onn": 144, "ons": 158, "ont": 131, "onv": 7, "oo": 6, "op": 152, "op ": 32,
+			"opc": 5, "opi": 10, "opo": 6, "opr": 11, "opt": 40, "opé": 34, "oq": 14, "oqu": 14,
+			"or": 444, "or ": 9, "ora": 13, "orc": 8, "ord": 14, "ore": 25, "ori": 40, "orm": 71,
+			"orr": 57, "ors": 57, "ort": 118, "oré": 19, "os": 185, "os ": 14, "osa": 8, "osi": 22,
+			"oss": 122, "ost": 7, "ot": 84, "ot ": 12, "ote": 14, "oth": 11, "oti": 8, "oto": 9,
+			"otr": 7, "ots": 9, "ott": 5, "ou": 656, "ou ": 74, "oub": 9, "ouc": 27, "oui": 6,
+			"oup": 8, "our": 340, "ous": 71, "out": 41, "ouv": 56, "oué": 8, "ov": 11, "ove": 5,
+			"ow": 17, "owe": 6, "ows": 6, "oy": 11, "oye": 6, "où": 6, "où ": 6, "p": 2386,
+			"p ": 83, "pa": 538, "pac": 39, "pag": 7, "pai": 8, "paq": 64, "par": 158, "pas": 232,
+			"pat": 18, "pc": 21, "pc ": 16, "pco": 5, "pd": 6, "pda": 5, "pe": 220, "pe ": 45,
+			"pel": 18, "pem": 5, "pen": 24, "per": 67, "pet": 7, "peu": 43, "pg": 5, "ph": 27,
+			"phe": 14, "pi": 50, "pie": 11, "pil": 13, "pip": 8, "pir": 6, "pl": 179, "pla": 30,
+			"ple": 23, "pli": 63, "plo": 5, "plt": 5, "plu": 31, "plè": 6, "plé": 15, "po": 533,
+			"poi": 17, "pol": 6, "pon": 38, "por": 71, "pos": 163, "pou": 216, "pow": 6, "pp": 136,
+			"ppa": 5, "ppe": 14, "ppl": 48, "ppo": 40, "ppr": 22, "pps": 5, "pr": 255, "pre": 60,
+			"pri": 51, "pro": 75, "prè": 21, "pré": 42, "ps": 24, "ps ": 16, "pst": 8, "pt": 90,
+			"pt ": 13, "pte": 15, "pti": 53, "pu": 63, "pu ": 17, "pub": 11, "pui": 25, "py": 5,
+			"pé": 117, "péc": 52, "pér": 63, "pê": 9, "pêc": 9, "pô": 18, "pôt": 18, "q": 435,
+			"qu": 428, "qu ": 21, "qua": 22, "que": 303, "qui": 50, "qué": 27, "r": 5369, "r ": 1404,
+			"ra": 388, "ra ": 19, "rab": 5, "rac": 41, "rag": 12, "rai": 62, "ral": 9, "ram": 27,
+			"ran": 93, "rap": 27, "ras": 11, "rat": 56, "rav": 19, "rb": 17, "rbr": 10, "rc": 124,
+			"rc ": 9, "rce": 54, "rch": 53, "rd": 43, "rd ": 22, "rde": 11, "re": 1298, "re ": 530,
+			"rea": 19, "reb": 9, "rec": 64, "red": 14, "ref": 5, "reg": 91, "rei": 6, "rel": 24,
+			"rem": 44, "ren": 86, "rep": 15, "req": 33, "rer": 64, "res": 212, "ret": 10, "reu": 55,
+			"rf": 7, "rg": 90, "rge": 51, "rgu": 33, "ri": 296, "ri ": 9, "ria": 10, "rib": 10,
+			"ric": 5, "rie": 42, "rif": 18, "rig": 23, "rim": 23, "rip": 17, "riq": 12, "rir": 23,
+			"ris": 35, "rit": 41, "riv": 11, "rié": 9, "rl": 13, "rl ": 10, "rm": 147, "rm ": 10,
+			"rma": 57, "rme": 18, "rmi": 40, "rmé": 12, "rn": 61, "rna": 13, "rne": 16, "rni": 28,
+			"ro": 250, "ro ": 30, "rob": 10, "roc": 22, "rod": 8, "rog": 8, "roi": 14, "rom": 13,
+			"ron": 16, "rop": 42, "ros": 10, "rot": 13, "rou": 38, "rp": 10, "rpc": 5, "rq": 9,
+			"rqu": 9, "rr": 183, "rra": 17, "rre": 113, "rri": 20, "rro": 19, "rrê": 9, "rs": 232,
+			"rs ": 174, "rse": 9, "rsi": 32, "rso": 6, "rsq": 6, "rt": 270, "rt ": 32, "rta": 12,
+			"rte": 48, "rti": 116, "rto": 31, "rtu": 5, "rté": 21, "ru": 70, "ruc": 51, "rui": 5,
+			"rv": 35, "rva": 11, "rve": 18, "rè": 30, "rès": 21, "rèt": 6, "ré": 357, "ré ": 36,
+			"réa": 30, "réc": 30, "réd": 7, "rée": 84, "réf": 39, "rég": 11, "réi": 6, "rém": 7,
+			"rép": 44, "rés": 26, "rét": 5, "rév": 20, "réé": 7, "rê": 11, "rêt": 11, "rô": 9,
+			"rôl": 9, "s": 5214, "s ": 2437, "sa": 213, "sa ": 5, "sab": 5, "sac": 14, "sag": 62,
+			"sai": 7, "san": 51, "sat": 44, "sau": 11, "say": 5, "sc": 45, "sca": 7, "sch": 5,
+			"scr": 21, "se": 428, "se ": 117, "sea": 6, "sec": 46, "seg": 8, "sel": 13, "sem": 27,
+			"sen": 6, "ser": 105, "ses": 23, "set": 6, "seu": 54, "sez": 8, "sf": 5, "sh": 17,
+			"sh ": 5, "she": 5, "sho": 6, "si": 389, "si ": 28, "sib": 125, "sie": 10, "sig": 50,
+			"sim": 9, "sio": 116, "sit": 23, "siz": 9, "sn": 5, "so": 206, "soc": 12, "soi": 8,
+			"sol": 17, "som": 5, "son": 55, "sor": 41, "sou": 66, "sp": 115, "sp ": 7, "spa": 22,
+			"spo": 31, "spé": 52, "sq": 19, "squ": 19, "sr": 8, "sr ": 6, "ss": 353, "ssa": 72,
+			"sse": 68, "ssi": 160, "sso": 20, "ssu": 15, "ssé": 14, "st": 568, "st ": 172, "sta": 85,
+			"std": 5, "ste": 59, "sti": 41, "sto": 10, "str": 167, "stè": 25, "su": 183, "suf": 5,
+			"sui": 28, "sul": 5, "sup": 68, "sur": 51, "sus": 13, "sv": 7, "sv ": 6, "sy": 78,
+			"sym": 39, "syn": 12, "sys": 27, "sé": 120, "sé ": 32, "séc": 14, "sée": 39, "sél": 22,
+			"t": 4911, "t ": 1194, "ta": 298, "ta ": 9, "tab": 28, "tad": 5, "tag": 12, "tai": 65,
+			"tal": 31, "tam": 5, "tan": 65, "tar": 9, "tat": 55, "tax": 10, "tc": 14, "tch": 11,
+			"td": 5, "te": 828, "te ": 285, "tec": 26, "tel": 7, "tem": 43, "ten": 130, "ter": 147,
+			"tes": 74, "tet": 11, "teu": 78, "tex": 18, "th": 62, "the": 23, "thm": 5, "tho": 5,
+			"thu": 8, "thè": 15, "ti": 1028, "ti ": 7, "tia": 15, "tib": 10, "tie": 67, "tif": 96,
+			"til": 120, "tin": 23, "tio": 532, "tip": 15, "tiq": 31, "tir": 26, "tis": 14, "tit": 13,
+			"tiv": 50, "tl": 13, "tls": 7, "tm": 5, "to": 149, "to ": 7, "toc": 16, "toi": 32,
+			"tom": 5, "tor": 16, "tou": 57, "tr": 554, "tra": 74, "tre": 272, "tri": 25, "trl": 7,
+			"tro": 56, "tru": 56, "tré": 53, "trô": 9, "ts": 191, "ts ": 189, "tt": 153, "tta": 5,
+			"tte": 106, "ttr": 36, "tu": 108, "tue": 18, "tur": 73, "ty": 47, "typ": 42, "tè": 53,
+			"tèm": 25, "tèr": 24, "té": 173, "té ": 91, "tée": 30, "tég": 8, "tél": 10, "tér": 14,
+			"tés": 17, "tê": 16, "têt": 16, "u": 3454, "u ": 422, "ua": 32, "uan": 17, "uat": 8,
+			"ub": 27, "ubl": 20, "uc": 136, "uch": 28, "ucl": 6, "uct": 56, "ucu": 41, "ue": 395,
+			"ue ": 187, "uel": 36, "uem": 9, "uer": 32, "ues": 47, "uet": 74, "ueu": 6, "uf": 5,
+			"uff": 5, "ui": 145, "ui ": 20, "uil": 21, "uis": 50, "uit": 15, "uiv": 25, "ul": 144,
+			"ul ": 16, "ula": 14, "ule": 64, "ull": 6, "ult": 34, "um": 102, "um ": 11, "umb": 7,
+			"ume": 37, "umé": 35, "un": 412, "un ": 215, "une": 162, "uni": 19, "up": 112, "upd": 5,
+			"upe": 10, "upl": 11, "upp": 58, "upé": 22, "ur": 769, "ur ": 476, "ura": 28, "urc": 52,
+			"ure": 95, "uri": 12, "urn": 23, "urr": 15, "urs": 47, "urt": 8, "uré": 5, "us": 197,
+			"us ": 119, "use": 5, "usi": 45, "uss": 15, "ut": 357, "ut ": 80, "uta": 10, "ute": 44,
+			"uth": 23, "uti": 135, "uto": 24, "utr": 23, "uté": 7, "uv": 84, "uva": 12, "uve": 46,
+			"uvr": 11, "uvé": 13, "ux": 59, "ux ": 57, "ué": 38, "ué ": 19, "uée": 14, "ués": 5,
+			"v": 895, "v ": 24, "va": 211, "vai": 26, "val": 148, "van": 20, "var": 10, "ve": 371,
+			"ve ": 36, "vea": 31, "vec": 67, "vel": 8, "ven": 37, "ver": 122, "ves": 12, "veu": 29,
+			"vez": 20, "vi": 88, "vi ": 5, "via": 5, "vid": 15, "vie": 5, "vir": 23, "vis": 20,
+			"vit": 7, "vo": 104, "voc": 6, "voi": 27, "vol": 8, "voq": 10, "vot": 6, "vou": 37,
+			"voy": 6, "vr": 29, "vra": 12, "vre": 7, "vri": 9, "vé": 51, "vé ": 8, "vée": 19,
+			"vér": 19, "w": 62, "w ": 10, "wa": 11, "war": 11, "we": 13, "wer": 10, "wi": 12,
+			"win": 11, "ws": 7, "ws ": 7, "x": 312, "x ": 125, "xa": 12, "xam": 5, "xc": 15,
+			"xce": 5, "xcl": 10, "xe": 22, "xe ": 14, "xer": 5, "xi": 24, "xis": 8, "xp": 34,
+			"xpi": 6, "xpl": 7, "xpr": 16, "xt": 43, "xte": 29, "xtr": 8, "xé": 32, "xéc": 27,
+			"y": 192, "y ": 15, "ya": 8, "ye": 10, "yer": 7, "ym": 39, "ymb": 35, "yn": 24,
+			"yna": 11, "ynt": 10, "yp": 47, "ype": 42, "ys": 36, "yse": 7, "yst": 25, "z": 94,
+			"z ": 60, "za": 6, "ze": 12, "zeo": 8, "zé": 11, "zér": 11, "à": 178, "à ": 178,
+			"â": 6, "âc": 6, "âch": 5, "ç": 11, "ça": 6, "è": 180, "èg": 6, "èl": 10,
+			"èle": 10, "èm": 37, "ème": 37, "èq": 16, "èqu": 16, "èr": 48, "ère": 48, "ès": 31,
+			"ès ": 29, "èt": 29, "ète": 15, "ètr": 14, "é": 1905, "é ": 277, "éa": 30, "éad": 18,
+			"éat": 7, "éb": 27, "ébo": 14, "ébu": 7, "éc": 250, "éca": 23, "éce": 5, "éch": 51,
+			"éci": 62, "éco": 6, "écr": 41, "écu": 55, "éd": 67, "éde": 6, "édi": 56, "édu": 5,
+			"ée": 301, "ée ": 187, "éer": 24, "ées": 87, "éf": 96, "éfa": 26, "éfi": 34, "éfé": 34,
+			"ég": 33, "éga": 11, "égi": 8, "égo": 5, "éi": 6, "éin": 6, "éj": 12, "éjà": 12,
+			"él": 58, "éla": 5, "éle": 24, "éli": 8, "élé": 20, "ém": 89, "éma": 19, "éme": 42,
+			"émo": 20, "ému": 6, "én": 28, "énu": 7, "éné": 15, "éo": 7, "ép": 113, "épa": 24,
+			"épe": 45, "épl": 9, "épo": 7, "épu": 8, "épô": 18, "éq": 5, "équ": 5, "ér": 233,
+			"éra": 52, "ére": 77, "éri": 50, "éro": 40, "éré": 14, "és": 105, "és ": 70, "ésa": 13,
+			"ése": 8, "éso": 7, "ét": 132, "éta": 46, "éte": 23, "éti": 12, "étr": 6, "été": 41,
+			"év": 28, "évi": 13, "évo": 11, "éé": 7, "ê": 141, "êc": 9, "êch": 9, "êm": 17,
+			"ême": 17, "êt": 115, "êt ": 6, "ête": 27, "êtr": 82, "î": 26, "în": 21, "îne": 21,
+			"ô": 34, "ôl": 9, "ôle": 9, "ôt": 23, "ôt ": 18, "ù": 6, "ù ": 6, "û": 6,
+		}},
+		"hu": {Total: 244675, Ngrams: map[string]int{
+			" a": 2316, " a ": 1222, " ad": 174, " ah": 11, " ak": 34, " al": 183, " am": 15, " an": 19,
+			" ap": 33, " ar": 58, " as": 11, " at": 10, " au": 17, " az": 509, " b": 425, " ba": 13,
+			" be": 223, " bi": 136, " bl": 10, " bá": 17, " c": 303, " ca": 27, " cd": 14, " co": 16,
+			" cs": 170, " ct": 12, " cé": 17, " cí": 31, " d": 126, " da": 13, " de": 40, " di": 11,
+			" do": 19, " dá": 11, " e": 780, " e ": 15, " eb": 11, " eg": 258, " el": 225, " en": 56,
+			" er": 35, " es": 49, " ez": 82, " f": 641, " fe": 169, " fi": 56, " fo": 126, " fr": 37,
+			" fu": 16, " fá": 201, " fü": 17, " g": 126, " ge": 15, " go": 21, " gs": 13, " gy": 27,
+			" gé": 18, " h": 695, " ha": 267, " he": 69, " hi": 228, " ho": 89, " ht": 15, " há": 15,
+			" i": 195, " id": 49, " ig": 15, " il": 13, " in": 47, " is": 38, " j": 132, " ja": 12,
+			" je": 80, " jo": 25, " k": 1083, " ka": 147, " ke": 145, " ki": 328, " ko": 55, " ku": 69,
+			" ké": 104, " kí": 26, " kó": 22, " kö": 146, " kü": 23, " l": 421, " le": 253, " li": 39,
+			" lo": 34, " lé": 66, " m": 820, " ma": 60, " me": 440, " mi": 97, " mo": 15, " mu": 18,
+			" má": 61, " mé": 41, " mó": 47, " mű": 30, " n": 825, " na": 40, " ne": 539, " ni": 84,
+			" nu": 34, " ny": 32, " né": 68, " o": 109, " ok": 16, " ol": 37, " op": 17, " os": 11,
+			" p": 251, " pa": 77, " pe": 11, " po": 38, " pr": 68, " pé": 13, " q": 18, " qw": 16,
+			" r": 227, " re": 133, " ro": 24, " ré": 37, " s": 706, " sa": 11, " se": 21, " sh": 15,
+			" si": 53, " so": 71, " sp": 19, " st": 22, " su": 14, " sz": 441, " sé": 16, " t": 573,
+			" t ": 15, " ta": 141, " te": 85, " ti": 23, " tr": 14, " tu": 28, " tá": 81, " tí": 37,
+			" tö": 88, " tú": 34, " u": 122, " ub": 12, " ur": 17, " ut": 47, " v": 498, " va": 237,
+			" ve": 44, " vi": 72, " vá": 82, " vé": 47, " w": 35, " wa": 11, " wi": 15, " x": 26,
+			" x ": 15, " z": 23, " zá": 21, " á": 127, " ál": 79, " át": 38, " é": 316, " ér": 155,
+			" és": 150, " í": 32, " ír": 26, " ö": 69, " ös": 62, " ú": 56, " új": 45, " ü": 34,
+			" ür": 14, " üz": 13, "a": 7153, "a ": 2155, "aa": 15, "aad": 14, "ab": 105, "ab ": 11,
+			"abb": 15, "abv": 38, "ac": 36, "ad": 322, "ad ": 16, "ada": 110, "adh": 18, "adi": 12,
+			"adj": 15, "adn": 24, "ado": 38, "adv": 37, "adá": 33, "af": 13, "ag": 341, "ag ": 24,
+			"ago": 42, "agy": 226, "ah": 13, "aho": 11, "ai": 93, "ai ": 34, "ain": 44, "aj": 66,
+			"ajd": 21, "ajt": 20, "ak": 432, "ak ": 195, "aka": 18, "akk": 18, "ako": 35, "akt": 99,
+			"akí": 22, "al": 636, "al ": 119, "ala": 89, "ali": 32, "alk": 44, "alm": 100, "alo": 52,
+			"alt": 42, "alá": 85, "aló": 39, "am": 155, "am ": 60, "ama": 21, "ame": 25, "ami": 14,
+			"an": 355, "an ": 160, "anc": 72, "and": 16, "ang": 23, "ani": 20, "ann": 19, "ap": 222,
+			"apc": 73, "apo": 24, "app": 24, "aps": 20, "apé": 33, "ar": 392, "ar ": 20, "ara": 168,
+			"arc": 40, "arg": 17, "arm": 12, "art": 99, "as": 311, "ash": 12, "ass": 11, "asz": 228,
+			"asá": 26, "at": 765, "at ": 126, "ata": 44, "atb": 11, "atc": 18, "ate": 14, "atf": 39,
+			"ati": 46, "atj": 20, "atk": 13, "atl": 24, "ato": 118, "att": 27, "atá": 83, "atí": 19,
+			"ató": 135, "au": 28, "aut": 20, "av": 31, "avo": 14, "az": 635, "az ": 480, "azo": 69,
+			"azá": 38, "azó": 22, "aá": 11, "aál": 11, "b": 1310, "b ": 101, "ba": 197, "ba ": 86,
+			"bak": 13, "ban": 73, "bb": 118, "bb ": 67, "bbi": 22, "be": 327, "be ": 32, "bef": 11,
+			"bej": 21, "bel": 24, "bem": 47, "ben": 63, "ber": 16, "bet": 25, "beá": 65, "bi": 178,
+			"bi ": 19, "bil": 108, "biz": 34, "bl": 42, "blo": 12, "blé": 19, "bo": 56, "bol": 13,
+			"bon": 19, "bos": 14, "br": 12, "bs": 18, "bsz": 15, "bu": 25, "bun": 12, "bv": 39,
+			"bvá": 39, "bá": 77, "báj": 15, "bál": 19, "bí": 13, "bó": 35, "ból": 35, "bú": 11,
+			"bő": 31, "ből": 22, "c": 997, "c ": 66, "ca": 45, "cap": 21, "cd": 15, "cd ": 12,
+			"ce": 28, "ce ": 11, "ch": 51, "chí": 27, "ci": 111, "cio": 15, "ció": 57, "ck": 43,
+			"ck ": 22, "cks": 12, "co": 33, "con": 12, "cs": 494, "cs ": 117, "csa": 83, "cse": 41,
+			"cso": 194, "csé": 14, "ct": 18, "ctr": 12, "cé": 19, "cél": 17, "cí": 38, "cím": 38,
+			"d": 1231, "d ": 115, "da": 136, "dat": 115, "de": 179, "de ": 34, "del": 26, "den": 26,
+			"det": 14, "dez": 16, "dh": 22, "dha": 18, "di": 98, "dia": 19, "dik": 50, "dj": 29,
+			"dja": 18, "dn": 35, "dni": 23, "do": 155, "dok": 18, "dol": 23, "don": 26, "dos": 26,
+			"dot": 41, "ds": 84, "dsz": 81, "du": 12, "dv": 47, "dva": 36, "dá": 60, "dás": 39,
+			"dát": 11, "dé": 75, "dél": 41, "dés": 16, "déz": 12, "dí": 48, "dít": 48, "dó": 19,
+			"dó ": 18, "dő": 52, "dő ": 22, "e": 7883, "e ": 598, "ea": 26, "eam": 21, "eb": 38,
+			"ebb": 23, "ec": 36, "eci": 17, "ed": 96, "ed ": 12, "ede": 15, "edi": 21, "edé": 41,
+			"ef": 21, "eg": 763, "eg ": 135, "ega": 70, "ege": 23, "egf": 33, "egg": 18, "egh": 36,
+			"egi": 13, "egj": 23, "egn": 16, "ego": 13, "egs": 13, "egy": 295, "egé": 14, "eh": 130,
+			"eha": 18, "ehe": 75, "eho": 30, "ei": 15, "ej": 102, "eje": 62, "ejl": 15, "ek": 368,
+			"ek ": 263, "eke": 32, "eké": 20, "el": 1285, "el ": 132, "eld": 12, "ele": 375, "elf": 14,
+			"elh": 81, "eli": 19, "elj": 21, "elk": 13, "ell": 92, "elm": 70, "els": 51, "elt": 55,
+			"elv": 30, "ely": 86, "elz": 13, "elé": 70, "elö": 17, "elü": 16, "elő": 81, "em": 617,
+			"em ": 484, "eme": 64, "emé": 13, "en": 934, "en ": 282, "end": 127, "ene": 153, "eng": 44,
+			"eni": 18, "enl": 13, "enn": 27, "ent": 180, "eny": 11, "ení": 16, "enü": 11, "enő": 27,
+			"eo": 19, "ep": 49, "epí": 42, "er": 623, "er ": 110, "erb": 12, "ere": 104, "eri": 15,
+			"erj": 13, "erl": 27, "erm": 19, "ern": 41, "err": 19, "ert": 61, "erz": 22, "erá": 15,
+			"erü": 70, "erő": 26, "es": 519, "es ": 228, "ese": 30, "ess": 37, "esz": 65, "esé": 12,
+			"esí": 114, "et": 879, "et ": 242, "eta": 32, "etb": 12, "ete": 101, "eti": 66, "etk": 20,
+			"etl": 32, "etr": 27, "ett": 152, "eté": 41, "etö": 25, "ető": 77, "etű": 21, "ev": 103,
+			"eve": 50, "evé": 13, "evő": 29, "ex": 23, "ext": 11, "ez": 513, "ez ": 130, "ezd": 17,
+			"eze": 144, "ezi": 19, "ezt": 22, "ezv": 11, "ezá": 11, "ezé": 65, "ező": 61, "eá": 79,
+			"eál": 77, "f": 1005, "f ": 15, "fa": 11, "fe": 253, "fej": 55, "fel": 180, "fer": 12,
+			"ff": 17, "ffe": 12, "fi": 83, "fig": 48, "fik": 12, "fo": 235, "fog": 34, "fol": 54,
+			"for": 122, "fr": 41, "fri": 29, "ft": 40, "ft ": 11, "ftv": 25, "fu": 19, "fut": 13,
+			"fá": 235, "fáj": 230, "fü": 23, "füg": 23, "g": 2083, "g ": 238, "ga": 172, "gad": 71,
+			"gat": 66, "gb": 16, "ge": 222, "ge ": 17, "ged": 38, "gek": 13, "ges": 104, "get": 19,
+			"gf": 37, "gfe": 30, "gg": 46, "ggá": 17, "gh": 43, "gha": 11, "ghi": 23, "gi": 54,
+			"gi ": 34, "gj": 29, "gje": 26, "gl": 29, "gla": 15, "gn": 46, "gny": 14, "gná": 11,
+			"go": 132, "gok": 39, "gol": 13, "gom": 19, "gos": 33, "goz": 12, "gp": 12, "gr": 62,
+			"gra": 32, "gre": 19, "gs": 31, "gsz": 12, "gt": 13, "gu": 28, "gum": 14, "gv": 17,
+			"gy": 658, "gy ": 347, "gya": 29, "gye": 122, "gyh": 11, "gyo": 24, "gys": 29, "gyz": 22,
+			"gyá": 20, "gá": 71, "gál": 46, "gát": 18, "gé": 60, "gén": 17, "gép": 23, "gés": 13,
+			"gő": 14, "gű": 12, "gű ": 12, "h": 1550, "h ": 21, "ha": 558, "ha ": 61, "hag": 39,
+			"haj": 18, "hal": 46, "han": 14, "har": 17, "has": 173, "hat": 181, "he": 311, "hel": 78,
+			"het": 150, "hez": 72, "hi": 278, "hib": 110, "hit": 88, "hiv": 12, "hiá": 26, "hiú": 23,
+			"ho": 271, "hog": 38, "hol": 11, "hos": 16, "hoz": 192, "ht": 16, "htt": 13, "há": 28,
+			"hál": 12, "hán": 11, "hí": 40, "hív": 40, "hö": 16, "höz": 16, "i": 2945, "i ": 574,
+			"ia": 94, "ia ": 50, "iad": 12, "ib": 159, "iba": 77, "ibo": 18, "ibá": 27, "ibú": 11,
+			"ic": 36, "id": 88, "id ": 13, "ide": 29, "idé": 12, "idő": 28, "ie": 22, "if": 56,
+			"ife": 23, "ifi": 11, "ig": 96, "ig ": 13, "igy": 47, "ih": 20, "iha": 17, "ij": 12,
+			"ije": 12, "ik": 271, "ik ": 121, "ika": 23, "ike": 49, "iku": 43, "ikö": 12, "il": 222,
+			"il ": 14, "ile": 13, "ill": 110, "ilt": 19, "ilé": 28, "im": 87, "imb": 12, "ime": 53,
+			"in": 392, "in ": 26, "ina": 25, "inc": 84, "ind": 69, "inf": 31, "ink": 29, "ino": 11,
+			"int": 80, "iná": 19, "io": 40, "ion": 25, "ios": 11, "ip": 14, "ir": 35, "irá": 12,
+			"is": 244, "is ": 63, "ism": 22, "iss": 75, "ist": 20, "isz": 39, "it": 161, "ite": 103,
+			"itá": 14, "iv": 45, "iva": 11, "ivá": 18, "iz": 68, "izt": 30, "izá": 16, "iá": 40,
+			"ián": 25, "ií": 52, "iír": 52, "ió": 81, "ió ": 19, "iók": 19, "iós": 20, "iú": 23,
+			"iús": 23, "j": 885, "j ": 12, "ja": 147, "ja ": 119, "jd": 21, "jdo": 19, "je": 250,
+			"je ": 13, "jeg": 25, "jel": 137, "jes": 22, "jez": 37, "jl": 246, "jl ": 95, "jlb": 15,
+			"jln": 13, "jlo": 49, "jlt": 29, "jlé": 11, "jo": 36, "job": 13, "jog": 13, "jr": 24,
+			"jra": 24, "jt": 41, "já": 58, "ják": 11, "ján": 11, "ját": 21, "jé": 14, "jén": 12,
+			"k": 3422, "k ": 1040, "ka": 328, "kai": 12, "kal": 59, "kap": 74, "kar": 88, "kat": 62,
+			"kb": 20, "kc": 11, "kci": 11, "ke": 375, "ke ": 24, "kek": 24, "kel": 67, "ker": 99,
+			"ket": 41, "kez": 96, "kh": 27, "kho": 12, "ki": 342, "ki ": 30, "kia": 12, "kib": 24,
+			"kif": 22, "kih": 17, "kij": 12, "kil": 30, "kim": 43, "kio": 12, "kis": 32, "kit": 12,
+			"kiv": 17, "kií": 52, "kk": 66, "kka": 17, "kke": 18, "kko": 18, "ko": 206, "kom": 14,
+			"kon": 37, "kor": 91, "kos": 11, "koz": 30, "kr": 35, "kre": 13, "ks": 104, "ksv": 11,
+			"ksé": 90, "kt": 118, "kte": 80, "ktu": 13, "ku": 127, "kul": 64, "kum": 11, "kus": 44,
+			"ká": 28, "ké": 203, "kén": 58, "kép": 51, "kér": 53, "kés": 16, "két": 14, "kí": 55,
+			"kít": 27, "kív": 22, "kó": 30, "kód": 28, "kö": 226, "kön": 65, "kör": 15, "köt": 31,
+			"köv": 20, "köz": 86, "kü": 46, "kül": 46, "l": 5367, "l ": 742, "la": 352, "la ": 23,
+			"lag": 11, "laj": 19, "lak": 21, "lal": 19, "lan": 30, "lap": 76, "las": 54, "lat": 81,
+			"lb": 27, "lba": 12, "lc": 71, "lcs": 69, "ld": 50, "ldo": 12, "ldá": 15, "le": 927,
+			"le ": 29, "leg": 49, "leh": 70, "lej": 11, "lek": 22, "lel": 39, "lem": 59, "len": 300,
+			"lep": 42, "les": 136, "let": 108, "lez": 25, "leá": 11, "lf": 20, "lg": 54, "lgo": 15,
+			"lgá": 37, "lh": 136, "lha": 123, "li": 186, "li ": 53, "lik": 16, "lin": 28, "lis": 51,
+			"lj": 56, "lja": 29, "lje": 23, "lk": 86, "lka": 41, "lke": 12, "lkü": 20, "ll": 403,
+			"ll ": 57, "lla": 50, "lle": 155, "llo": 11, "llí": 104, "lm": 176, "lma": 92, "lme": 66,
+			"ln": 51, "lne": 16, "lni": 23, "lo": 184, "loc": 27, "lok": 65, "lom": 12, "lot": 47,
+			"lr": 17, "lre": 13, "ls": 81, "lso": 17, "lsz": 15, "lsó": 13, "lső": 24, "lt": 426,
+			"lt ": 199, "lta": 64, "lte": 27, "lto": 38, "lts": 12, "ltá": 33, "lté": 27, "lu": 18,
+			"lv": 94, "lva": 43, "lve": 16, "lvá": 20, "lvé": 14, "ly": 216, "ly ": 24, "lya": 54,
+			"lye": 89, "lyi": 13, "lyo": 12, "lz": 15, "lá": 273, "láb": 19, "lál": 32, "lán": 35,
+			"lás": 133, "lát": 14, "láí": 24, "lé": 229, "léc": 11, "lém": 18, "lép": 47, "lér": 19,
+			"lés": 57, "lét": 57, "lév": 12, "lí": 136, "lít": 133, "ló": 186, "ló ": 63, "lói": 11,
+			"lók": 36, "lón": 12, "lós": 24, "lóz": 14, "lö": 29, "löl": 17, "lü": 19, "lül": 16,
+			"lő": 96, "lő ": 34, "lőt": 15, "m": 2637, "m ": 642, "ma": 323, "ma ": 23, "mad": 11,
+			"mag": 88, "mai": 12, "mar": 13, "mat": 40, "maz": 94, "mb": 62, "mba": 11, "mbo": 25,
+			"me": 734, "me ": 15, "meg": 355, "mel": 25, "men": 162, "mer": 38, "met": 48, "mez": 73,
+			"mi": 142, "mi ": 12, "mik": 17, "min": 69, "mk": 30, "mke": 16, "ml": 18, "ml ": 11,
+			"mm": 21, "mn": 12, "mo": 125, "mog": 53, "mok": 29, "mot": 11, "mp": 17, "mt": 16,
+			"mu": 24, "mun": 12, "mz": 12, "má": 213, "mác": 26, "mán": 36, "már": 37, "más": 53,
+			"mát": 29, "mé": 86, "méd": 18, "mén": 18, "mér": 17, "mét": 11, "mí": 11, "mó": 55,
+			"mód": 51, "mö": 15, "mör": 13, "mű": 33, "műv": 23, "n": 4130, "n ": 635, "na": 215,
+			"na ": 21, "nag": 33, "nak": 105, "nal": 23, "nat": 17, "nc": 194, "nc ": 18, "nci": 11,
+			"ncs": 145, "nd": 219, "nde": 54, "nds": 82, "ndí": 30, "ndó": 13, "ne": 853, "ne ": 39,
+			"neg": 18, "nek": 121, "nem": 449, "ner": 18, "net": 131, "nev": 58, "nf": 38, "nfo": 29,
+			"ng": 71, "nge": 45, "ni": 243, "ni ": 108, "nia": 22, "nin": 83, "nk": 63, "nk ": 11,
+			"nka": 13, "nke": 12, "nl": 29, "nle": 11, "nn": 56, "nna": 16, "nne": 18, "no": 112,
+			"nos": 79, "ns": 52, "nsá": 30, "nt": 414, "nt ": 83, "nta": 13, "nte": 58, "ntk": 19,
+			"nto": 25, "ntu": 36, "nty": 99, "ntá": 22, "nté": 30, "nu": 57, "nul": 38, "ny": 406,
+			"ny ": 41, "nye": 76, "nyi": 33, "nyo": 70, "nyt": 48, "nyv": 61, "nyz": 26, "nz": 11,
+			"ná": 231, "nál": 214, "né": 113, "néh": 11, "nél": 34, "név": 52, "ní": 18, "nít": 18,
+			"nö": 12, "nü": 11, "nő": 32, "nőr": 27, "o": 3165, "o ": 22, "ob": 46, "obb": 16,
+			"obl": 19, "oc": 53, "ock": 37, "od": 38, "ode": 21, "of": 35, "oft": 26, "og": 172,
+			"oga": 64, "ogl": 18, "ogo": 13, "ogr": 23, "ogy": 37, "ok": 337, "ok ": 206, "oka": 43,
+			"okk": 22, "oko": 20, "ol": 421, "ol ": 28, "ola": 22, "olg": 50, "oli": 20, "ols": 11,
+			"olv": 44, "oly": 57, "olá": 71, "olí": 21, "oló": 47, "om": 183, "om ": 19, "oma": 77,
+			"omb": 24, "omá": 26, "on": 360, "on ": 73, "ona": 23, "onl": 12, "ono": 68, "ons": 32,
+			"ont": 77, "oná": 12, "op": 53, "ope": 14, "opo": 26, "or": 416, "or ": 97, "ord": 30,
+			"orl": 11, "orm": 69, "orn": 18, "oro": 35, "orr": 44, "ors": 16, "ort": 44, "os": 322,
+			"os ": 132, "osa": 13, "oss": 26, "osu": 12, "osz": 33, "osí": 82, "ot": 328, "ot ": 72,
+			"ott": 222, "ov": 13, "ox": 12, "oxy": 12, "oz": 319, "oz ": 138, "oza": 15, "ozh": 22,
+			"ozi": 20, "ozz": 23, "ozá": 59, "ozó": 15, "p": 933, "p ": 73, "pa": 105, "par": 69,
+			"pc": 81, "pcs": 73, "pe": 101, "pec": 15, "per": 32, "pi": 21, "pl": 15, "po": 113,
+			"pon": 27, "por": 38, "pot": 25, "poz": 12, "pp": 27, "pps": 14, "pr": 76, "pro": 62,
+			"ps": 40, "ps ": 22, "pst": 14, "pt": 16, "pt ": 11, "pu": 62, "puf": 12, "pus": 47,
+			"pv": 11, "pé": 85, "pén": 11, "pér": 33, "pés": 31, "pí": 49, "pít": 49, "q": 21,
+			"qw": 16, "qwe": 16, "r": 3170, "r ": 303, "ra": 457, "ra ": 132, "rab": 13, "rai": 11,
+			"rak": 114, "ram": 31, "ran": 82, "rat": 42, "rb": 25, "rba": 12, "rc": 51, "rch": 32,
+			"rd": 48, "rdí": 16, "re": 474, "re ": 104, "rea": 24, "red": 13, "reg": 12, "reh": 41,
+			"rek": 43, "rel": 16, "rem": 11, "ren": 118, "res": 45, "ret": 24, "rg": 31, "rgu": 14,
+			"rh": 55, "rhe": 41, "ri": 130, "ri ": 21, "rib": 11, "ris": 36, "riz": 14, "rj": 24,
+			"rje": 13, "rk": 12, "rl": 78, "rl ": 24, "rlá": 37, "rm": 113, "rma": 17, "rmá": 65,
+			"rn": 90, "rna": 24, "rni": 15, "rny": 22, "rná": 12, "ro": 191, "rob": 20, "rog": 26,
+			"rok": 11, "rol": 46, "rom": 11, "ros": 15, "rox": 12, "roz": 23, "rr": 71, "rre": 19,
+			"rrá": 37, "rs": 30, "rsí": 13, "rt": 353, "rt ": 63, "rta": 67, "rte": 66, "rto": 37,
+			"rty": 12, "rté": 64, "rv": 95, "rvé": 80, "rz": 46, "rzi": 23, "rzé": 11, "rá": 183,
+			"rál": 15, "rán": 22, "rás": 120, "ré": 83, "rég": 15, "rés": 59, "rí": 26, "rít": 22,
+			"ró": 34, "rö": 19, "rü": 74, "rül": 74, "rő": 40, "rőf": 19, "ről": 12, "s": 5243,
+			"s ": 1199, "sa": 417, "sa ": 240, "sai": 13, "sak": 81, "sal": 13, "san": 19, "sat": 29,
+			"sb": 22, "se": 263, "se ": 124, "sek": 24, "sem": 13, "sen": 35, "set": 19, "sh": 72,
+			"sha": 11, "she": 20, "sho": 24, "si": 206, "si ": 113, "sik": 67, "sk": 39, "sko": 22,
+			"sm": 25, "sme": 18, "sn": 13, "so": 399, "soc": 12, "sok": 69, "sol": 85, "som": 63,
+			"son": 29, "sop": 23, "sor": 77, "sot": 15, "sp": 23, "spe": 15, "sr": 49, "sra": 33,
+			"sre": 11, "ss": 242, "ssa": 11, "ssz": 156, "ssé": 22, "ssí": 25, "st": 132, "st ": 55,
+			"sta": 18, "str": 25, "stá": 13, "su": 50, "sul": 35, "sun": 11, "sv": 19, "sv ": 14,
+			"sz": 1218, "sz ": 34, "sza": 147, "sze": 197, "szi": 77, "szk": 22, "szl": 26, "szn": 170,
+			"szo": 77, "szt": 124, "szá": 116, "szé": 18, "szí": 20, "szó": 27, "szö": 22, "szü": 103,
+			"sző": 11, "sá": 206, "ság": 60, "sáh": 64, "sán": 15, "sár": 20, "sás": 21, "sát": 20,
+			"sé": 261, "ség": 149, "séh": 38, "sém": 13, "sén": 12, "sér": 26, "sés": 12, "sí": 266,
+			"sít": 266, "só": 14, "só ": 11, "sú": 30, "sú ": 22, "ső": 30, "ső ": 21, "t": 6292,
+			"t ": 1453, "ta": 500, "ta ": 55, "taa": 14, "tag": 20, "tai": 19, "tak": 14, "tal": 163,
+			"tam": 14, "tan": 26, "tar": 97, "tat": 51, "tb": 30, "tc": 29, "tcs": 19, "te": 741,
+			"te ": 28, "tek": 34, "tel": 291, "ten": 62, "ter": 139, "tes": 28, "tet": 82, "tev": 29,
+			"tez": 15, "tf": 46, "tfo": 35, "th": 60, "tha": 30, "the": 18, "ti": 175, "ti ": 71,
+			"tik": 27, "til": 20, "tj": 53, "tja": 38, "tjá": 12, "tk": 66, "tke": 30, "tko": 17,
+			"tl": 87, "tla": 27, "tle": 34, "tlá": 13, "tm": 16, "tn": 24, "to": 386, "tok": 45,
+			"tol": 33, "tom": 28, "ton": 25, "tor": 32, "tos": 28, "tot": 129, "toz": 41, "tp": 19,
+			"tp ": 13, "tr": 160, "tra": 16, "tre": 81, "tri": 21, "trl": 12, "ts": 47, "tsz": 11,
+			"tt": 466, "tt ": 371, "tta": 25, "tte": 25, "ttp": 13, "ttr": 12, "tu": 141, "tu ": 11,
+			"tul": 19, "tum": 72, "tv": 85, "tva": 19, "tve": 41, "tvo": 13, "ty": 121, "ty ": 17,
+			"tyű": 98, "tá": 579, "ták": 12, "tál": 12, "tám": 53, "tán": 23, "tár": 112, "tás": 312,
+			"táv": 33, "té": 356, "ték": 62, "tén": 39, "tér": 13, "tés": 226, "tét": 11, "tí": 83,
+			"típ": 47, "tív": 24, "tó": 234, "tó ": 138, "tók": 25, "tót": 23, "tö": 124, "töb": 33,
+			"töl": 28, "töm": 14, "tör": 44, "tú": 42, "túl": 37, "tő": 121, "tő ": 89, "tők": 13,
+			"tű": 28, "u": 806, "u ": 27, "ub": 12, "ubu": 12, "uf": 12, "uff": 12, "ug": 15,
+			"uk": 14, "ul": 202, "ul ": 31, "ula": 20, "ulc": 64, "ull": 29, "ult": 44, "um": 140,
+			"um ": 42, "umb": 12, "ume": 30, "umo": 21, "un": 54, "un ": 11, "unk": 16, "unt": 14,
+			"ur": 35, "url": 12, "us": 144, "us ": 78, "usa": 15, "usú": 17, "ut": 95, "uta": 12,
+			"uto": 27, "utá": 25, "uá": 12, "uál": 12, "v": 1518, "v ": 87, "va": 411, "va ": 93,
+			"vag": 140, "val": 66, "van": 60, "vas": 35, "ve": 293, "ve ": 69, "veg": 17, "vek": 22,
+			"vel": 53, "ver": 59, "ves": 14, "vet": 35, "vez": 18, "vi": 88, "vid": 18, "vis": 56,
+			"vo": 69, "vol": 35, "von": 29, "vt": 63, "vtá": 60, "vu": 28, "vum": 26, "vá": 203,
+			"vál": 90, "ván": 57, "vár": 33, "vé": 172, "vég": 42, "vén": 94, "vét": 20, "ví": 14,
+			"vít": 13, "vü": 19, "vül": 19, "vő": 40, "vő ": 29, "w": 80, "wa": 17, "war": 14,
+			"we": 25, "wer": 18, "wi": 16, "win": 14, "x": 90, "x ": 37, "xt": 12, "xy": 12,
+			"y": 1435, "y ": 450, "ya": 88, "yam": 52, "yar": 17, "yb": 12, "ybe": 11, "ye": 287,
+			"yed": 12, "yel": 62, "yen": 20, "yes": 82, "yet": 20, "yez": 65, "yh": 13, "yi": 51,
+			"yi ": 14, "yil": 14, "yit": 15, "yn": 15, "yo": 106, "yom": 18, "yon": 11, "yor": 12,
+			"yos": 49, "yp": 12, "ys": 37, "ysz": 22, "ysé": 13, "yt": 56, "yte": 44, "yv": 65,
+			"yvt": 60, "yz": 51, "yzi": 21, "yzé": 21, "yá": 26, "yás": 18, "yé": 13, "yű": 101,
+			"yű ": 12, "yűk": 62, "yűz": 11, "z": 3218, "z ": 826, "za": 189, "za ": 15, "zab": 56,
+			"zak": 34, "zat": 35, "zav": 18, "zb": 25, "zbe": 22, "zd": 20, "zdő": 13, "ze": 411,
+			"ze ": 20, "zel": 39, "zen": 39, "zer": 114, "zes": 11, "zet": 149, "zh": 50, "zha": 36,
+			"zhe": 14, "zi": 201, "zi ": 25, "zig": 12, "zik": 51, "zim": 13, "zin": 51, "zió": 22,
+			"zk": 31, "zkö": 14, "zl": 26, "zle": 16, "zn": 187, "zne": 12, "zni": 13, "zná": 157,
+			"zo": 180, "zof": 25, "zok": 13, "zol": 49, "zon": 66, "zot": 23, "zs": 12, "zt": 195,
+			"zt ": 23, "zta": 18, "zte": 18, "zto": 46, "ztr": 14, "ztá": 34, "zté": 16, "zv": 26,
+			"zve": 21, "zz": 36, "zze": 13, "zzá": 20, "zá": 289, "zám": 98, "zár": 39, "zás": 108,
+			"zé": 134, "zél": 16, "zés": 107, "zí": 26, "zít": 11, "zó": 71, "zó ": 39, "zók": 13,
+			"zö": 39, "zöv": 12, "zü": 105, "zük": 90, "ző": 100, "ző ": 55, "á": 3049, "á ": 12,
+			"áb": 58, "ába": 18, "ább": 25, "ác": 45, "áci": 45, "ág": 69, "ág ": 14, "ági": 11,
+			"ágo": 16, "áh": 70, "áho": 70, "áj": 264, "ájl": 229, "ájt": 13, "ák": 48, "ák ": 42,
+			"ál": 658, "ál ": 39, "ála": 87, "álh": 41, "áli": 31, "álj": 20, "áll": 145, "ált": 126,
+			"ály": 13, "álá": 40, "áló": 93, "ám": 151, "ám ": 22, "áma": 11, "ámo": 65, "ámá": 29,
+			"án": 247, "án ": 37, "ána": 37, "ánc": 27, "áno": 12, "ány": 117, "ár": 272, "ár ": 48,
+			"ára": 89, "áro": 45, "ás": 828, "ás ": 162, "ása": 265, "ásh": 12, "ási": 73, "ásk": 22,
+			"áso": 82, "ásr": 32, "áss": 11, "ást": 24, "ásá": 112, "át": 187, "át ": 66, "áta": 13,
+			"átl": 16, "áto": 25, "átu": 35, "áv": 49, "áva": 15, "ávo": 29, "áz": 39, "ázo": 20,
+			"áí": 24, "áír": 24, "é": 2232, "éb": 14, "éc": 12, "éd": 23, "édi": 18, "ég": 221,
+			"ég ": 29, "ége": 121, "égi": 19, "égr": 15, "égé": 11, "égű": 11, "éh": 49, "éhe": 38,
+			"éhá": 11, "ék": 80, "ék ": 26, "éke": 36, "él": 147, "él ": 21, "éle": 23, "élk": 25,
+			"ély": 48, "ém": 42, "éma": 17, "émá": 14, "én": 265, "én ": 20, "éne": 54, "ént": 51,
+			"ény": 127, "ép": 127, "ép ": 17, "épe": 36, "épé": 39, "ér": 330, "ére": 30, "érh": 27,
+			"ért": 122, "érv": 81, "éré": 31, "és": 689, "és ": 326, "ése": 135, "ésh": 16, "ési": 54,
+			"ésr": 11, "ést": 15, "ész": 54, "ésé": 67, "ét": 124, "ét ": 32, "éte": 44, "étr": 41,
+			"év": 75, "év ": 35, "éve": 13, "évő": 11, "éz": 20, "í": 952, "íl": 11, "ím": 40,
+			"ímk": 24, "ín": 13, "íp": 47, "ípu": 47, "ír": 115, "íra": 14, "írá": 75, "ít": 610,
+			"íta": 13, "íte": 37, "íth": 26, "ítj": 21, "íto": 38, "íts": 13, "ítv": 26, "ítá": 177,
+			"íté": 156, "ító": 66, "ítő": 27, "ív": 91, "ív ": 25, "ívu": 26, "ívü": 19, "íz": 11,
+			"ó": 798, "ó ": 313, "óa": 13, "ób": 21, "ód": 91, "ód ": 19, "ódo": 45, "ói": 15,
+			"ói ": 12, "ój": 19, "ók": 98, "ók ": 56, "ókö": 13, "ól": 51, "ól ": 48, "ón": 22,
+			"óna": 11, "ór": 17, "ós": 47, "ós ": 18, "ósí": 15, "ót": 52, "ót ": 32, "ótá": 12,
+			"óz": 14, "óza": 12, "ö": 577, "öb": 33, "öbb": 33, "öl": 57, "ölt": 40, "öm": 15,
+			"ömö": 13, "ön": 85, "öny": 61, "ör": 82, "örh": 11, "örl": 11, "örn": 14, "örí": 13,
+			"ös": 75, "öss": 66, "öt": 51, "öte": 22, "ött": 16, "öv": 46, "öve": 39, "öz": 111,
+			"öz ": 32, "özb": 22, "özö": 15, "ú": 202, "ú ": 43, "új": 48, "újr": 24, "úl": 40,
+			"úl ": 26, "ús": 31, "úsu": 23, "út": 23, "útv": 13, "ü": 358, "üg": 29, "ügg": 23,
+			"ük": 96, "üks": 90, "ül": 169, "ül ": 50, "üld": 13, "üli": 16, "ült": 56, "ür": 17,
+			"üre": 14, "üz": 19, "üze": 19, "ő": 573, "ő ": 258, "őb": 20, "őd": 21, "őf": 23,
+			"őfo": 21, "őj": 13, "ők": 49, "ők ": 22, "őké": 13, "ől": 38, "ől ": 34, "őr": 29,
+			"őri": 13, "őrz": 16, "ős": 25, "ősé": 13, "őt": 28, "őtt": 11, "őv": 15, "őz": 12,
+			"ű": 202, "ű ": 49, "űk": 75, "űk ": 44, "űv": 25, "űve": 25, "űz": 16, "űze": 11,
+		}},
+		"it": {Total: 222648, Ngrams: map[string]int{
+			" a": 793, " a ": 73, " ab": 12, " ac": 29, " ad": 10, " af": 7, " ag": 51, " ai": 10,
+			" al": 228, " am": 10, " an": 46, " ap": 47, " ar": 76, " as": 26, " at": 63, " au": 61,
+			" av": 29, " az": 14, " b": 157, " ba": 32, " bi": 33, " bl": 33, " bo": 5, " br": 28,
+			" bu": 17, " by": 3, " c": 1134, " c ": 11, " ca": 110, " cd": 7, " ce": 30, " ch": 161,
+			" ci": 25, " cl": 21, " co": 678, " cr": 59, " ct": 11, " cu": 17, " d": 1663, " d ": 12,
+			" da": 173, " de": 626, " di": 729, " dn": 6, " do": 50, " dr": 4, " ds": 3, " du": 47,
+			" dv": 5, " e": 534, " e ": 93, " ec": 5, " ed": 9, " ef": 8, " el": 66, " em": 8,
+			" en": 15, " eo": 3, " ep": 4, " er": 77, " es": 233, " eu": 3, " ev": 3, " ex": 4,
+			" f": 427, " fa": 32, " fe": 6, " fi": 268, " fl": 11, " fo": 67, " fr": 13, " fs": 4,
+			" fu": 23, " g": 218, " ga": 5, " ge": 46, " gi": 47, " gl": 46, " gn": 12, " gr": 39,
+			" gs": 8, " gu": 4, " gz": 3, " h": 108, " ha": 44, " he": 31, " ho": 11, " ht": 8,
+			" hu": 10, " i": 1286, " i ": 173, " id": 42, " ig": 19, " il": 335, " im": 208, " in": 485,
+			" ip": 5, " is": 12, " k": 12, " ke": 8, " l": 730, " l ": 181, " la": 249, " ld": 3,
+			" le": 157, " li": 74, " lo": 56, " lu": 6, " m": 445, " ma": 111, " me": 127, " mi": 20,
+			" mm": 3, " mo": 138, " ms": 3, " mu": 32, " n": 781, " n ": 5, " ne": 188, " no": 506,
+			" nu": 76, " o": 312, " o ": 66, " ob": 8, " oc": 11, " of": 18, " og": 43, " om": 7,
+			" on": 3, " op": 71, " or": 25, " ot": 5, " ou": 43, " ov": 6, " p": 819, " pa": 136,
+			" pe": 267, " pi": 48, " pk": 3, " pl": 8, " po": 83, " pr": 206, " ps": 3, " pu": 61,
+			" q": 134, " qu": 127, " qw": 6, " r": 551, " ra": 16, " re": 185, " ri": 335, " ro": 10,
+			" ru": 3, " s": 1293, " sa": 37, " sb": 5, " sc": 114, " se": 252, " sh": 16, " si": 144,
+			" sl": 4, " so": 222, " sp": 123, " ss": 8, " st": 215, " su": 144, " t": 357, " ta": 75,
+			" te": 74, " ti": 41, " tl": 7, " to": 11, " tr": 104, " tu": 40, " u": 530, " u ": 3,
+			" ug": 4, " ul": 7, " um": 4, " un": 345, " up": 7, " ur": 12, " us": 82, " ut": 61,
+			" v": 317, " va": 96, " ve": 94, " vi": 73, " vo": 25, " vu": 28, " w": 22, " wa": 3,
+			" we": 4, " wh": 3, " wi": 8, " x": 11, " x ": 7, " xm": 4, " y": 4, " ya": 3,
+			" z": 12, " za": 4, " ze": 7, " è": 201, " è ": 201, "a": 6083, "a ": 1939, "aa": 3,
+			"ab": 79, "aba": 12, "abb": 3, "abe": 6, "abi": 45, "abo": 7, "ac": 151, "acc": 83,
+			"ace": 5, "ach": 11, "aci": 4, "ack": 41, "aco": 3, "ad": 62, "ad ": 28, "ada": 9,
+			"add": 3, "ade": 14, "ae": 6, "ae ": 3, "aem": 3, "af": 30, "aff": 9, "afi": 8,
+			"afo": 12, "ag": 163, "ag ": 11, "age": 5, "agg": 103, "agi": 23, "agl": 12, "agn": 4,
+			"ai": 62, "ai ": 22, "ail": 8, "ain": 10, "aiu": 20, "aj": 4, "ajo": 4, "ak": 8,
+			"ak ": 3, "ake": 4, "al": 679, "al ": 73, "ala": 8, "alb": 32, "alc": 31, "ale": 66,
+			"alf": 6, "alg": 7, "ali": 181, "all": 147, "alm": 18, "alo": 37, "als": 10, "alt": 55,
+			"alv": 8, "am": 255, "am ": 24, "ama": 6, "amb": 23, "ame": 105, "ami": 24, "aml": 3,
+			"amm": 21, "amo": 4, "amp": 45, "an": 379, "an ": 9, "ana": 16, "anc": 75, "and": 114,
+			"ane": 12, "ang": 3, "ani": 13, "ann": 26, "ano": 26, "ans": 5, "ant": 65, "anu": 4,
+			"anz": 7, "ap": 80, "ap ": 8, "api": 6, "apo": 5, "app": 46, "apr": 5, "apt": 5,
+			"ar": 629, "ar ": 8, "ara": 66, "arb": 3, "arc": 48, "ard": 30, "are": 304, "arg": 22,
+			"ari": 65, "arl": 9, "aro": 6, "arr": 5, "ars": 11, "art": 38, "arz": 3, "arà": 7,
+			"as": 230, "asc": 14, "ase": 49, "ash": 9, "asi": 5, "ass": 81, "ast": 56, "asu": 3,
+			"at": 830, "at ": 17, "ata": 139, "atc": 16, "ate": 42, "ati": 169, "ato": 307, "att": 132,
+			"atu": 4, "au": 77, "aum": 3, "aur": 3, "aus": 13, "aut": 55, "av": 140, "ave": 64,
+			"avi": 13, "avo": 32, "avv": 27, "ay": 6, "az": 269, "azi": 264, "azz": 5, "b": 715,
+			"b ": 15, "ba": 64, "bac": 5, "bal": 4, "bar": 4, "bas": 41, "bat": 3, "bb": 42,
+			"bbe": 19, "bbl": 20, "be": 64, "be ": 18, "bel": 6, "ber": 36, "bi": 303, "bi ": 7,
+			"bia": 23, "bie": 3, "bil": 224, "bin": 17, "bir": 6, "bis": 7, "bit": 13, "bl": 74,
+			"ble": 13, "bli": 22, "blo": 38, "bo": 48, "bol": 33, "boo": 3, "bor": 6, "br": 42,
+			"bra": 32, "bre": 7, "bs": 6, "bso": 3, "bu": 46, "buf": 5, "bug": 12, "bui": 4,
+			"bul": 3, "bum": 3, "bun": 6, "but": 7, "by": 3, "byt": 3, "c": 3021, "c ": 57,
+			"ca": 556, "ca ": 135, "cac": 10, "cad": 8, "cal": 24, "cam": 39, "can": 22, "car": 123,
+			"cas": 6, "cat": 132, "cau": 8, "caz": 44, "cc": 145, "cc ": 3, "cca": 11, "cce": 34,
+			"cch": 60, "cci": 12, "cco": 20, "ccu": 3, "cd": 7, "cd ": 4, "ce": 232, "ce ": 85,
+			"ced": 9, "ceg": 6, "cel": 10, "cen": 13, "cer": 42, "ces": 46, "cet": 9, "cev": 8,
+			"ch": 474, "ch ": 49, "che": 174, "chi": 235, "ché": 9, "ci": 255, "ci ": 40, "cia": 33,
+			"cic": 4, "cie": 3, "cif": 79, "cin": 4, "cis": 3, "cit": 57, "ciu": 17, "ciò": 3,
+			"ck": 69, "ck ": 35, "cke": 10, "cki": 3, "cko": 10, "cks": 7, "cl": 46, "cla": 5,
+			"cle": 5, "cli": 4, "clo": 14, "clu": 17, "cm": 10, "cma": 10, "co": 862, "co ": 62,
+			"cod": 24, "col": 47, "com": 223, "con": 363, "cop": 14, "cor": 115, "cos": 10, "cr": 145,
+			"cra": 15, "cre": 53, "cri": 63, "crl": 4, "cro": 10, "cs": 10, "csp": 8, "ct": 55,
+			"ct ": 5, "cto": 36, "ctr": 11, "cu": 91, "cui": 16, "cum": 11, "cun": 24, "cup": 12,
+			"cur": 15, "cuz": 12, "d": 2526, "d ": 154, "da": 275, "da ": 87, "dab": 5, "dae": 3,
+			"dag": 3, "dal": 69, "dam": 4, "dan": 8, "dap": 4, "dar": 23, "dat": 64, "db": 3,
+			"dd": 6, "ddi": 3, "de": 809, "de ": 47, "deb": 10, "dec": 11, "def": 42, "deg": 11,
+			"dei": 90, "del": 398, "dem": 10, "den": 35, "deo": 4, "der": 48, "des": 37, "det": 12,
+			"dev": 42, "dex": 7, "di": 972, "di ": 581, "dia": 17, "dib": 6, "dic": 55, "dif": 93,
+			"dig": 5, "dim": 16, "din": 22, "dip": 6, "dir": 78, "dis": 54, "dit": 11, "div": 22,
+			"diz": 4, "dl": 6, "dle": 4, "dn": 6, "dns": 6, "do": 188, "do ": 128, "doc": 10,
+			"dom": 4, "don": 7, "dop": 22, "dov": 11, "dp": 3, "dr": 10, "dra": 3, "dro": 3,
+			"ds": 7, "dsh": 3, "du": 67, "due": 6, "dul": 15, "dum": 4, "dup": 3, "dur": 35,
+			"dv": 6, "dvo": 3, "dx": 4, "dx ": 4, "e": 8055, "e ": 2993, "ea": 111, "ea ": 28,
+			"ead": 24, "eal": 6, "eam": 23, "ear": 14, "eat": 10, "eaz": 5, "eb": 47, "eb ": 3,
+			"eba": 11, "ebb": 19, "ebu": 9, "ec": 237, "ec ": 5, "eca": 3, "ecc": 22, "ece": 21,
+			"eci": 86, "eck": 11, "eco": 22, "ect": 41, "ecu": 23, "ed": 110, "ed ": 18, "eda": 3,
+			"ede": 61, "edi": 23, "ee": 9, "eep": 3, "ef": 60, "efe": 6, "eff": 8, "efi": 42,
+			"eg": 258, "ega": 25, "egg": 30, "egi": 23, "egl": 20, "egm": 11, "egn": 35, "ego": 13,
+			"egr": 14, "egu": 84, "ei": 102, "ei ": 96, "ein": 3, "el": 701, "el ": 230, "ela": 17,
+			"ele": 58, "elf": 5, "eli": 30, "ell": 343, "elp": 11, "elt": 4, "em": 164, "em ": 3,
+			"ema": 37, "emb": 7, "eme": 35, "emi": 8, "emo": 45, "emp": 23, "emu": 6, "en": 759,
+			"en ": 7, "ena": 3, "enc": 32, "end": 40, "ene": 69, "eng": 4, "eni": 6, "eno": 14,
+			"enp": 6, "ens": 24, "ent": 453, "enu": 21, "enz": 78, "eo": 20, "eo ": 12, "eol": 4,
+			"ep": 55, "epa": 9, "epo": 26, "epr": 4, "epu": 10, "eq": 6, "equ": 6, "er": 1215,
+			"er ": 287, "era": 94, "erc": 45, "ere": 200, "erf": 5, "erg": 39, "eri": 111, "erm": 48,
+			"ern": 22, "ero": 74, "erp": 5, "err": 101, "ers": 66, "ert": 46, "erv": 58, "erz": 11,
+			"es": 738, "es ": 7, "esa": 24, "esc": 30, "ese": 120, "esi": 23, "eso": 18, "esp": 22,
+			"ess": 261, "est": 228, "et": 279, "et ": 19, "eta": 39, "etc": 5, "ete": 18, "eti": 9,
+			"eto": 3, "etr": 12, "ets": 3, "ett": 168, "eu": 4, "ev": 92, "ev ": 6, "eva": 5,
+			"eve": 29, "evi": 18, "evo": 27, "evu": 7, "ew": 4, "ex": 14, "ex ": 9, "ey": 3,
+			"eys": 3, "ez": 71, "ezi": 53, "ezz": 17, "f": 1009, "f ": 33, "fa": 40, "fac": 7,
+			"fal": 10, "far": 4, "fat": 6, "fe": 63, "fer": 51, "fet": 10, "ff": 76, "ff ": 19,
+			"ffe": 22, "ffi": 15, "ffs": 13, "fi": 557, "fia": 4, "fic": 203, "fid": 7, "fig": 28,
+			"fil": 202, "fin": 64, "fir": 38, "fis": 4, "fiu": 5, "fl": 22, "fli": 9, "flu": 7,
+			"fo": 128, "fo ": 20, "fog": 4, "fon": 10, "for": 91, "fr": 23, "fra": 13, "fro": 6,
+			"fs": 20, "fse": 7, "fst": 8, "ft": 20, "ftw": 17, "fu": 24, "fun": 11, "fuo": 10,
+			"g": 1259, "g ": 60, "ga": 79, "ga ": 45, "gam": 6, "gan": 4, "gar": 13, "gat": 7,
+			"ge": 187, "ge ": 56, "gen": 36, "ger": 26, "ges": 22, "get": 45, "gg": 176, "gge": 63,
+			"ggi": 111, "gh": 35, "ghe": 33, "gi": 233, "gi ": 20, "gia": 11, "gib": 6, "gic": 4,
+			"gin": 28, "gio": 68, "gis": 18, "git": 40, "giu": 27, "già": 8, "gl": 98, "gli": 89,
+			"glo": 6, "gm": 12, "gme": 11, "gn": 83, "gna": 27, "gni": 15, "gno": 30, "gnu": 9,
+			"go": 57, "go ": 9, "gol": 17, "gom": 15, "gor": 8, "gp": 9, "gp ": 7, "gr": 87,
+			"gr ": 6, "gra": 53, "gre": 12, "gru": 12, "gs": 9, "gss": 4, "gst": 4, "gu": 128,
+			"gua": 13, "gue": 27, "gui": 61, "gur": 23, "h": 685, "h ": 81, "ha": 55, "ha ": 29,
+			"hai": 4, "hal": 7, "han": 10, "he": 246, "he ": 134, "hea": 20, "hec": 11, "hed": 4,
+			"hel": 17, "hem": 4, "her": 9, "het": 38, "hez": 6, "hi": 242, "hi ": 18, "hia": 87,
+			"hie": 70, "hin": 4, "hio": 8, "hit": 5, "hiu": 11, "hiv": 34, "ho": 16, "hos": 4,
+			"hr": 10, "hra": 7, "ht": 8, "htt": 8, "hu": 11, "hun": 9, "hé": 9, "hé ": 9,
+			"i": 8290, "i ": 2005, "ia": 324, "ia ": 91, "iab": 5, "ial": 35, "iam": 13, "ian": 21,
+			"iar": 23, "ias": 11, "iat": 41, "iav": 72, "iaz": 4, "ib": 210, "ibe": 5, "ibi": 186,
+			"ibr": 6, "ibu": 11, "ic": 575, "ic ": 6, "ica": 305, "ice": 74, "ich": 103, "ici": 22,
+			"icl": 4, "ico": 42, "icu": 13, "id": 140, "id ": 39, "ida": 22, "ide": 32, "idi": 9,
+			"ido": 35, "ie": 162, "ie ": 9, "ied": 11, "ieg": 6, "iem": 9, "ien": 44, "ier": 10,
+			"ies": 60, "iet": 9, "if": 255, "ife": 26, "iff": 28, "ifi": 197, "ig": 116, "iga": 23,
+			"igh": 14, "igi": 10, "igl": 10, "ign": 26, "igu": 28, "il": 828, "il ": 345, "ila": 5,
+			"ile": 382, "ili": 72, "ilm": 5, "ilo": 5, "ilt": 10, "im": 434, "im ": 7, "ima": 38,
+			"imb": 31, "ime": 63, "imi": 32, "imm": 25, "imo": 36, "imp": 189, "imu": 13, "in": 865,
+			"in ": 150, "ina": 133, "inc": 31, "ind": 85, "ine": 44, "inf": 36, "ing": 54, "ini": 93,
+			"ino": 18, "inp": 31, "ins": 44, "int": 102, "inu": 12, "inv": 24, "io": 721, "io ": 140,
+			"ion": 537, "ior": 35, "ios": 4, "ip": 108, "ip ": 7, "ipe": 25, "ipl": 7, "ipo": 36,
+			"ipr": 20, "ipt": 4, "ipv": 4, "ir": 191, "ir ": 6, "ire": 101, "irg": 6, "iri": 28,
+			"irm": 42, "is": 335, "isa": 22, "isc": 37, "ise": 10, "isi": 21, "iso": 29, "isp": 59,
+			"iss": 6, "ist": 105, "isu": 43, "it": 495, "it ": 119, "ita": 97, "ite": 28, "iti": 22,
+			"itm": 10, "ito": 101, "itt": 40, "itu": 16, "ità": 61, "iu": 119, "iun": 27, "ius": 66,
+			"iut": 24, "iv": 182, "iva": 22, "ive": 70, "ivi": 66, "ivo": 24, "ix": 4, "ix ": 4,
+			"iz": 190, "izi": 75, "izz": 114, "ià": 8, "ià ": 8, "iù": 15, "iù ": 15, "j": 12,
+			"je": 4, "jo": 6, "jor": 4, "k": 127, "k ": 59, "ka": 7, "ke": 26, "ke ": 4,
+			"ken": 4, "ket": 5, "ki": 5, "ko": 11, "kou": 10, "ks": 9, "ksv": 4, "l": 4287,
+			"l ": 1052, "la": 548, "la ": 402, "lab": 8, "lan": 11, "lar": 31, "las": 9, "lat": 33,
+			"lav": 31, "laz": 11, "lb": 32, "lbe": 29, "lc": 31, "lco": 7, "lcu": 23, "ld": 6,
+			"le": 808, "le ": 630, "lec": 4, "leg": 40, "lem": 26, "len": 31, "les": 7, "let": 41,
+			"lev": 4, "lez": 15, "lf": 11, "lf ": 5, "lfo": 5, "lg": 12, "lga": 4, "lgo": 6,
+			"li": 581, "li ": 163, "lia": 11, "lib": 10, "lic": 64, "lid": 55, "lie": 9, "lim": 34,
+			"lin": 30, "lio": 7, "lis": 13, "lit": 70, "liv": 30, "liz": 78, "ll": 588, "ll ": 161,
+			"lla": 212, "lle": 76, "lli": 17, "llo": 122, "lm": 23, "lme": 22, "lo": 389, "lo ": 234,
+			"loa": 7, "lob": 9, "loc": 63, "log": 10, "lon": 16, "lor": 38, "low": 9, "lp": 11,
+			"lp ": 8, "ls": 22, "ls ": 10, "lsi": 5, "lt": 113, "lt ": 10, "lta": 18, "lte": 8,
+			"lti": 25, "lto": 4, "ltr": 42, "lu": 44, "lud": 11, "lum": 5, "lun": 5, "lus": 14,
+			"lv": 12, "lva": 8, "m": 2104, "m ": 55, "ma": 382, "ma ": 132, "mac": 7, "mag": 16,
+			"mai": 29, "maj": 4, "mal": 16, "man": 69, "map": 7, "mar": 17, "mas": 12, "mat": 48,
+			"maz": 24, "mb": 73, "mbi": 33, "mbo": 33, "mbr": 6, "me": 579, "me ": 125, "med": 11,
+			"mem": 16, "men": 243, "mer": 90, "mes": 51, "met": 39, "mi": 229, "mi ": 28, "mic": 12,
+			"mig": 4, "min": 80, "mis": 5, "mit": 96, "ml": 7, "ml ": 7, "mm": 127, "mm ": 4,
+			"mma": 24, "mme": 17, "mmi": 80, "mn": 6, "mng": 4, "mo": 247, "mo ": 36, "moc": 9,
+			"mod": 115, "mon": 19, "mor": 14, "mos": 27, "mot": 18, "mp": 331, "mp ": 5, "mpa": 41,
+			"mpi": 20, "mpl": 19, "mpo": 217, "mpr": 24, "ms": 4, "mu": 57, "mul": 18, "mun": 4,
+			"muo": 13, "mut": 22, "n": 5024, "n ": 960, "na": 348, "na ": 139, "nag": 5, "nal": 52,
+			"nam": 33, "nar": 42, "nat": 52, "naz": 23, "nc": 148, "nc ": 4, "nca": 27, "nce": 14,
+			"nch": 50, "nci": 6, "ncl": 10, "nco": 27, "ncr": 10, "nd": 285, "nda": 31, "nde": 53,
+			"ndi": 110, "ndl": 4, "ndo": 78, "ne": 772, "ne ": 484, "nea": 8, "nec": 11, "neg": 14,
+			"nei": 5, "nel": 113, "nen": 20, "neo": 6, "ner": 36, "nes": 62, "net": 5, "new": 4,
+			"nf": 78, "nfi": 26, "nfl": 7, "nfo": 36, "nfr": 7, "ng": 102, "ng ": 19, "nga": 18,
+			"nge": 16, "ngh": 13, "ngi": 6, "ngo": 11, "ngr": 7, "ngu": 8, "ni": 310, "ni ": 139,
+			"nib": 18, "nic": 7, "nie": 4, "nim": 7, "nio": 5, "nis": 15, "nit": 69, "niz": 33,
+			"nk": 13, "nk ": 11, "nl": 5, "nn": 51, "nne": 27, "nno": 17, "no": 755, "no ": 177,
+			"nol": 6, "nom": 86, "non": 399, "nor": 33, "nos": 24, "not": 20, "np": 37, "npg": 6,
+			"npu": 29, "ns": 116, "ns ": 6, "nsa": 5, "nse": 34, "nsi": 35, "nss": 4, "nst": 23,
+			"nt": 780, "nt ": 11, "nta": 59, "nte": 323, "nti": 196, "nto": 118, "ntr": 67, "nu": 125,
+			"nu ": 7, "nua": 7, "nul": 18, "num": 43, "nuo": 20, "nut": 22, "nv": 35, "nve": 17,
+			"nvi": 15, "nz": 96, "nza": 52, "nze": 11, "nzi": 33, "o": 6389, "o ": 2081, "oa": 8,
+			"oad": 6, "ob": 33, "ob ": 5, "oba": 6, "obl": 11, "obs": 5, "oc": 175, "oca": 42,
+			"occ": 25, "oce": 23, "och": 8, "oci": 12, "ock": 15, "ocm": 10, "oco": 10, "ocr": 10,
+			"ocs": 8, "ocu": 10, "od": 148, "oda": 28, "ode": 10, "odi": 76, "odo": 17, "odu": 16,
+			"of": 46, "off": 22, "ofo": 4, "oft": 17, "og": 88, "ogg": 38, "ogi": 9, "ogl": 5,
+			"ogn": 6, "ogr": 23, "oi": 14, "oi ": 7, "ok": 7, "ol": 248, "ol ": 4, "ola": 30,
+			"ole": 19, "olg": 4, "oli": 26, "oll": 48, "olo": 88, "olt": 12, "olu": 10, "olv": 4,
+			"om": 380, "om ": 5, "oma": 53, "omb": 10, "ome": 119, "omi": 28, "omm": 78, "omo": 13,
+			"omp": 70, "omu": 4, "on": 1524, "on ": 503, "ona": 54, "onc": 6, "ond": 41, "one": 420,
+			"onf": 42, "ong": 10, "oni": 139, "onn": 24, "ono": 113, "ons": 37, "ont": 124, "onv": 9,
+			"oo": 13, "ook": 4, "oot": 7, "op": 162, "ope": 37, "opi": 8, "opo": 39, "opp": 27,
+			"opr": 8, "opz": 36, "or": 730, "or ": 20, "ora": 65, "ord": 27, "ore": 144, "org": 13,
+			"ori": 79, "orm": 60, "orn": 40, "oro": 30, "orp": 5, "orr": 49, "ors": 57, "ort": 68,
+			"ory": 58, "orz": 8, "os": 381, "osc": 18, "ose": 4, "osi": 55, "osp": 4, "oss": 159,
+			"ost": 127, "ot": 152, "ot ": 11, "ota": 23, "ote": 16, "oti": 12, "oto": 25, "otr": 14,
+			"ott": 50, "ou": 60, "oun": 4, "out": 55, "ov": 111, "ova": 52, "ove": 24, "ovi": 9,
+			"ovo": 8, "ovr": 15, "ow": 13, "ow ": 10, "ox": 7, "oxy": 6, "oz": 5, "ozi": 5,
+			"p": 2112, "p ": 63, "pa": 231, "pa ": 28, "pac": 63, "par": 47, "pas": 27, "pat": 27,
+			"paz": 23, "pd": 4, "pe": 448, "pe ": 6, "pec": 79, "ped": 4, "pel": 8, "pen": 22,
+			"per": 310, "pet": 11, "pg": 11, "pg ": 4, "pgp": 7, "ph": 9, "phr": 7, "pi": 102,
+			"pi ": 19, "pia": 15, "pib": 8, "pic": 5, "pid": 4, "pie": 9, "pin": 10, "pip": 14,
+			"più": 15, "pk": 4, "pl": 68, "pla": 5, "ple": 21, "pli": 36, "po": 547, "po ": 98,
+			"poi": 6, "pol": 5, "pon": 49, "por": 82, "pos": 283, "pot": 19, "pp": 123, "ppa": 7,
+			"ppi": 11, "ppl": 28, "ppo": 67, "pr": 283, "pre": 124, "pri": 62, "pro": 93, "ps": 17,
+			"ps ": 5, "pst": 9, "pt": 10, "pt ": 10, "pu": 147, "pub": 20, "pul": 7, "pun": 14,
+			"pus": 4, "put": 73, "può": 25, "pv": 4, "pv ": 4, "pz": 36, "pzi": 36, "q": 142,
+			"qu": 135, "qua": 38, "que": 83, "qui": 12, "qw": 6, "qwe": 6, "r": 4633, "r ": 333,
+			"ra": 603, "ra ": 147, "rab": 5, "rac": 20, "raf": 20, "rag": 12, "rak": 5, "ral": 6,
+			"ram": 52, "ran": 110, "rap": 9, "rar": 33, "ras": 30, "rat": 93, "rav": 4, "raz": 50,
+			"rb": 4, "rc": 95, "rca": 23, "rch": 42, "rco": 26, "rd": 57, "rd ": 41, "rdi": 7,
+			"rdo": 5, "re": 1237, "re ": 711, "rea": 75, "reb": 29, "rec": 61, "red": 38, "ree": 4,
+			"ref": 10, "reg": 30, "rei": 4, "rel": 11, "rem": 28, "ren": 36, "reo": 4, "rep": 37,
+			"rer": 9, "res": 76, "ret": 30, "rev": 32, "rez": 10, "rf": 6, "rg": 80, "rge": 49,
+			"rgh": 5, "rgo": 22, "ri": 836, "ri ": 100, "ria": 38, "rib": 11, "ric": 127, "rid": 13,
+			"rie": 8, "rif": 53, "rig": 44, "ril": 11, "rim": 95, "rin": 28, "rio": 37, "rip": 35,
+			"rir": 4, "ris": 75, "rit": 51, "riu": 38, "riv": 33, "riz": 35, "rk": 5, "rl": 39,
+			"rl ": 26, "rli": 4, "rlo": 6, "rm": 153, "rma": 95, "rme": 15, "rmi": 35, "rmn": 4,
+			"rn": 63, "rna": 27, "rni": 17, "rno": 16, "ro": 468, "ro ": 164, "rob": 12, "roc": 14,
+			"rod": 4, "rof": 8, "rog": 20, "rol": 32, "rom": 20, "ron": 22, "roo": 4, "rop": 32,
+			"ror": 63, "ros": 7, "rot": 12, "rov": 46, "rox": 6, "rp": 10, "rpo": 5, "rpr": 4,
+			"rr": 156, "rra": 11, "rre": 36, "rri": 21, "rro": 80, "rrà": 4, "rs": 137, "rsa": 6,
+			"rse": 13, "rsi": 54, "rso": 59, "rt": 155, "rt ": 5, "rta": 62, "rte": 14, "rti": 38,
+			"rto": 22, "rtu": 4, "rty": 5, "ru": 38, "rup": 13, "rus": 6, "ruz": 6, "rv": 59,
+			"rva": 17, "rve": 33, "rvi": 9, "ry": 62, "ry ": 62, "rz": 22, "rza": 9, "rzi": 5,
+			"rzo": 7, "rà": 12, "rà ": 12, "s": 4067, "s ": 61, "sa": 235, "sa ": 69, "sab": 16,
+			"sag": 37, "sal": 14, "san": 9, "sap": 4, "sar": 37, "sat": 33, "sau": 4, "saz": 4,
+			"sb": 5, "sbl": 5, "sc": 284, "sc ": 21, "sca": 34, "sce": 30, "sch": 15, "sci": 86,
+			"scl": 7, "sco": 25, "scr": 64, "se": 600, "se ": 139, "sec": 24, "seg": 140, "sel": 23,
+			"sem": 8, "sen": 69, "sep": 6, "seq": 4, "ser": 129, "ses": 11, "set": 12, "sez": 31,
+			"sf": 4, "sg": 4, "sg ": 4, "sh": 44, "sh ": 24, "sha": 11, "she": 7, "si": 573,
+			"si ": 82, "sia": 23, "sib": 136, "sic": 16, "sid": 6, "sie": 9, "sig": 10, "sim": 54,
+			"sin": 21, "sio": 105, "sis": 40, "sit": 33, "siv": 8, "siz": 26, "sl": 14, "sl ": 10,
+			"sn": 4, "so": 419, "so ": 149, "soc": 17, "sof": 17, "sol": 77, "son": 75, "sor": 21,
+			"sos": 10, "sot": 40, "sov": 9, "sp": 226, "sp ": 10, "spa": 28, "spe": 94, "sph": 7,
+			"spi": 6, "spo": 68, "spr": 10, "ss": 528, "ssa": 72, "sse": 100, "ssi": 222, "ssl": 9,
+			"sso": 58, "ssp": 7, "ssu": 44, "ssw": 12, "st": 784, "st ": 19, "sta": 333, "std": 7,
+			"ste": 64, "sti": 109, "sto": 114, "str": 137, "su": 243, "su ": 26, "sua": 44, "suc": 12,
+			"sud": 4, "sug": 5, "sui": 5, "sul": 44, "sun": 45, "suo": 4, "sup": 41, "sur": 4,
+			"sv": 7, "sv ": 6, "sw": 12, "swo": 11, "sy": 5, "sì": 4, "sì ": 4, "t": 4848,
+			"t ": 317, "ta": 898, "ta ": 386, "tab": 23, "tac": 5, "tad": 6, "tag": 23, "tai": 9,
+			"tal": 37, "tam": 49, "tan": 31, "tar": 48, "tas": 61, "tat": 163, "taz": 52, "tc": 21,
+			"tch": 20, "td": 8, "tdi": 4, "te": 772, "te ": 313, "tec": 5, "teg": 8, "tel": 4,
+			"tem": 47, "ten": 159, "ter": 158, "tes": 62, "tet": 7, "th": 8, "th ": 4, "ti": 764,
+			"ti ": 399, "tib": 9, "tic": 67, "tie": 31, "tif": 26, "til": 30, "tim": 15, "tin": 32,
+			"tio": 6, "tip": 39, "tir": 8, "tis": 8, "tit": 33, "tiv": 53, "tl": 13, "tls": 10,
+			"tm": 10, "tma": 4, "tmo": 4, "to": 932, "to ": 711, "toc": 12, "tog": 8, "tol": 4,
+			"tom": 19, "ton": 7, "top": 18, "tor": 136, "tos": 5, "tp": 52, "tp ": 7, "tpu": 42,
+			"tr": 405, "tra": 113, "tre": 47, "tri": 61, "trl": 11, "tro": 149, "tru": 20, "ts": 5,
+			"ts ": 4, "tt": 432, "tta": 41, "tte": 105, "tti": 92, "tto": 121, "ttp": 8, "ttr": 14,
+			"ttu": 45, "tu": 113, "tua": 18, "tui": 12, "tuo": 4, "tur": 41, "tut": 32, "tw": 18,
+			"twa": 17, "ty": 8, "ty ": 7, "tà": 65, "tà ": 65, "u": 2100, "u ": 40, "ua": 121,
+			"ua ": 9, "uag": 4, "ual": 67, "uan": 23, "uar": 10, "ub": 26, "ubb": 18, "uc": 19,
+			"ucc": 12, "ud": 20, "ude": 11, "udi": 4, "ue": 119, "ue ": 20, "uel": 11, "uen": 21,
+			"ues": 65, "uf": 9, "uff": 8, "ug": 24, "ug ": 11, "ugg": 4, "ugu": 4, "ui": 113,
+			"ui ": 40, "uin": 8, "uir": 38, "uis": 8, "uit": 11, "ul": 118, "ul ": 12, "ula": 7,
+			"uli": 11, "ull": 47, "ulo": 7, "ult": 30, "um": 79, "um ": 4, "ume": 64, "ump": 4,
+			"un": 497, "un ": 282, "una": 84, "und": 5, "une": 5, "ung": 27, "uni": 22, "unk": 9,
+			"uno": 15, "unt": 35, "unz": 11, "uo": 85, "uo ": 8, "uoi": 6, "uor": 10, "uot": 24,
+			"uov": 33, "up": 83, "upe": 15, "upl": 4, "upp": 50, "ups": 6, "ur": 136, "ura": 103,
+			"ure": 10, "uri": 5, "url": 11, "us": 191, "usa": 63, "usc": 70, "use": 5, "ush": 9,
+			"usi": 6, "uso": 21, "ust": 7, "usu": 4, "ut": 368, "ut ": 84, "uta": 12, "ute": 77,
+			"uti": 48, "uto": 68, "utp": 42, "utt": 32, "uz": 22, "uzi": 22, "uò": 25, "uò ": 25,
+			"v": 996, "v ": 21, "va": 202, "va ": 29, "val": 100, "vam": 10, "var": 26, "vat": 34,
+			"ve": 339, "ve ": 100, "vec": 20, "ved": 9, "vel": 29, "ven": 8, "ver": 165, "vi": 230,
+			"vi ": 49, "via": 22, "vic": 4, "vid": 6, "vie": 13, "vil": 5, "vio": 37, "vir": 8,
+			"vis": 72, "viz": 9, "vo": 121, "vo ": 33, "voc": 31, "vol": 12, "von": 12, "vor": 32,
+			"vr": 17, "vra": 9, "vre": 8, "vu": 37, "vuo": 28, "vut": 9, "vv": 27, "vve": 4,
+			"vvi": 23, "w": 81, "w ": 13, "wa": 23, "war": 22, "we": 11, "web": 4, "wer": 6,
+			"wi": 8, "win": 7, "wo": 14, "wor": 14, "x": 43, "x ": 28, "xm": 4, "xml": 4,
+			"xy": 6, "xy ": 6, "y": 109, "y ": 83, "ya": 4, "yi": 4, "ys": 5, "z": 868,
+			"za": 170, "za ": 99, "zam": 4, "zar": 35, "zat": 16, "zaz": 9, "ze": 23, "ze ": 12,
+			"zer": 6, "zi": 506, "zi ": 19, "zia": 36, "zio": 444, "zip": 6, "zo": 29, "zo ": 29,
+			"zz": 136, "zza": 105, "zzi": 7, "zzo": 22, "à": 85, "à ": 85, "è": 201, "è ": 201,
+			"é": 11, "é ": 11, "ì": 4, "ì ": 4, "ò": 28, "ò ": 28, "ù": 15, "ù ": 15,
+		}},
+		"nl": {Total: 243784, Ngrams: map[string]int{
+			" a": 828, " a ": 6, " aa": 160, " ac": 45, " ad": 18, " af": 86, " al": 223, " an": 36,
+			" ap": 44, " ar": 104, " au": 85, " b": 714, " b ": 7, " ba": 16, " be": 425, " bi": 128,
+			" bl": 14, " bo": 18, " br": 58, " bu": 24, " by": 16, " c": 268, " c ": 8, " ca": 30,
+			" cd": 13, " ce": 10, " ch": 7, " ci": 6, " co": 160, " cr": 8, " ct": 16, " d": 1097,
+			" d ": 7, " da": 107, " de": 631, " di": 159, " dn": 8, " do": 127, " dr": 12, " du": 29,
+			" dv": 6, " e": 833, " e ": 7, " ee": 389, " ef": 8, " ei": 39, " el": 41, " en": 180,
+			" er": 105, " es": 7, " ev": 6, " ex": 33, " f": 145, " fi": 17, " fo": 85, " fr": 15,
+			" fu": 14, " g": 788, " ga": 36, " ge": 666, " gn": 6, " gr": 50, " gs": 10, " h": 624,
+			" ha": 27, " he": 504, " hi": 12, " ho": 38, " ht": 7, " hu": 28, " i": 843, " id": 15,
+			" im": 9, " in": 403, " is": 395, " it": 6, " j": 27, " ja": 6, " je": 8, " k": 312,
+			" ka": 154, " ke": 15, " ki": 8, " kl": 24, " ko": 80, " kr": 8, " ku": 21, " l": 251,
+			" l ": 7, " la": 54, " le": 84, " li": 51, " lo": 38, " lu": 10, " m": 600, " ma": 180,
+			" me": 240, " mi": 70, " mo": 98, " n": 665, " n ": 24, " na": 127, " ne": 25, " ni": 386,
+			" no": 58, " nu": 41, " o": 1020, " o ": 6, " of": 146, " om": 106, " on": 240, " oo": 17,
+			" op": 397, " or": 14, " ou": 14, " ov": 67, " p": 417, " pa": 170, " pe": 24, " pi": 18,
+			" pl": 38, " po": 34, " pr": 101, " pu": 21, " q": 15, " qw": 12, " r": 191, " r ": 6,
+			" ra": 7, " re": 145, " ro": 16, " ru": 6, " s": 742, " s ": 22, " sa": 45, " sc": 70,
+			" se": 72, " sh": 38, " si": 30, " sl": 67, " so": 46, " sp": 24, " st": 200, " su": 20,
+			" sy": 96, " t": 713, " t ": 6, " ta": 41, " te": 272, " ti": 46, " tl": 11, " to": 255,
+			" tr": 25, " tu": 6, " tw": 18, " ty": 18, " u": 293, " u ": 43, " ui": 177, " un": 23,
+			" up": 17, " ur": 8, " uw": 10, " v": 1196, " va": 447, " ve": 393, " vi": 34, " vo": 294,
+			" vr": 13, " w": 438, " wa": 140, " we": 68, " wi": 57, " wo": 166, " x": 15, " x ": 10,
+			" z": 275, " za": 6, " ze": 42, " zi": 141, " zo": 64, " zu": 10, " zw": 8, " é": 37,
+			" éé": 33, "a": 5609, "a ": 115, "aa": 880, "aag": 7, "aak": 38, "aal": 67, "aam": 73,
+			"aan": 246, "aap": 6, "aar": 338, "aat": 94, "ab": 51, "abe": 25, "abl": 7, "abs": 6,
+			"ac": 195, "acc": 10, "ach": 121, "ack": 17, "act": 31, "ad": 75, "ad ": 21, "ade": 28,
+			"adr": 14, "af": 106, "af ": 10, "afd": 9, "afg": 15, "afh": 8, "afi": 8, "afs": 39,
+			"ag": 122, "ag ": 50, "age": 47, "agi": 10, "ai": 31, "ain": 13, "air": 8, "ak": 279,
+			"ak ": 24, "ake": 84, "akk": 128, "akt": 34, "al": 519, "al ": 112, "ald": 12, "ale": 47,
+			"alg": 8, "ali": 32, "all": 128, "als": 107, "alt": 29, "alv": 16, "am": 196, "am ": 83,
+			"ame": 81, "ami": 8, "amm": 19, "an": 1426, "an ": 732, "ana": 7, "and": 430, "ane": 6,
+			"ang": 82, "anh": 9, "ani": 10, "ank": 20, "anm": 18, "ann": 10, "ans": 25, "ant": 42,
+			"anu": 8, "anw": 8, "ap": 157, "ap ": 52, "app": 54, "aps": 23, "apt": 21, "ar": 611,
+			"ar ": 148, "ara": 25, "arc": 70, "ard": 155, "are": 40, "arg": 42, "ari": 18, "ark": 10,
+			"aro": 7, "ars": 45, "art": 36, "as": 111, "as ": 10, "ase": 14, "ash": 6, "ass": 49,
+			"ast": 20, "at": 547, "at ": 132, "ata": 27, "atc": 7, "ate": 38, "ati": 204, "ato": 8,
+			"atr": 16, "ats": 47, "att": 23, "atu": 32, "au": 147, "au ": 34, "aut": 89, "auz": 11,
+			"ax": 21, "axi": 15, "ay": 7, "b": 1306, "b ": 19, "ba": 71, "baa": 24, "bac": 9,
+			"ban": 7, "bar": 13, "bas": 15, "bb": 22, "bbe": 22, "be": 582, "bed": 7, "bee": 13,
+			"beg": 30, "beh": 41, "bek": 8, "bel": 37, "ben": 19, "bep": 12, "ber": 45, "bes": 281,
+			"bet": 11, "bev": 42, "bew": 15, "beë": 10, "bi": 164, "bib": 6, "bij": 91, "bin": 32,
+			"bit": 20, "bl": 57, "ble": 17, "bli": 21, "blo": 18, "bo": 51, "bol": 15, "boo": 8,
+			"bou": 8, "bov": 6, "bp": 11, "bpa": 8, "br": 231, "bre": 61, "bro": 48, "bru": 118,
+			"bs": 7, "bu": 57, "buf": 11, "bug": 9, "bui": 14, "bus": 6, "buu": 7, "by": 17,
+			"byt": 16, "c": 1403, "c ": 47, "ca": 147, "caa": 11, "cac": 6, "cap": 17, "car": 6,
+			"cat": 93, "cc": 14, "cce": 11, "cd": 15, "cd ": 7, "ce": 98, "ce ": 14, "cee": 20,
+			"cen": 11, "cer": 13, "ces": 33, "ch": 549, "ch ": 49, "cha": 47, "che": 75, "chi": 129,
+			"chr": 49, "cht": 169, "chu": 25, "ci": 38, "cia": 7, "cie": 6, "cif": 10, "cij": 6,
+			"ck": 58, "ck ": 25, "cka": 6, "cke": 6, "cks": 16, "cl": 11, "co": 246, "cod": 47,
+			"col": 10, "com": 57, "con": 117, "cor": 11, "cr": 39, "cre": 15, "cri": 15, "ct": 117,
+			"ct ": 20, "cte": 15, "cti": 49, "ctr": 16, "ctu": 9, "cu": 14, "cum": 6, "cur": 6,
+			"d": 3578, "d ": 652, "da": 220, "daa": 75, "dan": 34, "dat": 102, "dd": 22, "dde": 17,
+			"de": 1734, "de ": 918, "deb": 18, "dec": 12, "dee": 23, "def": 8, "del": 72, "den": 316,
+			"dep": 6, "der": 282, "des": 7, "dez": 53, "dg": 10, "di": 360, "die": 81, "dif": 9,
+			"dig": 117, "din": 52, "dis": 9, "dit": 68, "diu": 11, "dn": 13, "dns": 8, "do": 174,
+			"do ": 19, "doc": 10, "dod": 37, "doe": 25, "dom": 7, "doo": 45, "dow": 25, "dp": 6,
+			"dr": 86, "dra": 44, "dre": 14, "dri": 7, "dru": 17, "ds": 70, "ds ": 11, "dsh": 6,
+			"dsn": 15, "dss": 9, "dst": 6, "dt": 105, "dt ": 85, "dte": 15, "du": 80, "dub": 6,
+			"duc": 9, "dui": 28, "dur": 6, "dus": 19, "dv": 9, "e": 14097, "e ": 2266, "ea": 72,
+			"eam": 14, "eau": 42, "eb": 193, "ebb": 13, "ebe": 19, "ebi": 6, "ebo": 8, "ebr": 121,
+			"ebu": 13, "ec": 163, "ec ": 9, "ech": 46, "eci": 17, "eco": 41, "ect": 36, "ecu": 6,
+			"ed": 147, "ed ": 24, "ede": 50, "edi": 32, "edr": 9, "eds": 6, "edt": 13, "ee": 1150,
+			"ee ": 9, "eed": 35, "eef": 46, "eeg": 17, "eek": 56, "eel": 49, "eem": 80, "een": 588,
+			"eer": 244, "ees": 17, "ef": 141, "ef ": 48, "efe": 17, "eff": 8, "efi": 9, "eft": 49,
+			"eg": 389, "eg ": 20, "ega": 13, "ege": 272, "egg": 9, "egi": 36, "egr": 19, "egs": 8,
+			"eh": 61, "eha": 18, "ehe": 34, "eho": 8, "ei": 252, "eid": 50, "eig": 11, "eik": 22,
+			"eil": 20, "ein": 52, "eis": 84, "eit": 8, "ek": 275, "ek ": 31, "eke": 168, "eko": 11,
+			"eks": 43, "ekt": 16, "el": 770, "el ": 135, "ela": 23, "elb": 10, "eld": 130, "ele": 116,
+			"elf": 20, "eli": 145, "elk": 28, "ell": 91, "elp": 7, "els": 40, "elt": 7, "em": 215,
+			"em ": 76, "ema": 29, "emb": 10, "emd": 6, "eme": 56, "emm": 8, "emo": 9, "en": 3157,
+			"en ": 2429, "ena": 11, "end": 110, "ene": 44, "eng": 44, "enh": 8, "eni": 33, "enk": 21,
+			"enm": 7, "eno": 13, "enr": 28, "ens": 142, "ent": 203, "enu": 31, "enz": 8, "eo": 18,
+			"eog": 7, "eoo": 7, "ep": 139, "ep ": 12, "epa": 61, "epe": 24, "epo": 6, "epr": 9,
+			"eps": 8, "epu": 9, "er": 2030, "er ": 503, "era": 40, "erb": 40, "erd": 258, "ere": 339,
+			"erg": 31, "erh": 32, "eri": 59, "erk": 80, "erl": 15, "erm": 15, "ern": 51, "ero": 23,
+			"erp": 25, "err": 10, "ers": 228, "ert": 92, "eru": 9, "erv": 66, "erw": 96, "erz": 14,
+			"es": 642, "es ": 139, "esc": 49, "ese": 23, "esl": 13, "eso": 16, "esp": 10, "ess": 39,
+			"est": 332, "et": 1317, "et ": 1005, "eta": 36, "etb": 12, "ete": 38, "eth": 6, "eti": 8,
+			"eto": 7, "etr": 7, "ets": 104, "ett": 76, "eu": 143, "eug": 7, "eun": 43, "eur": 16,
+			"eut": 36, "euw": 37, "ev": 305, "eva": 43, "eve": 202, "evi": 12, "evo": 45, "ew": 43,
+			"ewe": 18, "ewi": 9, "ewo": 7, "ex": 60, "exe": 6, "exp": 14, "ext": 29, "ez": 97,
+			"eze": 83, "ezi": 9, "eë": 19, "eëi": 10, "eën": 6, "eï": 27, "eïn": 24, "f": 816,
+			"f ": 226, "fa": 9, "fb": 8, "fd": 36, "fd ": 6, "fde": 17, "fe": 48, "fec": 12,
+			"fen": 7, "fer": 25, "ff": 35, "ff ": 6, "ffe": 20, "fg": 16, "fge": 14, "fh": 8,
+			"fha": 8, "fi": 100, "fic": 27, "fig": 16, "fil": 19, "fin": 9, "fis": 10, "fië": 12,
+			"fk": 15, "fko": 12, "fl": 7, "fo": 123, "for": 38, "fou": 74, "fr": 15, "fra": 9,
+			"fs": 51, "fs ": 7, "fsl": 34, "ft": 91, "ft ": 68, "ftw": 17, "fu": 15, "fun": 14,
+			"g": 2585, "g ": 403, "ga": 69, "gaa": 46, "gan": 7, "gd": 34, "gd ": 22, "gde": 11,
+			"ge": 1555, "ge ": 104, "gea": 10, "geb": 142, "gec": 19, "ged": 27, "gee": 174, "geg": 132,
+			"geh": 20, "gel": 180, "gem": 34, "gen": 244, "geo": 13, "gep": 26, "ger": 36, "ges": 93,
+			"get": 35, "gev": 205, "gew": 21, "geï": 27, "gg": 26, "gge": 24, "gh": 9, "ghe": 6,
+			"gi": 101, "gin": 78, "gis": 10, "gn": 33, "gna": 20, "gnu": 7, "go": 20, "gor": 12,
+			"gr": 136, "gra": 45, "gre": 22, "gri": 9, "gro": 56, "gs": 82, "gsc": 6, "gse": 7,
+			"gsm": 7, "gss": 6, "gst": 26, "gsv": 8, "gt": 27, "gt ": 11, "gte": 13, "gu": 66,
+			"gum": 39, "gur": 15, "h": 1497, "h ": 68, "ha": 138, "haa": 12, "hak": 31, "hal": 30,
+			"han": 36, "hap": 11, "har": 11, "he": 750, "he ": 47, "heb": 15, "hed": 8, "hee": 69,
+			"hei": 30, "hel": 30, "hem": 11, "hen": 71, "her": 48, "het": 399, "heu": 7, "hi": 172,
+			"hie": 59, "hif": 13, "hij": 24, "hik": 23, "hil": 13, "hin": 22, "ho": 78, "hod": 6,
+			"hon": 13, "hoo": 18, "hor": 7, "hou": 25, "hr": 51, "hre": 16, "hri": 29, "ht": 178,
+			"ht ": 57, "hte": 68, "hti": 6, "hts": 18, "htt": 7, "htw": 20, "hu": 55, "hui": 19,
+			"hul": 6, "huw": 24, "i": 5075, "i ": 18, "ia": 51, "ia ": 13, "iaa": 6, "iab": 10,
+			"ial": 10, "ian": 6, "ias": 6, "ib": 24, "ibe": 6, "ibl": 6, "ibu": 8, "ic": 152,
+			"ica": 92, "ice": 22, "ich": 13, "ici": 8, "ict": 6, "id": 140, "id ": 44, "idd": 17,
+			"ide": 34, "idi": 28, "ids": 8, "ie": 1023, "ie ": 338, "ieb": 10, "iee": 12, "ief": 60,
+			"iek": 23, "iem": 6, "ien": 54, "ier": 21, "ies": 63, "iet": 350, "ieu": 37, "iev": 28,
+			"ieë": 6, "if": 71, "ifi": 44, "ift": 14, "ig": 270, "ig ": 60, "igd": 23, "ige": 94,
+			"igg": 10, "igh": 7, "igi": 26, "ign": 22, "igt": 9, "igu": 18, "ij": 621, "ij ": 87,
+			"ijd": 87, "ije": 7, "ijf": 41, "ijg": 18, "ijk": 109, "ijl": 21, "ijn": 137, "ijp": 15,
+			"ijs": 23, "ijv": 31, "ijw": 7, "ijz": 36, "ik": 166, "ik ": 34, "ikb": 23, "ike": 70,
+			"ikt": 35, "il": 102, "il ": 15, "ile": 26, "ili": 17, "ill": 21, "ilt": 8, "im": 66,
+			"ima": 13, "ime": 7, "imp": 11, "imt": 14, "imu": 11, "in": 1040, "in ": 208, "ina": 22,
+			"inc": 12, "ind": 126, "ine": 59, "inf": 17, "ing": 328, "inh": 13, "ini": 10, "ink": 16,
+			"inl": 7, "inn": 18, "ins": 89, "int": 41, "inu": 19, "inv": 44, "io": 43, "ion": 28,
+			"iot": 6, "ip": 28, "ip ": 6, "ipt": 14, "ir": 23, "ire": 11, "is": 678, "is ": 404,
+			"isa": 7, "isc": 70, "ise": 8, "isl": 46, "iss": 10, "ist": 124, "it": 439, "it ": 142,
+			"ite": 56, "itg": 40, "iti": 16, "ito": 7, "itp": 12, "itr": 6, "its": 52, "itv": 61,
+			"itw": 25, "iu": 12, "ium": 12, "iv": 63, "ive": 56, "ix": 11, "ix ": 11, "iz": 7,
+			"ië": 24, "iër": 19, "j": 679, "j ": 91, "ja": 10, "jd": 87, "jd ": 18, "jde": 59,
+			"jds": 6, "je": 30, "je ": 18, "jec": 7, "jf": 41, "jf ": 11, "jfd": 6, "jfe": 7,
+			"jfk": 7, "jft": 6, "jg": 18, "jge": 13, "jk": 109, "jk ": 43, "jke": 57, "jkh": 7,
+			"jl": 21, "jl ": 20, "jn": 137, "jn ": 123, "jp": 16, "jpl": 6, "js": 23, "jst": 23,
+			"ju": 16, "jui": 13, "jv": 31, "jve": 24, "jw": 7, "jwe": 7, "jz": 36, "jzi": 31,
+			"k": 1687, "k ": 217, "ka": 187, "kaa": 9, "kan": 156, "kb": 27, "kba": 25, "ke": 630,
+			"ke ": 75, "kee": 8, "kel": 46, "ken": 286, "ker": 88, "ket": 112, "keu": 8, "kg": 8,
+			"kh": 7, "khe": 7, "ki": 28, "kie": 8, "kin": 18, "kk": 149, "kke": 144, "kl": 28,
+			"kla": 10, "kle": 15, "ko": 126, "kol": 9, "kom": 29, "kon": 23, "kop": 55, "kor": 6,
+			"kr": 26, "kri": 19, "ks": 65, "ks ": 33, "kse": 6, "kst": 11, "ksv": 9, "kt": 151,
+			"kt ": 126, "kte": 21, "ku": 27, "kun": 24, "l": 2702, "l ": 369, "la": 196, "laa": 71,
+			"lab": 9, "lad": 7, "lag": 24, "lan": 33, "las": 11, "lat": 30, "lb": 21, "lbe": 7,
+			"lbr": 7, "ld": 157, "ld ": 49, "lde": 31, "ldi": 59, "ldo": 6, "ldt": 6, "le": 634,
+			"le ": 104, "lec": 25, "led": 15, "lee": 110, "leg": 27, "lei": 25, "lem": 26, "len": 159,
+			"ler": 26, "les": 19, "let": 14, "leu": 38, "lez": 25, "lf": 25, "lfd": 8, "lfs": 7,
+			"lg": 54, "lge": 40, "lgo": 11, "li": 366, "lia": 7, "lic": 22, "lid": 6, "lie": 23,
+			"lig": 23, "lij": 140, "lim": 7, "lin": 93, "lio": 6, "lis": 30, "lk": 30, "lk ": 16,
+			"lka": 7, "lke": 6, "ll": 275, "ll ": 30, "lle": 210, "lli": 20, "ln": 8, "lo": 151,
+			"loa": 11, "loc": 38, "log": 13, "lok": 20, "lom": 10, "loo": 16, "lop": 10, "los": 12,
+			"lot": 6, "lp": 15, "ls": 177, "ls ": 162, "lse": 7, "lt": 52, "lt ": 20, "lte": 15,
+			"lti": 9, "lu": 128, "lug": 6, "lui": 52, "luk": 47, "lus": 11, "lv": 18, "lve": 7,
+			"lvo": 11, "m": 1810, "m ": 323, "ma": 373, "ma ": 33, "maa": 65, "mac": 9, "mag": 32,
+			"mak": 50, "mal": 12, "man": 29, "map": 61, "mar": 13, "mat": 35, "max": 17, "mb": 38,
+			"mbe": 7, "mbi": 8, "mbo": 19, "md": 20, "mda": 12, "me": 540, "me ": 19, "med": 14,
+			"mee": 37, "mei": 8, "mel": 34, "men": 193, "mer": 47, "met": 181, "mg": 14, "mge": 14,
+			"mi": 124, "mid": 17, "min": 36, "mis": 55, "mm": 112, "mma": 46, "mme": 50, "mmi": 11,
+			"mo": 118, "mod": 24, "moe": 42, "mog": 26, "mon": 9, "mp": 64, "mp ": 6, "mpa": 8,
+			"mpl": 13, "mpo": 9, "mpr": 6, "mpu": 9, "ms": 16, "mst": 6, "mt": 25, "mte": 14,
+			"mu": 20, "mum": 11, "mz": 8, "mze": 8, "n": 7568, "n ": 3643, "na": 276, "na ": 22,
+			"naa": 171, "nal": 15, "nam": 28, "nat": 23, "nb": 26, "nbe": 18, "nc": 63, "nch": 8,
+			"nco": 24, "ncr": 7, "nct": 14, "nd": 852, "nd ": 230, "nda": 68, "nde": 426, "ndi": 37,
+			"ndo": 29, "nds": 39, "ndt": 7, "ndu": 6, "ne": 277, "ne ": 46, "nee": 22, "neg": 22,
+			"nel": 25, "nen": 92, "ner": 37, "net": 14, "nf": 42, "nfi": 17, "nfo": 18, "ng": 530,
+			"ng ": 221, "nga": 12, "nge": 207, "ngr": 7, "ngs": 56, "ngt": 16, "nh": 33, "nha": 10,
+			"nhe": 10, "nho": 13, "ni": 499, "nie": 384, "nin": 34, "nis": 9, "nit": 7, "niv": 40,
+			"nj": 13, "nju": 11, "nk": 61, "nk ": 7, "nke": 28, "nko": 13, "nl": 35, "nli": 19,
+			"nlo": 10, "nm": 40, "nma": 18, "nme": 7, "nmi": 14, "nn": 52, "nne": 47, "no": 90,
+			"nod": 11, "noe": 10, "nog": 24, "nom": 7, "non": 10, "noo": 7, "nor": 6, "np": 9,
+			"nr": 33, "nre": 25, "ns": 300, "ns ": 123, "nsc": 12, "nse": 10, "nss": 8, "nst": 127,
+			"nt": 473, "nt ": 130, "nta": 54, "ntb": 22, "nte": 92, "nti": 98, "ntr": 37, "nts": 7,
+			"ntu": 6, "ntv": 11, "nu": 117, "nu ": 19, "nul": 30, "num": 38, "nux": 16, "nv": 82,
+			"nve": 22, "nvo": 50, "nw": 10, "nwe": 8, "nz": 10, "nzi": 9, "o": 4340, "o ": 59,
+			"oa": 17, "oad": 11, "ob": 28, "obe": 10, "obl": 9, "oc": 114, "oca": 14, "oce": 27,
+			"och": 18, "ock": 35, "ocu": 6, "od": 140, "ode": 95, "odi": 14, "odu": 27, "oe": 479,
+			"oe ": 14, "oeg": 61, "oek": 19, "oel": 9, "oem": 8, "oen": 13, "oep": 59, "oer": 133,
+			"oes": 6, "oet": 141, "oev": 7, "of": 179, "of ": 136, "ofd": 12, "off": 9, "oft": 17,
+			"og": 109, "og ": 26, "oge": 30, "ogi": 11, "ogr": 28, "oi": 7, "ok": 46, "ok ": 20,
+			"oka": 6, "oke": 10, "okk": 7, "ol": 164, "ol ": 9, "old": 9, "ole": 36, "olg": 44,
+			"oli": 16, "oll": 16, "olo": 13, "ols": 9, "om": 285, "om ": 104, "oma": 11, "omb": 8,
+			"omd": 13, "ome": 25, "omg": 10, "omm": 47, "omp": 37, "oms": 11, "omz": 8, "on": 663,
+			"on ": 66, "ona": 14, "onb": 14, "onc": 23, "ond": 156, "one": 64, "onf": 23, "ong": 72,
+			"oni": 14, "onj": 11, "onl": 11, "onm": 13, "onn": 6, "ont": 130, "onv": 29, "oo": 477,
+			"oof": 12, "ook": 13, "ool": 12, "oom": 24, "oon": 50, "oop": 7, "oor": 317, "oot": 31,
+			"op": 504, "op ": 147, "opd": 37, "ope": 40, "opg": 51, "oph": 7, "opi": 20, "opl": 8,
+			"opm": 11, "opn": 19, "opp": 24, "ops": 14, "opt": 109, "opv": 11, "or": 659, "or ": 244,
+			"ora": 7, "orb": 7, "ord": 215, "ore": 24, "org": 8, "ori": 29, "orl": 9, "orm": 42,
+			"ort": 46, "orw": 7, "os": 39, "osi": 11, "oss": 7, "ost": 11, "ot": 87, "ot ": 37,
+			"ote": 17, "oth": 6, "ott": 16, "ou": 153, "ou ": 6, "oud": 42, "out": 78, "ouw": 11,
+			"ov": 83, "ove": 79, "ow": 37, "owe": 6, "own": 10, "ows": 13, "ox": 10, "oxy": 10,
+			"p": 1643, "p ": 260, "pa": 307, "pac": 9, "pad": 7, "pag": 7, "pak": 140, "pal": 11,
+			"par": 25, "pas": 43, "pat": 40, "pau": 12, "pd": 51, "pda": 13, "pdr": 37, "pe": 197,
+			"pe ": 26, "pec": 14, "pel": 25, "pen": 71, "per": 50, "pg": 57, "pge": 51, "ph": 7,
+			"pha": 7, "pi": 47, "pie": 16, "pij": 11, "pl": 94, "pla": 40, "ple": 10, "pli": 25,
+			"plo": 10, "plu": 8, "pm": 15, "pma": 10, "pn": 19, "pni": 10, "po": 66, "pog": 6,
+			"pon": 9, "por": 19, "pos": 18, "pp": 79, "ppa": 11, "ppe": 48, "ppo": 10, "pr": 146,
+			"pre": 30, "pri": 18, "pro": 96, "ps": 57, "ps ": 7, "psl": 19, "pst": 16, "pt": 159,
+			"pt ": 47, "pte": 7, "pti": 97, "pu": 50, "pub": 11, "pun": 25, "put": 10, "pv": 11,
+			"pvr": 9, "q": 18, "qu": 6, "qui": 6, "qw": 12, "qwe": 12, "r": 4664, "r ": 940,
+			"ra": 239, "ra ": 7, "raa": 21, "rac": 46, "rad": 13, "raf": 12, "rag": 19, "ral": 8,
+			"ram": 25, "ran": 43, "rat": 23, "rb": 50, "rba": 6, "rbe": 16, "rbi": 15, "rbr": 7,
+			"rc": 82, "rc ": 9, "rce": 10, "rch": 61, "rd": 633, "rd ": 188, "rde": 309, "rdi": 19,
+			"rdt": 75, "rdu": 14, "re": 826, "re ": 94, "rea": 20, "rec": 39, "red": 21, "ree": 94,
+			"ref": 11, "reg": 66, "rei": 118, "rek": 20, "rel": 8, "rem": 10, "ren": 229, "rep": 16,
+			"rer": 13, "res": 49, "rev": 9, "rg": 81, "rge": 26, "rgr": 10, "rgu": 39, "rh": 33,
+			"rha": 10, "rhi": 14, "ri": 270, "ri ": 8, "ria": 13, "rib": 9, "ric": 11, "rid": 6,
+			"rie": 13, "rif": 18, "rig": 28, "rij": 69, "rin": 41, "rip": 17, "rit": 15, "riv": 6,
+			"rk": 96, "rk ": 7, "rke": 36, "rki": 14, "rkr": 15, "rkt": 14, "rl": 44, "rl ": 20,
+			"rlo": 16, "rm": 62, "rm ": 12, "rma": 33, "rme": 6, "rmi": 8, "rn": 55, "rna": 25,
+			"rne": 13, "rni": 8, "ro": 349, "rob": 20, "roc": 28, "rod": 9, "roe": 33, "rog": 22,
+			"rok": 8, "rol": 38, "rom": 11, "ron": 59, "roo": 64, "rop": 11, "rot": 12, "rou": 11,
+			"rox": 10, "rp": 29, "rpa": 9, "rpl": 10, "rpr": 6, "rr": 14, "rs": 287, "rs ": 58,
+			"rsc": 54, "rsi": 52, "rsl": 9, "rsn": 8, "rso": 9, "rst": 71, "rt": 191, "rt ": 79,
+			"rte": 50, "rti": 13, "rtr": 6, "rts": 6, "rtu": 6, "rty": 6, "rtz": 7, "ru": 181,
+			"ruc": 8, "rug": 9, "rui": 135, "ruk": 17, "rus": 7, "rv": 67, "rva": 16, "rve": 29,
+			"rvi": 10, "rvo": 11, "rw": 105, "rwa": 25, "rwe": 19, "rwi": 59, "ry": 10, "ry ": 7,
+			"rz": 18, "rza": 6, "rzo": 8, "s": 3919, "s ": 1149, "sa": 71, "sac": 6, "sam": 40,
+			"sb": 16, "sba": 9, "sc": 292, "sch": 263, "sco": 6, "scr": 15, "sd": 8, "se": 296,
+			"se ": 36, "sec": 15, "see": 11, "sel": 50, "sen": 103, "ser": 46, "ses": 7, "set": 15,
+			"sg": 9, "sh": 55, "sh ": 6, "sha": 7, "she": 22, "shi": 13, "si": 172, "sie": 79,
+			"sig": 22, "sin": 39, "sis": 9, "sit": 9, "six": 6, "sj": 6, "sk": 7, "sl": 215,
+			"sla": 39, "sle": 52, "slo": 25, "slu": 94, "sm": 27, "sma": 16, "smo": 7, "sn": 37,
+			"sna": 27, "sne": 6, "so": 91, "soc": 15, "sof": 17, "som": 18, "soo": 12, "sor": 13,
+			"sp": 46, "spa": 16, "spe": 15, "spr": 6, "sr": 15, "ss": 142, "ssc": 7, "sse": 41,
+			"ssi": 60, "sst": 7, "ssy": 10, "st": 1096, "st ": 154, "sta": 488, "ste": 293, "sti": 24,
+			"sto": 18, "str": 65, "stu": 29, "su": 27, "sub": 10, "sv": 24, "sv ": 11, "sve": 7,
+			"sw": 7, "sy": 108, "sym": 19, "syn": 11, "sys": 77, "t": 6192, "t ": 2198, "ta": 663,
+			"ta ": 19, "taa": 62, "tab": 14, "tag": 16, "tai": 16, "tak": 6, "tal": 104, "tam": 6,
+			"tan": 316, "tar": 47, "tas": 6, "tat": 39, "tb": 43, "tbe": 12, "tbr": 29, "tc": 14,
+			"tch": 8, "te": 1212, "te ": 284, "tec": 6, "tee": 111, "teg": 7, "tei": 9, "tek": 123,
+			"tel": 112, "tem": 21, "ten": 273, "ter": 168, "tes": 27, "teu": 44, "tex": 19, "tf": 7,
+			"tg": 49, "tge": 42, "th": 94, "th ": 8, "the": 79, "tho": 6, "ti": 592, "tib": 6,
+			"tic": 73, "tie": 315, "tif": 16, "tig": 11, "tij": 58, "til": 13, "tim": 11, "tin": 19,
+			"tio": 25, "tis": 26, "tiv": 10, "tl": 23, "tle": 7, "tls": 13, "tm": 17, "tme": 12,
+			"tn": 9, "to": 331, "to ": 8, "toc": 9, "toe": 186, "tom": 12, "ton": 24, "too": 25,
+			"top": 14, "tor": 20, "tot": 23, "tp": 28, "tp ": 8, "tpa": 14, "tr": 203, "tra": 24,
+			"tre": 31, "tri": 26, "trl": 16, "tro": 78, "tru": 21, "ts": 244, "ts ": 94, "tsc": 12,
+			"tse": 87, "tsl": 14, "tst": 24, "tt": 138, "tte": 102, "tti": 9, "ttp": 7, "ttr": 8,
+			"tty": 9, "tu": 96, "tue": 7, "tuk": 6, "tum": 6, "tur": 12, "tus": 29, "tuu": 23,
+			"tv": 77, "tva": 14, "tvo": 61, "tw": 92, "twa": 44, "twe": 22, "two": 21, "ty": 43,
+			"ty ": 14, "typ": 29, "tz": 9, "tz ": 7, "u": 1757, "u ": 108, "ub": 32, "ubb": 6,
+			"ubl": 11, "ubp": 7, "uc": 27, "uce": 7, "uct": 10, "ud": 47, "ud ": 21, "ude": 17,
+			"ue": 11, "uee": 6, "uf": 11, "uff": 10, "ug": 33, "ug ": 10, "uge": 9, "ui": 460,
+			"uid": 33, "uik": 118, "uim": 14, "uis": 16, "uit": 271, "uk": 72, "uk ": 7, "ukk": 11,
+			"ukt": 50, "ul": 61, "ul ": 15, "ulb": 7, "ull": 18, "ult": 7, "um": 128, "um ": 32,
+			"ume": 52, "umm": 31, "ump": 7, "un": 146, "unc": 15, "und": 25, "uni": 23, "unn": 17,
+			"unt": 50, "up": 36, "upd": 13, "upl": 7, "ur": 99, "ur ": 16, "ura": 11, "ure": 21,
+			"uri": 15, "urt": 10, "us": 83, "us ": 46, "uss": 10, "ut": 236, "ut ": 56, "ute": 61,
+			"uth": 71, "uto": 17, "uu": 40, "uur": 30, "uut": 8, "uw": 89, "uw ": 31, "uwd": 7,
+			"uwe": 22, "uwi": 27, "ux": 19, "ux ": 18, "uz": 14, "uze": 13, "v": 1977, "v ": 23,
+			"va": 534, "vaa": 7, "val": 14, "van": 456, "var": 12, "vat": 38, "ve": 828, "ve ": 30,
+			"vea": 38, "vee": 14, "vei": 14, "vel": 16, "ven": 204, "ver": 505, "vi": 65, "via": 7,
+			"vic": 7, "vie": 8, "vin": 22, "vo": 482, "voe": 156, "vol": 67, "von": 16, "voo": 221,
+			"vor": 20, "vr": 25, "vra": 16, "vri": 9, "w": 866, "w ": 40, "wa": 229, "waa": 129,
+			"wac": 48, "war": 28, "wd": 12, "wd ": 9, "we": 189, "we ": 16, "wee": 31, "weg": 20,
+			"wel": 11, "wer": 90, "wez": 8, "wi": 163, "wij": 88, "wil": 14, "win": 41, "wit": 9,
+			"wn": 12, "wo": 198, "woo": 27, "wor": 166, "ws": 13, "ws ": 13, "x": 156, "x ": 56,
+			"xi": 16, "xim": 15, "xp": 14, "xpr": 10, "xt": 31, "xt ": 18, "xtr": 10, "xx": 9,
+			"xxx": 8, "xy": 10, "y": 221, "y ": 45, "ym": 19, "ymb": 19, "yn": 12, "yp": 35,
+			"ype": 28, "ys": 81, "yst": 77, "yt": 17, "yte": 17, "z": 493, "z ": 13, "za": 13,
+			"ze": 164, "ze ": 65, "zel": 17, "zen": 36, "zer": 7, "zes": 8, "zet": 24, "zi": 195,
+			"zie": 12, "zig": 28, "zij": 127, "zin": 16, "zit": 8, "zo": 84, "zoe": 18, "zon": 43,
+			"zu": 10, "zul": 7, "zw": 8, "é": 77, "én": 40, "én ": 40, "éé": 34, "één": 34,
+			"ë": 43, "ëi": 10, "ëin": 10, "ër": 20, "ëre": 17, "ï": 29, "ïn": 25, "ïns": 24,
+		}},
+		"no": {Total: 225097, Ngrams: map[string]int{
+			" a": 783, " a ": 5, " ab": 5, " ac": 5, " ad": 82, " ag": 5, " ak": 12, " al": 77,
+			" an": 65, " ap": 30, " ar": 86, " at": 47, " au": 33, " av": 326, " b": 553, " ba": 58,
+			" be": 76, " bi": 22, " bl": 92, " bo": 11, " br": 223, " bu": 10, " by": 26, " bå": 25,
+			" bø": 7, " c": 71, " c ": 10, " ca": 8, " cd": 12, " cl": 6, " co": 15, " cr": 10,
+			" d": 672, " d ": 9, " da": 72, " de": 341, " di": 50, " do": 21, " du": 163, " e": 1109,
+			" e ": 15, " eg": 10, " ei": 12, " ek": 60, " el": 157, " en": 318, " eo": 9, " er": 393,
+			" et": 110, " ev": 9, " f": 1178, " f ": 12, " fa": 36, " fe": 122, " fi": 286, " fj": 37,
+			" fl": 55, " fo": 443, " fr": 79, " fu": 41, " få": 6, " fø": 53, " g": 285, " ga": 29,
+			" ge": 21, " gi": 28, " gj": 57, " gl": 6, " gn": 11, " go": 11, " gp": 5, " gr": 53,
+			" gs": 11, " gy": 29, " gå": 14, " h": 337, " ha": 79, " he": 69, " hj": 6, " ho": 25,
+			" ht": 9, " hu": 15, " hv": 111, " hå": 10, " hø": 6, " i": 1157, " i ": 199, " id": 43,
+			" ig": 34, " ik": 526, " im": 23, " in": 315, " ip": 6, " j": 22, " jo": 10, " k": 761,
+			" ka": 185, " ki": 20, " kj": 53, " kl": 171, " ko": 249, " kr": 36, " ku": 34, " l": 371,
+			" l ": 9, " la": 93, " le": 126, " li": 99, " lo": 15, " lu": 5, " ly": 5, " lå": 7,
+			" lø": 6, " m": 596, " ma": 104, " me": 299, " mi": 67, " mo": 32, " mu": 20, " må": 52,
+			" mø": 14, " n": 449, " n ": 24, " na": 54, " ne": 47, " no": 48, " nu": 40, " ny": 44,
+			" nå": 36, " nø": 150, " o": 534, " o ": 9, " ob": 5, " oc": 7, " of": 18, " og": 173,
+			" om": 42, " op": 197, " or": 9, " os": 7, " ov": 57, " p": 579, " pa": 133, " pe": 20,
+			" pg": 11, " pi": 12, " pk": 8, " pl": 19, " po": 30, " pr": 144, " på": 189, " r": 199,
+			" r ": 11, " ra": 14, " re": 125, " ro": 16, " rø": 13, " s": 1386, " s ": 6, " sa": 92,
+			" sc": 11, " se": 156, " si": 145, " sj": 18, " sk": 198, " sl": 87, " sn": 5, " so": 211,
+			" sp": 35, " st": 290, " su": 18, " sv": 17, " sy": 50, " så": 10, " sø": 25, " t": 597,
+			" ta": 48, " te": 74, " ti": 341, " tj": 19, " to": 43, " tr": 22, " tt": 5, " tv": 11,
+			" ty": 20, " u": 461, " ub": 11, " uf": 6, " ug": 56, " ui": 6, " uk": 5, " ul": 8,
+			" un": 85, " up": 5, " us": 7, " ut": 245, " uv": 11, " v": 552, " va": 182, " ve": 207,
+			" vi": 108, " vo": 11, " væ": 35, " w": 32, " wa": 18, " wh": 6, " x": 17, " x ": 9,
+			" y": 9, " z": 6, " å": 275, " å ": 253, " åp": 20, " é": 16, " én": 16, " ø": 30,
+			" ød": 11, " øk": 12, "a": 4194, "a ": 292, "ab": 39, "aba": 12, "abe": 14, "abs": 5,
+			"ac": 19, "ach": 5, "ack": 6, "ad": 119, "ada": 8, "ade": 16, "adm": 6, "adr": 28,
+			"adv": 52, "ae": 11, "aen": 9, "af": 20, "afi": 14, "ag": 121, "ag ": 14, "age": 62,
+			"agg": 7, "agr": 25, "agt": 7, "ai": 12, "ain": 6, "ak": 170, "ak ": 10, "ake": 24,
+			"akk": 82, "aks": 13, "akt": 33, "al": 549, "al ": 81, "alb": 7, "ale": 18, "alg": 188,
+			"ali": 18, "alj": 8, "all": 163, "alo": 20, "als": 9, "alt": 22, "am": 190, "am ": 17,
+			"ame": 15, "aml": 11, "amm": 74, "ams": 14, "amt": 32, "amv": 8, "an": 562, "an ": 159,
+			"ana": 19, "and": 188, "ang": 79, "ani": 6, "anl": 6, "ann": 17, "ans": 19, "ant": 55,
+			"ap": 120, "ap ": 7, "ape": 6, "app": 78, "apt": 21, "ar": 676, "ar ": 111, "ara": 11,
+			"arb": 6, "arc": 16, "ard": 78, "are": 98, "arg": 34, "ari": 10, "ark": 60, "ars": 54,
+			"art": 186, "arø": 6, "as": 225, "ase": 30, "asj": 61, "ask": 8, "ass": 66, "ast": 50,
+			"at": 570, "at ": 97, "ata": 178, "ate": 75, "ati": 35, "ato": 24, "att": 56, "atu": 90,
+			"au": 36, "aut": 33, "av": 458, "av ": 259, "avb": 9, "ave": 8, "avh": 11, "avn": 109,
+			"avs": 44, "avt": 6, "b": 864, "b ": 9, "ba": 110, "bak": 18, "bar": 64, "bas": 15,
+			"bb": 12, "bbe": 11, "be": 128, "be ": 6, "beg": 12, "beh": 21, "bek": 21, "bel": 14,
+			"ber": 13, "bes": 13, "bet": 14, "bi": 55, "bib": 5, "bil": 8, "bin": 31, "bit": 6,
+			"bj": 5, "bje": 5, "bl": 142, "ble": 46, "bli": 76, "blo": 16, "bo": 30, "bok": 7,
+			"bol": 15, "br": 250, "bre": 10, "bru": 231, "bry": 6, "bs": 13, "bst": 8, "bu": 41,
+			"buf": 7, "bus": 9, "but": 12, "by": 31, "byg": 7, "byt": 23, "bå": 26, "båd": 23,
+			"bø": 7, "bør": 7, "c": 215, "c ": 42, "ca": 23, "cas": 8, "cd": 14, "cd ": 6,
+			"cdx": 5, "ce": 14, "ce ": 9, "ch": 26, "che": 15, "ck": 21, "cks": 9, "cl": 10,
+			"clo": 5, "co": 17, "con": 14, "cr": 19, "cre": 10, "crl": 8, "cs": 9, "csp": 7,
+			"ct": 6, "cu": 5, "d": 2743, "d ": 470, "da": 285, "da ": 8, "dag": 7, "dar": 72,
+			"dat": 191, "db": 7, "dd": 37, "dd ": 14, "dde": 19, "de": 937, "de ": 272, "dea": 7,
+			"def": 8, "deg": 5, "dek": 13, "del": 59, "dem": 9, "den": 175, "dep": 6, "der": 170,
+			"des": 27, "det": 174, "df": 25, "dfe": 5, "dfr": 12, "di": 307, "di ": 25, "die": 33,
+			"dif": 5, "dig": 144, "dik": 9, "din": 43, "dir": 15, "dis": 14, "dit": 12, "dk": 5,
+			"dl": 75, "dla": 9, "dle": 35, "dli": 31, "dm": 7, "dmi": 6, "dn": 13, "dni": 11,
+			"do": 95, "do ": 30, "doe": 33, "dok": 11, "dol": 5, "dom": 6, "dr": 114, "dre": 83,
+			"dri": 28, "ds": 40, "dsa": 7, "dsp": 8, "dss": 6, "dt": 34, "dt ": 11, "dta": 5,
+			"dte": 15, "du": 212, "du ": 153, "dum": 7, "dus": 33, "dut": 14, "dv": 56, "dva": 52,
+			"dx": 5, "dx ": 5, "dy": 7, "e": 10570, "e ": 2556, "ea": 28, "eak": 8, "eam": 12,
+			"eb": 12, "ec": 11, "ed": 402, "ed ": 248, "edd": 8, "ede": 74, "edi": 28, "edl": 21,
+			"edn": 9, "ee": 12, "ef": 75, "efe": 16, "eff": 9, "efi": 18, "eft": 20, "efø": 6,
+			"eg": 164, "eg ": 14, "ege": 14, "egg": 34, "egi": 11, "egl": 5, "egn": 65, "egr": 6,
+			"egu": 6, "egy": 5, "eh": 55, "eha": 18, "eho": 36, "ei": 158, "ei ": 6, "eie": 11,
+			"eil": 133, "ek": 231, "ek ": 10, "eke": 7, "ekk": 33, "eko": 7, "ekr": 23, "eks": 101,
+			"ekt": 26, "eku": 7, "ekv": 9, "el": 817, "el ": 157, "ela": 18, "eld": 55, "ele": 88,
+			"elf": 6, "elg": 47, "eli": 80, "ell": 205, "elp": 5, "els": 81, "elt": 43, "elv": 19,
+			"em": 161, "em ": 16, "ema": 16, "eme": 58, "emm": 25, "emo": 13, "emp": 6, "ems": 9,
+			"emt": 9, "en": 1621, "en ": 720, "ena": 8, "enc": 5, "end": 184, "ene": 134, "enf": 15,
+			"eng": 86, "enh": 14, "eni": 7, "enk": 40, "enl": 8, "enn": 93, "eno": 7, "enp": 7,
+			"ens": 51, "ent": 231, "enu": 5, "eo": 19, "eof": 6, "ep": 27, "ept": 7, "er": 2630,
+			"er ": 1625, "era": 31, "erb": 8, "erd": 39, "ere": 271, "erf": 24, "erg": 5, "erh": 16,
+			"eri": 107, "erk": 45, "erm": 21, "ern": 95, "ero": 5, "erp": 8, "err": 9, "ers": 74,
+			"ert": 226, "erv": 17, "es": 503, "es ": 202, "ese": 44, "esi": 46, "esk": 19, "esn": 9,
+			"eso": 5, "esp": 18, "ess": 84, "est": 54, "esu": 7, "et": 982, "et ": 584, "eta": 29,
+			"ete": 44, "eti": 14, "etn": 6, "eto": 10, "etr": 15, "ets": 41, "ett": 221, "ety": 7,
+			"eu": 5, "ev": 81, "eva": 5, "eve": 56, "evi": 6, "evn": 6, "evt": 5, "ew": 5,
+			"ex": 8, "f": 1695, "f ": 35, "fa": 44, "fal": 8, "fan": 20, "fe": 187, "fei": 133,
+			"fel": 11, "fen": 15, "fer": 20, "ff": 41, "ff ": 8, "ffe": 24, "ffi": 9, "fi": 431,
+			"fig": 6, "fik": 65, "fil": 285, "fin": 46, "fis": 23, "fj": 39, "fje": 39, "fl": 65,
+			"fla": 5, "fle": 36, "fli": 6, "fly": 17, "fo": 533, "fo ": 9, "for": 514, "fr": 104,
+			"fra": 75, "fre": 10, "fri": 17, "fs": 5, "ft": 45, "ft ": 9, "fte": 27, "fts": 5,
+			"fu": 51, "ful": 18, "fun": 32, "få": 6, "fø": 103, "føl": 41, "før": 56, "g": 2597,
+			"g ": 808, "ga": 59, "ga ": 11, "gam": 12, "gan": 27, "gd": 18, "gde": 14, "ge": 537,
+			"ge ": 151, "gel": 23, "gen": 161, "ger": 109, "ges": 10, "get": 71, "gf": 10, "gfr": 9,
+			"gg": 72, "gg ": 28, "gge": 33, "ggi": 5, "gh": 33, "ghe": 31, "gi": 134, "gi ": 31,
+			"gig": 9, "gin": 13, "gio": 5, "gir": 9, "gis": 16, "git": 38, "giv": 5, "gj": 83,
+			"gje": 71, "gjø": 8, "gl": 42, "gla": 12, "gle": 25, "gn": 195, "gn ": 47, "gna": 77,
+			"gne": 28, "gno": 25, "gnu": 12, "go": 14, "god": 11, "gp": 21, "gp ": 15, "gpg": 5,
+			"gr": 169, "gr ": 5, "gra": 78, "gre": 40, "gri": 5, "gru": 39, "gs": 119, "gs ": 8,
+			"gsf": 9, "gsk": 6, "gsl": 5, "gsm": 9, "gsp": 8, "gss": 18, "gst": 27, "gsv": 6,
+			"gså": 7, "gt": 109, "gt ": 85, "gte": 20, "gu": 44, "gul": 6, "gum": 28, "gur": 6,
+			"gv": 5, "gy": 91, "gyl": 85, "gyn": 5, "gå": 29, "gå ": 9, "går": 10, "gåt": 10,
+			"h": 584, "h ": 19, "ha": 108, "ha ": 5, "han": 33, "har": 64, "he": 181, "hel": 16,
+			"hem": 24, "hen": 48, "het": 61, "hev": 23, "hi": 12, "hil": 7, "hj": 6, "hje": 6,
+			"ho": 91, "hod": 9, "hol": 67, "hop": 10, "ht": 9, "htt": 9, "hu": 16, "hur": 11,
+			"hv": 112, "hve": 24, "hvi": 72, "hvo": 11, "hå": 12, "hån": 12, "hø": 14, "hør": 8,
+			"høy": 6, "i": 4751, "i ": 315, "ia": 29, "ia ": 8, "iab": 5, "ial": 11, "ib": 29,
+			"ibl": 8, "ibu": 13, "ic": 12, "ica": 5, "id": 194, "id ": 62, "idd": 8, "ide": 43,
+			"idi": 36, "idl": 20, "ids": 24, "ie": 90, "ie ": 7, "iel": 10, "ien": 19, "ier": 40,
+			"iet": 11, "if": 99, "ifi": 70, "ift": 18, "ig": 475, "ig ": 210, "ige": 69, "igg": 8,
+			"igh": 33, "igj": 10, "igl": 11, "ign": 114, "igu": 9, "ik": 693, "ik ": 9, "ika": 49,
+			"ike": 27, "ikk": 567, "ikn": 6, "iks": 16, "ikt": 12, "il": 877, "il ": 456, "ila": 15,
+			"ilb": 14, "ild": 34, "ile": 113, "ilf": 10, "ilg": 26, "ilh": 8, "ilk": 27, "ill": 91,
+			"ilm": 9, "iln": 18, "ilp": 5, "ils": 24, "ilt": 15, "im": 58, "ima": 8, "ime": 21,
+			"imp": 22, "in": 1018, "in ": 26, "ina": 24, "ind": 29, "ine": 29, "inf": 31, "ing": 489,
+			"ini": 6, "inj": 59, "ink": 20, "inn": 208, "ins": 39, "int": 25, "inu": 21, "inæ": 5,
+			"io": 28, "ion": 13, "ior": 6, "iot": 5, "ip": 24, "ipt": 7, "ir": 76, "ir ": 44,
+			"ire": 6, "irk": 15, "irm": 5, "is": 351, "is ": 119, "ise": 48, "isi": 5, "isj": 8,
+			"isk": 52, "isl": 16, "isn": 11, "iss": 9, "ist": 75, "it": 158, "it ": 8, "ite": 29,
+			"iti": 9, "itm": 5, "its": 13, "itt": 74, "itu": 10, "iv": 208, "iv ": 83, "ivb": 6,
+			"ive": 80, "ivi": 16, "ivå": 8, "ix": 11, "ix ": 11, "j": 510, "ja": 8, "ja ": 7,
+			"je": 278, "je ": 30, "jek": 24, "jel": 31, "jem": 6, "jen": 101, "jer": 69, "jes": 8,
+			"jet": 5, "jo": 152, "job": 6, "jon": 138, "ju": 8, "jus": 8, "jø": 61, "jør": 52,
+			"k": 4046, "k ": 263, "ka": 342, "ka ": 6, "kad": 5, "kal": 64, "kan": 177, "kap": 12,
+			"kas": 7, "kat": 64, "ke": 1151, "ke ": 638, "kef": 10, "kel": 174, "ken": 33, "ker": 179,
+			"kes": 51, "ket": 39, "kev": 10, "kg": 6, "ki": 125, "kif": 11, "kil": 34, "kin": 26,
+			"kiv": 45, "kj": 79, "kje": 34, "kjø": 45, "kk": 893, "kk ": 55, "kke": 813, "kki": 11,
+			"kl": 228, "kla": 169, "kle": 35, "klo": 5, "klu": 15, "kn": 22, "kne": 5, "kny": 5,
+			"ko": 319, "kob": 11, "kod": 39, "kol": 8, "kom": 130, "kon": 81, "kop": 19, "kor": 27,
+			"kr": 206, "kre": 54, "kri": 119, "kro": 6, "kry": 20, "ks": 174, "ks ": 25, "kse": 7,
+			"ksi": 11, "ksj": 30, "ksk": 12, "ksp": 12, "kst": 53, "ksv": 9, "kt": 137, "kt ": 61,
+			"kte": 27, "kti": 32, "ktu": 5, "ku": 63, "kul": 6, "kum": 11, "kun": 38, "kur": 5,
+			"kv": 11, "kve": 8, "ky": 7, "kå": 6, "kår": 6, "l": 4517, "l ": 848, "la": 403,
+			"la ": 23, "lag": 91, "lan": 13, "lar": 167, "las": 53, "lat": 47, "lb": 25, "lba": 16,
+			"ld": 255, "ld ": 23, "lde": 100, "ldi": 114, "ldr": 5, "ldt": 6, "le": 885, "le ": 142,
+			"led": 17, "leg": 31, "lem": 50, "len": 124, "ler": 396, "les": 65, "let": 49, "lf": 31,
+			"lfi": 10, "lfo": 5, "lfø": 9, "lg": 304, "lg ": 35, "lga": 11, "lge": 135, "lgf": 9,
+			"lgj": 16, "lgt": 92, "lh": 10, "lhø": 8, "li": 459, "li ": 10, "lig": 136, "lik": 47,
+			"lin": 142, "lio": 5, "lir": 30, "lis": 43, "lit": 34, "lj": 12, "lje": 8, "lk": 38,
+			"lke": 6, "lkn": 7, "lko": 13, "lkå": 6, "ll": 556, "ll ": 105, "lla": 40, "lle": 295,
+			"llf": 8, "lli": 34, "llo": 25, "lls": 24, "llt": 8, "lly": 5, "lm": 15, "lme": 11,
+			"ln": 23, "lna": 21, "lo": 112, "lob": 8, "loc": 10, "log": 32, "lok": 24, "lom": 20,
+			"lon": 7, "lov": 5, "lp": 12, "lp ": 5, "ls": 158, "ls ": 9, "lse": 79, "lsk": 19,
+			"lst": 17, "lsu": 16, "lsv": 5, "lsø": 6, "lt": 106, "lt ": 42, "lta": 14, "lte": 15,
+			"lti": 14, "ltj": 8, "ltr": 5, "lu": 107, "lud": 15, "lug": 6, "lum": 6, "lut": 74,
+			"lv": 25, "lv ": 6, "lve": 6, "lvi": 5, "ly": 48, "lyk": 22, "lyt": 19, "lå": 44,
+			"lå ": 12, "lås": 8, "låt": 17, "læ": 8, "lær": 8, "lø": 28, "løk": 5, "løp": 7,
+			"løs": 14, "m": 2014, "m ": 307, "ma": 292, "ma ": 13, "mak": 5, "mal": 14, "man": 99,
+			"map": 58, "mar": 15, "mas": 31, "mat": 48, "mb": 34, "mbi": 18, "mbo": 14, "md": 5,
+			"me": 638, "me ": 36, "med": 181, "mel": 70, "men": 148, "mer": 109, "mes": 7, "met": 81,
+			"mf": 6, "mi": 92, "mid": 16, "mil": 6, "min": 44, "mis": 21, "ml": 22, "ml ": 5,
+			"mla": 7, "mle": 6, "mm": 240, "mma": 72, "mme": 164, "mn": 8, "mng": 5, "mo": 61,
+			"mod": 31, "mon": 11, "mot": 10, "mp": 82, "mp ": 5, "mpa": 10, "mpi": 6, "mpl": 19,
+			"mpo": 25, "mpr": 11, "mr": 16, "mrå": 11, "ms": 30, "msn": 6, "msv": 14, "mt": 57,
+			"mt ": 12, "mti": 37, "mu": 22, "mul": 20, "mv": 8, "mva": 7, "må": 63, "må ": 40,
+			"mål": 21, "mø": 21, "møn": 19, "n": 5191, "n ": 1300, "na": 243, "nal": 49, "nar": 5,
+			"nas": 11, "nat": 59, "nav": 106, "nc": 9, "nd": 531, "nd ": 19, "nda": 122, "nde": 173,
+			"ndi": 20, "ndl": 32, "ndo": 71, "ndr": 74, "ndt": 18, "ne": 580, "ne ": 232, "ned": 28,
+			"neh": 30, "nen": 60, "ner": 109, "nes": 47, "net": 43, "nf": 56, "nfl": 6, "nfo": 44,
+			"ng": 682, "ng ": 310, "nga": 7, "ngd": 14, "nge": 190, "ngi": 30, "ngl": 19, "ngr": 8,
+			"ngs": 91, "ngt": 8, "nh": 30, "nhe": 11, "nho": 18, "ni": 119, "nin": 74, "nis": 11,
+			"nit": 8, "niv": 8, "nj": 59, "nje": 54, "nk": 108, "nk ": 8, "nke": 39, "nkl": 9,
+			"nkr": 6, "nks": 19, "nkt": 21, "nl": 37, "nli": 24, "nn": 375, "nn ": 76, "nnd": 43,
+			"nne": 190, "nnh": 15, "nni": 8, "nnl": 11, "nns": 14, "no": 96, "no ": 9, "noe": 21,
+			"nom": 6, "non": 8, "nor": 29, "not": 7, "np": 7, "npg": 7, "ns": 163, "ns ": 21,
+			"nsa": 6, "nse": 22, "nsi": 6, "nsk": 20, "nst": 70, "nt": 411, "nt ": 87, "nta": 49,
+			"nte": 166, "nti": 38, "ntl": 15, "ntr": 41, "nu": 90, "nul": 31, "num": 22, "nut": 6,
+			"nux": 17, "nv": 19, "nve": 16, "ny": 55, "ny ": 7, "nye": 13, "nyt": 32, "nå": 39,
+			"nå ": 8, "når": 21, "næ": 7, "nær": 7, "nø": 167, "nøk": 159, "o": 2850, "o ": 96,
+			"ob": 46, "obb": 11, "obl": 25, "oc": 30, "ock": 14, "ocs": 7, "od": 120, "ode": 58,
+			"odt": 6, "odu": 34, "oe": 56, "oe ": 6, "oen": 36, "oer": 14, "of": 32, "of ": 7,
+			"off": 17, "og": 269, "og ": 177, "oge": 9, "ogg": 9, "ogr": 64, "ogs": 7, "ok": 60,
+			"oka": 6, "okk": 23, "oks": 7, "oku": 11, "ol": 161, "old": 73, "oli": 6, "oll": 39,
+			"olo": 7, "ols": 13, "olu": 8, "om": 436, "om ": 236, "oma": 12, "omb": 19, "oml": 7,
+			"omm": 84, "omp": 40, "omr": 14, "oms": 6, "omt": 10, "on": 312, "on ": 95, "one": 72,
+			"onf": 8, "onl": 8, "ons": 17, "ont": 77, "onv": 16, "oo": 11, "oot": 6, "op": 253,
+			"ope": 15, "opi": 16, "opp": 217, "or": 737, "or ": 349, "orb": 9, "ord": 67, "ore": 54,
+			"orh": 7, "ori": 18, "ork": 6, "orm": 69, "orr": 13, "ors": 17, "ort": 84, "orv": 33,
+			"os": 81, "ose": 36, "osi": 20, "ost": 12, "ot": 49, "ot ": 10, "ota": 7, "ote": 10,
+			"ots": 6, "ott": 7, "ou": 12, "ov": 76, "ove": 70, "ox": 6, "oxy": 6, "p": 1724,
+			"p ": 89, "pa": 172, "pak": 82, "par": 14, "pas": 51, "pat": 7, "pc": 7, "pd": 23,
+			"pda": 21, "pe": 206, "pe ": 76, "pen": 21, "per": 59, "pes": 24, "pet": 10, "pf": 17,
+			"pfø": 16, "pg": 74, "pg ": 9, "pgi": 45, "pgp": 15, "ph": 25, "phe": 23, "pi": 50,
+			"pie": 12, "pil": 12, "pin": 13, "pk": 10, "pl": 54, "pla": 15, "ple": 18, "pli": 12,
+			"plu": 7, "pn": 20, "pne": 15, "po": 96, "pon": 19, "por": 41, "pos": 27, "pp": 322,
+			"pp ": 21, "ppd": 18, "ppe": 94, "ppf": 16, "ppg": 47, "pph": 25, "ppo": 14, "ppr": 31,
+			"pps": 38, "ppt": 7, "pr": 204, "pre": 39, "pri": 29, "pro": 117, "prø": 15, "ps": 44,
+			"pse": 18, "pst": 15, "pt": 66, "pt ": 30, "pte": 25, "pu": 37, "pub": 7, "pun": 20,
+			"på": 190, "på ": 180, "pø": 9, "pør": 9, "r": 6146, "r ": 2284, "ra": 231, "ra ": 62,
+			"rad": 10, "raf": 7, "rak": 10, "ram": 70, "ran": 19, "rap": 9, "ras": 18, "rat": 10,
+			"rav": 8, "rb": 31, "rbe": 14, "rbi": 6, "rc": 20, "rc ": 15, "rd": 192, "rd ": 70,
+			"rde": 27, "rdf": 20, "rdi": 49, "rdu": 14, "re": 1035, "re ": 303, "rea": 18, "red": 42,
+			"ree": 7, "ref": 41, "reg": 29, "rek": 34, "rel": 39, "rem": 12, "ren": 102, "rep": 13,
+			"rer": 151, "res": 126, "ret": 84, "rev": 27, "rf": 25, "rfo": 8, "rg": 45, "rgu": 28,
+			"rh": 23, "rhe": 16, "rho": 6, "ri": 411, "ri ": 15, "ria": 7, "rib": 13, "rie": 14,
+			"rif": 12, "rig": 14, "rik": 6, "rim": 16, "rin": 153, "rio": 6, "rip": 10, "ris": 7,
+			"rit": 24, "riv": 108, "rk": 126, "rk ": 20, "rke": 37, "rki": 48, "rko": 7, "rl": 38,
+			"rl ": 12, "rle": 9, "rli": 14, "rm": 101, "rma": 61, "rmi": 9, "rmn": 6, "rmå": 6,
+			"rmø": 6, "rn": 103, "rn ": 31, "rna": 17, "rne": 34, "rnø": 10, "ro": 199, "rob": 13,
+			"rod": 8, "rog": 60, "rol": 40, "rom": 8, "roo": 6, "ros": 31, "rot": 10, "rox": 6,
+			"rp": 9, "rpr": 8, "rr": 53, "rre": 39, "rri": 12, "rs": 184, "rs ": 14, "rse": 73,
+			"rsj": 33, "rsk": 18, "rso": 7, "rst": 18, "rsø": 6, "rt": 537, "rt ": 190, "rte": 250,
+			"rti": 74, "rts": 10, "ru": 293, "ruk": 235, "run": 19, "rup": 25, "rv": 56, "rva": 18,
+			"rve": 25, "rvå": 10, "ry": 66, "ryk": 29, "ryp": 18, "ryt": 6, "rå": 17, "råd": 11,
+			"rø": 64, "røm": 30, "rør": 19, "røv": 15, "s": 4032, "s ": 509, "sa": 122, "sa ": 8,
+			"sam": 82, "sat": 8, "sb": 12, "sc": 15, "sch": 11, "sd": 10, "sda": 7, "se": 630,
+			"se ": 117, "sed": 7, "seg": 9, "sek": 21, "sel": 92, "sen": 77, "ser": 165, "ses": 62,
+			"set": 67, "sf": 29, "sfe": 6, "sfi": 11, "sfo": 11, "sh": 10, "si": 265, "sia": 6,
+			"sid": 10, "sie": 7, "sif": 18, "sig": 93, "sik": 26, "sim": 8, "sin": 33, "sis": 32,
+			"sit": 18, "six": 6, "sj": 161, "sje": 21, "sjo": 138, "sk": 390, "sk ": 52, "ska": 71,
+			"ske": 30, "ski": 34, "skj": 17, "skl": 12, "sko": 26, "skr": 132, "sku": 6, "sky": 6,
+			"sl": 170, "sle": 21, "sli": 11, "slu": 70, "sly": 16, "slå": 37, "sm": 31, "sma": 9,
+			"sme": 12, "sn": 45, "sna": 7, "sni": 29, "so": 276, "soc": 10, "sok": 7, "som": 183,
+			"son": 9, "sor": 53, "sp": 95, "sp ": 7, "spe": 24, "spi": 9, "spo": 21, "spu": 18,
+			"spø": 9, "sr": 13, "sre": 7, "ss": 206, "ss ": 23, "sse": 69, "ssi": 11, "sso": 43,
+			"sst": 26, "ssu": 18, "st": 795, "st ": 72, "sta": 200, "std": 12, "ste": 202, "sti": 62,
+			"sto": 18, "str": 102, "sty": 17, "stå": 11, "stø": 75, "su": 68, "sub": 6, "suf": 7,
+			"sul": 7, "sum": 18, "sur": 18, "sv": 60, "sv ": 14, "sva": 36, "sve": 7, "sy": 58,
+			"sym": 17, "syn": 11, "sys": 29, "så": 17, "så ": 17, "sø": 37, "søk": 34, "t": 5858,
+			"t ": 1612, "ta": 551, "ta ": 118, "tab": 19, "tad": 13, "tae": 9, "taf": 9, "tag": 8,
+			"tak": 18, "tal": 119, "tan": 81, "tar": 49, "tas": 19, "tat": 76, "tb": 10, "tc": 6,
+			"td": 53, "tda": 42, "tdb": 6, "te": 1312, "te ": 463, "ted": 17, "teg": 60, "tek": 37,
+			"tel": 35, "tem": 45, "ten": 112, "ter": 361, "tes": 49, "tet": 126, "tf": 26, "tfo": 6,
+			"tfø": 12, "tg": 17, "tgå": 6, "th": 11, "th ": 9, "ti": 698, "ti ": 10, "tib": 7,
+			"tid": 79, "tif": 50, "tig": 37, "til": 354, "tim": 7, "tin": 44, "tio": 6, "tis": 46,
+			"tit": 9, "tiv": 36, "tj": 38, "tje": 38, "tk": 13, "tka": 9, "tl": 36, "tli": 19,
+			"tlø": 10, "tm": 9, "tn": 34, "tni": 25, "to": 137, "to ": 31, "tod": 11, "tol": 7,
+			"tom": 34, "top": 9, "tor": 27, "tp": 30, "tp ": 10, "tpa": 14, "tr": 238, "tr ": 8,
+			"tra": 15, "tre": 63, "tri": 27, "tro": 43, "tru": 16, "try": 35, "trø": 30, "ts": 113,
+			"ts ": 7, "tsd": 6, "tse": 14, "tsf": 13, "tsk": 21, "tsm": 9, "tsn": 7, "tst": 18,
+			"tt": 605, "tt ": 220, "tta": 11, "tte": 257, "tti": 33, "ttp": 12, "ttr": 38, "tts": 9,
+			"tty": 9, "tu": 115, "tue": 6, "tur": 61, "tus": 40, "tv": 31, "tve": 8, "tvi": 19,
+			"ty": 62, "ty ": 8, "typ": 35, "tyr": 15, "tå": 11, "tår": 6, "tø": 84, "tør": 28,
+			"tøt": 48, "u": 1699, "u ": 165, "ua": 8, "ub": 26, "ubl": 8, "ubr": 9, "ubs": 6,
+			"ud": 22, "ude": 16, "ue": 15, "uel": 12, "uf": 20, "uff": 10, "ug": 64, "ugg": 6,
+			"ugy": 56, "ui": 11, "uk": 245, "uk ": 68, "uke": 137, "ukk": 7, "ukt": 22, "ul": 107,
+			"uli": 16, "ull": 56, "ult": 16, "ulæ": 6, "um": 102, "um ": 17, "ume": 42, "umm": 31,
+			"ump": 7, "un": 198, "un ": 16, "und": 72, "ung": 9, "unk": 36, "unn": 47, "up": 48,
+			"upp": 25, "ur": 121, "ur ": 19, "urb": 7, "urd": 8, "ure": 34, "urs": 22, "urt": 14,
+			"us": 111, "us ": 53, "use": 16, "usj": 7, "usk": 8, "ust": 13, "ut": 399, "ut ": 72,
+			"utd": 40, "ute": 48, "utf": 17, "utg": 16, "utk": 7, "utl": 13, "utn": 16, "uto": 23,
+			"utp": 14, "uts": 18, "utt": 95, "utv": 12, "uv": 11, "uve": 11, "ux": 19, "ux ": 19,
+			"v": 1803, "v ": 377, "va": 324, "vak": 6, "val": 189, "van": 12, "var": 106, "vb": 17,
+			"vba": 8, "vbr": 8, "ve": 539, "ve ": 32, "ved": 85, "vel": 64, "ven": 53, "ver": 254,
+			"ves": 8, "vet": 36, "vh": 12, "vhe": 11, "vi": 244, "vid": 24, "vil": 34, "vin": 20,
+			"vir": 15, "vis": 134, "vn": 119, "vn ": 86, "vne": 20, "vo": 24, "vol": 11, "vor": 12,
+			"vs": 51, "vsl": 41, "vt": 15, "vt ": 9, "vtr": 6, "vu": 6, "vv": 7, "vå": 18,
+			"vå ": 6, "våk": 10, "væ": 38, "vær": 38, "w": 50, "w ": 6, "wa": 19, "war": 18,
+			"wh": 6, "whi": 6, "x": 82, "x ": 49, "xx": 11, "xxx": 8, "xy": 6, "y": 477,
+			"y ": 50, "ya": 6, "yd": 10, "ye": 23, "ye ": 11, "yer": 8, "yg": 11, "ygg": 8,
+			"yk": 53, "ykk": 45, "ykt": 7, "yl": 90, "yld": 87, "ym": 21, "ymb": 14, "yn": 17,
+			"yp": 53, "ype": 35, "ypt": 18, "yr": 17, "yr ": 6, "ys": 33, "yst": 29, "yt": 85,
+			"yte": 24, "ytt": 54, "z": 11, "å": 753, "å ": 531, "åd": 39, "åde": 34, "åk": 13,
+			"åk ": 6, "ål": 25, "ål ": 13, "ån": 15, "ånd": 15, "åp": 20, "åpn": 19, "år": 54,
+			"år ": 45, "årl": 6, "ås": 12, "åt": 32, "ått": 29, "æ": 57, "ær": 57, "ære": 31,
+			"ært": 15, "é": 20, "én": 16, "én ": 16, "ø": 629, "ød": 20, "øde": 12, "øk": 210,
+			"øk ": 13, "øke": 17, "øki": 7, "økk": 134, "økl": 30, "økt": 9, "øl": 41, "ølg": 41,
+			"øm": 34, "øm ": 13, "ømm": 20, "øn": 23, "øns": 22, "øp": 8, "ør": 181, "ør ": 49,
+			"øre": 48, "øri": 21, "ørl": 11, "ørr": 28, "ørs": 13, "ørt": 10, "øs": 18, "øse": 8,
+			"øt": 50, "øtt": 50, "øv": 17, "øv ": 6, "øve": 9, "øy": 23, "øy ": 8, "øye": 7,
+		}},
+		"pl": {Total: 227233, Ngrams: map[string]int{
+			" a": 367, " a ": 14, " ab": 62, " ad": 32, " ak": 42, " al": 61, " an": 19, " ap": 26,
+			" ar": 67, " at": 11, " au": 18, " b": 361, " ba": 21, " be": 48, " bi": 39, " bl": 18,
+			" br": 52, " by": 70, " bę": 15, " bł": 84, " c": 242, " ca": 24, " ce": 31, " ch": 12,
+			" co": 34, " cy": 9, " cz": 88, " d": 667, " da": 77, " de": 31, " di": 18, " dl": 72,
+			" do": 343, " dr": 27, " du": 18, " dw": 9, " dy": 11, " dz": 25, " dł": 15, " e": 76,
+			" e ": 9, " ek": 9, " el": 26, " f": 91, " fi": 17, " fo": 35, " fu": 9, " g": 159,
+			" ga": 25, " gi": 36, " gn": 9, " go": 10, " gr": 28, " gs": 10, " gł": 12, " h": 61,
+			" ha": 15, " he": 20, " hi": 12, " ht": 13, " i": 353, " i ": 128, " ic": 9, " id": 36,
+			" ig": 13, " in": 121, " is": 29, " j": 385, " ja": 64, " je": 295, " ju": 13, " k": 456,
+			" ka": 75, " ki": 17, " kl": 125, " ko": 189, " kr": 14, " kt": 27, " l": 241, " li": 115,
+			" lo": 30, " lu": 79, " m": 451, " ma": 89, " me": 27, " mi": 49, " mo": 229, " mu": 44,
+			" n": 1093, " na": 395, " ni": 608, " no": 40, " nu": 26, " o": 540, " o ": 37, " ob": 115,
+			" oc": 30, " od": 127, " of": 16, " og": 15, " ok": 14, " op": 98, " or": 9, " os": 42,
+			" ot": 12, " oz": 16, " p": 1604, " pa": 130, " pe": 19, " pi": 20, " pl": 263, " po": 665,
+			" pr": 425, " pu": 36, " pł": 14, " q": 12, " r": 274, " ra": 19, " re": 106, " ro": 93,
+			" rz": 11, " ró": 30, " s": 744, " sa": 13, " sc": 38, " se": 74, " sh": 10, " si": 119,
+			" sk": 68, " sp": 97, " st": 142, " su": 13, " sy": 83, " sz": 22, " są": 37, " t": 428,
+			" ta": 61, " te": 113, " to": 63, " tr": 52, " tw": 11, " ty": 105, " u": 546, " uc": 9,
+			" ud": 41, " un": 23, " up": 24, " ur": 45, " us": 116, " ut": 40, " uw": 56, " uz": 18,
+			" uż": 139, " v": 9, " w": 1052, " w ": 199, " wa": 53, " wc": 16, " we": 98, " wi": 81,
+			" wo": 9, " wp": 22, " ws": 65, " wy": 430, " wz": 16, " wę": 9, " wł": 36, " z": 909,
+			" z ": 138, " za": 370, " zb": 15, " zd": 20, " ze": 67, " zg": 11, " zm": 63, " zn": 96,
+			" zo": 59, " zr": 9, " zw": 34, " zł": 18, " ł": 43, " ła": 29, " łą": 12, " ś": 51,
+			" śc": 30, " śr": 11, " ź": 23, " źr": 23, " ż": 38, " ża": 13, " że": 18, "a": 6645,
+			"a ": 1512, "ab": 98, "abl": 10, "aby": 63, "ac": 333, "ach": 64, "aci": 25, "acj": 128,
+			"ack": 16, "acy": 12, "acz": 76, "ad": 189, "ad ": 21, "ada": 24, "adk": 11, "adn": 47,
+			"ado": 16, "adr": 29, "adz": 13, "af": 20, "afu": 10, "ag": 141, "aga": 75, "agi": 10,
+			"agł": 17, "ai": 33, "ain": 23, "aj": 154, "aj ": 46, "ajn": 11, "ają": 58, "ak": 340,
+			"ak ": 63, "akc": 34, "aki": 72, "ako": 80, "akt": 30, "aku": 23, "akó": 11, "al": 418,
+			"al ": 9, "ala": 26, "alb": 10, "ale": 98, "ali": 45, "aln": 100, "alo": 85, "alt": 23,
+			"am": 264, "am ": 29, "ame": 30, "ami": 127, "amo": 29, "amu": 19, "an": 1046, "an ": 23,
+			"ana": 42, "and": 42, "ane": 186, "ang": 14, "ani": 475, "ano": 42, "anu": 12, "any": 179,
+			"aną": 14, "ap": 158, "api": 93, "apo": 10, "app": 14, "ar": 331, "ar ": 16, "ara": 21,
+			"arc": 55, "ard": 42, "are": 14, "arg": 22, "arn": 13, "aro": 12, "ars": 9, "art": 67,
+			"aru": 15, "ary": 11, "arz": 24, "as": 209, "as ": 30, "ase": 10, "aso": 30, "ast": 54,
+			"asu": 27, "asz": 23, "asł": 11, "at": 317, "at ": 43, "ata": 59, "ate": 19, "atk": 16,
+			"atn": 19, "ato": 45, "atr": 12, "atu": 33, "aty": 42, "ató": 12, "au": 39, "auf": 14,
+			"aut": 18, "aw": 337, "awa": 22, "awd": 48, "awe": 14, "awi": 178, "awn": 30, "az": 192,
+			"az ": 14, "aza": 15, "azu": 17, "azw": 106, "azy": 20, "ać": 207, "ać ": 207, "ał": 165,
+			"ał ": 25, "ała": 25, "ałe": 10, "ałk": 9, "ało": 46, "ały": 11, "ałą": 13, "ałę": 14,
+			"ań": 25, "ań ": 11, "ańc": 12, "aś": 28, "aśc": 19, "aż": 79, "aż ": 25, "ażd": 16,
+			"aże": 16, "ażn": 17, "b": 1000, "b ": 98, "ba": 54, "ba ": 19, "be": 78, "ber": 9,
+			"bez": 51, "bi": 133, "bib": 10, "bie": 89, "bl": 69, "ble": 17, "bli": 24, "blo": 27,
+			"bn": 12, "bo": 56, "bo ": 9, "boc": 15, "bol": 21, "br": 108, "bra": 99, "bs": 43,
+			"bsł": 33, "bu": 47, "by": 169, "by ": 93, "byt": 10, "być": 55, "bę": 19, "będ": 18,
+			"bł": 85, "błą": 49, "błę": 34, "c": 2603, "c ": 90, "ca": 87, "ca ": 19, "cal": 30,
+			"cał": 10, "ce": 245, "ce ": 47, "ceg": 24, "cej": 25, "cel": 16, "cen": 74, "cer": 20,
+			"ces": 25, "ceń": 10, "ch": 485, "ch ": 342, "cha": 22, "che": 17, "chi": 39, "cho": 37,
+			"ci": 409, "ci ": 107, "cia": 52, "cie": 137, "cio": 30, "ciu": 20, "ciw": 14, "cią": 18,
+			"cj": 285, "cja": 69, "cje": 36, "cji": 141, "cjo": 12, "cję": 16, "ck": 34, "ck ": 19,
+			"cn": 14, "co": 52, "co ": 9, "con": 13, "cor": 9, "cr": 11, "cs": 11, "ct": 19,
+			"cu": 27, "cuc": 13, "cy": 94, "cy ": 36, "cyc": 20, "cyf": 12, "cyj": 12, "cym": 10,
+			"cz": 700, "cz ": 36, "cza": 123, "czb": 25, "cze": 146, "czk": 12, "czn": 116, "czo": 54,
+			"czy": 150, "czą": 15, "czę": 15, "cą": 10, "cą ": 10, "d": 2204, "d ": 164, "da": 369,
+			"da ": 11, "daj": 28, "dal": 18, "dan": 151, "dar": 40, "dat": 37, "dać": 24, "dał": 39,
+			"dc": 56, "dcz": 53, "de": 160, "dek": 42, "den": 47, "di": 30, "dir": 9, "dk": 26,
+			"dl": 75, "dla": 72, "dm": 16, "dmo": 9, "dn": 165, "dna": 24, "dne": 22, "dni": 61,
+			"dno": 19, "dny": 25, "dną": 11, "do": 447, "do ": 178, "dod": 38, "dok": 20, "dom": 44,
+			"dop": 11, "dos": 26, "dow": 95, "doz": 9, "dp": 80, "dpi": 55, "dpo": 20, "dr": 80,
+			"dre": 22, "drz": 28, "drę": 14, "ds": 28, "dst": 18, "dt": 10, "du": 52, "du ": 14,
+			"duł": 12, "duż": 17, "dw": 20, "dy": 67, "dy ": 30, "dz": 190, "dza": 25, "dze": 21,
+			"dzi": 95, "dzo": 20, "dzy": 23, "dą": 12, "dł": 103, "dła": 17, "dło": 63, "dłu": 17,
+			"dź": 11, "e": 5954, "e ": 1924, "ea": 41, "ead": 15, "eam": 19, "eb": 37, "ec": 155,
+			"ec ": 20, "ece": 41, "ech": 11, "eci": 12, "ect": 10, "ecz": 26, "ed": 145, "ed ": 17,
+			"ede": 20, "edn": 41, "edy": 22, "edz": 20, "ee": 9, "ef": 41, "efe": 32, "eg": 333,
+			"ego": 299, "egu": 13, "ej": 271, "ej ": 170, "eje": 14, "ejs": 15, "eją": 14, "ejś": 50,
+			"ek": 264, "ek ": 41, "eka": 10, "ekc": 30, "eki": 35, "eko": 10, "ekr": 10, "eks": 58,
+			"ekt": 55, "eku": 11, "el": 174, "ela": 10, "ele": 28, "eli": 25, "eln": 44, "elo": 28,
+			"els": 10, "elu": 13, "em": 222, "em ": 106, "ema": 16, "eme": 27, "emo": 21, "emu": 34,
+			"en": 608, "en ": 43, "enc": 54, "ene": 14, "eni": 334, "enn": 10, "ent": 124, "eo": 23,
+			"ep": 88, "epo": 34, "epr": 30, "er": 502, "er ": 30, "era": 112, "ere": 33, "erm": 10,
+			"ern": 15, "ero": 43, "ers": 66, "ert": 30, "eru": 10, "erw": 39, "ery": 22, "erz": 63,
+			"es": 352, "es ": 20, "esi": 11, "esj": 9, "est": 213, "esu": 20, "esz": 31, "et": 202,
+			"et ": 34, "eta": 19, "etl": 46, "etu": 20, "etw": 13, "ety": 25, "etó": 16, "eu": 9,
+			"ew": 92, "ewa": 30, "ewi": 13, "ewn": 30, "ex": 14, "ez": 158, "ez ": 71, "eze": 13,
+			"ezi": 24, "ezn": 9, "ezp": 10, "ezw": 20, "eć": 14, "eć ": 14, "eł": 33, "ełn": 14,
+			"eń": 48, "eń ": 43, "eś": 62, "eśl": 47, "eż": 119, "eże": 37, "eżk": 22, "eżn": 9,
+			"eży": 28, "eżą": 17, "f": 410, "f ": 26, "fa": 30, "fan": 16, "fe": 39, "fer": 35,
+			"ff": 16, "ffs": 9, "fi": 120, "fig": 16, "fik": 71, "fil": 15, "fl": 19, "fli": 9,
+			"fo": 93, "fo ": 9, "for": 83, "fr": 15, "fs": 16, "fse": 9, "fu": 19, "fu ": 10,
+			"fun": 9, "g": 1087, "g ": 49, "ga": 126, "ga ": 26, "gan": 47, "gał": 24, "ge": 26,
+			"gi": 119, "gi ": 25, "gie": 23, "gin": 10, "git": 35, "giw": 17, "gl": 25, "gn": 48,
+			"gna": 12, "gno": 23, "go": 355, "go ": 303, "god": 10, "gow": 18, "goś": 9, "gp": 14,
+			"gp ": 9, "gr": 117, "gra": 98, "gru": 10, "gs": 12, "gst": 10, "gu": 90, "gu ": 38,
+			"gul": 13, "gum": 19, "gur": 12, "gó": 27, "gól": 9, "gów": 9, "gą": 16, "gą ": 16,
+			"gł": 39, "głó": 24, "h": 578, "h ": 353, "ha": 43, "ham": 9, "han": 9, "has": 9,
+			"he": 39, "hea": 14, "hem": 9, "hi": 57, "hiw": 29, "ho": 38, "hom": 19, "how": 13,
+			"ht": 16, "htt": 12, "i": 5997, "i ": 842, "ia": 559, "ia ": 365, "iad": 14, "iaj": 13,
+			"iam": 9, "ian": 60, "iar": 18, "ias": 20, "iał": 25, "ib": 22, "ibl": 9, "ic": 176,
+			"ic ": 27, "ice": 22, "ich": 28, "icz": 70, "id": 98, "id ": 22, "ide": 35, "idł": 33,
+			"ie": 2030, "ie ": 1078, "ieb": 9, "iec": 39, "ied": 40, "ieg": 13, "iej": 47, "iek": 50,
+			"iel": 52, "iem": 51, "ien": 142, "ieo": 18, "iep": 44, "ier": 164, "ies": 28, "iet": 99,
+			"iew": 26, "iez": 28, "ieć": 14, "ień": 19, "ieś": 9, "ież": 48, "if": 15, "ig": 45,
+			"ign": 20, "igu": 12, "ii": 25, "ii ": 25, "ij": 24, "ij ": 10, "ija": 12, "ik": 477,
+			"ik ": 64, "ika": 158, "iki": 40, "iko": 14, "iku": 110, "ikó": 75, "il": 42, "il ": 9,
+			"im": 36, "imp": 10, "in": 269, "in ": 14, "ina": 21, "ind": 38, "inf": 48, "ini": 49,
+			"inn": 23, "ins": 30, "int": 18, "io": 187, "iod": 29, "iom": 25, "ion": 64, "iot": 10,
+			"iow": 43, "ip": 12, "ir": 16, "is": 373, "is ": 25, "isa": 53, "isk": 16, "ist": 76,
+			"isu": 54, "isy": 29, "isz": 58, "isó": 42, "it": 88, "it ": 35, "ita": 11, "ite": 14,
+			"iu": 87, "iu ": 65, "ium": 20, "iw": 121, "iwa": 57, "iwe": 13, "iwo": 13, "iwu": 24,
+			"iz": 44, "iza": 20, "ią": 63, "ią ": 16, "iąg": 13, "iąz": 31, "ić": 70, "ić ": 70,
+			"ię": 224, "ię ": 115, "ięc": 53, "ięd": 21, "ięk": 10, "ięt": 22, "ił": 9, "iś": 11,
+			"iż": 10, "j": 1446, "j ": 293, "ja": 165, "ja ": 63, "jak": 58, "jal": 13, "jan": 9,
+			"je": 416, "je ": 107, "jed": 45, "jeg": 10, "jes": 194, "jeś": 33, "jeż": 13, "ji": 167,
+			"ji ": 167, "jm": 14, "jn": 37, "jne": 9, "jno": 12, "jny": 14, "jo": 13, "jon": 9,
+			"js": 26, "ju": 16, "już": 13, "ją": 146, "ją ": 38, "jąc": 97, "ję": 27, "ję ": 19,
+			"jś": 101, "jśc": 101, "k": 2378, "k ": 204, "ka": 425, "ka ": 117, "kac": 38, "kal": 22,
+			"kam": 13, "kan": 17, "kat": 113, "kaz": 34, "kać": 13, "każ": 35, "kc": 80, "kce": 24,
+			"kcj": 46, "ki": 330, "ki ": 147, "kic": 16, "kie": 125, "kiw": 32, "kl": 141, "kla": 46,
+			"klu": 83, "kn": 9, "ko": 503, "ko ": 95, "kod": 42, "kol": 16, "kom": 50, "kon": 115,
+			"kop": 14, "kow": 116, "koń": 31, "kr": 92, "kra": 20, "kre": 22, "kro": 16, "kry": 18,
+			"kró": 12, "ks": 81, "ks ": 10, "ksi": 9, "kst": 19, "ksu": 17, "ksz": 9, "kt": 136,
+			"ktu": 45, "kty": 24, "któ": 50, "ku": 188, "ku ": 144, "kuj": 23, "kum": 10, "kó": 97,
+			"ków": 97, "ką": 9, "kę": 13, "kę ": 13, "kł": 48, "kła": 37, "l": 1963, "l ": 85,
+			"la": 246, "la ": 128, "lac": 9, "lan": 24, "lar": 10, "law": 42, "lb": 10, "le": 267,
+			"le ": 66, "lec": 46, "led": 10, "lem": 34, "len": 26, "lez": 23, "leż": 38, "li": 585,
+			"li ": 60, "lic": 68, "lik": 278, "lin": 27, "lio": 9, "lis": 37, "lit": 12, "liw": 25,
+			"liz": 27, "lić": 19, "lk": 52, "lko": 49, "ln": 200, "lne": 68, "lni": 64, "lny": 49,
+			"lo": 219, "lob": 9, "loc": 9, "log": 66, "lok": 46, "lon": 42, "low": 37, "ls": 21,
+			"lsk": 15, "lt": 42, "lt ": 11, "lte": 9, "lu": 184, "lu ": 16, "lub": 76, "luc": 83,
+			"m": 1816, "m ": 319, "ma": 289, "ma ": 49, "mac": 57, "mag": 61, "maj": 9, "mal": 17,
+			"mas": 15, "mat": 45, "mb": 25, "mbo": 21, "mc": 10, "mcz": 10, "me": 152, "me ": 9,
+			"men": 66, "mer": 29, "met": 28, "mi": 387, "mi ": 86, "mia": 82, "mie": 94, "mij": 10,
+			"min": 32, "mię": 44, "ml": 11, "mn": 29, "mni": 16, "mo": 328, "moc": 16, "mod": 24,
+			"mog": 19, "mon": 13, "mow": 37, "moś": 10, "moż": 189, "mp": 36, "mpo": 9, "mpr": 11,
+			"mu": 152, "mu ": 61, "muj": 12, "mun": 21, "mus": 49, "my": 44, "myś": 28, "mó": 14,
+			"mów": 11, "n": 4909, "n ": 107, "na": 880, "na ": 361, "nac": 53, "nad": 24, "nag": 24,
+			"naj": 28, "nak": 53, "nal": 77, "nan": 28, "nap": 15, "nar": 23, "nas": 21, "nat": 17,
+			"naw": 16, "naz": 106, "nać": 15, "nał": 9, "nc": 73, "ncj": 47, "nd": 93, "nda": 39,
+			"nde": 38, "ne": 524, "ne ": 303, "neg": 116, "nej": 63, "ner": 16, "nf": 74, "nfi": 16,
+			"nfl": 9, "nfo": 48, "ng": 23, "ngi": 10, "ni": 1948, "ni ": 30, "nia": 346, "nic": 60,
+			"nie": 1200, "nii": 11, "nik": 126, "nio": 31, "niu": 46, "nić": 15, "nię": 36, "niż": 10,
+			"nk": 31, "nkc": 10, "nn": 40, "nne": 16, "nni": 9, "no": 245, "no ": 84, "nor": 20,
+			"nos": 12, "now": 55, "noś": 49, "np": 15, "ns": 44, "nst": 30, "nt": 200, "nt ": 23,
+			"nta": 12, "nte": 28, "nto": 12, "ntr": 19, "ntu": 26, "nty": 42, "ntó": 33, "nu": 63,
+			"nu ": 19, "num": 17, "ny": 446, "ny ": 228, "nyc": 156, "nym": 54, "ną": 72, "ną ": 44,
+			"nąć": 21, "nę": 16, "nęt": 14, "o": 5210, "o ": 934, "ob": 229, "oba": 14, "obe": 10,
+			"obi": 62, "obl": 19, "obo": 20, "obr": 34, "obs": 35, "oby": 9, "oc": 138, "oce": 29,
+			"ocz": 68, "od": 536, "od ": 31, "oda": 122, "odc": 54, "ode": 17, "odk": 9, "odm": 9,
+			"odn": 36, "odo": 35, "odp": 69, "odr": 16, "ods": 20, "odu": 22, "odw": 9, "ody": 9,
+			"odz": 23, "odł": 30, "of": 21, "off": 11, "og": 173, "og ": 10, "ogi": 10, "ogo": 11,
+			"ogr": 71, "ogu": 30, "ogó": 15, "ogą": 14, "oj": 12, "oje": 9, "ok": 162, "oka": 69,
+			"oki": 14, "oko": 18, "okr": 20, "oku": 21, "okł": 9, "ol": 152, "ola": 12, "ole": 70,
+			"oli": 27, "oln": 17, "olo": 14, "om": 223, "om ": 22, "oma": 13, "omi": 61, "omo": 26,
+			"omp": 25, "omu": 24, "omy": 29, "on": 399, "on ": 12, "ona": 50, "one": 71, "onf": 26,
+			"oni": 41, "ono": 48, "ont": 42, "ony": 78, "op": 167, "opc": 35, "ope": 27, "opi": 30,
+			"opr": 43, "or": 344, "or ": 16, "ora": 28, "orc": 15, "ore": 14, "ori": 28, "orm": 75,
+			"oro": 26, "ort": 23, "ory": 17, "orz": 64, "oró": 13, "os": 221, "osi": 13, "oso": 20,
+			"ost": 131, "osu": 10, "osz": 35, "ot": 90, "ote": 14, "otn": 9, "oto": 22, "otw": 14,
+			"ow": 783, "owa": 308, "owe": 120, "owi": 98, "own": 52, "owo": 27, "owy": 126, "ową": 19,
+			"owł": 11, "oz": 159, "ozd": 9, "ozi": 25, "ozm": 19, "ozn": 20, "ozp": 16, "ozs": 9,
+			"ozw": 28, "ozy": 25, "oł": 45, "oła": 15, "ołą": 18, "oń": 33, "ońc": 31, "oś": 149,
+			"ośc": 79, "ość": 59, "oż": 207, "oże": 48, "ożl": 16, "ożn": 136, "p": 2621, "p ": 86,
+			"pa": 196, "pac": 45, "pak": 72, "pam": 26, "par": 17, "pas": 21, "pc": 43, "pcj": 35,
+			"pe": 80, "pec": 13, "pen": 10, "per": 18, "pew": 10, "peł": 14, "pg": 16, "pgp": 9,
+			"pi": 305, "pie": 45, "pis": 224, "pk": 15, "pl": 291, "pli": 270, "pn": 18, "po": 776,
+			"po ": 24, "pob": 34, "poc": 15, "pod": 217, "pok": 48, "pol": 61, "pom": 41, "pon": 23,
+			"pop": 23, "por": 35, "pos": 18, "pot": 31, "pow": 107, "poz": 62, "poł": 19, "pp": 14,
+			"pps": 9, "pr": 582, "pra": 129, "pre": 26, "pro": 143, "prz": 253, "pró": 16, "ps": 20,
+			"pst": 10, "pt": 24, "pu": 89, "pu ": 23, "puj": 15, "pus": 26, "py": 20, "pó": 14,
+			"pł": 18, "pły": 15, "q": 14, "r": 2979, "r ": 93, "ra": 592, "ra ": 88, "rac": 31,
+			"raf": 20, "raj": 16, "rak": 57, "ram": 81, "ran": 67, "rat": 14, "raw": 134, "raz": 32,
+			"rać": 17, "raż": 16, "rc": 74, "rch": 37, "rci": 10, "rd": 49, "rdo": 37, "re": 308,
+			"re ": 33, "rea": 25, "red": 12, "ref": 33, "reg": 19, "rej": 9, "rek": 9, "rem": 12,
+			"ren": 31, "rep": 27, "res": 46, "reś": 14, "rg": 28, "rgu": 19, "ri": 55, "riu": 19,
+			"rl": 23, "rl ": 23, "rm": 88, "rma": 72, "rmi": 11, "rn": 29, "rna": 11, "rne": 10,
+			"ro": 414, "rob": 35, "roc": 23, "rod": 20, "rog": 66, "rol": 15, "ron": 19, "rop": 10,
+			"ros": 39, "row": 79, "roz": 69, "rp": 9, "rs": 80, "rsj": 31, "rsk": 12, "rsz": 25,
+			"rt": 126, "rto": 61, "rtu": 11, "rty": 29, "ru": 103, "ru ": 16, "ruc": 30, "ruj": 12,
+			"ruk": 10, "rup": 11, "rw": 44, "rwe": 16, "rws": 9, "ry": 143, "ry ": 35, "ryb": 25,
+			"ryc": 13, "ryp": 10, "ryt": 15, "ryw": 18, "rz": 557, "rz ": 21, "rza": 22, "rze": 253,
+			"rzn": 14, "rzo": 20, "rzu": 13, "rzy": 187, "rzą": 11, "rzę": 15, "ró": 122, "rób": 12,
+			"róc": 9, "ród": 24, "rót": 11, "rów": 30, "róż": 27, "rę": 17, "ręc": 13, "s": 2788,
+			"s ": 123, "sa": 88, "sa ": 9, "sam": 12, "san": 31, "sać": 19, "sc": 57, "sca": 30,
+			"sch": 14, "se": 115, "se ": 10, "sek": 38, "sem": 10, "ser": 25, "set": 10, "sh": 17,
+			"si": 198, "si ": 29, "sie": 28, "sią": 9, "się": 109, "sj": 40, "sja": 16, "sji": 20,
+			"sk": 172, "ska": 33, "ski": 50, "sko": 20, "skr": 30, "skł": 25, "sl": 9, "so": 70,
+			"sob": 19, "sow": 33, "sp": 135, "sp ": 10, "spa": 21, "spe": 15, "spi": 13, "spo": 18,
+			"spr": 43, "ss": 12, "st": 924, "st ": 208, "sta": 305, "ste": 71, "stk": 36, "stn": 24,
+			"sto": 40, "str": 107, "stu": 11, "sty": 33, "stą": 17, "stę": 58, "su": 188, "su ": 97,
+			"suj": 30, "sun": 33, "sy": 139, "sy ": 13, "syg": 10, "sym": 27, "sys": 50, "syw": 22,
+			"sz": 317, "sz ": 35, "sza": 43, "szc": 28, "sze": 60, "szk": 10, "szu": 14, "szy": 74,
+			"szę": 29, "só": 54, "sów": 53, "są": 37, "są ": 37, "sł": 63, "słu": 43, "t": 3063,
+			"t ": 417, "ta": 539, "ta ": 35, "tac": 24, "tag": 21, "taj": 14, "tak": 14, "tal": 84,
+			"tan": 96, "tar": 41, "tat": 31, "taw": 78, "tać": 33, "tał": 33, "tc": 11, "te": 344,
+			"te ": 30, "teg": 48, "tej": 17, "tek": 35, "tel": 44, "tem": 60, "ten": 30, "ter": 49,
+			"ti": 28, "tk": 122, "tka": 12, "tki": 39, "tko": 57, "tl": 52, "tla": 39, "tm": 12,
+			"tn": 64, "tne": 15, "tni": 39, "to": 324, "to ": 66, "tok": 12, "tom": 20, "tor": 83,
+			"tos": 18, "tow": 58, "toś": 48, "tp": 18, "tp ": 13, "tr": 244, "tra": 22, "tre": 25,
+			"tro": 30, "tru": 25, "try": 31, "trz": 80, "tró": 11, "tt": 17, "ttp": 12, "tu": 175,
+			"tu ": 113, "tua": 23, "tuj": 10, "tur": 22, "tw": 87, "twa": 18, "two": 53, "twó": 12,
+			"ty": 343, "ty ": 83, "tyc": 46, "tyf": 47, "tyk": 14, "tyl": 50, "tym": 27, "typ": 32,
+			"tyw": 25, "tó": 131, "tór": 39, "tów": 92, "tą": 25, "tą ": 15, "tąp": 10, "tę": 69,
+			"tę ": 23, "tęp": 46, "u": 2248, "u ": 656, "ua": 28, "ual": 22, "ub": 94, "ub ": 76,
+			"uc": 146, "uch": 48, "ucz": 83, "ud": 52, "uda": 41, "uf": 23, "ufa": 16, "ug": 66,
+			"ugi": 38, "ugo": 12, "ui": 13, "uj": 148, "uj ": 46, "uje": 48, "ują": 53, "uk": 27,
+			"ul": 37, "ul ": 10, "ula": 16, "um": 117, "um ": 46, "ume": 45, "un": 117, "uni": 57,
+			"unk": 20, "unt": 10, "uną": 11, "up": 43, "ur": 89, "ura": 17, "url": 12, "uru": 28,
+			"ury": 11, "us": 209, "usi": 33, "ust": 82, "usu": 37, "usz": 30, "ut": 85, "uto": 18,
+			"utu": 10, "utw": 34, "uw": 63, "uwa": 19, "uwi": 41, "uz": 18, "uzy": 17, "uł": 17,
+			"uż": 172, "uż ": 13, "uży": 144, "v": 39, "v ": 12, "ve": 11, "w": 3389, "w ": 545,
+			"wa": 659, "wa ": 71, "wag": 13, "wal": 22, "wan": 353, "war": 80, "wać": 59, "waż": 26,
+			"wc": 23, "wcz": 14, "wd": 51, "wdz": 38, "we": 293, "we ": 56, "weg": 51, "wej": 79,
+			"wer": 63, "wet": 12, "wew": 14, "wi": 515, "wia": 34, "wid": 40, "wie": 226, "win": 13,
+			"wio": 43, "wis": 51, "wią": 31, "wić": 14, "wię": 28, "wk": 23, "wka": 13, "wn": 147,
+			"wna": 10, "wne": 13, "wni": 66, "wno": 20, "wny": 15, "wnę": 14, "wo": 157, "wo ": 13,
+			"wod": 12, "wol": 29, "wor": 55, "wow": 11, "woł": 14, "woś": 14, "wp": 22, "wpi": 14,
+			"wr": 18, "wró": 10, "ws": 92, "wsp": 15, "wst": 18, "wsz": 48, "wt": 10, "wu": 30,
+			"wum": 24, "wy": 638, "wy ": 112, "wyb": 22, "wyc": 46, "wyd": 14, "wyg": 15, "wyj": 56,
+			"wyk": 55, "wym": 96, "wyp": 53, "wyr": 21, "wys": 27, "wyw": 20, "wył": 30, "wyś": 47,
+			"wz": 23, "wzo": 12, "wó": 23, "wór": 10, "wą": 32, "wą ": 25, "wę": 25, "wę ": 14,
+			"wł": 52, "wła": 24, "wło": 13, "włą": 15, "x": 42, "x ": 30, "y": 2856, "y ": 826,
+			"yb": 50, "ybr": 16, "ybu": 13, "yc": 341, "ych": 254, "yci": 29, "ycz": 48, "yd": 23,
+			"yda": 18, "yf": 82, "yfi": 70, "yfr": 12, "yg": 33, "ygn": 10, "yj": 100, "yj ": 18,
+			"yjn": 15, "yjś": 51, "yk": 84, "yka": 10, "yki": 11, "ykl": 10, "yko": 28, "ykł": 14,
+			"yl": 55, "ylk": 48, "ym": 261, "ym ": 101, "yma": 82, "ymb": 21, "ymc": 10, "ymi": 28,
+			"ymu": 18, "yn": 65, "yna": 23, "ync": 11, "yni": 10, "yp": 101, "yp ": 10, "ypi": 47,
+			"ypt": 10, "ypu": 15, "yr": 30, "yra": 17, "ys": 148, "ysk": 21, "yst": 102, "ysz": 11,
+			"yt": 232, "yt ": 11, "yta": 52, "yte": 53, "ytk": 50, "yto": 28, "yty": 13, "yw": 152,
+			"ywa": 105, "ywn": 12, "ywo": 14, "yć": 131, "yć ": 131, "ył": 48, "yłą": 31, "yś": 76,
+			"yśl": 29, "yśw": 46, "z": 3337, "z ": 320, "za": 651, "za ": 90, "zab": 12, "zac": 32,
+			"zad": 10, "zai": 19, "zaj": 21, "zak": 29, "zal": 24, "zam": 44, "zan": 69, "zap": 101,
+			"zar": 11, "zas": 87, "zau": 14, "zaw": 55, "zać": 10, "zał": 10, "zb": 41, "zby": 15,
+			"zc": 30, "zcz": 29, "zd": 34, "zda": 19, "ze": 572, "ze ": 80, "zeb": 11, "zec": 21,
+			"zed": 19, "zeg": 31, "zek": 49, "zem": 17, "zen": 113, "zer": 50, "zes": 34, "zet": 13,
+			"zew": 26, "zez": 52, "zeń": 12, "zeż": 16, "zg": 24, "zi": 169, "zi ": 22, "zia": 21,
+			"zie": 52, "zio": 47, "zk": 30, "zki": 10, "zko": 13, "zm": 83, "zmi": 78, "zn": 260,
+			"zna": 132, "zne": 52, "zni": 31, "zny": 37, "zo": 187, "zon": 91, "zor": 13, "zos": 55,
+			"zow": 14, "zp": 27, "zpa": 11, "zpi": 10, "zr": 10, "zs": 14, "zu": 54, "zu ": 11,
+			"zuj": 17, "zuk": 11, "zw": 191, "zw ": 20, "zwa": 34, "zwi": 28, "zwo": 20, "zwy": 52,
+			"zwę": 14, "zy": 507, "zy ": 121, "zyc": 15, "zym": 27, "zyn": 33, "zys": 55, "zyt": 120,
+			"zyw": 29, "zyć": 52, "zą": 37, "zą ": 10, "ząd": 11, "zę": 59, "zę ": 29, "zęd": 13,
+			"zęś": 12, "zł": 23, "zło": 12, "ó": 546, "ób": 13, "óc": 11, "ód": 26, "ódł": 20,
+			"ój": 13, "ól": 17, "ór": 56, "óre": 20, "óry": 11, "órz": 17, "ót": 11, "ów": 352,
+			"ów ": 311, "ówk": 14, "ówn": 22, "ół": 10, "óż": 28, "óżn": 27, "ą": 648, "ą ": 232,
+			"ąc": 214, "ące": 63, "ący": 51, "ącz": 87, "ąd": 80, "ąd ": 52, "ąda": 15, "ąg": 13,
+			"ąp": 10, "ąt": 20, "ątk": 13, "ąz": 31, "ąza": 23, "ąć": 27, "ąć ": 27, "ąź": 10,
+			"ąź ": 10, "ć": 525, "ć ": 525, "ę": 543, "ę ": 230, "ęc": 72, "ęce": 17, "ęci": 40,
+			"ęcz": 13, "ęd": 97, "ędn": 32, "ędz": 38, "ędą": 10, "ęk": 10, "ęp": 46, "ępn": 18,
+			"ępu": 18, "ęt": 39, "ętr": 14, "ęz": 21, "ęzi": 14, "ęś": 12, "ł": 805, "ł ": 50,
+			"ła": 183, "ła ": 36, "ład": 44, "łam": 10, "łan": 32, "łat": 13, "łań": 13, "łaś": 21,
+			"łe": 21, "łe ": 10, "łk": 11, "łn": 15, "ło": 159, "ło ": 79, "łok": 11, "łow": 48,
+			"łoż": 16, "łu": 72, "ług": 57, "ły": 50, "ły ": 21, "łyt": 12, "łó": 36, "łów": 35,
+			"łą": 148, "łąc": 87, "łąd": 49, "łąź": 10, "łę": 50, "łęd": 34, "łęz": 14, "ń": 118,
+			"ń ": 64, "ńc": 45, "ńcu": 16, "ńcz": 21, "ś": 502, "śc": 247, "ści": 247, "śl": 84,
+			"śle": 14, "śli": 33, "śln": 27, "śn": 18, "śni": 16, "śr": 18, "św": 52, "świ": 51,
+			"ść": 67, "ść ": 67, "ź": 50, "ź ": 18, "źr": 23, "źró": 23, "ż": 668, "ż ": 51,
+			"ża": 18, "żad": 13, "żd": 17, "że": 134, "że ": 59, "żel": 13, "żen": 41, "żk": 22,
+			"żka": 10, "żl": 16, "żli": 16, "żn": 189, "żna": 136, "żni": 38, "żno": 10, "ży": 180,
+			"ży ": 29, "życ": 27, "żyj": 17, "żyt": 55, "żyw": 26, "żyć": 24, "żą": 26, "żąc": 16,
+		}},
+		"pt": {Total: 214518, Ngrams: map[string]int{
+			" a": 1178, " a ": 323, " ab": 26, " ac": 57, " ad": 31, " ag": 10, " ai": 6, " aj": 6,
+			" al": 100, " am": 15, " an": 59, " ao": 99, " ap": 99, " ar": 77, " as": 83, " at": 58,
+			" au": 84, " av": 32, " b": 116, " ba": 25, " bi": 29, " bl": 16, " br": 10, " bu": 8,
+			" by": 14, " bz": 5, " c": 919, " c ": 8, " ca": 172, " cd": 8, " ce": 11, " ch": 56,
+			" ci": 15, " cl": 10, " co": 541, " cp": 5, " cr": 44, " ct": 8, " cu": 9, " cá": 6,
+			" có": 21, " d": 1839, " da": 166, " de": 1236, " di": 105, " do": 280, " dp": 7, " du": 20,
+			" dv": 6, " dw": 6, " e": 1006, " e ": 106, " ec": 11, " el": 17, " em": 130, " en": 165,
+			" er": 77, " es": 340, " et": 6, " ev": 7, " ex": 130, " f": 693, " fa": 115, " fe": 15,
+			" fi": 320, " fl": 15, " fo": 188, " fr": 12, " fu": 16, " g": 118, " ga": 5, " ge": 42,
+			" gn": 14, " gr": 37, " h": 88, " ha": 13, " hi": 13, " ho": 24, " ht": 12, " há": 12,
+			" hú": 5, " i": 538, " id": 22, " ig": 20, " im": 136, " in": 321, " ip": 5, " ir": 6,
+			" is": 13, " it": 6, " j": 24, " jo": 7, " já": 12, " k": 14, " ke": 6, " l": 307,
+			" la": 17, " le": 53, " li": 155, " lo": 65, " m": 437, " ma": 135, " me": 93, " mi": 11,
+			" mm": 8, " mo": 108, " mu": 34, " má": 24, " mé": 6, " mú": 8, " n": 824, " n ": 10,
+			" na": 56, " ne": 126, " no": 228, " nu": 28, " nã": 306, " ní": 21, " nú": 43, " o": 693,
+			" o ": 318, " ob": 47, " oc": 6, " of": 11, " om": 10, " on": 7, " op": 81, " or": 25,
+			" os": 72, " ou": 113, " p": 1057, " pa": 492, " pe": 95, " pg": 5, " pi": 7, " po": 221,
+			" pr": 201, " pu": 7, " pô": 5, " pú": 6, " q": 128, " qu": 120, " qw": 6, " r": 287,
+			" ra": 8, " re": 258, " ro": 5, " s": 953, " s ": 6, " sa": 72, " sc": 7, " se": 395,
+			" sh": 15, " si": 132, " so": 82, " st": 29, " su": 128, " sy": 5, " sã": 30, " sí": 22,
+			" só": 17, " t": 417, " ta": 95, " te": 173, " ti": 34, " tl": 8, " to": 39, " tr": 45,
+			" tê": 8, " u": 479, " u ": 5, " um": 276, " un": 23, " up": 7, " ur": 7, " us": 66,
+			" ut": 81, " v": 231, " va": 72, " ve": 95, " vi": 17, " vo": 15, " vá": 18, " ví": 6,
+			" w": 38, " wa": 15, " wi": 7, " wo": 7, " x": 18, " x ": 9, " z": 12, " ze": 11,
+			" º": 18, " º ": 18, " à": 9, " à ": 9, " á": 15, " ár": 12, " é": 168, " é ": 165,
+			" í": 13, " ín": 11, " ú": 24, " úl": 15, " ún": 7, "a": 7458, "a ": 2124, "ab": 100,
+			"aba": 10, "abe": 52, "abi": 8, "abr": 7, "abs": 9, "ac": 228, "aca": 7, "ace": 14,
+			"ach": 14, "ack": 9, "aco": 97, "act": 65, "acç": 8, "ad": 811, "ad ": 14, "ada": 215,
+			"ade": 67, "adi": 18, "ado": 442, "adr": 32, "adu": 11, "af": 5, "ag": 82, "ag ": 5,
+			"aga": 9, "age": 55, "ai": 155, "ail": 9, "ain": 23, "aio": 11, "air": 18, "ais": 78,
+			"aj": 7, "aju": 6, "ak": 8, "ak ": 8, "al": 594, "al ": 150, "ala": 54, "alc": 8,
+			"ale": 13, "alg": 17, "alh": 92, "ali": 105, "all": 5, "alm": 13, "alo": 38, "alq": 10,
+			"alt": 70, "am": 238, "am ": 61, "ama": 58, "amb": 21, "ame": 62, "ami": 15, "amp": 16,
+			"an": 329, "an ": 6, "ana": 19, "anc": 13, "and": 81, "ang": 9, "anh": 34, "ani": 15,
+			"ano": 8, "ans": 19, "ant": 80, "anu": 8, "anç": 20, "ao": 101, "ao ": 97, "ap": 150,
+			"ap ": 5, "apa": 19, "ape": 26, "apl": 32, "app": 13, "apr": 5, "aps": 8, "apt": 22,
+			"apó": 13, "ar": 998, "ar ": 449, "ara": 310, "arc": 23, "are": 26, "arf": 7, "arg": 29,
+			"ari": 21, "arm": 6, "aro": 7, "arq": 42, "arr": 19, "art": 20, "ará": 20, "arâ": 6,
+			"as": 538, "as ": 396, "ase": 11, "asi": 19, "asp": 8, "ass": 59, "ast": 35, "at": 246,
+			"at ": 6, "ata": 25, "atc": 5, "ate": 12, "ati": 43, "ato": 46, "atr": 28, "atu": 48,
+			"ató": 17, "au": 100, "au ": 6, "aus": 5, "aut": 81, "av": 133, "ava": 6, "ave": 48,
+			"avi": 28, "avo": 23, "avr": 18, "ax": 8, "axe": 5, "az": 60, "az ": 11, "aze": 15,
+			"azi": 31, "aç": 373, "aço": 25, "açã": 285, "açõ": 63, "aí": 56, "aíd": 56, "b": 508,
+			"b ": 18, "ba": 49, "ba ": 5, "bal": 8, "ban": 7, "bas": 12, "be": 63, "bel": 21,
+			"ber": 13, "beç": 25, "bi": 67, "bib": 7, "bie": 10, "bil": 7, "bin": 16, "bit": 14,
+			"biç": 7, "bj": 10, "bje": 8, "bl": 59, "ble": 12, "bli": 27, "blo": 19, "bo": 39,
+			"bol": 22, "bos": 7, "br": 61, "bra": 6, "bre": 28, "bri": 16, "bru": 8, "bs": 24,
+			"bso": 6, "bst": 15, "bt": 25, "bte": 22, "bu": 39, "buf": 6, "bui": 6, "but": 14,
+			"by": 16, "byt": 14, "bz": 5, "bé": 7, "bém": 7, "bó": 12, "ból": 12, "c": 2933,
+			"c ": 52, "ca": 586, "ca ": 71, "cab": 25, "cac": 10, "cad": 100, "cai": 6, "cal": 51,
+			"cam": 32, "can": 19, "cap": 30, "car": 88, "cas": 29, "cat": 9, "caç": 112, "cc": 8,
+			"cd": 10, "ce": 207, "ce ": 28, "ced": 10, "cen": 15, "cer": 23, "ces": 118, "ch": 367,
+			"ch ": 7, "cha": 59, "che": 294, "chi": 5, "ci": 294, "cia": 94, "cid": 27, "cie": 5,
+			"cif": 51, "cim": 7, "cio": 67, "cip": 5, "cis": 14, "cit": 10, "ck": 40, "ck ": 17,
+			"cke": 8, "cks": 12, "cl": 102, "cla": 59, "cle": 5, "clo": 5, "clu": 25, "co": 808,
+			"co ": 65, "cod": 15, "coi": 10, "col": 23, "com": 274, "con": 253, "cop": 12, "cor": 49,
+			"cos": 14, "cot": 88, "cp": 6, "cr": 140, "cre": 57, "cri": 57, "cro": 5, "crá": 5,
+			"crã": 8, "ct": 125, "cta": 12, "cte": 29, "cti": 20, "cto": 13, "ctr": 8, "ctu": 30,
+			"ctó": 6, "cu": 86, "cul": 13, "cum": 15, "cup": 6, "cur": 24, "cut": 15, "cuç": 7,
+			"cá": 8, "cál": 6, "cç": 50, "cçã": 41, "cçõ": 9, "cê": 12, "cê ": 9, "có": 21,
+			"cód": 19, "d": 3638, "d ": 74, "da": 592, "da ": 369, "dad": 110, "dam": 11, "dar": 8,
+			"das": 74, "dat": 14, "dd": 5, "de": 1554, "de ": 1097, "dec": 7, "def": 79, "dei": 20,
+			"del": 11, "dem": 48, "den": 41, "dep": 30, "der": 49, "des": 111, "det": 18, "dev": 28,
+			"di": 244, "dia": 10, "dic": 46, "did": 21, "dif": 24, "dig": 25, "din": 19, "dir": 44,
+			"dis": 38, "do": 1009, "do ": 640, "doc": 13, "doi": 6, "don": 15, "dor": 108, "dos": 210,
+			"dow": 11, "dp": 8, "dpk": 7, "dr": 41, "drã": 27, "ds": 11, "ds ": 9, "du": 47,
+			"duc": 5, "dum": 7, "dup": 7, "duz": 12, "dv": 7, "dw": 6, "dwa": 6, "dê": 11,
+			"dên": 9, "e": 7509, "e ": 2008, "ea": 56, "ead": 14, "eal": 12, "eam": 12, "eat": 5,
+			"eb": 11, "ec": 405, "ec ": 5, "eca": 10, "ece": 87, "ech": 9, "eci": 95, "ecl": 56,
+			"eco": 8, "ecr": 15, "ect": 33, "ecu": 37, "ecç": 42, "ed": 92, "ed ": 9, "ede": 51,
+			"edi": 25, "ee": 8, "ef": 103, "efe": 18, "efi": 80, "eg": 115, "ega": 12, "egi": 23,
+			"egm": 10, "egr": 8, "egu": 48, "egí": 5, "ei": 407, "eia": 21, "ein": 12, "eio": 9,
+			"eir": 303, "eis": 21, "eit": 36, "ej": 31, "eja": 21, "eje": 5, "ejo": 5, "el": 322,
+			"el ": 167, "ela": 35, "ele": 30, "elf": 10, "eli": 12, "elm": 5, "elo": 53, "em": 553,
+			"em ": 296, "ema": 118, "emb": 6, "eme": 21, "emi": 8, "emo": 40, "emp": 35, "emã": 6,
+			"emó": 18, "en": 745, "ena": 29, "enc": 65, "end": 71, "ene": 8, "enh": 38, "eno": 25,
+			"enq": 14, "ens": 50, "ent": 401, "enu": 7, "env": 14, "enç": 10, "eo": 9, "eo ": 7,
+			"ep": 83, "epa": 13, "epe": 16, "epo": 20, "epr": 10, "epu": 9, "epú": 11, "eq": 24,
+			"equ": 24, "er": 930, "er ": 276, "era": 100, "erb": 5, "ere": 65, "erg": 6, "eri": 49,
+			"erm": 68, "ern": 23, "ero": 51, "erp": 5, "err": 90, "ers": 76, "ert": 35, "erv": 34,
+			"erá": 12, "erê": 13, "es": 1090, "es ": 356, "esa": 22, "esc": 92, "ese": 29, "esg": 5,
+			"esi": 6, "esk": 6, "esl": 8, "esm": 17, "eso": 7, "esp": 124, "esq": 20, "ess": 158,
+			"est": 217, "esu": 8, "esv": 12, "et": 139, "et ": 17, "eta": 43, "ete": 19, "eti": 8,
+			"eto": 17, "etr": 14, "etó": 5, "eu": 24, "eu ": 16, "ev": 81, "eve": 60, "evi": 13,
+			"evo": 5, "ew": 5, "ex": 164, "ex ": 5, "exc": 24, "exe": 25, "exi": 22, "exp": 25,
+			"ext": 56, "ez": 16, "ez ": 10, "eza": 6, "eç": 65, "eça": 34, "eço": 23, "eçã": 7,
+			"eú": 16, "eúd": 16, "f": 1156, "f ": 38, "fa": 124, "fac": 7, "fal": 75, "fav": 20,
+			"faz": 13, "fe": 60, "fec": 11, "fei": 7, "fer": 36, "ff": 24, "ff ": 7, "ffe": 6,
+			"ffi": 6, "fi": 559, "fia": 6, "fic": 394, "fig": 18, "fil": 16, "fim": 15, "fin": 87,
+			"fix": 9, "fl": 25, "fli": 9, "flu": 14, "fo": 252, "fo ": 15, "foi": 54, "fol": 8,
+			"fon": 29, "for": 139, "fos": 5, "fr": 21, "fra": 16, "fs": 5, "ft": 17, "ft ": 7,
+			"ftw": 9, "fu": 18, "fun": 14, "g": 685, "g ": 37, "ga": 107, "ga ": 18, "gad": 14,
+			"gar": 38, "gas": 5, "gat": 9, "gaç": 21, "ge": 118, "gem": 43, "gen": 19, "ger": 29,
+			"ges": 13, "get": 6, "gh": 5, "gi": 60, "gid": 13, "gin": 12, "gir": 5, "gis": 18,
+			"gl": 12, "glê": 6, "gm": 11, "gme": 10, "gn": 35, "gno": 16, "gnu": 14, "go": 59,
+			"go ": 35, "gor": 7, "gos": 7, "got": 7, "gp": 9, "gp ": 7, "gr": 79, "gr ": 6,
+			"gra": 37, "gri": 7, "gru": 17, "grá": 7, "gs": 5, "gu": 129, "gua": 6, "gue": 5,
+			"gui": 26, "gul": 12, "gum": 21, "gun": 13, "gur": 41, "gí": 5, "gív": 5, "h": 786,
+			"h ": 18, "ha": 214, "ha ": 109, "had": 16, "ham": 10, "han": 9, "har": 10, "has": 12,
+			"hav": 43, "he": 338, "he ": 18, "hec": 19, "hei": 282, "hel": 5, "hes": 5, "hi": 27,
+			"hif": 6, "ho": 116, "ho ": 66, "hor": 17, "hos": 7, "hou": 13, "ht": 16, "htt": 10,
+			"hu": 30, "hum": 30, "há": 12, "há ": 12, "i": 4930, "i ": 88, "ia": 326, "ia ": 165,
+			"iad": 26, "iai": 16, "ial": 25, "ian": 13, "iar": 41, "ias": 29, "ib": 61, "iba": 8,
+			"ibi": 12, "ibl": 8, "ibu": 22, "ic": 713, "ica": 282, "ice": 23, "ich": 279, "ici": 67,
+			"ico": 51, "id": 314, "id ": 19, "ida": 110, "ide": 27, "ido": 152, "ie": 26, "ien": 11,
+			"if": 127, "ifi": 96, "ifr": 7, "ift": 6, "ig": 161, "iga": 55, "igi": 15, "ign": 18,
+			"igo": 31, "igu": 23, "il": 177, "il ": 24, "ila": 18, "ile": 16, "ilh": 14, "ili": 88,
+			"im": 278, "im ": 18, "ima": 37, "imb": 15, "ime": 27, "imi": 36, "imo": 17, "imp": 120,
+			"in": 788, "in ": 10, "ina": 130, "inc": 45, "ind": 32, "ine": 29, "inf": 53, "ing": 23,
+			"inh": 68, "ini": 123, "ino": 27, "ins": 62, "int": 78, "inu": 17, "inv": 53, "iná": 10,
+			"inâ": 11, "iní": 7, "io": 231, "io ": 106, "ion": 65, "ior": 22, "ios": 25, "iot": 7,
+			"ip": 72, "ipa": 7, "ipl": 9, "ipo": 29, "ipt": 6, "ipu": 6, "ir": 510, "ir ": 144,
+			"ira": 12, "ire": 32, "iro": 300, "irt": 6, "is": 402, "is ": 115, "isa": 25, "isc": 8,
+			"iso": 30, "isp": 24, "iss": 15, "ist": 172, "it": 217, "it ": 13, "ita": 31, "ite": 20,
+			"iti": 37, "itm": 6, "ito": 76, "itu": 25, "iv": 128, "iva": 45, "ive": 11, "ivi": 8,
+			"ivo": 60, "ix": 26, "ix ": 10, "ixo": 12, "iz": 178, "iz ": 9, "iza": 162, "ize": 6,
+			"iá": 19, "iáv": 13, "iç": 73, "iço": 8, "içã": 53, "içõ": 12, "j": 90, "ja": 29,
+			"ja ": 17, "jam": 6, "je": 16, "jo": 12, "jog": 7, "ju": 19, "jun": 13, "já": 12,
+			"já ": 12, "k": 95, "k ": 34, "ka": 7, "ke": 17, "ket": 8, "kg": 8, "kg ": 7,
+			"ks": 13, "ksv": 10, "kt": 6, "kto": 6, "l": 2083, "l ": 398, "la": 250, "la ": 55,
+			"lac": 6, "lad": 35, "lan": 6, "lar": 39, "las": 48, "lat": 13, "lav": 22, "laç": 20,
+			"lc": 15, "lca": 6, "lcu": 7, "ld": 10, "ld ": 8, "le": 170, "le ": 25, "lec": 20,
+			"leg": 7, "lei": 11, "lem": 31, "ler": 25, "les": 8, "let": 26, "lf": 11, "lf ": 10,
+			"lg": 18, "lgu": 12, "lh": 131, "lha": 66, "lhe": 16, "lho": 49, "li": 534, "lia": 6,
+			"lib": 6, "lic": 89, "lid": 74, "lig": 40, "lim": 16, "lin": 75, "lio": 7, "lis": 44,
+			"lit": 13, "liz": 154, "ll": 14, "ll ": 9, "lm": 22, "lme": 20, "lo": 251, "lo ": 71,
+			"loa": 14, "loc": 84, "log": 7, "lon": 13, "loq": 14, "lor": 24, "los": 20, "lq": 10,
+			"lqu": 10, "ls": 15, "ls ": 11, "lt": 122, "lt ": 10, "lta": 37, "lte": 33, "ltg": 6,
+			"lti": 28, "lu": 55, "lui": 11, "lus": 8, "lut": 7, "lux": 11, "luí": 6, "lv": 14,
+			"lve": 9, "lá": 9, "lê": 12, "lês": 6, "m": 2689, "m ": 718, "ma": 628, "ma ": 242,
+			"mac": 9, "mad": 21, "mag": 23, "mai": 47, "mal": 22, "man": 81, "map": 14, "mar": 20,
+			"mas": 50, "mat": 49, "maç": 40, "mb": 69, "mbi": 15, "mbo": 28, "mbé": 7, "mbó": 12,
+			"me": 429, "me ": 83, "mei": 12, "mem": 21, "men": 192, "mer": 45, "mes": 38, "met": 23,
+			"meç": 6, "mi": 161, "mic": 11, "mid": 15, "mil": 9, "min": 54, "mir": 18, "mis": 13,
+			"mit": 35, "mm": 8, "mo": 237, "mo ": 79, "moc": 6, "mod": 26, "mon": 24, "mor": 24,
+			"mos": 36, "mov": 32, "mp": 287, "mpa": 26, "mpi": 24, "mpl": 23, "mpo": 148, "mpr": 50,
+			"mu": 46, "mud": 7, "mui": 20, "mul": 13, "má": 28, "máq": 16, "mã": 7, "mão": 6,
+			"mé": 19, "mér": 7, "mét": 8, "mí": 9, "mó": 21, "mór": 18, "mú": 8, "múl": 8,
+			"n": 3432, "n ": 47, "na": 324, "na ": 77, "nad": 32, "nag": 6, "nai": 17, "nal": 69,
+			"nar": 49, "nas": 26, "nat": 35, "naç": 6, "nc": 180, "nca": 18, "nce": 14, "nci": 65,
+			"ncl": 15, "nco": 53, "ncr": 8, "nd": 221, "nd ": 6, "nda": 11, "nde": 70, "ndi": 42,
+			"ndo": 74, "ndê": 11, "ne": 213, "ne ": 15, "nec": 77, "nen": 51, "ner": 11, "nes": 27,
+			"nf": 100, "nfi": 32, "nfl": 8, "nfo": 52, "ng": 56, "ng ": 12, "nga": 16, "ngl": 6,
+			"ngu": 9, "nh": 156, "nha": 70, "nhe": 19, "nho": 37, "nhu": 28, "ni": 194, "nib": 17,
+			"nic": 44, "nid": 35, "nim": 7, "nip": 6, "nir": 23, "nit": 10, "niç": 27, "nj": 13,
+			"nju": 11, "nk": 6, "nl": 11, "nlo": 6, "no": 328, "no ": 121, "nom": 97, "nor": 28,
+			"nos": 14, "not": 25, "nou": 6, "nov": 24, "nq": 16, "nqu": 16, "ns": 162, "ns ": 24,
+			"nsa": 19, "nse": 12, "nsf": 10, "nsi": 10, "nso": 11, "nst": 51, "nsã": 10, "nt": 769,
+			"nt ": 8, "nta": 67, "nte": 293, "nti": 108, "ntl": 7, "nto": 129, "ntr": 127, "ntá": 9,
+			"nté": 12, "nu": 74, "nu ": 18, "nua": 7, "nul": 7, "num": 21, "nux": 11, "nv": 83,
+			"nve": 18, "nvi": 7, "nvo": 9, "nvá": 45, "ná": 12, "nár": 11, "nâ": 11, "nâm": 11,
+			"nã": 307, "não": 307, "nç": 39, "nça": 27, "nçã": 11, "né": 6, "ní": 35, "níc": 7,
+			"nív": 28, "nó": 8, "nú": 45, "núm": 41, "o": 7124, "o ": 3442, "oa": 19, "oad": 14,
+			"ob": 91, "obj": 10, "obl": 8, "obr": 38, "obt": 25, "oc": 208, "oca": 71, "oce": 35,
+			"oci": 9, "ock": 30, "oco": 12, "ocr": 7, "ocu": 24, "ocê": 9, "od": 144, "oda": 6,
+			"ode": 79, "odi": 16, "odo": 31, "odu": 12, "of": 35, "off": 11, "oft": 10, "og": 39,
+			"ogo": 7, "ogr": 21, "oi": 80, "oi ": 54, "oin": 8, "ois": 18, "ol": 129, "ola": 19,
+			"ole": 10, "olh": 22, "oli": 10, "olo": 33, "olu": 10, "olv": 11, "om": 419, "om ": 92,
+			"oma": 38, "ome": 113, "omi": 10, "omo": 42, "omp": 111, "on": 486, "on ": 11, "ona": 68,
+			"onc": 10, "ond": 19, "one": 27, "onf": 46, "ong": 17, "onh": 16, "oni": 14, "onj": 11,
+			"ono": 12, "ons": 19, "ont": 186, "onv": 15, "oní": 7, "op": 106, "op ": 7, "opc": 14,
+			"ope": 25, "opy": 7, "opç": 41, "oq": 14, "oqu": 14, "or": 700, "or ": 248, "ora": 62,
+			"ord": 20, "ore": 43, "ori": 33, "orm": 99, "orn": 16, "orr": 43, "ort": 109, "orá": 9,
+			"orç": 9, "os": 790, "os ": 598, "osi": 35, "oss": 112, "ost": 36, "ot": 157, "ot ": 8,
+			"ota": 33, "ote": 94, "oti": 7, "oto": 10, "ou": 159, "ou ": 133, "out": 19, "ov": 67,
+			"ova": 16, "ove": 30, "ovi": 10, "ovo": 11, "ow": 13, "own": 7, "ox": 11, "oxy": 10,
+			"p": 2162, "p ": 52, "pa": 600, "pac": 94, "pad": 31, "pag": 7, "pal": 25, "pam": 6,
+			"par": 323, "pas": 62, "pat": 9, "paz": 9, "paç": 24, "pc": 18, "pci": 12, "pd": 10,
+			"pdi": 6, "pe": 295, "pe ": 14, "pea": 8, "pec": 58, "ped": 8, "pej": 7, "pel": 26,
+			"pen": 49, "peq": 7, "per": 100, "pg": 9, "pgp": 7, "pi": 47, "pia": 9, "pid": 9,
+			"pil": 19, "pk": 8, "pkg": 8, "pl": 86, "pla": 10, "ple": 25, "pli": 38, "plo": 9,
+			"po": 533, "po ": 60, "pod": 67, "poi": 10, "pol": 9, "pon": 57, "por": 158, "pos": 165,
+			"pp": 13, "pps": 8, "pr": 292, "pre": 113, "pri": 60, "pro": 107, "ps": 27, "ps ": 13,
+			"pst": 9, "pt": 44, "pt ": 19, "pta": 10, "ptu": 10, "pu": 37, "pu ": 7, "pul": 7,
+			"pur": 12, "py": 7, "pç": 42, "pçã": 13, "pçõ": 29, "pó": 13, "pós": 13, "pú": 17,
+			"púb": 17, "q": 284, "qu": 276, "qua": 50, "que": 150, "qui": 71, "qw": 6, "qwe": 6,
+			"r": 4936, "r ": 1140, "ra": 909, "ra ": 382, "rab": 9, "rac": 22, "rad": 150, "rag": 7,
+			"rai": 23, "ral": 6, "ram": 48, "ran": 61, "rar": 85, "ras": 31, "rat": 10, "rav": 9,
+			"raç": 56, "rb": 7, "rc": 33, "rc ": 11, "rca": 10, "rd": 27, "rd ": 8, "rde": 11,
+			"re": 690, "re ": 59, "rea": 29, "rec": 60, "red": 48, "ref": 20, "reg": 37, "rei": 30,
+			"rel": 34, "rem": 57, "ren": 11, "rep": 41, "req": 7, "res": 147, "ret": 26, "rev": 42,
+			"reç": 27, "rf": 12, "rf ": 7, "rg": 40, "rgu": 31, "ri": 476, "ri ": 7, "ria": 126,
+			"rib": 22, "ric": 13, "rid": 18, "rif": 17, "rig": 33, "rim": 47, "rin": 18, "rio": 87,
+			"rip": 7, "rir": 22, "rit": 17, "riv": 6, "riz": 14, "riá": 9, "rl": 16, "rl ": 14,
+			"rm": 180, "rm ": 6, "rma": 107, "rmi": 62, "rn": 41, "rna": 20, "rne": 9, "rno": 10,
+			"ro": 637, "ro ": 357, "rob": 9, "roc": 50, "rod": 12, "rof": 8, "rog": 18, "rol": 19,
+			"rom": 11, "ros": 110, "rot": 8, "rou": 6, "rox": 10, "rp": 8, "rq": 44, "rqu": 44,
+			"rr": 155, "rra": 17, "rre": 35, "rri": 10, "rro": 79, "rru": 12, "rs": 87, "rso": 15,
+			"rsã": 52, "rsõ": 6, "rt": 177, "rta": 77, "rte": 44, "rti": 23, "rtu": 8, "rty": 6,
+			"ru": 54, "rup": 30, "rut": 10, "rv": 38, "rva": 10, "rvi": 24, "rá": 56, "rá ": 16,
+			"rác": 13, "ráf": 7, "rár": 9, "ráv": 6, "râ": 6, "râm": 6, "rã": 36, "rã ": 8,
+			"rão": 28, "rç": 11, "rça": 8, "ré": 6, "rê": 13, "rên": 13, "rí": 10, "ró": 9,
+			"s": 4644, "s ": 1595, "sa": 212, "sa ": 28, "sac": 11, "sad": 23, "sag": 14, "sai": 8,
+			"sam": 10, "san": 8, "sar": 28, "sat": 8, "saí": 54, "sc": 120, "sca": 6, "sco": 36,
+			"scr": 59, "scu": 6, "se": 498, "se ": 84, "sec": 46, "seg": 57, "sej": 12, "sel": 29,
+			"sem": 73, "sen": 28, "sep": 8, "ser": 111, "ses": 12, "set": 8, "seu": 17, "sf": 11,
+			"sfe": 8, "sh": 19, "shi": 6, "si": 253, "sia": 19, "sic": 7, "sid": 9, "sim": 23,
+			"sin": 55, "sis": 73, "sit": 19, "siv": 10, "siç": 13, "sk": 7, "skt": 6, "sl": 11,
+			"sm": 21, "smo": 18, "so": 205, "so ": 79, "sob": 29, "soc": 23, "sof": 10, "sol": 29,
+			"son": 10, "sos": 12, "sp": 179, "spa": 35, "spe": 105, "spo": 35, "sq": 22, "squ": 22,
+			"ss": 352, "ssa": 16, "sse": 32, "ssi": 39, "sso": 39, "ssu": 7, "ssá": 70, "ssã": 31,
+			"ssí": 104, "ssõ": 8, "st": 575, "st ": 10, "sta": 195, "std": 6, "ste": 144, "sti": 52,
+			"sto": 33, "str": 74, "stá": 36, "stã": 11, "su": 149, "sua": 15, "sub": 21, "suf": 6,
+			"sul": 11, "sup": 65, "sus": 11, "sv": 25, "sv ": 13, "svi": 11, "sy": 7, "sá": 71,
+			"sár": 70, "sã": 133, "são": 133, "sí": 127, "sím": 22, "sív": 105, "só": 17, "só ": 17,
+			"sõ": 15, "sõe": 15, "t": 3421, "t ": 116, "ta": 645, "ta ": 173, "tab": 30, "tad": 93,
+			"tag": 10, "tai": 15, "tal": 60, "tam": 62, "tan": 10, "tar": 81, "tas": 58, "tat": 17,
+			"taç": 19, "tc": 11, "tch": 6, "td": 6, "te": 975, "te ": 298, "tec": 65, "teg": 9,
+			"tei": 6, "tem": 124, "ten": 136, "ter": 179, "tes": 112, "tex": 24, "teú": 16, "tg": 7,
+			"tgr": 6, "ti": 467, "tic": 96, "tid": 26, "tif": 20, "tig": 20, "til": 98, "tim": 17,
+			"tin": 32, "tip": 38, "tir": 23, "tit": 12, "tiv": 67, "tl": 19, "tl ": 9, "tls": 8,
+			"tm": 7, "to": 450, "to ": 267, "tod": 20, "tom": 14, "top": 6, "tor": 53, "tos": 57,
+			"tot": 8, "tou": 10, "tp": 12, "tp ": 9, "tr": 346, "tra": 202, "tre": 15, "tri": 42,
+			"trl": 8, "tro": 60, "tru": 14, "ts": 8, "ts ": 7, "tt": 19, "ttp": 10, "tu": 143,
+			"tua": 64, "tui": 13, "tur": 57, "tw": 9, "twa": 9, "ty": 16, "ty ": 11, "tá": 57,
+			"tá ": 37, "tár": 9, "tát": 6, "tã": 14, "tão": 14, "té": 18, "tém": 13, "tê": 13,
+			"têm": 11, "tí": 10, "tó": 32, "tór": 31, "u": 1972, "u ": 190, "ua": 155, "ua ": 19,
+			"uai": 10, "ual": 69, "uan": 36, "uar": 7, "ub": 27, "ub ": 7, "ubs": 11, "uc": 11,
+			"ud": 22, "uda": 13, "ue": 160, "ue ": 93, "uei": 9, "uem": 16, "uen": 7, "uer": 21,
+			"uf": 12, "uff": 6, "ufi": 6, "ui": 156, "ui ": 12, "uin": 37, "uir": 21, "uit": 26,
+			"uiv": 37, "uiç": 8, "ul": 74, "ul ": 9, "ula": 29, "ulo": 13, "ult": 20, "um": 382,
+			"um ": 199, "uma": 126, "ume": 38, "umé": 7, "un": 82, "unc": 9, "und": 6, "uni": 20,
+			"uns": 9, "unt": 24, "unç": 9, "up": 119, "upe": 12, "upl": 14, "upo": 68, "upt": 12,
+			"ur": 151, "ura": 112, "urc": 6, "uro": 7, "urs": 11, "us": 101, "us ": 11, "usa": 49,
+			"use": 8, "uso": 9, "usp": 11, "usã": 6, "ut": 245, "uta": 20, "ute": 66, "uti": 80,
+			"uto": 46, "utr": 16, "utu": 8, "ux": 26, "ux ": 14, "uxo": 11, "uz": 12, "uzi": 7,
+			"uç": 16, "uçã": 15, "uê": 7, "uí": 12, "uíd": 7, "v": 993, "v ": 20, "va": 154,
+			"va ": 16, "vad": 17, "val": 40, "vam": 6, "var": 28, "vas": 8, "vaz": 31, "ve": 451,
+			"ve ": 63, "vei": 22, "vej": 6, "vel": 166, "ven": 9, "ver": 164, "ves": 10, "vez": 8,
+			"vi": 126, "via": 11, "vid": 37, "vio": 13, "vir": 7, "vis": 38, "viç": 8, "vo": 133,
+			"vo ": 64, "voc": 14, "vol": 13, "vor": 28, "vos": 12, "vr": 22, "vra": 18, "vá": 65,
+			"vál": 58, "ví": 6, "w": 87, "w ": 11, "wa": 32, "war": 28, "we": 10, "wer": 7,
+			"wi": 7, "win": 7, "wn": 9, "wnl": 6, "wo": 8, "wor": 8, "x": 265, "x ": 47,
+			"xa": 10, "xc": 24, "xce": 12, "xcl": 12, "xe": 30, "xec": 23, "xi": 28, "xis": 20,
+			"xo": 23, "xo ": 21, "xp": 25, "xp ": 6, "xpr": 8, "xt": 56, "xte": 12, "xto": 21,
+			"xtr": 23, "xy": 10, "xy ": 10, "y": 80, "y ": 36, "yp": 7, "ype": 6, "yt": 14,
+			"yte": 14, "z": 290, "z ": 34, "za": 174, "za ": 13, "zad": 72, "zar": 28, "zaç": 56,
+			"ze": 33, "ze ": 7, "zer": 22, "zi": 41, "zia": 14, "zio": 17, "º": 20, "º ": 20,
+			"à": 9, "à ": 9, "á": 368, "á ": 82, "ác": 13, "áct": 13, "áf": 9, "áfi": 7,
+			"ál": 68, "álc": 6, "áli": 60, "áq": 16, "áqu": 16, "ár": 118, "ári": 105, "ás": 9,
+			"át": 14, "áti": 14, "áv": 33, "áve": 33, "â": 24, "âm": 19, "âme": 6, "âmi": 11,
+			"ã": 934, "ã ": 9, "ão": 924, "ão ": 924, "ç": 673, "ça": 71, "ça ": 28, "çal": 25,
+			"çar": 9, "ço": 56, "ço ": 45, "ços": 11, "çã": 430, "ção": 430, "çõ": 116, "çõe": 116,
+			"é": 235, "é ": 175, "ém": 23, "ém ": 23, "ér": 9, "éri": 9, "és": 7, "ét": 13,
+			"éti": 7, "ê": 71, "ê ": 14, "êm": 11, "êm ": 11, "ên": 32, "ênc": 31, "ês": 14,
+			"ês ": 14, "í": 294, "íc": 15, "íci": 13, "íd": 69, "ída": 59, "íg": 7, "ím": 22,
+			"ímb": 21, "ín": 16, "índ": 11, "ív": 146, "íve": 146, "ó": 138, "ó ": 17, "ód": 22,
+			"ódi": 19, "ól": 15, "óli": 13, "ón": 6, "óni": 6, "ór": 49, "óri": 49, "ós": 17,
+			"ós ": 16, "ô": 7, "õ": 136, "õe": 136, "ões": 136, "ú": 121, "úb": 17, "úbl": 17,
+			"úd": 16, "údo": 16, "úl": 24, "últ": 23, "úm": 41, "úme": 39, "ún": 14, "úni": 7,
+		}},
+		"ro": {Total: 218765, Ngrams: map[string]int{
+			" a": 1284, " a ": 331, " ab": 10, " ac": 195, " ad": 54, " af": 58, " ai": 5, " aj": 7,
+			" al": 132, " am": 13, " an": 52, " ap": 60, " ar": 113, " as": 37, " at": 21, " au": 112,
+			" av": 40, " aș": 26, " aț": 6, " b": 127, " ba": 18, " bi": 48, " bl": 24, " br": 10,
+			" bu": 17, " c": 1077, " c ": 10, " ca": 203, " ce": 58, " ch": 66, " ci": 73, " cl": 8,
+			" co": 363, " cr": 35, " ct": 10, " cu": 182, " câ": 25, " că": 36, " d": 1457, " d ": 6,
+			" da": 117, " de": 995, " di": 190, " dn": 9, " do": 44, " dr": 13, " ds": 8, " du": 38,
+			" dv": 12, " dw": 8, " e": 647, " e ": 11, " ec": 7, " ed": 7, " el": 32, " em": 6,
+			" en": 19, " ep": 12, " er": 98, " es": 239, " et": 25, " ex": 144, " eș": 28, " f": 616,
+			" fa": 22, " fi": 316, " fl": 20, " fo": 140, " fp": 11, " fr": 13, " fu": 34, " fă": 53,
+			" g": 179, " ga": 9, " ge": 40, " gn": 14, " go": 18, " gr": 26, " gs": 10, " gă": 41,
+			" h": 32, " ha": 12, " he": 7, " ht": 6, " i": 541, " ic": 8, " id": 35, " ie": 50,
+			" ig": 15, " il": 7, " im": 83, " in": 322, " ip": 7, " is": 5, " j": 15, " jo": 6,
+			" k": 16, " l": 437, " l ": 6, " la": 158, " le": 35, " li": 124, " lo": 66, " lu": 34,
+			" lă": 5, " m": 402, " ma": 128, " me": 75, " mi": 31, " mo": 108, " mu": 51, " n": 947,
+			" n ": 10, " na": 5, " ne": 207, " ni": 62, " no": 55, " nu": 604, " o": 351, " o ": 148,
+			" ob": 39, " oc": 18, " of": 6, " om": 5, " op": 102, " or": 26, " p": 1034, " pa": 114,
+			" pe": 347, " pi": 17, " pl": 10, " po": 198, " pr": 221, " pu": 103, " pă": 7, " q": 16,
+			" q ": 5, " qw": 11, " r": 334, " r ": 16, " ra": 9, " re": 255, " ro": 7, " ru": 20,
+			" ră": 20, " s": 1239, " s ": 85, " sa": 80, " sc": 55, " se": 372, " sf": 13, " sh": 24,
+			" si": 114, " sl": 11, " so": 48, " sp": 84, " st": 99, " su": 137, " să": 100, " t": 369,
+			" ta": 102, " te": 45, " ti": 80, " tl": 14, " to": 16, " tr": 95, " tt": 5, " u": 451,
+			" u ": 7, " ul": 51, " um": 5, " un": 228, " ur": 48, " ut": 93, " uș": 5, " v": 243,
+			" va": 97, " ve": 70, " vi": 39, " vm": 6, " vo": 12, " vr": 5, " vă": 9, " w": 26,
+			" wa": 7, " we": 8, " x": 13, " x ": 8, " z": 17, " ze": 12, " î": 416, " îi": 5,
+			" îl": 9, " îm": 13, " în": 389, " ş": 8, " ș": 103, " și": 90, " șt": 9, " ț": 7,
+			" ți": 7, "a": 6397, "a ": 1493, "ab": 113, "abe": 26, "abi": 65, "abl": 5, "abs": 8,
+			"abă": 5, "ac": 388, "acc": 41, "ace": 122, "ach": 63, "ack": 8, "aco": 6, "act": 98,
+			"acă": 29, "acț": 10, "ad": 68, "ada": 7, "ade": 5, "adi": 8, "adm": 6, "adr": 23,
+			"adă": 10, "af": 61, "afa": 22, "afi": 36, "ag": 47, "age": 9, "agh": 11, "agi": 19,
+			"ai": 90, "ai ": 61, "ain": 17, "aj": 50, "aj ": 12, "aja": 9, "aje": 12, "aju": 16,
+			"ak": 12, "ak ": 9, "al": 575, "al ": 105, "ala": 29, "alc": 8, "ale": 70, "alf": 9,
+			"alg": 7, "ali": 140, "all": 6, "alo": 89, "alt": 24, "alu": 23, "ală": 49, "am": 118,
+			"am ": 25, "amb": 13, "ame": 30, "ami": 19, "amp": 9, "amu": 10, "amă": 5, "an": 229,
+			"an ": 13, "ana": 36, "anc": 5, "and": 44, "ane": 8, "ani": 20, "anj": 5, "ans": 11,
+			"ant": 37, "anu": 6, "ană": 5, "anț": 24, "ap": 110, "apa": 5, "ape": 8, "apl": 26,
+			"apo": 9, "app": 11, "aps": 14, "apt": 19, "apu": 6, "apă": 10, "ar": 1167, "ar ": 84,
+			"ara": 91, "arb": 6, "arc": 37, "ard": 24, "are": 678, "arf": 7, "arg": 18, "arh": 35,
+			"ari": 18, "arm": 10, "aro": 15, "art": 49, "aru": 9, "ară": 77, "as": 182, "asa": 14,
+			"asc": 14, "ase": 10, "asn": 10, "aso": 9, "ast": 106, "asu": 5, "at": 941, "at ": 232,
+			"ata": 13, "ate": 314, "ati": 56, "ato": 115, "atr": 16, "atu": 38, "ată": 150, "au": 215,
+			"au ": 110, "aug": 8, "aut": 73, "auz": 12, "av": 45, "ave": 40, "ax": 9, "az": 201,
+			"aza": 5, "ază": 186, "aţ": 21, "aţi": 21, "aș": 62, "așa": 8, "ași": 10, "așt": 43,
+			"aț": 192, "ați": 186, "b": 649, "b ": 19, "ba": 39, "bab": 6, "bar": 7, "baz": 9,
+			"be": 41, "bel": 24, "ber": 10, "bi": 192, "bi ": 7, "bib": 14, "bie": 17, "bil": 115,
+			"bin": 15, "biț": 14, "bl": 96, "bla": 10, "ble": 24, "bli": 34, "blo": 25, "bm": 5,
+			"bo": 59, "bol": 52, "bor": 5, "br": 15, "bri": 8, "bs": 13, "bu": 113, "buc": 7,
+			"bui": 73, "bun": 5, "bus": 6, "but": 12, "bă": 24, "bă ": 18, "băr": 5, "bț": 16,
+			"bți": 16, "c": 3324, "c ": 126, "ca": 569, "ca ": 80, "cab": 6, "cac": 7, "cal": 44,
+			"can": 7, "cap": 20, "car": 232, "cat": 103, "cau": 11, "caţ": 5, "caț": 46, "cc": 47,
+			"cc ": 5, "cce": 42, "cd": 6, "ce": 423, "ce ": 76, "cea": 45, "cel": 15, "cen": 11,
+			"cep": 47, "cer": 43, "ces": 167, "ceţ": 6, "ch": 236, "ch ": 13, "che": 170, "chi": 51,
+			"ci": 269, "ci ": 21, "cia": 17, "cie": 10, "cif": 61, "cii": 7, "cin": 10, "cio": 20,
+			"cit": 88, "ciu": 23, "ck": 41, "ck ": 21, "cke": 7, "cks": 9, "cl": 37, "cla": 13,
+			"clu": 16, "co": 437, "cod": 44, "col": 17, "com": 117, "con": 175, "cop": 19, "cor": 55,
+			"cr": 137, "cra": 10, "cre": 63, "cri": 49, "cro": 8, "cru": 5, "cs": 7, "ct": 306,
+			"ct ": 41, "cta": 40, "cte": 76, "cti": 38, "cto": 44, "ctr": 8, "ctu": 39, "ctă": 18,
+			"cu": 320, "cu ": 154, "cui": 8, "cul": 25, "cum": 22, "cun": 29, "cur": 30, "cut": 34,
+			"cuț": 10, "cv": 12, "cve": 8, "câ": 36, "câm": 8, "cân": 15, "cât": 11, "că": 184,
+			"că ": 115, "căr": 39, "căt": 11, "cău": 11, "cț": 123, "cți": 123, "d": 2157, "d ": 144,
+			"da": 183, "da ": 15, "dac": 26, "dar": 36, "dat": 94, "dau": 6, "db": 5, "de": 1155,
+			"de ": 810, "dea": 7, "dec": 37, "def": 22, "del": 12, "den": 31, "deo": 10, "dep": 41,
+			"der": 31, "des": 86, "det": 25, "dex": 6, "dez": 18, "di": 332, "dia": 36, "dic": 20,
+			"die": 5, "dif": 33, "dim": 32, "din": 66, "dir": 65, "dis": 41, "dit": 14, "diț": 8,
+			"dm": 6, "dmi": 6, "dn": 10, "dns": 9, "do": 53, "doa": 17, "dom": 9, "dou": 11,
+			"dr": 41, "dre": 34, "ds": 11, "du": 110, "duc": 17, "dul": 46, "dup": 34, "dus": 7,
+			"dv": 12, "dvo": 6, "dw": 14, "dwa": 13, "dx": 6, "dx ": 6, "dă": 59, "dă ": 43,
+			"dăc": 6, "dău": 10, "e": 9549, "e ": 3605, "ea": 797, "ea ": 456, "eac": 12, "eal": 38,
+			"eam": 18, "eap": 12, "ear": 9, "eas": 46, "eat": 8, "eaz": 170, "eaș": 17, "eb": 81,
+			"eb ": 8, "ebu": 68, "ec": 545, "ec ": 20, "eca": 12, "ece": 77, "ech": 19, "eci": 67,
+			"ecl": 5, "eco": 21, "ecr": 12, "ect": 144, "ecu": 68, "ecv": 10, "ecâ": 11, "ecă": 7,
+			"ecț": 69, "ed": 113, "ed ": 9, "ede": 28, "edi": 73, "ee": 28, "ee ": 5, "eea": 10,
+			"eed": 5, "ef": 59, "efe": 33, "efi": 23, "eg": 154, "eg ": 7, "ega": 22, "ege": 15,
+			"egi": 52, "egm": 18, "ego": 9, "egu": 6, "egă": 21, "ei": 167, "ei ": 98, "eia": 25,
+			"eie": 20, "eii": 10, "ein": 5, "ej": 6, "eja": 5, "el": 412, "el ": 31, "ela": 5,
+			"ele": 243, "elf": 5, "eli": 17, "ell": 9, "elo": 48, "elu": 48, "em": 199, "em ": 14,
+			"ema": 7, "eme": 62, "emi": 5, "emn": 34, "emo": 32, "emp": 10, "emu": 22, "emă": 11,
+			"en": 730, "end": 25, "ene": 30, "eng": 8, "eni": 22, "ens": 48, "ent": 536, "enu": 13,
+			"enz": 10, "enț": 26, "eo": 18, "eo ": 7, "ep": 170, "epa": 14, "epe": 24, "epo": 18,
+			"ept": 72, "epu": 19, "epă": 9, "er": 1007, "er ": 135, "era": 62, "erc": 16, "ere": 194,
+			"erf": 6, "erg": 8, "eri": 91, "erm": 52, "ern": 37, "ero": 105, "erp": 12, "err": 6,
+			"ers": 66, "ert": 68, "eru": 88, "erv": 48, "eră": 8, "es": 636, "es ": 16, "esa": 96,
+			"esc": 49, "ese": 30, "esi": 32, "esk": 10, "eso": 5, "esp": 27, "est": 334, "esu": 26,
+			"esă": 5, "et": 266, "et ": 47, "eta": 62, "ete": 74, "eti": 29, "eto": 5, "etr": 8,
+			"etu": 16, "etă": 19, "eu": 15, "eun": 5, "ev": 51, "eva": 28, "evo": 15, "ew": 10,
+			"ewl": 5, "ex": 185, "ex ": 13, "exa": 6, "exc": 12, "exe": 28, "exi": 42, "exp": 30,
+			"ext": 52, "ez": 87, "eza": 15, "eze": 25, "ezo": 10, "ezu": 8, "eză": 23, "eî": 5,
+			"eş": 9, "eşt": 6, "eţ": 14, "eţi": 14, "eș": 137, "eșe": 14, "eși": 50, "eșt": 57,
+			"eșu": 16, "eț": 38, "ețe": 5, "eți": 33, "f": 1154, "f ": 30, "fa": 55, "fac": 12,
+			"fan": 7, "far": 22, "faț": 6, "fe": 54, "fec": 9, "fer": 40, "ff": 16, "ff ": 7,
+			"fi": 588, "fi ": 44, "fic": 193, "fie": 39, "fig": 16, "fil": 11, "fin": 24, "fir": 17,
+			"fix": 6, "fiş": 20, "fiș": 217, "fl": 34, "fli": 11, "flu": 19, "fo": 202, "fo ": 5,
+			"fol": 22, "for": 99, "fos": 68, "fp": 11, "fpi": 5, "fpu": 5, "fr": 19, "fra": 8,
+			"fre": 8, "ft": 25, "ft ": 14, "ftw": 9, "fu": 40, "fun": 17, "fur": 14, "fuz": 5,
+			"fâ": 13, "fâr": 13, "fă": 57, "fă ": 8, "făr": 44, "g": 629, "g ": 36, "ga": 48,
+			"ga ": 5, "gal": 10, "gat": 19, "ge": 83, "ge ": 16, "gen": 24, "ger": 19, "ges": 8,
+			"get": 7, "gh": 19, "ghi": 13, "gi": 93, "gim": 11, "gin": 18, "gis": 48, "git": 6,
+			"gl": 12, "gle": 6, "gm": 21, "gme": 20, "gn": 31, "gno": 20, "gnu": 10, "go": 36,
+			"goa": 7, "goc": 5, "gol": 5, "gor": 11, "got": 6, "gp": 8, "gp ": 6, "gr": 66,
+			"gra": 35, "gre": 11, "gru": 13, "gs": 14, "gst": 9, "gu": 72, "gul": 23, "gum": 16,
+			"gur": 32, "gă": 82, "gă ": 15, "găm": 5, "găs": 40, "găt": 21, "h": 367, "h ": 21,
+			"ha": 21, "han": 5, "har": 12, "he": 191, "he ": 14, "hea": 12, "hei": 54, "hel": 11,
+			"hem": 10, "het": 80, "hi": 115, "hia": 15, "hid": 11, "hif": 10, "hil": 6, "him": 19,
+			"hiv": 32, "ht": 7, "htt": 6, "i": 6738, "i ": 1067, "ia": 197, "ia ": 95, "iab": 7,
+			"ial": 22, "ian": 8, "iar": 18, "iat": 29, "iaz": 12, "ib": 96, "ibe": 8, "ibi": 48,
+			"ibl": 15, "ibu": 16, "ic": 492, "ic ": 30, "ica": 210, "ice": 48, "ich": 25, "ici": 92,
+			"ico": 12, "ict": 9, "icu": 6, "ică": 51, "id": 130, "id ": 54, "ide": 41, "idă": 25,
+			"ie": 630, "ie ": 248, "iec": 26, "ied": 8, "iei": 37, "ien": 7, "ier": 235, "ies": 9,
+			"iet": 10, "ieș": 47, "if": 210, "ife": 7, "ifi": 182, "ifr": 6, "ift": 10, "ig": 59,
+			"ign": 16, "igu": 32, "ii": 173, "ii ": 140, "iil": 32, "il": 442, "il ": 44, "ila": 14,
+			"ile": 178, "ili": 121, "ilo": 42, "ilt": 6, "ilă": 33, "im": 375, "im ": 5, "ima": 36,
+			"imb": 80, "ime": 80, "imi": 48, "imm": 12, "imp": 102, "imu": 8, "in": 777, "in ": 68,
+			"ina": 68, "inc": 46, "ind": 34, "ine": 83, "inf": 46, "ing": 12, "ini": 73, "ink": 10,
+			"ins": 86, "int": 158, "inu": 26, "inv": 22, "ină": 25, "inţ": 6, "inț": 9, "io": 139,
+			"io ": 15, "ioa": 14, "iol": 5, "ion": 71, "ior": 10, "iot": 19, "ip": 106, "ip ": 28,
+			"ipl": 12, "ips": 26, "ipt": 13, "ipu": 14, "ir": 239, "ir ": 13, "ira": 12, "ire": 163,
+			"irg": 13, "iri": 13, "iru": 16, "is": 266, "is ": 9, "isc": 8, "ise": 8, "ism": 25,
+			"isp": 20, "ist": 179, "isă": 8, "it": 392, "it ": 102, "ita": 67, "ite": 72, "iti": 58,
+			"itm": 8, "ito": 11, "itu": 16, "ită": 55, "iu": 295, "iu ": 35, "iul": 20, "iun": 238,
+			"iv": 147, "iv ": 12, "iva": 30, "ive": 66, "ivi": 7, "ivă": 26, "ix": 10, "iz": 187,
+			"iza": 134, "ize": 27, "izi": 6, "iză": 14, "iş": 20, "işi": 18, "iș": 222, "ișa": 10,
+			"ișe": 22, "iși": 186, "iț": 61, "iți": 54, "j": 78, "j ": 13, "ja": 22, "jat": 7,
+			"je": 16, "je ": 9, "jo": 7, "ju": 19, "jul": 8, "k": 109, "k ": 50, "ka": 12,
+			"ke": 9, "ket": 6, "ks": 9, "ksv": 8, "kt": 10, "kto": 9, "ku": 7, "l": 3236,
+			"l ": 703, "la": 276, "la ": 155, "lab": 12, "lan": 13, "lar": 33, "las": 11, "lat": 34,
+			"laț": 8, "lc": 11, "lcu": 7, "ld": 8, "ld ": 6, "le": 621, "le ": 421, "lea": 27,
+			"lec": 26, "leg": 44, "lei": 6, "lel": 15, "lem": 42, "let": 10, "lez": 8, "leș": 6,
+			"lf": 14, "lg": 8, "lgo": 7, "li": 541, "li ": 7, "lia": 12, "lib": 12, "lic": 99,
+			"lid": 60, "lim": 41, "lin": 57, "lio": 14, "lip": 20, "lis": 44, "lit": 25, "liz": 139,
+			"ll": 26, "ll ": 19, "ln": 13, "lni": 11, "lo": 328, "lo ": 6, "loa": 51, "loc": 118,
+			"log": 9, "lon": 10, "lor": 104, "los": 21, "ls": 20, "ls ": 16, "lt": 105, "lt ": 19,
+			"lta": 12, "lte": 35, "lti": 24, "ltr": 7, "lu": 403, "lu ": 9, "luc": 9, "lud": 14,
+			"lui": 202, "lul": 79, "lum": 9, "lun": 21, "lur": 29, "lux": 18, "lv": 13, "lva": 8,
+			"lă": 126, "lă ": 111, "lăt": 8, "lț": 6, "lți": 6, "m": 1823, "m ": 105, "ma": 330,
+			"ma ": 14, "mag": 24, "mai": 62, "mal": 12, "man": 34, "mar": 46, "mat": 73, "maș": 8,
+			"maț": 37, "mb": 98, "mba": 11, "mbl": 10, "mbo": 52, "mbă": 11, "me": 480, "me ": 75,
+			"mea": 14, "med": 36, "mel": 68, "mem": 26, "men": 186, "mer": 27, "mes": 20, "met": 22,
+			"mi": 172, "mic": 32, "mil": 6, "min": 42, "mip": 6, "mir": 7, "mis": 17, "mit": 46,
+			"mm": 14, "mme": 12, "mn": 36, "mna": 12, "mnă": 17, "mo": 143, "moa": 27, "mob": 6,
+			"mod": 54, "mon": 11, "mor": 25, "mp": 225, "mp ": 25, "mpa": 23, "mpi": 19, "mpl": 54,
+			"mpo": 51, "mpr": 25, "mpu": 25, "mu": 107, "mul": 85, "mun": 8, "mut": 10, "mă": 94,
+			"mă ": 22, "măr": 56, "măt": 13, "n": 4338, "n ": 551, "na": 218, "na ": 30, "nai": 9,
+			"naj": 6, "nal": 65, "nam": 13, "nar": 35, "nat": 35, "naț": 15, "nc": 171, "nce": 35,
+			"nch": 12, "nci": 7, "nco": 25, "ncr": 29, "nct": 17, "ncă": 17, "ncț": 16, "nd": 146,
+			"nd ": 28, "nda": 31, "nde": 41, "ndi": 20, "ndu": 10, "ndă": 13, "ne": 572, "ne ": 142,
+			"nea": 136, "nec": 95, "neg": 14, "nei": 13, "nel": 6, "nen": 25, "ner": 44, "nes": 8,
+			"net": 6, "nev": 30, "new": 6, "nex": 7, "nez": 14, "nf": 87, "nfi": 17, "nfl": 9,
+			"nfo": 52, "ng": 56, "ng ": 13, "nge": 6, "ngi": 11, "ngl": 9, "ngă": 8, "ni": 356,
+			"ni ": 38, "nia": 14, "nib": 16, "nic": 50, "nie": 21, "nii": 29, "nil": 35, "nim": 12,
+			"nio": 6, "nir": 13, "nit": 37, "niu": 16, "niv": 22, "niz": 17, "niț": 18, "nk": 15,
+			"nk ": 11, "nl": 17, "nlo": 8, "nlă": 8, "no": 117, "noi": 11, "non": 9, "nor": 23,
+			"nos": 23, "not": 17, "nou": 14, "nr": 15, "nre": 15, "ns": 190, "ns ": 21, "nse": 9,
+			"nsf": 7, "nsi": 47, "nst": 68, "nsu": 21, "nt": 878, "nt ": 121, "nta": 61, "nte": 183,
+			"nti": 77, "nto": 9, "ntr": 360, "ntu": 21, "ntâ": 15, "ntă": 30, "nu": 696, "nu ": 417,
+			"nui": 19, "nul": 29, "num": 199, "nut": 15, "nv": 31, "nva": 13, "nve": 15, "nz": 17,
+			"nzi": 10, "nă": 72, "nă ": 50, "năt": 20, "nţ": 12, "nţe": 6, "nț": 99, "nțe": 16,
+			"nți": 48, "nță": 29, "o": 2945, "o ": 214, "oa": 345, "oal": 8, "oan": 7, "oar": 220,
+			"oat": 96, "ob": 76, "oba": 9, "obi": 26, "obl": 21, "obț": 16, "oc": 231, "oc ": 17,
+			"oca": 83, "oce": 25, "oci": 16, "ock": 31, "oct": 15, "ocu": 17, "ocă": 10, "od": 128,
+			"od ": 15, "oda": 15, "ode": 21, "odi": 23, "odu": 52, "of": 29, "off": 10, "oft": 11,
+			"og": 39, "ogi": 6, "ogr": 29, "oi": 25, "oi ": 8, "oin": 6, "ol": 164, "ol ": 28,
+			"ola": 8, "ole": 10, "oli": 9, "olo": 36, "olu": 54, "olv": 10, "olă": 6, "om": 145,
+			"oma": 25, "ome": 25, "omp": 75, "omu": 10, "on": 368, "on ": 36, "ona": 62, "ond": 7,
+			"one": 59, "onf": 39, "oni": 34, "ons": 20, "ont": 44, "onu": 7, "onv": 8, "onț": 38,
+			"oo": 10, "oon": 6, "op": 148, "op ": 12, "ope": 41, "opi": 14, "opr": 20, "opţ": 6,
+			"opț": 51, "or": 668, "or ": 197, "ora": 41, "ord": 9, "ore": 33, "ori": 115, "orm": 92,
+			"orn": 19, "orp": 7, "ort": 73, "oru": 55, "oră": 12, "os": 140, "osc": 19, "ose": 7,
+			"osi": 28, "ost": 76, "ot": 113, "ot ": 42, "ote": 23, "oti": 6, "oto": 6, "otr": 14,
+			"otu": 7, "otă": 7, "ou": 34, "ou ": 6, "out": 7, "ouă": 10, "ov": 14, "ow": 11,
+			"owe": 9, "ox": 7, "oxy": 7, "oz": 31, "ozi": 29, "p": 2188, "p ": 98, "pa": 182,
+			"pac": 58, "pan": 10, "par": 51, "pat": 22, "paț": 20, "pc": 15, "pc ": 12, "pe": 505,
+			"pe ": 49, "pec": 61, "pel": 8, "pen": 285, "per": 89, "pi": 73, "pia": 7, "pie": 15,
+			"pil": 14, "pin": 12, "pir": 9, "pl": 125, "ple": 26, "pli": 73, "plt": 6, "plu": 8,
+			"po": 338, "poa": 85, "pol": 8, "pon": 48, "por": 87, "pos": 16, "pot": 47, "pow": 9,
+			"poz": 31, "pp": 11, "pps": 7, "pr": 327, "pra": 14, "pre": 121, "pri": 72, "pro": 113,
+			"ps": 54, "ps ": 24, "pse": 21, "pst": 7, "pt": 117, "pt ": 8, "pta": 68, "ptu": 13,
+			"ptă": 15, "pu": 202, "pub": 14, "pui": 10, "pul": 38, "pun": 46, "pur": 7, "put": 76,
+			"pă": 63, "pă ": 31, "păr": 15, "păs": 8, "păș": 8, "pţ": 6, "pţi": 6, "pț": 52,
+			"pți": 52, "q": 16, "qw": 11, "qwe": 11, "r": 5241, "r ": 495, "ra": 416, "ra ": 64,
+			"rac": 44, "rag": 8, "rak": 7, "ral": 10, "ram": 35, "ran": 39, "rap": 10, "rar": 87,
+			"rat": 73, "raț": 15, "rb": 10, "rc": 79, "rc ": 6, "rca": 42, "rch": 11, "rcă": 14,
+			"rd": 37, "rd ": 17, "rdw": 6, "re": 1727, "re ": 660, "rea": 386, "reb": 68, "rec": 129,
+			"red": 36, "ree": 16, "ref": 32, "reg": 65, "rei": 9, "rel": 73, "rem": 28, "ren": 16,
+			"rep": 29, "res": 74, "ret": 14, "reu": 8, "rev": 15, "rez": 37, "reș": 16, "reț": 9,
+			"rf": 18, "rf ": 8, "rfa": 6, "rg": 43, "rge": 7, "rgu": 29, "rh": 35, "rhi": 35,
+			"ri": 614, "ri ": 122, "ria": 18, "rib": 16, "ric": 29, "rie": 60, "rif": 17, "rii": 53,
+			"ril": 94, "rim": 46, "rin": 25, "rio": 22, "rip": 13, "rit": 45, "riu": 9, "riv": 23,
+			"rl": 15, "rl ": 15, "rm": 186, "rm ": 15, "rma": 94, "rmi": 47, "rmă": 21, "rn": 75,
+			"rna": 14, "rne": 14, "rni": 30, "rnă": 15, "ro": 285, "ro ": 10, "roa": 85, "rob": 26,
+			"roc": 27, "rod": 16, "rog": 27, "rol": 30, "ron": 7, "rop": 14, "ror": 9, "rot": 7,
+			"rox": 7, "rp": 21, "rpc": 7, "rpo": 6, "rr": 6, "rs": 99, "rsa": 13, "rsi": 44,
+			"rso": 12, "rsă": 16, "rt": 200, "rt ": 13, "rta": 42, "rte": 52, "rti": 55, "rtu": 16,
+			"rty": 8, "ru": 593, "ru ": 281, "ruc": 36, "rui": 6, "rul": 211, "rup": 29, "rut": 10,
+			"rv": 48, "rva": 25, "rve": 13, "rvi": 8, "rz": 8, "rzi": 8, "ră": 199, "ră ": 160,
+			"răd": 6, "răr": 16, "răs": 10, "rș": 13, "rși": 13, "rț": 9, "rți": 6, "s": 3003,
+			"s ": 214, "sa": 214, "sa ": 27, "saj": 19, "sam": 10, "sar": 64, "sat": 12, "sau": 75,
+			"sc": 154, "sc ": 14, "sca": 9, "sch": 34, "scr": 43, "scu": 32, "scă": 17, "se": 481,
+			"se ": 205, "sec": 71, "seg": 18, "sel": 36, "sem": 36, "sen": 7, "ser": 27, "ses": 10,
+			"set": 29, "seş": 6, "seș": 17, "sf": 26, "sfâ": 13, "sh": 30, "sh ": 6, "she": 9,
+			"shi": 10, "si": 308, "si ": 10, "sib": 12, "sie": 18, "sig": 15, "sim": 59, "sin": 18,
+			"sis": 27, "sit": 49, "siu": 74, "sk": 12, "skt": 9, "sl": 13, "sla": 9, "sm": 30,
+			"sme": 25, "sn": 13, "sn ": 10, "so": 88, "so ": 8, "soc": 26, "sof": 11, "sol": 8,
+			"son": 13, "sor": 20, "sp": 154, "spa": 22, "spe": 66, "spo": 21, "spr": 20, "spu": 15,
+			"sr": 6, "ss": 9, "st": 895, "st ": 107, "sta": 163, "std": 8, "ste": 359, "sti": 35,
+			"sto": 9, "str": 132, "stu": 11, "stă": 68, "su": 193, "sub": 14, "sul": 26, "sun": 49,
+			"sup": 57, "sur": 19, "sv": 11, "sv ": 9, "să": 143, "să ": 135, "t": 5327, "t ": 870,
+			"ta": 668, "ta ": 137, "tab": 52, "tai": 8, "taj": 7, "tal": 60, "tam": 14, "tan": 34,
+			"tar": 86, "tas": 65, "tat": 160, "taţ": 8, "taț": 20, "tc": 8, "td": 10, "te": 1455,
+			"te ": 823, "tea": 77, "tec": 32, "teg": 6, "tei": 19, "tel": 71, "tem": 40, "ten": 90,
+			"tep": 35, "ter": 178, "tet": 25, "tex": 21, "teţ": 6, "teș": 10, "teț": 14, "tf": 7,
+			"ti": 574, "ti ": 20, "tib": 20, "tic": 42, "tif": 85, "til": 95, "tim": 52, "tin": 42,
+			"tio": 19, "tip": 51, "tir": 21, "tis": 31, "tit": 15, "tiv": 65, "tiz": 6, "tl": 20,
+			"tls": 17, "tm": 8, "to": 269, "toa": 42, "toc": 12, "ton": 6, "top": 11, "tor": 172,
+			"tot": 7, "tp": 11, "tp ": 8, "tr": 662, "tr ": 14, "tra": 81, "tre": 128, "tri": 44,
+			"trl": 8, "tro": 30, "tru": 341, "tră": 11, "tt": 18, "ttp": 6, "tu": 293, "tua": 27,
+			"tui": 6, "tul": 97, "tun": 7, "tur": 86, "tut": 54, "tw": 10, "twa": 9, "ty": 16,
+			"ty ": 14, "tâ": 20, "tâl": 11, "tă": 390, "tă ": 368, "tăr": 15, "u": 4263, "u ": 1032,
+			"ua": 65, "ua ": 10, "ual": 29, "uat": 16, "uaț": 7, "ub": 36, "ubl": 19, "uc": 73,
+			"uce": 12, "ucr": 9, "uct": 12, "ucț": 26, "ud": 25, "ude": 13, "udi": 8, "uf": 7,
+			"ug": 30, "uga": 9, "ugă": 12, "ui": 327, "ui ": 235, "uie": 61, "uit": 10, "uiz": 9,
+			"ul": 769, "ul ": 436, "ula": 24, "ule": 16, "ull": 8, "ult": 63, "ulu": 199, "ulă": 15,
+			"um": 269, "um ": 25, "uma": 27, "ume": 145, "umi": 9, "ump": 7, "umă": 51, "un": 672,
+			"un ": 186, "una": 7, "unc": 42, "und": 14, "une": 163, "ung": 21, "uni": 102, "uno": 30,
+			"uns": 12, "unt": 56, "unu": 22, "up": 128, "up ": 8, "upe": 12, "upl": 12, "upo": 26,
+			"upr": 15, "upt": 8, "upu": 13, "upă": 30, "ur": 288, "ur ": 7, "ura": 47, "ure": 13,
+			"uri": 111, "url": 7, "urm": 26, "urn": 19, "urs": 25, "ură": 25, "us": 35, "us ": 11,
+			"use": 7, "ut": 407, "ut ": 93, "uta": 28, "ute": 84, "uti": 92, "uto": 18, "utu": 66,
+			"ută": 19, "ux": 26, "ux ": 13, "uxu": 10, "uz": 20, "uza": 11, "uă": 13, "uă ": 12,
+			"uș": 8, "uț": 20, "uți": 18, "v": 636, "v ": 30, "va": 205, "va ": 25, "val": 133,
+			"var": 14, "vat": 30, "ve": 226, "ve ": 13, "vea": 14, "vec": 11, "vei": 10, "vel": 23,
+			"ven": 12, "ver": 122, "veș": 6, "vi": 67, "vic": 8, "vid": 7, "vin": 7, "vir": 22,
+			"vm": 6, "vo": 40, "voc": 14, "vol": 7, "vor": 11, "vr": 6, "vă": 39, "vă ": 36,
+			"w": 87, "wa": 33, "war": 31, "we": 28, "web": 7, "wer": 20, "x": 266, "x ": 55,
+			"xa": 11, "xc": 12, "xcl": 11, "xe": 29, "xec": 27, "xi": 48, "xis": 32, "xiu": 6,
+			"xp": 30, "xpi": 9, "xpo": 9, "xpr": 10, "xt": 53, "xt ": 15, "xte": 16, "xti": 12,
+			"xtr": 9, "xu": 14, "xul": 13, "xy": 7, "xy ": 7, "y": 50, "y ": 26, "z": 578,
+			"z ": 14, "za": 167, "za ": 23, "zac": 11, "zar": 27, "zat": 94, "ze": 70, "ze ": 12,
+			"zea": 28, "zen": 11, "zer": 16, "zi": 62, "zi ": 6, "zie": 7, "zit": 15, "ziț": 16,
+			"zo": 13, "zol": 10, "zu": 13, "zul": 6, "ză": 231, "ză ": 212, "zăr": 14, "â": 81,
+			"âl": 11, "âln": 11, "âm": 8, "âmp": 8, "ân": 29, "ând": 19, "âng": 7, "âr": 19,
+			"ârș": 13, "ât": 11, "ât ": 11, "î": 422, "îl": 9, "îl ": 9, "îm": 15, "împ": 14,
+			"în": 393, "în ": 221, "îna": 11, "înc": 75, "înl": 16, "înr": 15, "înt": 46, "ă": 1827,
+			"ă ": 1385, "ăc": 7, "ăci": 6, "ăd": 6, "ădă": 6, "ăm": 8, "ăr": 215, "ăr ": 17,
+			"ărc": 20, "ări": 82, "ăru": 39, "ără": 44, "ăs": 61, "ăsi": 37, "ăsp": 10, "ăt": 83,
+			"ăto": 20, "ătr": 9, "ătu": 47, "ău": 24, "ăug": 10, "ăut": 11, "ăș": 12, "ăși": 7,
+			"ăț": 12, "ăți": 10, "ş": 41, "şi": 24, "şie": 17, "şt": 13, "şte": 12, "ţ": 67,
+			"ţe": 8, "ţi": 53, "ţi ": 36, "ș": 564, "șa": 24, "șar": 8, "șat": 7, "șe": 38,
+			"șea": 22, "șec": 12, "și": 359, "și ": 79, "șie": 186, "șin": 8, "șir": 61, "șit": 25,
+			"șo": 6, "șt": 112, "ște": 106, "ști": 6, "șu": 20, "șua": 16, "ț": 636, "ța": 8,
+			"ța ": 7, "țe": 25, "țe ": 9, "țea": 6, "țel": 6, "ți": 560, "ți ": 109, "ția": 38,
+			"ție": 84, "ții": 57, "țim": 7, "țin": 67, "țio": 41, "țiu": 151, "ță": 38, "ță ": 38,
+		}},
+		"ru": {Total: 227808, Ngrams: map[string]int{
+			" a": 24, " ar": 8, " b": 12, " c": 27, " d": 26, " e": 14, " f": 14, " g": 29,
+			" gi": 11, " h": 16, " i": 10, " l": 10, " m": 15, " n": 17, " p": 30, " pc": 15,
+			" r": 15, " r ": 11, " s": 40, " ss": 8, " st": 8, " t": 13, " u": 30, " ur": 18,
+			" x": 12, " а": 237, " аб": 19, " ав": 11, " ад": 56, " ал": 10, " ан": 8, " ар": 47,
+			" ас": 15, " ат": 12, " ау": 41, " б": 329, " ба": 27, " бе": 36, " би": 52, " бл": 24,
+			" бо": 25, " бу": 42, " бы": 121, " в": 961, " в ": 322, " ва": 32, " вв": 36, " ве": 94,
+			" ви": 24, " вк": 13, " вл": 8, " вн": 44, " во": 64, " вр": 39, " вс": 40, " вт": 8,
+			" вх": 18, " вы": 212, " г": 44, " ге": 8, " гр": 22, " д": 661, " да": 88, " дв": 32,
+			" де": 50, " ди": 45, " дл": 223, " до": 200, " др": 19, " е": 77, " ег": 12, " ес": 47,
+			" ещ": 8, " ж": 16, " з": 405, " за": 330, " зн": 67, " и": 790, " и ": 111, " иг": 23,
+			" ид": 33, " из": 144, " ил": 84, " им": 88, " ин": 152, " ис": 145, " их": 8, " к": 591,
+			" к ": 31, " ка": 137, " кл": 90, " ко": 309, " кр": 14, " л": 42, " ли": 12, " ло": 20,
+			" м": 228, " м ": 13, " ма": 40, " ме": 72, " мн": 15, " мо": 72, " н": 1143, " на": 233,
+			" не": 793, " ни": 25, " но": 75, " ну": 15, " о": 746, " о ": 32, " об": 195, " ог": 10,
+			" од": 54, " ож": 32, " ок": 10, " он": 21, " оп": 101, " ос": 41, " от": 165, " ош": 71,
+			" п": 1374, " па": 157, " пе": 151, " пл": 34, " по": 511, " пр": 475, " пу": 36, " р": 404,
+			" ра": 190, " ре": 190, " ря": 16, " с": 1110, " с ": 129, " сб": 24, " св": 14, " сд": 12,
+			" се": 78, " си": 133, " ск": 24, " сл": 93, " см": 31, " со": 207, " сп": 86, " ср": 26,
+			" сс": 33, " ст": 136, " су": 32, " сц": 9, " сч": 9, " т": 263, " та": 42, " те": 40,
+			" ти": 32, " то": 106, " тр": 40, " у": 384, " у ": 9, " уд": 146, " уж": 13, " ук": 73,
+			" ум": 25, " уп": 23, " ус": 65, " уч": 9, " ф": 275, " фа": 212, " фл": 9, " фо": 27,
+			" фр": 8, " фу": 14, " х": 41, " хо": 16, " ц": 28, " це": 17, " ч": 151, " ча": 29,
+			" че": 19, " чи": 24, " чт": 72, " ш": 28, " ша": 11, " ши": 14, " э": 143, " эк": 18,
+			" эл": 22, " эт": 97, " я": 26, " яв": 15, "a": 66, "a ": 11, "ad": 8, "ar": 17,
+			"b": 13, "c": 75, "c ": 34, "d": 63, "d ": 26, "e": 62, "e ": 15, "ea": 9,
+			"el": 8, "f": 42, "f ": 17, "g": 43, "gi": 12, "git": 12, "h": 22, "he": 8,
+			"i": 71, "i ": 14, "im": 8, "in": 11, "it": 17, "it ": 17, "k": 10, "l": 60,
+			"l ": 35, "m": 49, "m ": 12, "n": 49, "n ": 16, "nu": 12, "o": 42, "p": 89,
+			"p ": 34, "pc": 15, "pc ": 13, "r": 83, "r ": 28, "re": 9, "rl": 16, "rl ": 16,
+			"s": 95, "s ": 20, "sp": 11, "sp ": 8, "ss": 10, "st": 11, "t": 82, "t ": 36,
+			"u": 61, "u ": 20, "ur": 18, "url": 15, "v": 17, "w": 11, "x": 27, "x ": 20,
+			"y": 11, "а": 5646, "а ": 981, "аб": 118, "абл": 30, "або": 54, "абс": 22, "ав": 229,
+			"ава": 40, "аве": 24, "ави": 70, "авл": 42, "авн": 22, "авт": 11, "аг": 86, "ага": 10,
+			"аго": 25, "агр": 34, "ад": 130, "ада": 48, "аде": 12, "адр": 54, "ае": 160, "аем": 31,
+			"ает": 127, "аж": 66, "ажд": 13, "аже": 34, "аз": 344, "аз ": 21, "аза": 86, "азд": 43,
+			"азм": 24, "азн": 28, "азо": 49, "азр": 28, "азу": 17, "азы": 25, "ай": 268, "айд": 18,
+			"айл": 215, "айт": 29, "ак": 204, "ак ": 51, "аке": 56, "ако": 30, "акр": 12, "акс": 13,
+			"акт": 17, "ал": 367, "ал ": 17, "ала": 12, "але": 22, "али": 39, "ало": 162, "аль": 72,
+			"аля": 11, "алё": 8, "ам": 196, "ам ": 22, "аме": 59, "ами": 53, "амм": 30, "амя": 22,
+			"ан": 667, "ан ": 31, "ана": 29, "анг": 8, "анд": 101, "ане": 10, "ани": 166, "анн": 183,
+			"ано": 82, "анс": 9, "ант": 16, "аны": 18, "ап": 165, "апа": 25, "апи": 63, "апр": 27,
+			"апу": 13, "апя": 21, "ар": 213, "ара": 50, "арг": 12, "аре": 9, "ари": 14, "аро": 22,
+			"арт": 39, "ару": 12, "арх": 33, "ас": 146, "ас ": 10, "аск": 10, "асн": 17, "асп": 12,
+			"асс": 24, "аст": 49, "асш": 14, "ат": 699, "ат ": 22, "ата": 93, "ате": 97, "ати": 29,
+			"атн": 20, "ато": 73, "атр": 15, "ату": 12, "атч": 15, "аты": 17, "ать": 297, "ау": 41,
+			"аут": 39, "аф": 9, "ах": 32, "ах ": 25, "ац": 139, "аци": 139, "ач": 119, "ача": 19,
+			"аче": 86, "ачи": 10, "аш": 29, "аши": 17, "аю": 88, "ают": 31, "ающ": 54, "ая": 132,
+			"ая ": 128, "аё": 8, "аёт": 8, "б": 1073, "б ": 17, "ба": 59, "бав": 19, "баз": 12,
+			"бай": 16, "бе": 60, "без": 36, "бел": 12, "би": 62, "биб": 8, "бит": 46, "бк": 61,
+			"бка": 48, "бл": 94, "бле": 18, "бли": 32, "бло": 37, "бн": 53, "бна": 16, "бно": 31,
+			"бо": 128, "бок": 9, "бол": 29, "бор": 17, "бот": 32, "боч": 23, "бр": 122, "бра": 103,
+			"бро": 10, "бс": 23, "бсо": 16, "бу": 86, "буд": 32, "буе": 25, "бут": 12, "бх": 49,
+			"бхо": 49, "бщ": 37, "бще": 30, "бъ": 49, "бъе": 46, "бы": 161, "бы ": 41, "был": 25,
+			"быт": 86, "бя": 8, "в": 2973, "в ": 532, "ва": 542, "ва ": 42, "вае": 59, "вам": 13,
+			"ван": 128, "ват": 204, "ваш": 8, "ваю": 56, "вая": 10, "вв": 36, "вве": 13, "вво": 23,
+			"ве": 376, "ве ": 18, "вед": 27, "вей": 8, "век": 8, "вен": 33, "вер": 179, "вес": 30,
+			"вет": 60, "ви": 180, "вид": 18, "вие": 11, "вил": 23, "вир": 9, "вис": 18, "вит": 55,
+			"виш": 11, "вия": 12, "вк": 78, "вка": 21, "вке": 9, "вки": 20, "вкл": 13, "вку": 12,
+			"вл": 136, "вле": 91, "вли": 10, "вля": 29, "вм": 12, "вме": 11, "вн": 115, "вне": 33,
+			"вни": 11, "вно": 29, "вну": 25, "вны": 15, "во": 408, "во ": 42, "вог": 18, "вод": 87,
+			"вое": 10, "воз": 68, "вои": 12, "вой": 33, "вок": 11, "вол": 75, "вом": 16, "вор": 9,
+			"вос": 14, "вп": 17, "впа": 17, "вр": 58, "вре": 51, "вс": 42, "все": 22, "вт": 30,
+			"вто": 30, "ву": 73, "вуе": 35, "вую": 24, "вх": 18, "вхо": 18, "вы": 282, "вы ": 25,
+			"выб": 16, "выв": 66, "вые": 15, "вый": 27, "вык": 8, "вып": 34, "выр": 27, "вых": 24,
+			"вя": 17, "вяз": 17, "г": 735, "г ": 36, "га": 38, "га ": 18, "ге": 18, "ген": 8,
+			"ги": 118, "ги ": 10, "гис": 88, "гл": 17, "гн": 34, "гно": 27, "го": 314, "го ": 249,
+			"гов": 11, "гол": 25, "гр": 103, "гра": 56, "гру": 42, "гу": 41, "гум": 12, "гут": 15,
+			"д": 2288, "д ": 97, "да": 478, "да ": 63, "дав": 11, "дае": 30, "дак": 10, "дал": 142,
+			"дан": 128, "дар": 26, "дат": 38, "даю": 9, "дв": 38, "дво": 17, "дд": 44, "дде": 43,
+			"де": 452, "де ": 15, "дей": 27, "дек": 54, "дел": 114, "ден": 100, "дер": 100, "дес": 9,
+			"дет": 21, "ди": 220, "диа": 26, "дим": 51, "дин": 65, "дит": 43, "дк": 20, "дкл": 10,
+			"дл": 235, "дли": 19, "для": 207, "дм": 10, "дн": 86, "дно": 41, "дны": 25, "до": 300,
+			"до ": 15, "доб": 25, "дов": 31, "дол": 83, "дом": 23, "доп": 45, "дос": 47, "дп": 39,
+			"дпи": 28, "дпо": 10, "др": 84, "дре": 52, "дро": 9, "дру": 19, "дс": 42, "дск": 12,
+			"дст": 29, "ду": 90, "ду ": 22, "дул": 12, "дуп": 19, "дут": 11, "дую": 15, "ды": 20,
+			"ды ": 14, "дё": 13, "дён": 10, "е": 6564, "е ": 1467, "еа": 12, "еб": 33, "ебу": 25,
+			"ев": 136, "еве": 39, "ево": 66, "ег": 159, "еги": 88, "его": 59, "ед": 271, "ед ": 10,
+			"еда": 15, "еде": 61, "еди": 39, "едн": 13, "едо": 43, "едп": 11, "едс": 27, "еду": 35,
+			"ее": 30, "ее ": 19, "еет": 10, "еж": 90, "ежд": 40, "ежи": 46, "ез": 80, "ез ": 24,
+			"еза": 20, "езо": 15, "езу": 9, "еи": 17, "ей": 166, "ей ": 113, "ейн": 10, "ейс": 27,
+			"ек": 271, "ека": 14, "еко": 41, "екс": 69, "ект": 100, "еку": 24, "ел": 344, "ел ": 20,
+			"ела": 36, "еле": 50, "ели": 26, "ело": 12, "елы": 9, "ель": 132, "еля": 49, "ем": 318,
+			"ем ": 60, "ема": 14, "емб": 14, "еме": 105, "емо": 17, "ему": 23, "емы": 57, "емя": 18,
+			"ен": 1134, "ен ": 98, "ена": 47, "ене": 59, "ени": 522, "енн": 133, "ено": 38, "ент": 146,
+			"ены": 36, "ень": 16, "еня": 14, "ео": 92, "еоб": 62, "еож": 10, "еп": 85, "епо": 55,
+			"епр": 25, "ер": 690, "ер ": 41, "ера": 112, "ерв": 49, "ере": 160, "ерж": 90, "ери": 23,
+			"ерк": 21, "ерн": 56, "еро": 14, "ерс": 41, "ерт": 18, "ерш": 19, "еры": 9, "ес": 342,
+			"ес ": 10, "еса": 26, "еск": 48, "есл": 38, "есс": 26, "ест": 134, "есу": 14, "есь": 10,
+			"ет": 565, "ет ": 213, "ета": 34, "етв": 14, "ете": 14, "етк": 42, "етн": 11, "ето": 30,
+			"етр": 33, "етс": 145, "еты": 14, "ех": 11, "ец": 44, "ец ": 14, "еци": 25, "еч": 74,
+			"еча": 27, "ече": 28, "ечи": 9, "еш": 45, "еши": 17, "ешн": 13, "ещ": 68, "еще": 55,
+			"ж": 621, "жа": 37, "жат": 22, "жд": 59, "жде": 19, "ждо": 10, "жду": 12, "ждё": 9,
+			"же": 202, "же ": 35, "жен": 128, "жет": 35, "жи": 174, "жив": 49, "жид": 42, "жим": 49,
+			"жит": 29, "жк": 11, "жн": 118, "жно": 84, "жны": 26, "з": 1429, "з ": 113, "за": 454,
+			"за ": 36, "зав": 39, "заг": 56, "зад": 33, "зак": 22, "зам": 27, "зан": 42, "зап": 125,
+			"зат": 48, "зв": 58, "зва": 14, "зве": 9, "звл": 15, "зво": 16, "зд": 90, "зда": 40,
+			"зде": 50, "зи": 55, "зир": 13, "зит": 32, "зк": 23, "зки": 12, "зм": 134, "зме": 73,
+			"змо": 59, "зн": 116, "зна": 100, "зо": 190, "зоб": 11, "зов": 129, "зон": 26, "зоп": 14,
+			"зр": 30, "зре": 21, "зу": 61, "зу ": 9, "зуе": 34, "зы": 41, "зыв": 36, "зя": 34,
+			"зя ": 33, "и": 5526, "и ": 1046, "иа": 56, "иал": 23, "иап": 25, "иб": 94, "ибк": 61,
+			"ибо": 11, "ибу": 12, "ив": 135, "ива": 91, "ивн": 12, "иг": 50, "игн": 31, "ид": 99,
+			"ида": 45, "иде": 48, "ие": 360, "ие ": 315, "ией": 12, "ием": 22, "из": 191, "из ": 66,
+			"изв": 34, "изи": 14, "изм": 50, "изо": 10, "ии": 199, "ии ": 199, "ий": 122, "ий ": 111,
+			"ийс": 9, "ик": 192, "ик ": 23, "ика": 124, "ико": 13, "икт": 9, "ил": 170, "ила": 10,
+			"или": 95, "ило": 32, "иль": 15, "им": 383, "им ": 40, "има": 25, "имв": 70, "име": 81,
+			"ими": 14, "имо": 82, "имп": 12, "имы": 19, "имя": 33, "ин": 337, "ин ": 25, "ина": 44,
+			"инд": 54, "ине": 14, "ини": 16, "инн": 10, "инс": 61, "инт": 21, "ину": 9, "инф": 34,
+			"ины": 20, "иня": 11, "ио": 21, "ион": 9, "ип": 40, "ип ": 17, "ипа": 13, "ир": 186,
+			"ире": 14, "ири": 12, "иро": 121, "иру": 26, "ис": 521, "иса": 23, "исе": 14, "иси": 45,
+			"иск": 52, "исл": 29, "исо": 19, "исп": 105, "ист": 173, "исх": 10, "ись": 35, "ит": 508,
+			"ит ": 39, "ита": 52, "ите": 114, "итн": 31, "ито": 36, "иты": 11, "ить": 212, "иф": 116,
+			"ифи": 104, "ифр": 11, "их": 44, "их ": 43, "иц": 42, "ица": 9, "ици": 10, "ицы": 10,
+			"ич": 105, "иче": 63, "ичн": 30, "иш": 40, "ишк": 25, "ию": 92, "ию ": 92, "ия": 367,
+			"ия ": 332, "иям": 11, "иян": 16, "й": 1111, "й ": 706, "йд": 23, "йде": 22, "йк": 13,
+			"йки": 10, "йл": 216, "йл ": 54, "йла": 54, "йле": 16, "йло": 60, "йлы": 31, "йн": 15,
+			"йне": 11, "йс": 47, "йск": 9, "йст": 35, "йт": 77, "йте": 13, "йти": 45, "к": 2438,
+			"к ": 203, "ка": 661, "ка ": 181, "кае": 14, "каж": 19, "каз": 116, "как": 38, "кал": 31,
+			"кам": 12, "кан": 10, "кар": 13, "кат": 131, "кац": 45, "кач": 9, "кая": 26, "ке": 129,
+			"ке ": 65, "кет": 61, "ки": 212, "ки ": 159, "кир": 18, "ких": 13, "кл": 154, "кла": 22,
+			"кло": 12, "клю": 115, "кн": 9, "ко": 572, "ко ": 68, "ков": 31, "код": 42, "кой": 30,
+			"кол": 29, "ком": 138, "кон": 98, "коп": 10, "кор": 48, "кот": 55, "кр": 77, "кра": 13,
+			"кри": 12, "кры": 35, "кс": 104, "кс ": 19, "кса": 15, "кси": 25, "ксп": 14, "кст": 16,
+			"кт": 132, "кта": 13, "кти": 13, "ктн": 41, "кто": 25, "кту": 17, "кты": 10, "ку": 86,
+			"ку ": 54, "кущ": 18, "кц": 79, "кци": 78, "л": 2894, "л ": 135, "ла": 241, "ла ": 108,
+			"лав": 41, "лаг": 15, "лад": 10, "лам": 15, "лас": 18, "лат": 19, "ле": 371, "ле ": 61,
+			"лев": 17, "лед": 34, "леж": 10, "лей": 19, "лек": 13, "лел": 9, "лем": 32, "лен": 144,
+			"леч": 11, "лж": 83, "лже": 39, "лжн": 42, "ли": 387, "ли ": 156, "лив": 9, "лиз": 18,
+			"лик": 16, "лин": 22, "лир": 11, "лит": 35, "лиц": 19, "лич": 27, "лиш": 29, "лия": 18,
+			"лк": 27, "лки": 16, "лл": 10, "лн": 55, "лне": 22, "лни": 18, "лня": 13, "ло": 458,
+			"ло ": 22, "лов": 132, "лог": 60, "лож": 47, "лок": 55, "лом": 11, "лон": 19, "лос": 96,
+			"лоч": 9, "лс": 10, "лся": 10, "лу": 55, "луч": 33, "лч": 22, "лча": 22, "лы": 56,
+			"лы ": 56, "ль": 489, "ль ": 36, "льз": 175, "льк": 68, "льн": 155, "льт": 21, "льш": 20,
+			"лю": 140, "лют": 16, "люч": 115, "ля": 334, "ля ": 264, "ляе": 19, "лям": 9, "ляр": 13,
+			"лят": 12, "ляю": 10, "лё": 15, "лён": 14, "м": 2013, "м ": 374, "ма": 192, "ма ": 25,
+			"мал": 15, "ман": 41, "мас": 9, "мат": 29, "мац": 34, "маш": 13, "мб": 15, "мбл": 14,
+			"мв": 70, "мво": 69, "ме": 509, "ме ": 33, "мее": 10, "меж": 11, "мен": 205, "мер": 48,
+			"мес": 42, "мет": 74, "меч": 18, "мещ": 54, "ми": 164, "ми ": 99, "мин": 16, "мит": 33,
+			"мич": 9, "мм": 70, "мми": 32, "ммы": 12, "мн": 35, "мно": 24, "мо": 300, "мо ": 50,
+			"мог": 31, "мод": 18, "мое": 19, "мож": 100, "мол": 22, "мос": 16, "мот": 12, "мощ": 12,
+			"мп": 46, "мпл": 10, "мпо": 31, "му": 53, "му ": 43, "мы": 98, "мы ": 32, "мые": 20,
+			"мый": 15, "мых": 17, "мя": 73, "мя ": 51, "мят": 22, "н": 5225, "н ": 197, "на": 583,
+			"на ": 203, "наз": 29, "най": 30, "нак": 12, "нал": 31, "нам": 11, "нар": 26, "нас": 17,
+			"нач": 108, "ная": 57, "нг": 12, "нд": 159, "нд ": 26, "нда": 41, "нде": 53, "ндн": 15,
+			"нды": 11, "не": 1010, "не ": 403, "нев": 83, "нег": 11, "нед": 35, "неи": 12, "нек": 37,
+			"нел": 33, "нем": 11, "нен": 122, "нео": 73, "неп": 50, "нер": 29, "нес": 24, "нет": 26,
+			"нец": 12, "неш": 12, "ни": 863, "ни ": 35, "ние": 279, "нии": 56, "ний": 45, "ник": 32,
+			"ним": 20, "нит": 39, "ниц": 15, "нич": 20, "нию": 30, "ния": 259, "нк": 22, "нкц": 14,
+			"нн": 375, "нна": 18, "нно": 110, "нны": 223, "ння": 10, "но": 830, "но ": 231, "нов": 155,
+			"ног": 132, "ное": 68, "ной": 84, "ном": 58, "нор": 28, "нос": 63, "нс": 88, "нст": 75,
+			"нт": 214, "нт ": 21, "нта": 39, "нте": 36, "нти": 78, "нто": 17, "нты": 12, "ну": 88,
+			"нул": 16, "нут": 30, "ную": 27, "нф": 49, "нфо": 34, "нц": 12, "нч": 9, "ны": 604,
+			"ны ": 96, "ные": 143, "ный": 199, "ным": 49, "ных": 117, "нь": 16, "ня": 57, "няе": 14,
+			"нят": 23, "няя": 12, "нё": 15, "нён": 15, "о": 7027, "о ": 853, "об": 418, "об ": 12,
+			"оба": 23, "обе": 18, "обл": 16, "обн": 52, "обо": 16, "обр": 93, "обх": 49, "общ": 37,
+			"объ": 49, "обы": 37, "ов": 859, "ов ": 195, "ова": 264, "ове": 70, "ови": 36, "овк": 59,
+			"овл": 45, "овн": 24, "ово": 64, "овп": 17, "овр": 14, "овт": 11, "овы": 44, "ог": 330,
+			"ог ": 24, "ога": 25, "ого": 210, "огр": 43, "огу": 18, "од": 498, "од ": 57, "ода": 44,
+			"одд": 43, "оде": 57, "оди": 123, "одк": 14, "одн": 55, "одо": 17, "одп": 28, "одр": 10,
+			"одс": 15, "оду": 12, "ое": 129, "ое ": 109, "оед": 13, "ож": 205, "оже": 81, "ожи": 46,
+			"ожн": 65, "оз": 150, "озв": 15, "озд": 37, "ози": 24, "озм": 58, "озн": 13, "ои": 59,
+			"оиз": 13, "оис": 14, "оич": 11, "ой": 279, "ой ": 227, "ойк": 13, "ойт": 28, "ок": 260,
+			"ок ": 84, "ока": 78, "оке": 14, "оки": 41, "око": 21, "оку": 12, "ол": 649, "ол ": 21,
+			"ола": 23, "оле": 23, "олж": 83, "оли": 21, "олн": 55, "оло": 66, "олу": 29, "олч": 22,
+			"олы": 11, "оль": 253, "олю": 16, "оля": 22, "ом": 362, "ом ": 164, "ома": 46, "оме": 40,
+			"омм": 35, "омо": 21, "омп": 27, "ому": 20, "он": 226, "он ": 22, "она": 30, "оне": 43,
+			"они": 19, "онн": 11, "онс": 16, "онт": 31, "онф": 15, "оо": 38, "ооб": 27, "оот": 9,
+			"оп": 215, "опа": 16, "опе": 60, "опи": 17, "опо": 22, "опр": 43, "опу": 43, "ор": 399,
+			"ор ": 31, "ора": 32, "ори": 59, "орм": 62, "орн": 9, "оро": 50, "орр": 34, "орт": 27,
+			"оры": 47, "оря": 15, "ос": 415, "ос ": 9, "оси": 32, "осл": 41, "осн": 13, "оср": 19,
+			"осс": 11, "ост": 166, "ось": 95, "от": 354, "от ": 38, "ота": 16, "отв": 23, "оте": 12,
+			"отк": 53, "отн": 12, "ото": 91, "отп": 10, "отр": 17, "отс": 41, "ох": 20, "охр": 11,
+			"оц": 29, "оце": 28, "оч": 159, "оче": 19, "очи": 43, "очк": 24, "очн": 56, "очт": 16,
+			"ош": 80, "оши": 74, "ощ": 12, "ощь": 10, "оя": 18, "оян": 15, "п": 2373, "п ": 31,
+			"па": 253, "па ": 22, "пад": 15, "паз": 25, "пак": 66, "пам": 22, "пар": 66, "пас": 16,
+			"пат": 15, "пе": 263, "пен": 9, "пер": 200, "пец": 30, "печ": 21, "пи": 159, "пис": 144,
+			"пл": 46, "пла": 30, "по": 806, "по ": 58, "пов": 30, "под": 128, "поз": 33, "пои": 13,
+			"пок": 44, "пол": 274, "пом": 37, "пон": 26, "поп": 11, "пор": 32, "пос": 72, "пот": 13,
+			"поч": 19, "пп": 16, "пр": 633, "пра": 63, "пре": 152, "при": 157, "про": 253, "пу": 100,
+			"пус": 69, "пут": 17, "пы": 10, "пя": 24, "пят": 21, "р": 3616, "р ": 127, "ра": 750,
+			"ра ": 76, "раб": 62, "рав": 83, "раж": 28, "раз": 160, "рак": 11, "рал": 11, "рам": 69,
+			"ран": 110, "рас": 33, "рат": 46, "раф": 9, "рац": 28, "рв": 51, "рве": 28, "рг": 17,
+			"ргу": 12, "ре": 783, "ре ": 17, "реб": 26, "рев": 18, "рег": 89, "ред": 146, "реж": 67,
+			"рез": 32, "рек": 50, "рем": 89, "рен": 47, "рео": 11, "реп": 28, "рес": 73, "рет": 15,
+			"реч": 10, "реш": 25, "рж": 90, "ржа": 15, "ржи": 69, "ри": 317, "ри ": 76, "риб": 12,
+			"рив": 15, "рии": 9, "рил": 25, "рим": 24, "рин": 35, "рир": 35, "рит": 19, "рия": 23,
+			"рк": 32, "рка": 12, "рки": 10, "рм": 70, "рма": 56, "рми": 11, "рн": 85, "рна": 19,
+			"рно": 27, "рны": 25, "ро": 646, "роб": 35, "ров": 210, "рог": 34, "рои": 28, "рой": 57,
+			"рок": 70, "рол": 27, "ром": 26, "рос": 40, "рот": 13, "роц": 24, "роч": 36, "рр": 36,
+			"рре": 34, "рс": 57, "рси": 40, "рт": 93, "рти": 34, "ртн": 27, "рту": 10, "ру": 182,
+			"ру ": 11, "руг": 19, "руе": 24, "руж": 17, "руз": 28, "рук": 63, "руп": 11, "рх": 37,
+			"рхи": 33, "рш": 24, "рше": 9, "рши": 10, "ры": 144, "ры ": 56, "рыв": 15, "рые": 28,
+			"рыт": 27, "ря": 49, "ря ": 9, "ряд": 23, "с": 3524, "с ": 195, "са": 91, "са ": 46,
+			"сан": 11, "сат": 11, "сац": 15, "сб": 24, "сбо": 14, "сбр": 10, "св": 22, "свя": 9,
+			"сд": 12, "сде": 9, "се": 144, "се ": 26, "сей": 15, "сем": 16, "сер": 47, "си": 280,
+			"си ": 31, "сии": 18, "сим": 88, "син": 10, "сир": 10, "сис": 47, "сит": 32, "ск": 211,
+			"ска": 93, "ске": 20, "ски": 30, "ско": 37, "скр": 12, "сл": 226, "сла": 9, "сле": 82,
+			"сли": 85, "сло": 35, "слу": 14, "см": 45, "сме": 24, "смо": 14, "сн": 46, "сно": 32,
+			"сны": 9, "со": 287, "соб": 13, "сов": 33, "сод": 45, "сое": 13, "соз": 37, "сок": 24,
+			"сол": 17, "соо": 36, "сор": 13, "сос": 15, "сох": 11, "сп": 234, "спе": 36, "спи": 43,
+			"спо": 130, "спр": 15, "ср": 45, "сра": 14, "сре": 21, "сро": 10, "сс": 94, "ссе": 16,
+			"ссо": 13, "сст": 10, "ссы": 35, "ст": 972, "ст ": 15, "ста": 196, "ств": 144, "сте": 65,
+			"сти": 121, "стн": 14, "сто": 76, "стр": 262, "сту": 31, "сть": 35, "су": 84, "сур": 9,
+			"сут": 33, "сущ": 22, "сх": 17, "схо": 10, "сц": 9, "сце": 9, "сч": 11, "сш": 17,
+			"сши": 12, "сы": 50, "сыл": 37, "сь": 147, "сь ": 144, "ся": 240, "ся ": 238, "т": 4602,
+			"т ": 430, "та": 524, "та ": 115, "таб": 19, "тав": 13, "тае": 23, "так": 34, "тал": 61,
+			"там": 9, "тан": 129, "тар": 19, "тат": 64, "тая": 9, "тв": 185, "тве": 50, "тви": 31,
+			"твл": 10, "тво": 24, "тву": 57, "те": 475, "те ": 89, "тей": 17, "тек": 60, "тел": 141,
+			"тем": 48, "тен": 66, "тер": 34, "ти": 421, "ти ": 143, "тив": 15, "тим": 27, "тип": 33,
+			"тир": 29, "тит": 34, "тиф": 90, "тич": 18, "тк": 105, "тка": 21, "тки": 30, "ткл": 13,
+			"ткр": 19, "тку": 14, "тн": 187, "тна": 14, "тно": 83, "тны": 87, "то": 677, "то ": 67,
+			"тоб": 42, "тов": 88, "тог": 27, "той": 53, "ток": 25, "тол": 64, "том": 29, "тор": 169,
+			"тот": 16, "точ": 55, "тоя": 16, "тп": 10, "тпр": 9, "тр": 400, "тр ": 46, "тра": 50,
+			"тре": 52, "три": 36, "тро": 123, "тру": 62, "тры": 23, "тс": 264, "тсл": 9, "тст": 41,
+			"тсу": 30, "тся": 179, "ту": 74, "туп": 27, "тур": 20, "тч": 28, "тчи": 13, "ты": 91,
+			"ты ": 54, "тыв": 10, "тый": 9, "ть": 690, "ть ": 650, "тьс": 37, "тя": 11, "у": 1543,
+			"у ": 189, "уа": 9, "уг": 22, "уги": 12, "уд": 190, "уда": 142, "уде": 20, "удо": 9,
+			"уду": 11, "уе": 120, "уем": 27, "ует": 93, "уж": 43, "уже": 30, "уз": 31, "узи": 9,
+			"узк": 13, "уй": 13, "ук": 142, "ука": 75, "укц": 56, "ул": 50, "уле": 17, "уль": 15,
+			"уля": 11, "ум": 55, "уме": 20, "умо": 22, "ун": 21, "унк": 15, "уп": 87, "уп ": 9,
+			"упа": 13, "упе": 10, "упп": 11, "упр": 33, "ур": 51, "урс": 12, "уры": 14, "ус": 144,
+			"уск": 32, "усл": 11, "уст": 89, "ут": 164, "ут ": 33, "уте": 41, "утр": 24, "утс": 33,
+			"уть": 15, "уч": 47, "уче": 16, "учи": 15, "ущ": 51, "уще": 37, "ущи": 10, "ую": 84,
+			"ую ": 46, "ующ": 30, "ф": 484, "фа": 217, "фай": 214, "фе": 11, "фи": 130, "фик": 107,
+			"фл": 17, "фо": 71, "фор": 60, "фр": 19, "фу": 14, "фун": 14, "х": 463, "х ": 241,
+			"хв": 11, "хва": 11, "хе": 10, "хи": 35, "хив": 21, "хит": 11, "хо": 128, "ход": 108,
+			"хот": 10, "хр": 20, "хра": 18, "ц": 398, "ц ": 14, "ца": 15, "ца ": 10, "це": 71,
+			"це ": 10, "цел": 15, "цен": 16, "цес": 23, "ци": 268, "циа": 24, "цие": 11, "ции": 105,
+			"ций": 9, "циф": 14, "цию": 51, "ция": 40, "цы": 10, "цы ": 10, "ч": 857, "ч ": 30,
+			"ча": 137, "ча ": 17, "чал": 13, "чан": 33, "час": 30, "чат": 29, "че": 274, "чег": 15,
+			"чей": 24, "чем": 9, "чен": 144, "чер": 12, "чес": 53, "чи": 170, "чи ": 12, "чив": 9,
+			"чик": 13, "чис": 34, "чит": 75, "чк": 30, "чко": 10, "чн": 94, "чни": 16, "чно": 36,
+			"чны": 33, "чт": 91, "чте": 18, "что": 64, "чё": 19, "чёт": 15, "ш": 322, "ша": 35,
+			"ша ": 11, "шаб": 9, "ше": 50, "ше ": 19, "шен": 17, "ши": 155, "шиб": 71, "шин": 12,
+			"шир": 23, "шит": 19, "шк": 31, "шко": 26, "шн": 18, "щ": 293, "ща": 17, "щая": 10,
+			"ще": 165, "щег": 12, "щей": 28, "щен": 86, "щес": 22, "щи": 86, "щие": 29, "щий": 28,
+			"щих": 12, "щь": 10, "щью": 10, "ъ": 50, "ъе": 46, "ъед": 9, "ъек": 37, "ы": 1569,
+			"ы ": 393, "ыб": 16, "ыбр": 12, "ыв": 135, "ыва": 64, "ыве": 15, "ыво": 48, "ые": 214,
+			"ые ": 214, "ый": 259, "ый ": 254, "ык": 11, "ыл": 64, "ыл ": 12, "ыли": 9, "ылк": 24,
+			"ыло": 11, "ым": 73, "ым ": 48, "ыми": 23, "ып": 34, "ыпо": 32, "ыр": 28, "ыра": 19,
+			"ыс": 10, "ыт": 121, "ыты": 11, "ыть": 96, "ых": 167, "ых ": 153, "ыхо": 14, "ыч": 10,
+			"ыш": 10, "ь": 1369, "ь ": 838, "ьз": 175, "ьзо": 107, "ьзу": 35, "ьзя": 33, "ьк": 69,
+			"ько": 65, "ьн": 155, "ьно": 84, "ьны": 61, "ьс": 41, "ься": 37, "ьт": 30, "ьте": 13,
+			"ьш": 28, "ьше": 17, "ью": 17, "ью ": 14, "э": 154, "эк": 21, "экс": 14, "эл": 22,
+			"эле": 21, "эт": 100, "это": 91, "ю": 455, "ю ": 160, "ют": 66, "ют ": 21, "ютн": 16,
+			"ютс": 26, "юч": 115, "юч ": 25, "юча": 17, "юче": 35, "ючи": 32, "ющ": 99, "юща": 9,
+			"юще": 40, "ющи": 50, "я": 1416, "я ": 1075, "яв": 24, "явл": 16, "яд": 25, "яду": 13,
+			"яе": 38, "яем": 11, "яет": 27, "яз": 27, "язы": 10, "ям": 27, "ям ": 9, "ями": 16,
+			"ян": 31, "яни": 31, "яр": 13, "ярн": 10, "ят": 87, "ята": 11, "яти": 21, "ято": 14,
+			"ять": 33, "ях": 9, "ях ": 9, "ящ": 13, "ящи": 13, "яю": 20, "яющ": 13, "яя": 12,
+			"яя ": 12, "ё": 108, "ё ": 15, "ён": 55, "ён ": 17, "ённ": 38, "ёт": 24, "ётн": 9,
+		}},
+		"sv": {Total: 213898, Ngrams: map[string]int{
+			" a": 1107, " ab": 8, " ac": 11, " ad": 28, " ak": 27, " al": 70, " an": 314, " ap": 17,
+			" ar": 107, " as": 11, " at": 246, " au": 46, " av": 216, " b": 314, " ba": 35, " be": 65,
+			" bi": 53, " bl": 25, " bo": 58, " br": 16, " bu": 8, " by": 27, " bå": 8, " bö": 14,
+			" c": 117, " ca": 20, " cd": 6, " ce": 12, " ch": 16, " co": 24, " cp": 7, " ct": 9,
+			" d": 527, " d ": 8, " da": 54, " de": 311, " di": 52, " do": 10, " dp": 6, " du": 57,
+			" dä": 6, " då": 8, " e": 638, " ef": 52, " ej": 12, " el": 117, " en": 294, " er": 14,
+			" es": 6, " et": 83, " ex": 42, " f": 1067, " fa": 13, " fe": 90, " fi": 175, " fj": 25,
+			" fl": 61, " fo": 31, " fp": 6, " fr": 91, " fs": 9, " fu": 24, " fy": 6, " fä": 17,
+			" få": 15, " fö": 498, " g": 248, " ga": 8, " ge": 30, " gi": 65, " gl": 12, " gn": 6,
+			" gr": 57, " gs": 8, " gå": 18, " gö": 24, " h": 305, " ha": 112, " he": 38, " hi": 54,
+			" ho": 22, " ht": 7, " hu": 12, " hä": 35, " hå": 11, " hö": 6, " i": 1217, " i ": 178,
+			" ic": 25, " id": 17, " ig": 29, " im": 14, " in": 913, " is": 23, " j": 38, " ja": 6,
+			" jo": 6, " ju": 12, " jä": 12, " k": 620, " ka": 186, " kl": 14, " ko": 208, " kr": 68,
+			" ku": 69, " kä": 27, " kö": 31, " l": 290, " la": 17, " li": 57, " lo": 51, " lä": 117,
+			" lå": 13, " lö": 20, " m": 512, " ma": 54, " me": 228, " mi": 83, " mo": 31, " mu": 10,
+			" mä": 8, " må": 77, " mö": 9, " n": 295, " n ": 7, " na": 40, " ne": 19, " ni": 21,
+			" no": 48, " nu": 29, " ny": 77, " nä": 29, " nå": 20, " o": 468, " oa": 6, " ob": 45,
+			" oc": 119, " of": 12, " og": 28, " ok": 12, " ol": 6, " om": 135, " op": 45, " or": 17,
+			" os": 6, " ot": 11, " ov": 12, " p": 470, " pa": 115, " pc": 15, " pe": 12, " pi": 9,
+			" pl": 20, " po": 40, " pr": 94, " pu": 13, " på": 135, " q": 8, " r": 259, " r ": 10,
+			" ra": 37, " re": 172, " ro": 13, " rä": 8, " rö": 6, " s": 1162, " sa": 65, " sc": 10,
+			" se": 100, " sh": 6, " si": 46, " sk": 175, " sl": 56, " so": 181, " sp": 42, " ss": 6,
+			" st": 282, " su": 12, " sv": 13, " sy": 75, " sä": 30, " så": 14, " sö": 28, " t": 604,
+			" ta": 103, " te": 62, " th": 8, " ti": 271, " tj": 7, " to": 30, " tr": 53, " tv": 27,
+			" ty": 20, " u": 358, " un": 54, " up": 78, " ur": 13, " ut": 194, " v": 414, " v ": 7,
+			" va": 126, " ve": 53, " vi": 135, " vä": 80, " w": 25, " wi": 7, " x": 10, " x ": 7,
+			" y": 11, " yt": 6, " z": 9, " ä": 273, " än": 72, " är": 178, " äv": 20, " å": 37,
+			" åt": 37, " ö": 90, " öp": 8, " öv": 70, "a": 6252, "a ": 1408, "ab": 39, "aba": 7,
+			"abe": 15, "abs": 7, "ac": 59, "acc": 9, "ach": 10, "ack": 32, "ad": 390, "ad ": 117,
+			"ada": 9, "add": 12, "ade": 204, "adr": 31, "af": 16, "ag": 120, "ag ": 21, "age": 12,
+			"agg": 44, "agn": 25, "agr": 6, "ah": 18, "ahå": 12, "ai": 9, "ain": 9, "ak": 186,
+			"aka": 22, "ake": 60, "akn": 15, "akt": 79, "al": 376, "al ": 52, "ala": 21, "ald": 11,
+			"ale": 10, "alf": 7, "ali": 39, "alk": 6, "all": 107, "alo": 56, "als": 13, "alt": 31,
+			"am": 208, "am ": 38, "ame": 14, "amm": 52, "amn": 71, "amv": 7, "an": 972, "an ": 230,
+			"and": 256, "anf": 26, "ang": 125, "ank": 12, "ann": 21, "anr": 7, "ans": 53, "ant": 68,
+			"anv": 152, "ap": 109, "apa": 45, "app": 30, "aps": 13, "ar": 905, "ar ": 367, "ara": 133,
+			"arb": 27, "ard": 49, "are": 82, "arg": 20, "ari": 15, "arj": 8, "ark": 64, "arn": 36,
+			"ars": 23, "art": 55, "as": 276, "as ": 119, "ase": 24, "ash": 6, "asi": 12, "ask": 10,
+			"asr": 7, "ass": 21, "ast": 65, "at": 838, "at ": 104, "ata": 151, "atc": 22, "ate": 59,
+			"ati": 118, "atn": 13, "ato": 15, "ats": 42, "att": 268, "atu": 41, "au": 54, "aut": 50,
+			"av": 245, "av ": 157, "ava": 6, "avb": 17, "avs": 42, "ax": 14, "axi": 6, "b": 704,
+			"b ": 26, "ba": 126, "bak": 17, "bar": 65, "bas": 33, "bb": 9, "be": 123, "bef": 6,
+			"beg": 11, "beh": 21, "bel": 19, "ber": 16, "bes": 13, "bet": 29, "bi": 90, "bil": 22,
+			"bin": 24, "bis": 8, "bit": 27, "bj": 46, "bje": 42, "bl": 56, "bla": 10, "ble": 16,
+			"bli": 14, "blo": 12, "bo": 96, "bol": 36, "bor": 57, "br": 32, "bre": 11, "bry": 12,
+			"bs": 13, "bso": 7, "bu": 27, "buf": 7, "but": 9, "by": 32, "byg": 10, "byt": 20,
+			"bå": 8, "båd": 8, "bö": 14, "bör": 14, "c": 915, "c ": 59, "ca": 28, "cac": 10,
+			"cap": 11, "cc": 13, "cce": 9, "cd": 6, "ce": 82, "cen": 12, "cep": 8, "cer": 34,
+			"ces": 21, "ch": 218, "ch ": 117, "cha": 17, "che": 76, "ci": 36, "cif": 13, "cit": 8,
+			"ck": 381, "ck ": 62, "cka": 83, "cke": 133, "ckl": 21, "ckn": 44, "cks": 19, "co": 29,
+			"com": 6, "cop": 8, "cor": 7, "cp": 8, "cr": 13, "cre": 7, "ct": 22, "ct ": 8,
+			"ctr": 7, "d": 2749, "d ": 598, "da": 407, "da ": 73, "dah": 9, "dan": 25, "dar": 87,
+			"das": 57, "dat": 146, "db": 9, "dd": 53, "dd ": 15, "dda": 10, "dde": 23, "de": 1060,
+			"de ": 364, "def": 12, "del": 75, "dem": 6, "den": 180, "der": 122, "des": 111, "det": 123,
+			"dex": 52, "di": 132, "dia": 9, "dif": 24, "dig": 43, "din": 11, "dir": 16, "dis": 7,
+			"dit": 12, "dj": 15, "dje": 8, "dl": 13, "dla": 7, "dm": 6, "dn": 40, "dni": 29,
+			"do": 51, "do ": 18, "dom": 6, "don": 8, "dor": 8, "dot": 7, "dp": 8, "dr": 141,
+			"dra": 66, "dre": 38, "dri": 29, "ds": 79, "ds ": 41, "dss": 8, "dst": 10, "dt": 8,
+			"du": 85, "du ": 47, "dul": 19, "dup": 6, "dv": 14, "dvä": 7, "dä": 7, "där": 7,
+			"då": 9, "då ": 8, "e": 6904, "e ": 1196, "ea": 41, "ead": 19, "eam": 6, "eb": 8,
+			"ec": 147, "eci": 22, "eck": 107, "ect": 10, "ed": 288, "ed ": 155, "eda": 24, "edd": 30,
+			"ede": 28, "edi": 19, "edj": 10, "eds": 6, "ee": 8, "ef": 102, "efe": 22, "efi": 22,
+			"eft": 51, "eg": 146, "eg ": 8, "ega": 8, "ege": 7, "egi": 82, "egm": 8, "egr": 9,
+			"egu": 6, "egå": 6, "eh": 61, "ehå": 48, "ehö": 11, "ej": 15, "ej ": 14, "ek": 185,
+			"ek ": 23, "eka": 8, "eke": 7, "eko": 13, "ekt": 106, "ekv": 11, "el": 484, "el ": 104,
+			"ela": 74, "elb": 25, "ele": 12, "elf": 11, "ell": 167, "eln": 23, "elp": 7, "els": 30,
+			"elt": 8, "elä": 7, "em": 129, "em ": 13, "ema": 17, "emb": 9, "eme": 45, "emh": 7,
+			"emo": 9, "emp": 10, "emt": 7, "en": 1238, "en ": 763, "ena": 26, "end": 76, "ene": 26,
+			"eng": 8, "enh": 13, "enk": 9, "enl": 6, "enn": 50, "eno": 17, "ens": 57, "ent": 167,
+			"eo": 8, "ep": 20, "epa": 6, "ept": 8, "er": 1737, "er ": 708, "era": 432, "ere": 37,
+			"erf": 10, "erh": 20, "eri": 200, "erk": 21, "erl": 11, "erm": 23, "ern": 53, "ero": 12,
+			"err": 10, "ers": 93, "ert": 37, "erv": 52, "es": 275, "es ": 108, "esk": 15, "ess": 80,
+			"est": 39, "esu": 18, "et": 671, "et ": 393, "eta": 27, "ete": 39, "eti": 8, "etr": 9,
+			"ets": 48, "ett": 123, "etu": 8, "ev": 19, "eve": 6, "ex": 120, "ex ": 17, "exa": 6,
+			"exe": 41, "exp": 8, "ext": 33, "f": 1628, "f ": 29, "fa": 35, "fal": 10, "far": 6,
+			"fe": 132, "fel": 90, "fer": 28, "ff": 50, "ff ": 10, "ffa": 9, "ffe": 9, "ffi": 10,
+			"ffr": 7, "fi": 330, "fic": 9, "fie": 19, "fig": 18, "fik": 24, "fil": 197, "fin": 48,
+			"fix": 11, "fj": 25, "fjä": 25, "fl": 85, "fla": 32, "fle": 14, "fli": 12, "fly": 19,
+			"fo": 89, "fo ": 8, "fof": 7, "fog": 7, "for": 62, "fp": 7, "fr": 108, "fra": 12,
+			"fre": 6, "frå": 84, "fs": 15, "ft": 81, "ft ": 10, "fte": 59, "fu": 29, "ful": 10,
+			"fun": 19, "fy": 8, "fä": 30, "fäl": 17, "fär": 12, "få": 15, "får": 11, "fö": 555,
+			"föl": 25, "för": 529, "g": 2165, "g ": 494, "ga": 244, "ga ": 102, "gad": 9, "gam": 6,
+			"gan": 14, "gar": 94, "gat": 11, "gav": 6, "gd": 12, "ge": 340, "ge ": 57, "gel": 7,
+			"gen": 171, "ger": 49, "ges": 18, "get": 29, "gg": 108, "gg ": 23, "gga": 46, "ggn": 7,
+			"ggo": 16, "gh": 19, "ghe": 15, "gi": 240, "gil": 57, "gin": 8, "gis": 82, "git": 27,
+			"giv": 57, "gl": 30, "gli": 14, "gm": 10, "gme": 10, "gn": 90, "gna": 27, "gne": 8,
+			"gni": 27, "gno": 20, "gnu": 6, "go": 45, "gon": 7, "gor": 26, "got": 11, "gp": 7,
+			"gr": 161, "gra": 65, "gre": 43, "gru": 26, "grä": 18, "gs": 131, "gsb": 8, "gsf": 7,
+			"gsg": 9, "gsi": 8, "gsk": 8, "gsl": 9, "gsm": 10, "gsp": 7, "gss": 13, "gst": 21,
+			"gt": 91, "gt ": 85, "gu": 46, "gul": 7, "gum": 20, "gur": 16, "gä": 21, "gän": 11,
+			"gär": 9, "gå": 33, "gån": 9, "går": 8, "gö": 30, "gör": 29, "h": 753, "h ": 137,
+			"ha": 160, "ha ": 10, "han": 62, "har": 81, "he": 168, "he ": 9, "hea": 13, "hec": 50,
+			"hel": 17, "hem": 16, "hen": 7, "her": 7, "het": 46, "hi": 65, "hin": 15, "hit": 33,
+			"hj": 8, "hjä": 7, "ho": 34, "hop": 26, "ht": 10, "hu": 28, "hum": 7, "huv": 15,
+			"hä": 35, "häm": 20, "här": 14, "hå": 81, "hål": 73, "hår": 6, "hö": 22, "höv": 10,
+			"i": 4548, "i ": 200, "ia": 37, "ia ": 12, "ial": 10, "ib": 22, "ibl": 6, "ibu": 9,
+			"ic": 78, "ic ": 6, "ice": 25, "ick": 41, "id": 120, "id ": 62, "ida": 6, "ide": 26,
+			"idi": 11, "ids": 7, "ie": 57, "ien": 7, "ier": 42, "if": 91, "iff": 20, "ifi": 48,
+			"ift": 17, "ig": 335, "ig ": 86, "iga": 40, "ige": 35, "igh": 18, "ign": 46, "igt": 83,
+			"igu": 16, "ik": 77, "ik ": 10, "ika": 36, "ike": 9, "ikt": 19, "il": 606, "il ": 74,
+			"ild": 27, "ile": 93, "ilj": 16, "ilk": 6, "ill": 286, "iln": 7, "ils": 10, "ilt": 65,
+			"im": 50, "ima": 8, "ime": 16, "imp": 15, "in": 1653, "in ": 83, "ina": 36, "inc": 43,
+			"ind": 105, "ine": 22, "inf": 33, "ing": 565, "ini": 21, "ink": 22, "inl": 18, "inm": 6,
+			"inn": 84, "ino": 8, "ins": 112, "int": 474, "inu": 7, "inä": 8, "io": 247, "ion": 241,
+			"ip": 21, "ir": 27, "ire": 8, "is": 400, "is ": 6, "isa": 52, "ise": 61, "isk": 58,
+			"iss": 54, "ist": 160, "it": 216, "it ": 43, "ita": 21, "ite": 42, "iti": 27, "itl": 7,
+			"itm": 7, "ito": 6, "its": 7, "itt": 50, "iv": 289, "iv ": 71, "iva": 33, "ive": 77,
+			"ivi": 7, "ivn": 42, "ivs": 10, "ivt": 9, "ivå": 28, "ix": 14, "ix ": 8, "j": 263,
+			"j ": 27, "ja": 41, "ja ": 12, "jan": 16, "jar": 7, "je": 80, "je ": 18, "jec": 6,
+			"jek": 38, "jer": 15, "jl": 7, "jli": 7, "jo": 10, "ju": 22, "jus": 12, "jä": 63,
+			"jäl": 10, "jäm": 14, "jän": 7, "jär": 31, "k": 2307, "k ": 167, "ka": 539, "ka ": 93,
+			"kad": 58, "kal": 43, "kan": 150, "kap": 47, "kar": 36, "kas": 9, "kat": 91, "ke": 279,
+			"ke ": 35, "kel": 52, "ken": 57, "ker": 47, "ket": 80, "kg": 8, "ki": 108, "kic": 7,
+			"kif": 9, "kil": 20, "kin": 12, "kit": 11, "kiv": 48, "kl": 62, "kla": 21, "kli": 23,
+			"klo": 10, "klu": 7, "kn": 87, "kna": 24, "kni": 57, "ko": 302, "kod": 41, "kol": 9,
+			"kom": 123, "kon": 80, "kop": 13, "kor": 32, "kr": 183, "kre": 12, "kri": 93, "kro": 7,
+			"kry": 7, "krä": 61, "ks": 30, "kst": 10, "kså": 7, "kt": 324, "kt ": 74, "kta": 11,
+			"kte": 25, "kti": 169, "ktu": 24, "ku": 82, "kun": 70, "kv": 40, "kve": 11, "kvä": 22,
+			"kä": 47, "käl": 24, "kän": 15, "kär": 8, "kö": 33, "kör": 30, "l": 3738, "l ": 533,
+			"la": 342, "la ": 113, "lad": 11, "lag": 63, "lak": 22, "lan": 42, "lar": 31, "las": 10,
+			"lat": 41, "lb": 44, "lba": 38, "ld": 61, "ld ": 19, "lda": 12, "lde": 7, "ldr": 8,
+			"lds": 6, "le": 477, "le ": 10, "led": 14, "lek": 30, "lel": 11, "lem": 26, "len": 48,
+			"ler": 267, "les": 9, "let": 58, "lf": 31, "lf ": 7, "lg": 18, "lgä": 11, "lh": 16,
+			"lha": 13, "li": 295, "lic": 25, "lid": 9, "lig": 89, "lik": 28, "lin": 23, "lis": 72,
+			"lit": 28, "lj": 69, "lj ": 11, "lja": 25, "lje": 18, "lk": 44, "lka": 13, "lko": 19,
+			"ll": 810, "ll ": 243, "lla": 95, "llb": 14, "lle": 227, "llf": 9, "llg": 11, "llh": 12,
+			"lli": 10, "llk": 17, "lln": 23, "llo": 17, "llr": 9, "lls": 38, "llt": 16, "llä": 13,
+			"llå": 45, "ln": 56, "ln ": 14, "lna": 18, "lni": 21, "lo": 196, "lob": 9, "loc": 28,
+			"log": 77, "lok": 45, "lon": 9, "lp": 19, "lpa": 8, "lr": 14, "lrä": 8, "ls": 97,
+			"ls ": 9, "lsa": 7, "lse": 20, "lsk": 11, "lst": 23, "lt": 149, "lt ": 48, "lta": 11,
+			"lte": 14, "lti": 67, "lu": 96, "lud": 8, "lut": 75, "lv": 11, "ly": 74, "lyc": 50,
+			"lyt": 19, "lä": 171, "läg": 70, "läm": 14, "län": 26, "läs": 49, "lå": 69, "lås": 7,
+			"låt": 48, "lö": 38, "lös": 33, "m": 1990, "m ": 353, "ma": 266, "ma ": 38, "mal": 20,
+			"man": 81, "mar": 17, "mas": 11, "mat": 73, "max": 8, "mb": 73, "mb ": 7, "mba": 7,
+			"mbi": 16, "mbl": 9, "mbo": 34, "md": 18, "me": 453, "me ": 10, "med": 201, "mel": 8,
+			"men": 109, "mer": 68, "met": 54, "mf": 13, "mfö": 11, "mh": 11, "mi": 100, "min": 35,
+			"mis": 50, "ml": 30, "mlo": 17, "mm": 185, "mma": 112, "mme": 66, "mn": 88, "mn ": 59,
+			"mna": 8, "mo": 71, "mod": 28, "mon": 12, "mot": 16, "mp": 91, "mpa": 17, "mpl": 18,
+			"mpo": 23, "mpr": 14, "mr": 14, "mre": 9, "ms": 33, "mst": 16, "mt": 38, "mta": 17,
+			"mu": 16, "mul": 10, "mv": 11, "mva": 7, "mä": 22, "män": 14, "må": 83, "mål": 15,
+			"mås": 61, "mö": 11, "möj": 7, "n": 6156, "n ": 1448, "na": 366, "na ": 140, "nad": 13,
+			"nak": 7, "nal": 15, "nam": 73, "nan": 14, "nar": 26, "nas": 16, "nat": 53, "nc": 55,
+			"nc ": 7, "nch": 41, "nd": 818, "nd ": 90, "nda": 206, "nde": 331, "ndi": 21, "ndl": 7,
+			"ndn": 12, "ndo": 34, "ndr": 88, "nds": 15, "ne": 236, "ne ": 17, "ned": 9, "neg": 7,
+			"neh": 38, "nen": 45, "ner": 91, "net": 12, "nf": 89, "nfi": 17, "nfl": 12, "nfo": 37,
+			"nfö": 19, "ng": 794, "ng ": 306, "nga": 120, "ngd": 10, "nge": 174, "ngi": 49, "ngl": 16,
+			"ngs": 98, "nh": 16, "nhe": 12, "ni": 322, "nie": 8, "nin": 252, "nis": 7, "nit": 24,
+			"niv": 27, "nk": 89, "nk ": 7, "nka": 17, "nkl": 9, "nko": 7, "nkr": 7, "nks": 7,
+			"nkt": 28, "nl": 37, "nle": 8, "nli": 14, "nlo": 9, "nm": 7, "nn": 170, "nna": 74,
+			"nne": 57, "nns": 24, "nnu": 7, "no": 100, "nol": 20, "nom": 14, "nor": 31, "not": 16,
+			"nr": 10, "nro": 8, "ns": 354, "ns ": 71, "nsa": 15, "nse": 17, "nsi": 7, "nsk": 25,
+			"nsl": 28, "nsn": 10, "nsp": 7, "nsr": 9, "nst": 134, "nt": 841, "nt ": 87, "nta": 85,
+			"nte": 558, "nti": 56, "ntr": 27, "nts": 9, "nu": 69, "nu ": 15, "nul": 7, "num": 33,
+			"nv": 166, "nve": 7, "nvä": 154, "ny": 90, "ny ": 12, "nya": 9, "nyc": 56, "nyt": 7,
+			"nä": 41, "när": 34, "nå": 21, "någ": 17, "o": 2413, "o ": 43, "oa": 11, "ob": 69,
+			"obj": 43, "obl": 7, "oc": 183, "oce": 25, "och": 110, "ock": 38, "od": 83, "od ": 25,
+			"oda": 10, "ode": 10, "odi": 9, "odu": 23, "oe": 10, "oen": 9, "of": 26, "ofi": 7,
+			"og": 163, "og ": 28, "oga": 8, "oge": 31, "ogg": 17, "ogi": 30, "ogr": 43, "ok": 73,
+			"oka": 37, "oke": 12, "oko": 9, "ol": 136, "ol ": 12, "ole": 13, "oli": 21, "olk": 11,
+			"oll": 52, "olu": 8, "om": 472, "om ": 259, "oma": 11, "omb": 17, "omd": 7, "ome": 24,
+			"oml": 17, "omm": 70, "omp": 36, "oms": 17, "on": 397, "on ": 146, "one": 87, "onf": 28,
+			"oni": 11, "ons": 52, "ont": 45, "onv": 7, "oo": 13, "op": 131, "op ": 15, "ope": 38,
+			"opi": 10, "opk": 9, "opp": 36, "opy": 8, "or": 401, "or ": 61, "ora": 12, "ord": 32,
+			"ore": 28, "ori": 22, "orl": 32, "orm": 50, "orn": 11, "orr": 10, "ors": 7, "ort": 117,
+			"orä": 9, "os": 54, "osi": 12, "ost": 31, "ot": 95, "ot ": 26, "ota": 8, "ote": 21,
+			"oti": 14, "oto": 11, "ou": 16, "ov": 20, "ovä": 9, "ow": 8, "p": 1359, "p ": 103,
+			"pa": 251, "pa ": 53, "pac": 26, "pad": 14, "pak": 60, "pan": 7, "par": 47, "pas": 11,
+			"pat": 22, "pc": 19, "pc ": 17, "pd": 37, "pda": 36, "pe": 114, "pec": 19, "pek": 10,
+			"pel": 12, "pen": 13, "per": 49, "pg": 8, "pi": 30, "pie": 8, "pk": 16, "pko": 9,
+			"pl": 65, "pla": 23, "ple": 17, "pli": 15, "pm": 10, "pn": 13, "pna": 7, "po": 91,
+			"pon": 11, "por": 31, "pos": 38, "pp": 177, "pp ": 16, "ppa": 32, "ppd": 32, "ppe": 13,
+			"ppl": 16, "ppn": 10, "ppo": 8, "ppr": 10, "pps": 24, "pr": 144, "pre": 19, "pri": 16,
+			"pro": 90, "pru": 12, "ps": 44, "ps ": 14, "pst": 15, "pt": 34, "pt ": 11, "pte": 12,
+			"pu": 24, "pu ": 7, "py": 11, "på": 151, "på ": 128, "pår": 13, "q": 10, "r": 5706,
+			"r ": 1859, "ra": 884, "ra ": 345, "rad": 141, "raf": 10, "rak": 9, "ral": 17, "ram": 60,
+			"ran": 65, "rar": 64, "ras": 64, "rat": 77, "rav": 11, "rb": 42, "rbe": 28, "rc": 8,
+			"rd": 174, "rd ": 60, "rda": 8, "rde": 68, "rdn": 12, "re": 607, "re ": 110, "rea": 21,
+			"rec": 8, "red": 48, "ref": 28, "reg": 96, "rek": 31, "rel": 20, "rem": 10, "ren": 82,
+			"rep": 9, "rer": 51, "res": 62, "ret": 18, "rev": 9, "rf": 30, "rfi": 7, "rfö": 8,
+			"rg": 30, "rgu": 20, "rh": 31, "rhe": 15, "ri": 431, "ria": 8, "rib": 9, "rif": 9,
+			"rig": 19, "rik": 7, "rim": 19, "rin": 228, "ris": 10, "rit": 14, "riv": 87, "rj": 16,
+			"rja": 8, "rje": 8, "rk": 90, "rka": 7, "rke": 12, "rki": 53, "rkl": 9, "rl": 74,
+			"rl ": 12, "rle": 32, "rli": 21, "rm": 89, "rm ": 11, "rma": 43, "rmo": 17, "rn": 124,
+			"rn ": 19, "rna": 49, "rni": 34, "rnt": 8, "ro": 174, "roc": 25, "roe": 9, "rog": 42,
+			"rol": 25, "rop": 12, "ror": 7, "rot": 18, "rp": 10, "rr": 57, "rra": 7, "rre": 22,
+			"rs": 197, "rs ": 18, "rse": 15, "rsi": 31, "rsk": 24, "rso": 10, "rsp": 16, "rst": 42,
+			"rsä": 12, "rsö": 12, "rt": 229, "rt ": 107, "rta": 26, "rte": 44, "rti": 15, "rts": 15,
+			"ru": 121, "ruk": 61, "run": 29, "rup": 11, "rut": 7, "rv": 96, "rva": 30, "rve": 27,
+			"rvä": 34, "ry": 61, "ryc": 25, "rym": 13, "ryt": 12, "rä": 149, "räc": 10, "räd": 12,
+			"räf": 8, "rän": 38, "rär": 9, "rät": 9, "räv": 55, "rå": 91, "råg": 7, "rån": 77,
+			"rö": 30, "röm": 21, "s": 3899, "s ": 587, "sa": 216, "sa ": 92, "sad": 10, "sak": 19,
+			"sam": 54, "sar": 9, "sas": 8, "sat": 11, "sb": 22, "sba": 13, "sc": 21, "sch": 10,
+			"se": 326, "se ": 28, "seg": 8, "sek": 38, "sem": 10, "sen": 53, "ser": 146, "ses": 14,
+			"set": 9, "sf": 27, "sfi": 14, "sg": 20, "sgr": 17, "sh": 30, "sh ": 15, "si": 145,
+			"sid": 8, "sig": 48, "sin": 16, "sio": 39, "sit": 13, "sk": 377, "sk ": 47, "ska": 109,
+			"ske": 10, "ski": 46, "sko": 20, "skr": 99, "skt": 18, "skä": 10, "sl": 185, "sl ": 8,
+			"sla": 26, "sli": 8, "slu": 73, "sly": 47, "slä": 14, "slå": 8, "sm": 23, "sme": 9,
+			"smo": 7, "sn": 43, "sni": 23, "snu": 8, "so": 223, "soc": 9, "sol": 9, "som": 160,
+			"son": 7, "sor": 28, "sp": 92, "spa": 13, "spe": 30, "spr": 12, "spå": 13, "sr": 33,
+			"sre": 22, "ss": 194, "ss ": 29, "ssa": 23, "sse": 40, "ssi": 12, "ssl": 54, "sst": 15,
+			"st": 1029, "st ": 107, "sta": 201, "ste": 245, "sti": 36, "sto": 51, "str": 139, "stu": 14,
+			"sty": 27, "stä": 100, "stå": 27, "stö": 64, "su": 47, "suf": 7, "sur": 13, "sv": 36,
+			"sva": 18, "svä": 7, "sy": 87, "sym": 36, "syn": 14, "sys": 37, "sä": 59, "säk": 18,
+			"sän": 10, "sät": 28, "så": 22, "så ": 15, "sö": 45, "sök": 44, "t": 6454, "t ": 1605,
+			"ta": 865, "ta ": 277, "tab": 21, "tad": 62, "tag": 33, "tai": 7, "tak": 8, "tal": 128,
+			"tan": 157, "tar": 71, "tas": 28, "tat": 51, "tb": 7, "tc": 32, "tch": 29, "td": 20,
+			"tda": 17, "te": 1313, "te ": 512, "tec": 48, "teg": 14, "tek": 17, "tel": 16, "tem": 45,
+			"ten": 91, "ter": 465, "tes": 14, "tet": 60, "tex": 20, "tf": 42, "tfi": 12, "tfö": 11,
+			"tg": 13, "th": 12, "thu": 8, "ti": 798, "tid": 24, "tie": 9, "tif": 19, "tig": 87,
+			"tik": 8, "til": 271, "tin": 27, "tio": 201, "tip": 7, "tis": 61, "tiv": 62, "tj": 8,
+			"tjä": 7, "tk": 14, "tko": 8, "tl": 35, "tli": 16, "tlö": 7, "tm": 20, "tma": 7,
+			"tn": 58, "tna": 10, "tni": 46, "to": 140, "tok": 8, "tol": 11, "tom": 23, "top": 10,
+			"tor": 66, "tp": 13, "tp ": 8, "tr": 302, "tr ": 14, "tra": 38, "tre": 37, "tri": 13,
+			"trl": 8, "tro": 25, "tru": 70, "try": 35, "trä": 38, "trö": 21, "ts": 176, "ts ": 67,
+			"tse": 8, "tsk": 38, "tst": 15, "tsv": 7, "tsä": 9, "tt": 561, "tt ": 372, "tta": 108,
+			"tte": 14, "ttn": 12, "ttr": 25, "tu": 100, "tue": 19, "tum": 22, "tur": 36, "tus": 13,
+			"tv": 37, "tvi": 14, "två": 15, "ty": 63, "ty ": 7, "tyc": 10, "typ": 24, "tyr": 14,
+			"tä": 110, "täc": 7, "täl": 70, "täm": 12, "tän": 17, "tå": 27, "tån": 20, "tö": 76,
+			"töd": 56, "tök": 9, "tör": 10, "u": 1245, "u ": 80, "ub": 13, "uc": 8, "ud": 32,
+			"ude": 18, "ue": 26, "uel": 22, "uf": 16, "uff": 15, "uk": 63, "ukt": 61, "ul": 67,
+			"ule": 18, "ull": 22, "ult": 13, "um": 107, "um ": 12, "umb": 7, "ume": 27, "umm": 39,
+			"ump": 7, "umr": 7, "un": 199, "und": 138, "ung": 15, "unk": 23, "unt": 14, "up": 110,
+			"upp": 95, "ur": 92, "ur ": 18, "ura": 12, "ure": 13, "url": 8, "urn": 7, "urs": 23,
+			"us": 46, "us ": 10, "use": 7, "ust": 15, "ut": 353, "ut ": 70, "uta": 66, "utd": 15,
+			"ute": 59, "utf": 24, "utl": 8, "utm": 7, "utn": 12, "uto": 13, "utr": 16, "uts": 12,
+			"utt": 22, "utö": 10, "uv": 17, "uvu": 15, "v": 1595, "v ": 255, "va": 245, "va ": 31,
+			"val": 43, "van": 8, "var": 146, "vb": 19, "vbi": 7, "vbr": 10, "ve": 306, "ven": 44,
+			"ver": 205, "vet": 39, "vi": 171, "via": 7, "vid": 49, "vil": 22, "vin": 12, "vis": 64,
+			"vm": 8, "vn": 42, "vna": 27, "vni": 15, "vo": 17, "vor": 12, "vr": 9, "vs": 104,
+			"vs ": 59, "vsl": 17, "vst": 15, "vt": 14, "vt ": 8, "vu": 16, "vud": 15, "vä": 321,
+			"väg": 26, "väl": 22, "vän": 210, "vär": 56, "vå": 48, "vå ": 16, "vån": 20, "w": 54,
+			"w ": 10, "wa": 7, "war": 7, "we": 10, "wi": 7, "win": 7, "x": 179, "x ": 49,
+			"xa": 11, "xe": 43, "xek": 7, "xer": 13, "xet": 17, "xf": 8, "xi": 10, "xp": 8,
+			"xt": 33, "xt ": 12, "xtr": 10, "y": 456, "y ": 40, "ya": 12, "yc": 144, "yck": 144,
+			"yg": 15, "ygg": 11, "ym": 53, "ymb": 34, "ymm": 9, "yn": 17, "yp": 30, "yp ": 19,
+			"yr": 23, "ys": 48, "yst": 37, "yt": 65, "yt ": 7, "yta": 9, "yte": 14, "ytt": 33,
+			"z": 15, "ä": 1357, "äc": 17, "äck": 17, "äd": 17, "äd ": 7, "äf": 8, "äg": 97,
+			"äg ": 12, "äge": 38, "ägg": 33, "äk": 23, "äke": 17, "äl": 147, "älj": 18, "äll": 98,
+			"älp": 7, "ält": 14, "äm": 61, "ämf": 9, "ämn": 11, "ämp": 13, "ämt": 20, "än": 425,
+			"än ": 23, "änd": 221, "äng": 52, "änk": 18, "änn": 12, "äns": 38, "änt": 57, "äp": 9,
+			"är": 371, "är ": 230, "ärd": 76, "ärf": 8, "ärm": 7, "ärr": 25, "ärt": 9, "äs": 55,
+			"äsa": 22, "äsn": 7, "äst": 7, "ät": 47, "ätt": 44, "äv": 76, "äve": 30, "ävs": 43,
+			"å": 696, "å ": 179, "åd": 14, "åe": 7, "åen": 7, "åg": 27, "ågo": 16, "ål": 98,
+			"ål ": 9, "åld": 7, "åll": 71, "ån": 139, "ån ": 95, "ånd": 20, "ång": 23, "år": 45,
+			"år ": 26, "åra": 10, "ås": 72, "åst": 64, "åt": 99, "åt ": 19, "åta": 7, "åte": 37,
+			"åts": 14, "åv": 10, "ö": 955, "öd": 65, "öd ": 18, "öds": 30, "öj": 9, "öjl": 7,
+			"ök": 56, "ök ": 9, "öka": 11, "ökn": 8, "ökv": 21, "öl": 28, "ölj": 27, "öm": 27,
+			"öm ": 7, "ömm": 7, "öms": 9, "ön": 8, "öns": 7, "öp": 8, "öpp": 8, "ör": 622,
+			"ör ": 398, "öra": 33, "örb": 8, "öre": 32, "öri": 7, "örj": 8, "örl": 14, "örr": 8,
+			"örs": 43, "örv": 32, "ös": 35, "ösa": 9, "öse": 12, "öst": 9, "öv": 84, "öve": 73,
+		}},
+		"uk": {Total: 228161, Ngrams: map[string]int{
+			" a": 32, " ar": 9, " c": 31, " d": 23, " e": 20, " el": 9, " f": 9, " g": 27,
+			" gn": 10, " h": 11, " i": 22, " l": 19, " m": 21, " n": 19, " no": 8, " o": 14,
+			" p": 23, " r": 28, " r ": 19, " s": 42, " st": 8, " t": 28, " tl": 9, " u": 13,
+			" v": 13, " w": 13, " x": 12, " x ": 8, " z": 8, " а": 284, " аб": 93, " ав": 12,
+			" ад": 53, " ал": 24, " ар": 63, " ас": 10, " ат": 10, " б": 354, " ба": 36, " бе": 37,
+			" бл": 10, " бу": 186, " бі": 74, " в": 1226, " в ": 18, " ва": 24, " вв": 18, " вд": 116,
+			" ве": 46, " вж": 12, " вз": 9, " ви": 531, " вк": 135, " вл": 10, " вм": 9, " вн": 9,
+			" вс": 73, " ву": 10, " вх": 23, " ві": 169, " г": 37, " гр": 23, " д": 808, " да": 147,
+			" дв": 10, " де": 32, " дж": 19, " ди": 29, " дл": 241, " до": 232, " др": 9, " ді": 82,
+			" е": 39, " ек": 16, " ел": 20, " ж": 19, " жо": 14, " з": 836, " з ": 141, " за": 395,
+			" зб": 36, " зв": 25, " зг": 8, " зм": 48, " зн": 118, " зо": 9, " зс": 17, " зі": 19,
+			" й": 22, " йо": 15, " к": 504, " ка": 57, " ке": 20, " кл": 102, " ко": 250, " кр": 35,
+			" кі": 39, " л": 97, " ла": 13, " ли": 48, " ло": 12, " лі": 15, " м": 470, " ма": 170,
+			" ме": 55, " мо": 149, " му": 11, " мі": 78, " н": 1098, " на": 297, " не": 703, " но": 44,
+			" ну": 26, " ні": 20, " о": 378, " об": 96, " од": 60, " он": 19, " оп": 109, " ос": 34,
+			" от": 30, " оч": 15, " п": 1563, " па": 119, " пе": 253, " по": 540, " пр": 442, " пі": 195,
+			" р": 559, " ра": 21, " ре": 203, " ро": 238, " ру": 15, " ря": 48, " рі": 28, " с": 708,
+			" се": 79, " си": 166, " ск": 28, " сл": 91, " сп": 119, " ст": 164, " су": 27, " сх": 12,
+			" т": 243, " та": 79, " те": 30, " ти": 72, " то": 35, " тр": 13, " ту": 9, " у": 323,
+			" у ": 249, " ув": 24, " ум": 17, " ус": 19, " ф": 243, " фа": 185, " фо": 33, " фу": 11,
+			" фі": 12, " х": 15, " ц": 139, " це": 36, " ци": 13, " ць": 34, " ці": 50, " ч": 163,
+			" ча": 86, " че": 14, " чи": 62, " ш": 46, " ша": 8, " ши": 20, " шл": 10, " щ": 101,
+			" ще": 13, " що": 88, " я": 182, " я ": 10, " яз": 30, " як": 111, " ят": 24, " є": 116,
+			" є ": 84, " єд": 12, " єк": 13, " і": 303, " і ": 66, " іг": 11, " ід": 27, " із": 27,
+			" ім": 20, " ін": 133, " іс": 15, "a": 88, "a ": 29, "ap": 11, "ar": 20, "b": 16,
+			"b ": 11, "c": 90, "c ": 31, "cr": 8, "cs": 11, "d": 60, "d ": 28, "e": 73,
+			"e ": 16, "el": 11, "elf": 8, "et": 8, "f": 46, "f ": 23, "g": 36, "g ": 8,
+			"gn": 11, "gnu": 10, "h": 26, "h ": 11, "i": 73, "i ": 10, "id": 13, "id ": 11,
+			"in": 10, "ip": 8, "k": 13, "l": 70, "l ": 18, "lf": 8, "lf ": 8, "ls": 11,
+			"ls ": 10, "m": 65, "m ": 15, "n": 67, "n ": 19, "no": 9, "nu": 15, "nu ": 9,
+			"o": 55, "o ": 11, "oc": 10, "op": 10, "p": 94, "p ": 40, "pc": 8, "ps": 9,
+			"r": 95, "r ": 45, "rc": 9, "rc ": 9, "s": 119, "s ": 33, "sa": 9, "sp": 14,
+			"sp ": 13, "st": 14, "t": 105, "t ": 30, "tf": 9, "tl": 10, "tls": 9, "to": 8,
+			"tr": 10, "u": 52, "u ": 21, "v": 28, "w": 21, "wa": 8, "war": 8, "x": 20,
+			"x ": 16, "y": 13, "y ": 9, "z": 8, "а": 5991, "а ": 935, "аб": 180, "абе": 14,
+			"абл": 36, "або": 100, "абс": 16, "ав": 207, "ав ": 10, "ава": 82, "аве": 29, "ави": 19,
+			"авл": 8, "авт": 13, "аві": 27, "аг": 87, "ага": 45, "агн": 13, "аго": 22, "ад": 163,
+			"ада": 20, "адн": 10, "адр": 56, "адт": 31, "аді": 10, "аж": 55, "аже": 28, "аз": 342,
+			"аз ": 13, "аза": 118, "азв": 91, "азо": 63, "азу": 34, "азі": 13, "ай": 235, "айд": 12,
+			"айл": 184, "айт": 26, "ак": 119, "ак ": 11, "аке": 8, "аки": 11, "ако": 17, "акр": 8,
+			"акс": 18, "аку": 26, "ал": 416, "ал ": 12, "ала": 23, "алг": 11, "але": 46, "али": 16,
+			"ало": 197, "алу": 12, "аль": 74, "алі": 21, "ам": 241, "ам ": 48, "ама": 10, "аме": 44,
+			"ами": 82, "амн": 14, "амі": 31, "ан": 1118, "ан ": 8, "ана": 26, "анд": 94, "ани": 150,
+			"анн": 386, "ано": 267, "анс": 8, "ант": 46, "ань": 11, "ані": 104, "ап": 197, "апа": 46,
+			"апи": 82, "апк": 22, "апо": 18, "апу": 15, "ар": 212, "ара": 58, "арг": 13, "арн": 14,
+			"аро": 19, "арт": 30, "арх": 49, "арі": 17, "ас": 191, "ас ": 61, "асе": 10, "аск": 15,
+			"асн": 14, "асо": 20, "аст": 44, "асу": 10, "ат": 638, "ат ": 16, "ата": 43, "ати": 395,
+			"атк": 30, "атн": 32, "ато": 64, "атр": 16, "ату": 12, "аті": 23, "ах": 37, "ах ": 28,
+			"ац": 80, "аці": 72, "ач": 304, "ача": 48, "аче": 206, "ачи": 11, "ачі": 17, "аш": 33,
+			"аши": 15, "аю": 22, "ают": 21, "ає": 162, "ає ": 127, "аєм": 12, "аєт": 23, "б": 1021,
+			"б ": 33, "ба": 114, "ба ": 13, "баг": 15, "баз": 14, "бай": 15, "бач": 53, "бе": 76,
+			"без": 52, "бен": 8, "бер": 16, "би": 48, "би ": 18, "бир": 15, "бит": 11, "бк": 24,
+			"бки": 18, "бл": 97, "бле": 13, "бли": 26, "бло": 21, "блю": 8, "блі": 21, "бм": 10,
+			"бн": 17, "бо": 139, "бо ": 80, "бов": 9, "бор": 21, "бот": 14, "бр": 82, "бра": 38,
+			"бро": 38, "бс": 17, "бсо": 13, "бу": 244, "був": 22, "буд": 38, "бул": 36, "бут": 126,
+			"бі": 107, "біб": 17, "біл": 30, "біт": 43, "в": 3900, "в ": 413, "ва": 752, "ва ": 51,
+			"ваг": 17, "вал": 15, "вам": 12, "ван": 376, "вар": 11, "ват": 185, "вач": 58, "ває": 10,
+			"вв": 18, "вве": 12, "вд": 130, "вда": 124, "ве": 246, "ве ": 29, "вед": 58, "вел": 17,
+			"вер": 97, "вес": 28, "вж": 29, "вже": 11, "вжи": 12, "вз": 9, "ви": 791, "ви ": 53,
+			"виб": 30, "вив": 90, "вид": 27, "виз": 46, "вий": 62, "вик": 217, "вил": 35, "вим": 37,
+			"вин": 12, "вип": 12, "вир": 38, "вис": 8, "вит": 38, "вих": 31, "вищ": 15, "вия": 30,
+			"вк": 151, "вка": 127, "вкл": 13, "вл": 113, "вла": 10, "вле": 92, "влю": 9, "вм": 9,
+			"вн": 159, "вне": 17, "вни": 25, "вно": 37, "вну": 9, "вню": 15, "вня": 22, "вні": 32,
+			"во": 358, "во ": 44, "вог": 30, "вод": 31, "вол": 114, "вом": 8, "вор": 78, "вос": 11,
+			"вою": 8, "вої": 14, "вп": 8, "вс": 85, "вст": 67, "вся": 11, "вт": 33, "вто": 27,
+			"ву": 154, "ву ": 53, "вув": 65, "вуз": 10, "вує": 13, "вх": 27, "вхі": 17, "ві": 396,
+			"ві ": 33, "від": 233, "вій": 10, "віл": 8, "вір": 51, "віт": 8, "віш": 21, "г": 822,
+			"г ": 20, "га": 79, "га ": 13, "гал": 25, "гат": 19, "ги": 9, "гл": 12, "гм": 12,
+			"гме": 12, "гн": 44, "гна": 8, "гно": 36, "го": 385, "го ": 316, "год": 8, "гол": 23,
+			"гор": 22, "гою": 9, "гр": 81, "гра": 59, "гру": 19, "гу": 28, "гу ": 9, "гум": 13,
+			"гі": 143, "гіс": 133, "д": 2552, "д ": 196, "да": 393, "да ": 11, "дав": 11, "дал": 128,
+			"дан": 159, "дар": 16, "дат": 39, "дає": 11, "дб": 51, "дба": 49, "дв": 17, "дво": 9,
+			"де": 174, "де ": 20, "дек": 42, "ден": 90, "дес": 8, "дж": 81, "джа": 16, "дже": 63,
+			"ди": 128, "ди ": 17, "дин": 43, "дит": 33, "дк": 108, "дка": 18, "дки": 11, "дкл": 15,
+			"дко": 20, "дкр": 22, "дку": 14, "дкі": 8, "дл": 241, "для": 241, "дн": 169, "дна": 21,
+			"дне": 8, "дни": 24, "дно": 71, "дну": 9, "днь": 14, "дні": 21, "до": 382, "до ": 145,
+			"доб": 11, "дов": 48, "дод": 28, "доз": 8, "док": 25, "дом": 62, "доп": 14, "дос": 33,
+			"дп": 77, "дпи": 36, "дпо": 36, "др": 69, "дре": 51, "дру": 10, "дс": 27, "дст": 15,
+			"дт": 89, "дтв": 8, "дто": 31, "дтр": 50, "ду": 79, "ду ": 32, "дув": 15, "дул": 16,
+			"дш": 13, "дше": 11, "дь": 19, "дь ": 16, "ді": 225, "ді ": 18, "діа": 54, "дів": 8,
+			"дій": 13, "діл": 84, "дін": 10, "діт": 10, "дії": 12, "е": 4090, "е ": 751, "еа": 13,
+			"еал": 9, "еб": 21, "ебу": 16, "ев": 116, "еви": 30, "еві": 59, "ег": 156, "егм": 12,
+			"егі": 133, "ед": 201, "едб": 48, "еде": 40, "едж": 33, "еди": 9, "едн": 16, "едо": 15,
+			"еді": 19, "еж": 116, "ежа": 30, "еже": 11, "ежи": 45, "ежн": 13, "ежі": 12, "ез": 99,
+			"ез ": 29, "еза": 16, "езп": 30, "ей": 52, "ей ": 35, "ек": 289, "ека": 12, "еки": 13,
+			"еко": 74, "екр": 9, "екс": 63, "ект": 96, "екі": 12, "ел": 67, "ела": 9, "еле": 18,
+			"ели": 15, "ело": 8, "ем": 159, "ем ": 24, "ема": 31, "емб": 10, "еме": 21, "еми": 24,
+			"емо": 25, "емі": 14, "ен": 884, "ен ": 14, "ена": 9, "ене": 10, "ени": 51, "енн": 439,
+			"ено": 175, "ент": 105, "ену": 12, "енш": 10, "ень": 26, "ені": 20, "ео": 25, "еоч": 10,
+			"еп": 47, "епе": 11, "епо": 11, "епр": 16, "ер": 715, "ер ": 23, "ера": 109, "ерв": 33,
+			"ере": 339, "ери": 13, "ерн": 12, "еро": 18, "ерс": 26, "ерт": 27, "еру": 13, "ерш": 46,
+			"ері": 28, "ес": 168, "еса": 13, "еси": 13, "есо": 10, "ест": 33, "есу": 73, "ет": 96,
+			"ета": 12, "етв": 13, "етр": 44, "ех": 11, "ец": 35, "еци": 14, "ець": 14, "еч": 23,
+			"ече": 14, "ечн": 8, "еш": 19, "ея": 8, "ж": 519, "ж ": 16, "жа": 52, "жам": 18,
+			"жат": 19, "жб": 9, "же": 163, "же ": 45, "жен": 96, "жер": 19, "жи": 67, "жим": 37,
+			"жин": 11, "жит": 15, "жл": 41, "жли": 41, "жн": 115, "жна": 70, "жно": 15, "жні": 9,
+			"жо": 14, "жод": 8, "жу": 19, "жі": 16, "жі ": 12, "з": 1816, "з ": 212, "за": 576,
+			"за ": 92, "заб": 36, "зав": 69, "заг": 35, "зак": 13, "зал": 13, "зам": 13, "зан": 100,
+			"зап": 100, "зар": 11, "зас": 17, "зат": 34, "зац": 9, "заш": 10, "зб": 39, "збе": 12,
+			"зби": 12, "збі": 13, "зв": 140, "зв ": 28, "зва": 22, "зви": 32, "зво": 23, "зву": 25,
+			"зг": 24, "зга": 8, "зго": 14, "зд": 81, "зді": 76, "зи": 12, "зк": 19, "зко": 8,
+			"зл": 8, "зм": 99, "змі": 90, "зн": 275, "зна": 255, "зо": 84, "зов": 28, "зом": 9,
+			"зон": 43, "зп": 78, "зпе": 25, "зпо": 12, "зпі": 39, "зр": 14, "зс": 20, "зсу": 17,
+			"зт": 8, "зта": 8, "зу": 58, "зу ": 12, "зув": 23, "зуп": 8, "зш": 18, "зши": 18,
+			"зі": 41, "зі ": 22, "и": 4857, "и ": 1443, "иб": 44, "ибр": 16, "ибу": 10, "ив": 196,
+			"ив ": 8, "ива": 18, "иве": 55, "иви": 8, "ивн": 17, "иво": 61, "ивс": 8, "иві": 12,
+			"иг": 12, "игн": 8, "ид": 42, "ида": 20, "идш": 11, "из": 101, "изн": 84, "ий": 291,
+			"ий ": 278, "ик": 321, "ик ": 13, "ика": 50, "ики": 17, "икл": 26, "ико": 198, "икі": 8,
+			"ил": 193, "ила": 45, "илк": 96, "илу": 19, "иль": 16, "им": 482, "им ": 146, "има": 33,
+			"имв": 105, "ими": 53, "имк": 44, "имо": 16, "иму": 36, "имч": 9, "имі": 34, "ин": 138,
+			"ин ": 22, "ина": 34, "ини": 21, "инн": 12, "ино": 8, "инт": 12, "ину": 9, "ип": 116,
+			"ип ": 17, "ипо": 34, "ипт": 9, "ипу": 33, "ир": 90, "ира": 34, "ире": 21, "ири": 9,
+			"ирі": 20, "ис": 518, "ис ": 32, "иса": 15, "иси": 11, "иск": 38, "исл": 31, "исн": 17,
+			"исо": 19, "ист": 270, "ису": 48, "ися": 13, "исі": 17, "ит": 313, "ита": 46, "ити": 184,
+			"итм": 11, "ить": 42, "иф": 101, "ифр": 24, "ифі": 76, "их": 254, "их ": 237, "ихі": 10,
+			"иц": 32, "иці": 22, "ич": 54, "ичн": 42, "иш": 65, "ишв": 11, "ише": 47, "ищ": 18,
+			"ия": 30, "ияв": 30, "й": 745, "й ": 377, "йд": 17, "йде": 14, "йл": 185, "йл ": 52,
+			"йла": 52, "йли": 20, "йло": 13, "йлі": 47, "йм": 12, "йн": 29, "йно": 12, "йо": 18,
+			"йог": 15, "йс": 21, "йсь": 13, "йт": 75, "йте": 11, "йти": 50, "йті": 8, "к": 2584,
+			"к ": 103, "ка": 561, "ка ": 194, "каз": 145, "кал": 16, "кам": 8, "кан": 33, "кат": 109,
+			"ках": 13, "кац": 11, "кає": 9, "ке": 41, "ке ": 9, "кер": 12, "кет": 12, "кеш": 8,
+			"ки": 241, "ки ": 176, "кий": 26, "ким": 16, "ких": 14, "кл": 169, "кла": 48, "кли": 18,
+			"клю": 98, "кн": 16, "кне": 8, "ко": 695, "ков": 81, "ког": 9, "код": 55, "кож": 15,
+			"кол": 16, "ком": 95, "кон": 89, "кор": 295, "кою": 23, "кр": 91, "кра": 31, "кре": 8,
+			"кри": 45, "кс": 93, "кс ": 12, "кси": 19, "ксо": 10, "ксп": 12, "кст": 20, "ксу": 13,
+			"кт": 140, "кти": 10, "ктн": 80, "кто": 14, "кту": 26, "ку": 180, "ку ": 108, "кув": 36,
+			"кун": 19, "кц": 79, "кці": 77, "кщ": 30, "кщо": 30, "кі": 143, "кі ": 28, "ків": 26,
+			"кіл": 40, "кін": 29, "кіс": 19, "л": 2412, "л ": 129, "ла": 233, "ла ": 89, "лав": 22,
+			"лад": 25, "лан": 33, "лас": 32, "лат": 12, "лг": 11, "лго": 11, "ле": 229, "ле ": 17,
+			"леж": 29, "лем": 23, "лен": 142, "ли": 235, "ли ": 57, "лив": 44, "лик": 31, "лит": 10,
+			"лиц": 26, "лиш": 50, "лк": 99, "лка": 57, "лко": 28, "ло": 360, "ло ": 94, "лов": 35,
+			"лог": 35, "лок": 27, "лом": 19, "лон": 15, "лос": 116, "лу": 91, "лу ": 50, "луж": 17,
+			"луч": 23, "ль": 257, "ль ": 15, "льк": 42, "льн": 126, "льо": 28, "льт": 17, "льш": 20,
+			"лю": 141, "люв": 15, "люд": 8, "лют": 13, "люч": 98, "ля": 340, "ля ": 290, "лян": 11,
+			"лях": 10, "ляц": 10, "лі": 284, "лі ": 38, "лів": 103, "лід": 65, "ліз": 12, "лік": 10,
+			"ліо": 17, "ліч": 17, "м": 2251, "м ": 406, "ма": 339, "ма ": 18, "мак": 10, "мал": 50,
+			"ман": 49, "мас": 11, "мат": 51, "мац": 11, "маю": 12, "має": 104, "мб": 13, "мбл": 10,
+			"мв": 105, "мво": 104, "ме": 214, "меж": 38, "мен": 84, "мер": 29, "мет": 55, "ми": 292,
+			"ми ": 168, "мил": 100, "мк": 50, "мки": 30, "мкн": 11, "мл": 28, "мле": 27, "мн": 36,
+			"мно": 21, "мо": 277, "мо ": 32, "мов": 17, "мог": 23, "мод": 24, "мож": 142, "мос": 10,
+			"мою": 20, "мп": 40, "мпо": 32, "му": 147, "му ": 108, "мув": 12, "мч": 9, "мча": 9,
+			"мі": 293, "мі ": 37, "між": 18, "мін": 57, "мір": 46, "міс": 78, "міт": 19, "міч": 13,
+			"міщ": 12, "н": 6133, "н ": 71, "на": 827, "на ": 246, "нав": 41, "над": 58, "наз": 92,
+			"най": 22, "нак": 17, "нал": 50, "нам": 17, "нан": 34, "нар": 12, "нат": 24, "нач": 181,
+			"нд": 128, "нд ": 28, "нда": 30, "нде": 28, "нди": 10, "нді": 13, "не": 866, "не ": 500,
+			"нев": 50, "нед": 12, "нез": 11, "нек": 66, "нем": 45, "нен": 80, "нео": 19, "неп": 34,
+			"нер": 19, "нес": 13, "ни": 535, "ни ": 34, "ний": 164, "ник": 37, "ним": 96, "нит": 11,
+			"них": 188, "нк": 66, "нка": 15, "нки": 22, "нкц": 11, "нн": 861, "нно": 10, "ння": 789,
+			"нні": 38, "но": 933, "но ": 357, "нов": 128, "ног": 179, "ном": 76, "нор": 27, "нос": 65,
+			"ною": 24, "ної": 53, "нс": 86, "нст": 71, "нт": 204, "нт ": 22, "нта": 82, "нте": 24,
+			"нти": 42, "нтр": 10, "нті": 11, "ну": 173, "ну ": 60, "нув": 32, "нул": 31, "нут": 33,
+			"нує": 12, "нф": 20, "нфо": 13, "нц": 18, "нш": 26, "нши": 11, "нь": 81, "нь ": 39,
+			"ньо": 41, "ню": 30, "нюв": 16, "ня": 834, "ня ": 767, "ням": 37, "нян": 17, "нят": 9,
+			"ні": 349, "ні ": 253, "ній": 24, "нім": 13, "ніс": 15, "ніш": 11, "о": 6373, "о ": 1230,
+			"об": 253, "об ": 28, "оба": 17, "оби": 24, "обк": 20, "обл": 16, "обм": 10, "обо": 31,
+			"обр": 55, "обу": 19, "обі": 14, "ов": 762, "ов ": 15, "ова": 106, "ове": 46, "овж": 12,
+			"ови": 150, "овк": 15, "овл": 62, "овн": 49, "ово": 84, "овт": 17, "ову": 94, "ові": 96,
+			"ог": 418, "ог ": 16, "ого": 328, "огр": 54, "огу": 9, "од": 293, "од ": 12, "ода": 31,
+			"одж": 21, "оди": 70, "одн": 44, "одо": 44, "оду": 48, "оді": 14, "ож": 172, "оже": 36,
+			"ожл": 40, "ожн": 89, "оз": 284, "оза": 24, "озв": 15, "озг": 11, "озд": 76, "озм": 47,
+			"озн": 15, "озп": 48, "озш": 18, "ой": 35, "ойт": 33, "ок": 150, "ок ": 52, "ока": 41,
+			"оку": 22, "ол": 233, "ол ": 39, "оли": 25, "оло": 35, "олу": 20, "оль": 27, "олю": 13,
+			"оля": 22, "олі": 45, "ом": 487, "ом ": 112, "ома": 45, "оме": 35, "оми": 107, "омл": 27,
+			"омо": 30, "омп": 32, "ому": 85, "он": 241, "он ": 10, "она": 39, "оне": 24, "онк": 10,
+			"оно": 30, "онс": 10, "онт": 31, "ону": 56, "оні": 11, "оп": 190, "опе": 121, "опи": 14,
+			"опо": 22, "опу": 13, "ор": 673, "ор ": 21, "ора": 15, "оре": 119, "ори": 248, "орм": 46,
+			"орн": 14, "оро": 66, "орт": 31, "ору": 21, "орю": 15, "оря": 16, "орі": 44, "ос": 363,
+			"осе": 13, "оси": 35, "осн": 27, "осо": 11, "ост": 130, "осу": 9, "ося": 114, "от": 158,
+			"оте": 19, "отн": 11, "ото": 39, "отр": 54, "оту": 10, "ох": 12, "оц": 23, "оце": 21,
+			"оч": 137, "оча": 29, "очи": 32, "очн": 36, "очі": 24, "ош": 30, "ошк": 11, "ошу": 13,
+			"ою": 103, "ою ": 103, "оі": 17, "оіг": 14, "ої": 84, "ої ": 82, "п": 2545, "п ": 36,
+			"па": 180, "паз": 41, "пак": 29, "пам": 25, "пар": 72, "пе": 440, "пер": 385, "пец": 20,
+			"печ": 22, "пи": 201, "пи ": 11, "пин": 10, "пис": 157, "пит": 16, "пк": 22, "пко": 14,
+			"пл": 20, "пн": 15, "пни": 9, "по": 738, "пов": 177, "под": 12, "поз": 40, "пок": 29,
+			"пол": 27, "пом": 115, "пон": 33, "поп": 39, "пор": 70, "пос": 59, "пот": 59, "поч": 30,
+			"пош": 26, "пр": 518, "пра": 56, "пре": 14, "при": 187, "про": 255, "пт": 19, "пто": 10,
+			"пу": 83, "пу ": 25, "пус": 44, "пі": 269, "під": 171, "піз": 39, "піл": 18, "піс": 26,
+			"р": 3733, "р ": 154, "ра": 469, "ра ": 58, "рав": 37, "раз": 45, "рам": 96, "ран": 87,
+			"рап": 38, "рат": 25, "рац": 39, "рв": 35, "рве": 24, "рг": 15, "ргу": 14, "ре": 780,
+			"реа": 9, "реб": 19, "рев": 53, "рег": 141, "ред": 129, "реж": 44, "рез": 31, "рек": 89,
+			"рел": 21, "рем": 12, "рен": 66, "реп": 10, "рер": 10, "рес": 92, "рет": 17, "рех": 10,
+			"ри": 677, "ри ": 95, "риб": 11, "рив": 27, "рид": 9, "риз": 51, "рий": 11, "рим": 97,
+			"рин": 10, "рип": 37, "рис": 224, "рит": 71, "риш": 11, "рк": 23, "рки": 16, "рм": 51,
+			"рма": 36, "рн": 52, "рни": 15, "рно": 15, "ро": 704, "ро ": 23, "роб": 103, "ров": 31,
+			"рог": 54, "рож": 10, "роз": 230, "рой": 35, "рок": 9, "рол": 26, "ром": 22, "рон": 18,
+			"роп": 14, "рос": 21, "рот": 20, "роц": 21, "роч": 29, "роі": 14, "рс": 40, "рсі": 24,
+			"рт": 93, "рта": 10, "рти": 28, "ртн": 18, "рто": 10, "рту": 17, "ру": 189, "ру ": 29,
+			"рув": 31, "рук": 84, "руп": 19, "рух": 14, "рх": 52, "рхі": 49, "рц": 14, "рці": 12,
+			"рш": 49, "рше": 13, "рши": 21, "рю": 16, "ря": 73, "ряд": 64, "рі": 227, "рі ": 28,
+			"ріб": 17, "рів": 122, "різ": 10, "ріш": 12, "с": 2789, "с ": 118, "са": 40, "са ": 16,
+			"сат": 9, "се": 107, "сег": 12, "сем": 11, "сер": 69, "си": 255, "си ": 19, "сил": 38,
+			"сим": 115, "син": 12, "сис": 43, "сич": 10, "ск": 114, "ска": 47, "ски": 11, "ско": 10,
+			"скр": 10, "ску": 22, "скі": 11, "сл": 161, "сла": 18, "сло": 27, "слу": 9, "сля": 29,
+			"слі": 72, "сн": 94, "сне": 16, "сни": 19, "сно": 33, "сну": 19, "со": 99, "соб": 11,
+			"сов": 29, "сок": 20, "сол": 14, "сом": 10, "сор": 10, "сп": 142, "спе": 20, "спи": 39,
+			"спо": 22, "спр": 36, "спі": 21, "ст": 1076, "ст ": 11, "ста": 258, "ств": 53, "сте": 65,
+			"сти": 132, "стк": 9, "сто": 108, "стр": 236, "сту": 97, "сть": 38, "сті": 55, "су": 210,
+			"су ": 72, "сув": 86, "сум": 20, "сх": 14, "схе": 10, "сц": 17, "сця": 9, "сь": 40,
+			"ськ": 31, "ся": 216, "ся ": 214, "сі": 73, "сі ": 17, "сів": 17, "сія": 10, "сії": 12,
+			"т": 3699, "т ": 81, "та": 568, "та ": 68, "таб": 33, "тав": 10, "таж": 37, "так": 37,
+			"тал": 50, "тан": 214, "тар": 13, "тат": 68, "тв": 77, "тве": 11, "тво": 64, "те": 198,
+			"те ": 27, "тей": 18, "тек": 59, "тем": 39, "тер": 29, "ти": 1086, "ти ": 770, "тив": 19,
+			"тик": 10, "тим": 44, "тин": 17, "тип": 61, "тис": 39, "тит": 37, "тиф": 55, "тич": 25,
+			"тк": 63, "тка": 9, "тки": 19, "тко": 23, "тм": 11, "тн": 171, "тна": 22, "тне": 9,
+			"тни": 71, "тно": 36, "тні": 22, "то": 405, "то ": 55, "тов": 138, "тог": 17, "ток": 21,
+			"том": 25, "тор": 88, "точ": 40, "тр": 439, "тр ": 66, "тра": 34, "тре": 10, "три": 129,
+			"тро": 42, "тру": 88, "трі": 70, "ту": 193, "ту ": 42, "тув": 74, "туп": 38, "тур": 25,
+			"тут": 9, "ть": 219, "ть ": 149, "тьс": 67, "тя": 12, "ті": 155, "ті ": 95, "тів": 41,
+			"у": 2251, "у ": 872, "ув": 464, "ув ": 15, "ува": 433, "уд": 39, "уде": 14, "удь": 13,
+			"уж": 21, "ужб": 9, "уже": 11, "уз": 17, "ук": 105, "укт": 13, "уку": 17, "укц": 66,
+			"ул": 95, "уло": 30, "уль": 38, "уля": 9, "улі": 10, "ум": 64, "ум ": 9, "уме": 21,
+			"умо": 17, "умі": 12, "ун": 40, "унк": 30, "уп": 73, "уп ": 15, "упи": 15, "упн": 15,
+			"упу": 18, "ур": 39, "ури": 14, "ус": 72, "уск": 20, "уст": 27, "усі": 14, "ут": 183,
+			"ут ": 11, "ути": 137, "уф": 12, "ух": 14, "ухо": 14, "уч": 27, "уче": 11, "ущ": 10,
+			"ую": 20, "уют": 17, "ує": 60, "ує ": 29, "уєм": 12, "уєт": 19, "ф": 399, "фа": 186,
+			"фай": 184, "фе": 10, "фо": 55, "фор": 45, "фр": 25, "фро": 10, "фру": 11, "фу": 11,
+			"фун": 11, "фі": 104, "фік": 77, "фіч": 13, "х": 467, "х ": 293, "хе": 12, "хем": 10,
+			"хи": 9, "хо": 47, "ход": 17, "хом": 16, "хі": 88, "хів": 37, "хід": 35, "хіт": 12,
+			"ц": 463, "це": 69, "це ": 19, "цей": 20, "цес": 20, "ци": 27, "циф": 17, "ць": 52,
+			"ць ": 14, "цьо": 34, "цю": 13, "ця": 26, "ця ": 22, "ці": 273, "ці ": 46, "ціа": 10,
+			"цій": 36, "ціл": 15, "цію": 27, "ція": 18, "ціє": 21, "ції": 94, "ч": 892, "ч ": 31,
+			"ча": 202, "ча ": 46, "час": 103, "чат": 30, "чає": 11, "че": 282, "чем": 15, "чен": 245,
+			"чер": 11, "чи": 145, "чи ": 17, "чин": 11, "чис": 34, "чит": 63, "чн": 129, "чна": 15,
+			"чни": 41, "чно": 44, "чні": 22, "чо": 10, "чу": 10, "чі": 66, "чі ": 16, "чів": 26,
+			"чік": 23, "ш": 380, "ш ": 12, "ша": 27, "ша ": 11, "шаб": 9, "шв": 13, "шви": 11,
+			"ше": 95, "ше ": 61, "шен": 32, "ши": 96, "ший": 11, "шим": 15, "шир": 31, "шиф": 21,
+			"шк": 17, "шко": 16, "шл": 11, "шля": 10, "шн": 19, "шо": 19, "шт": 16, "шу": 35,
+			"шув": 10, "шук": 16, "ші": 18, "ші ": 12, "щ": 180, "ща": 9, "ще": 39, "ще ": 17,
+			"щен": 22, "що": 118, "що ": 66, "щоб": 13, "щод": 39, "щу": 11, "ь": 669, "ь ": 241,
+			"ьк": 79, "ька": 39, "ьки": 15, "ькі": 21, "ьн": 126, "ьне": 11, "ьни": 41, "ьно": 54,
+			"ьні": 11, "ьо": 106, "ьо ": 11, "ьов": 23, "ьог": 38, "ьом": 20, "ьс": 73, "ься": 67,
+			"ьт": 17, "ьш": 20, "ьше": 14, "ю": 411, "ю ": 170, "юв": 45, "юва": 45, "ют": 61,
+			"ютн": 13, "ють": 47, "юч": 102, "юч ": 21, "юча": 18, "юче": 25, "ючі": 25, "ює": 15,
+			"я": 1769, "я ": 1340, "яв": 42, "явл": 27, "явн": 11, "яд": 69, "ядк": 50, "ядо": 13,
+			"яз": 30, "язк": 17, "як": 118, "як ": 19, "яки": 28, "яко": 12, "якщ": 30, "які": 22,
+			"ям": 50, "ям ": 33, "ями": 15, "ян": 31, "янн": 13, "ят": 52, "яти": 15, "яті": 24,
+			"ях": 13, "ях ": 11, "яц": 10, "яці": 10, "є": 403, "є ": 249, "єд": 19, "єдн": 19,
+			"єк": 13, "єкт": 13, "єм": 39, "ємо": 29, "єт": 49, "єть": 45, "єї": 17, "єї ": 17,
+			"і": 3591, "і ": 725, "іа": 72, "іаг": 13, "іал": 13, "іап": 41, "іб": 42, "ібл": 17,
+			"ібн": 11, "ів": 420, "ів ": 318, "івн": 61, "іву": 16, "іві": 11, "іг": 39, "іга": 10,
+			"ігн": 23, "ід": 538, "ід ": 142, "іда": 10, "іде": 34, "ідк": 48, "ідн": 70, "ідо": 54,
+			"ідп": 77, "ідс": 19, "ідт": 58, "іж": 22, "іж ": 12, "із": 95, "із ": 28, "іза": 9,
+			"ізн": 47, "ій": 99, "ій ": 61, "ійн": 16, "ійс": 17, "ік": 120, "іка": 67, "ікс": 10,
+			"іку": 24, "іл": 216, "іл ": 20, "іле": 10, "іли": 11, "ілу": 27, "іль": 96, "ілі": 34,
+			"ім": 42, "ім ": 20, "ін": 252, "ін ": 12, "іна": 9, "інд": 30, "іне": 14, "іни": 14,
+			"інк": 18, "інн": 11, "інс": 64, "інф": 13, "інц": 15, "інш": 16, "іо": 22, "іот": 17,
+			"ір": 113, "ір ": 38, "іри": 12, "ірк": 22, "іс": 300, "ісл": 31, "існ": 26, "іст": 225,
+			"ісц": 16, "іт": 113, "іте": 19, "ітк": 13, "іто": 35, "іть": 29, "іх": 10, "іч": 52,
+			"ічн": 38, "іш": 47, "іша": 10, "ішн": 18, "іщ": 13, "іще": 12, "ію": 40, "ію ": 35,
+			"ія": 41, "ія ": 30, "іє": 26, "ієї": 17, "ії": 120, "ії ": 120, "ї": 225, "ї ": 219,
+		}},
+	},
+}
@@ -0,0 +1,64 @@
+//go:build !cgo
+// +build !cgo
+
+package detector
+
+// Without cgo, CLD2 is not linked and NGRAM_ENGINE is the default engine.
+
+import "errors"
+
+// LanguageFromName returns name if it is the code of a language NGRAM_ENGINE detects, or
+// UNKNOWN_LANGUAGE_CODE otherwise.
+func LanguageFromName(name string) string {
+	if engine, found := engines[NGRAM_ENGINE].(*NgramEngine); found {
+		for _, code := range engine.Languages() {
+			if code == name {
+				return code
+			}
+		}
+	}
+	return UNKNOWN_LANGUAGE_CODE
+}
+
+// languageScripts returns no scripts, which CLD2 would know.
+func languageScripts(code string) []script {
+	return nil
+}
+
+// CLD2Language describes a value of CLD2's Language enum.
+type CLD2Language struct {
+	Code         string
+	Name         string
+	DeclaredName string
+	Scripts      []string
+}
+
+// CLD2Languages returns no languages, as CLD2 is not linked.
+func CLD2Languages() []CLD2Language {
+	return nil
+}
+
+// LoadModel returns an error, as CLD2 is not linked.
+func LoadModel(path string) error {
+	return errors.New("CLD2 is not available in builds without cgo, its model cannot be loaded")
+}
+
+// IsModelLoaded returns false, as CLD2 is not linked.
+func IsModelLoaded() bool {
+	return false
+}
+
+// IsModelDynamic returns false, as CLD2 is not linked.
+func IsModelDynamic() bool {
+	return false
+}
+
+// Tables returns an empty string, as CLD2 is not linked.
+func Tables() string {
+	return ""
+}
+
+// Version returns an empty string, as CLD2 is not linked.
+func Version() string {
+	return ""
+}
@@ -0,0 +1,26 @@
+//go:build !cgo
+// +build !cgo
+
+package detector
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert" // Assertion package
+)
+
+func TestNoCGO(t *testing.T) {
+	fmt.Println(">> Testing the build without cgo...")
+
+	assert.Equal(t, []string{ENSEMBLE_ENGINE, NGRAM_ENGINE}, EngineNames())
+	assert.Equal(t, NGRAM_ENGINE, DefaultEngine().Name())
+	assert.Equal(t, "ru", LanguageFromName("ru"))
+	assert.Equal(t, UNKNOWN_LANGUAGE_CODE, LanguageFromName("zh"))
+	assert.Nil(t, CLD2Languages())
+
+	err := LoadModel("cld2_data.bin")
+	assert.Equal(t, "CLD2 is not available in builds without cgo, its model cannot be loaded", err.Error())
+	assert.False(t, IsModelLoaded())
+	assert.False(t, IsModelDynamic())
+}
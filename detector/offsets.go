@@ -0,0 +1,110 @@
+package detector
+
+import (
+	"bytes"
+	"sort"
+)
+
+// offsetSpan is a run of bytes copied unchanged from the original text into the
+// preprocessed text.
+type offsetSpan struct {
+	stripped int // Offset of the run in the preprocessed text
+	original int // Offset of the run in the original text
+	length   int
+}
+
+// OffsetMap maps byte offsets in preprocessed text back to the text it was produced
+// from, so that positions reported by CLD2 can be handed back to clients.
+type OffsetMap struct {
+	spans       []offsetSpan
+	strippedLen int
+	originalLen int
+	source      *OffsetMap // Maps the original text further back, if it was preprocessed too
+}
+
+// IdentityOffsets returns an OffsetMap for text that was not changed by preprocessing.
+func IdentityOffsets(text string) OffsetMap {
+	return OffsetMap{
+		spans:       []offsetSpan{{stripped: 0, original: 0, length: len(text)}},
+		strippedLen: len(text),
+		originalLen: len(text),
+	}
+}
+
+// Original returns the offset in the original text that corresponds to offset in the
+// preprocessed text. Offsets that fall between copied runs map to the end of the
+// preceding run, so consecutive ranges in the preprocessed text map to consecutive
+// ranges covering the whole original text.
+func (m OffsetMap) Original(offset int) int {
+	original := m.original(offset)
+	if m.source != nil {
+		return m.source.Original(original)
+	}
+	return original
+}
+
+// original maps offset back through this map only.
+func (m OffsetMap) original(offset int) int {
+	if offset <= 0 {
+		return 0
+	}
+	if offset >= m.strippedLen {
+		return m.originalLen
+	}
+
+	i := sort.Search(len(m.spans), func(i int) bool { return m.spans[i].stripped > offset }) - 1
+	if i < 0 {
+		return 0
+	}
+	span := m.spans[i]
+	if offset < span.stripped+span.length {
+		return span.original + offset - span.stripped
+	}
+	return span.original + span.length
+}
+
+// StripRanges removes the given byte ranges from text, replacing each with a single
+// space so that the words around it are not joined. Ranges may overlap and need not
+// be sorted. The returned OffsetMap maps the result back to text.
+func StripRanges(text string, ranges [][]int) (string, OffsetMap) {
+	offsets := OffsetMap{originalLen: len(text)}
+	if len(ranges) == 0 {
+		return text, IdentityOffsets(text)
+	}
+
+	sorted := make([][]int, len(ranges))
+	copy(sorted, ranges)
+	sort.Sort(byStart(sorted))
+
+	var result bytes.Buffer
+	kept := 0 // Start of the next run of text to keep
+	for _, r := range sorted {
+		start, end := r[0], r[1]
+		if end <= kept {
+			continue
+		}
+		if start < kept {
+			start = kept
+		}
+		if start > kept {
+			offsets.spans = append(offsets.spans, offsetSpan{stripped: result.Len(), original: kept, length: start - kept})
+			result.WriteString(text[kept:start])
+		}
+		result.WriteByte(' ')
+		kept = end
+	}
+	if kept < len(text) {
+		offsets.spans = append(offsets.spans, offsetSpan{stripped: result.Len(), original: kept, length: len(text) - kept})
+		result.WriteString(text[kept:])
+	}
+
+	offsets.strippedLen = result.Len()
+	return result.String(), offsets
+}
+
+// byStart sorts byte ranges by their start offset.
+type byStart [][]int
+
+func (r byStart) Len() int           { return len(r) }
+func (r byStart) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
+func (r byStart) Less(i, j int) bool { return r[i][0] < r[j][0] }
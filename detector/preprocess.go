@@ -0,0 +1,147 @@
+package detector
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// DEFAULT_PREPROCESS lists the filters a Detector applies to plain text unless told otherwise.
+const DEFAULT_PREPROCESS = "code,markdown,urls,emails,mentions,hashtags"
+
+var textFilters = make(map[string]TextFilter)
+
+// TextFilter finds parts of a text that skew language detection, such as links,
+// markup or code.
+type TextFilter interface {
+	// Name is the name requests select the filter by.
+	Name() string
+	// Strip returns the byte ranges of text that should not be scored.
+	Strip(text string) [][]int
+}
+
+// RegisterTextFilter makes filter selectable by its name, replacing any filter
+// previously registered under that name.
+func RegisterTextFilter(filter TextFilter) {
+	textFilters[filter.Name()] = filter
+}
+
+// GetTextFilters returns the registered filters with the given names, in order.
+func GetTextFilters(names []string) ([]TextFilter, error) {
+	filters := make([]TextFilter, 0, len(names))
+	for _, name := range names {
+		filter, found := textFilters[strings.TrimSpace(name)]
+		if !found {
+			return nil, errors.New("Unknown preprocess filter: " + name)
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+// TextFilterNames returns the names of all registered filters, sorted.
+func TextFilterNames() []string {
+	names := make([]string, 0, len(textFilters))
+	for name := range textFilters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Preprocess runs text through filters in order. It returns the filtered text along
+// with an OffsetMap back to the text it was given.
+func Preprocess(text string, filters []TextFilter) (string, OffsetMap) {
+	offsets := IdentityOffsets(text)
+	for i, filter := range filters {
+		var filterOffsets OffsetMap
+		text, filterOffsets = StripRanges(text, filter.Strip(text))
+		if i > 0 {
+			source := offsets
+			filterOffsets.source = &source
+		}
+		offsets = filterOffsets
+	}
+	return text, offsets
+}
+
+// PatternFilter strips every match of its patterns. Patterns with capture groups
+// strip only the text of their groups, leaving the rest of the match in place.
+type PatternFilter struct {
+	name     string
+	patterns []*regexp.Regexp
+}
+
+// NewPatternFilter returns a PatternFilter with the given name and patterns.
+func NewPatternFilter(name string, patterns ...string) *PatternFilter {
+	filter := &PatternFilter{name: name}
+	for _, pattern := range patterns {
+		filter.patterns = append(filter.patterns, regexp.MustCompile(pattern))
+	}
+	return filter
+}
+
+func (f *PatternFilter) Name() string {
+	return f.name
+}
+
+func (f *PatternFilter) Strip(text string) [][]int {
+	var ranges [][]int
+	for _, pattern := range f.patterns {
+		for _, match := range pattern.FindAllStringSubmatchIndex(text, -1) {
+			if len(match) == 2 {
+				ranges = append(ranges, match)
+				continue
+			}
+			for group := 2; group < len(match); group += 2 {
+				if match[group] >= 0 {
+					ranges = append(ranges, match[group:group+2])
+				}
+			}
+		}
+	}
+	return ranges
+}
+
+// Register built-in filters
+func init() {
+	// Fenced code blocks (closed or running to the end of the text) and inline code.
+	// A single pattern, so that fences are not mistaken for inline code.
+	RegisterTextFilter(NewPatternFilter("code",
+		"(?s)```.*?(?:```|$)|~~~.*?(?:~~~|$)|`[^`\n]+`",
+	))
+
+	// Images, link targets, reference definitions, HTML comments and tags, emoji shortcodes
+	RegisterTextFilter(NewPatternFilter("markdown",
+		`!\[[^\]\n]*\]\([^)\n]*\)`,
+		`(\[)[^\]\n]*(\]\([^)\n]*\))`,
+		`(?m)^[ \t]*\[[^\]\n]+\]:[ \t]*\S+.*$`,
+		`(?s)<!--.*?(?:-->|$)`,
+		`</?[a-zA-Z][^<>]*>`,
+		`:[a-z0-9_+-]+:`,
+	))
+
+	// Links with a scheme, and bare links starting with www.
+	RegisterTextFilter(NewPatternFilter("urls",
+		`(?i)\b(?:[a-z][a-z0-9+.-]*://|www\.)\S+`,
+	))
+
+	RegisterTextFilter(NewPatternFilter("emails",
+		`[\w.+-]+@[\w-]+(?:\.[\w-]+)+`,
+	))
+
+	// @username, but not the domain of an email address
+	RegisterTextFilter(NewPatternFilter("mentions",
+		`(?:^|[^\w@])(@[\w][\w.-]*)`,
+	))
+
+	// #tag, but not HTML entities or Markdown headings
+	RegisterTextFilter(NewPatternFilter("hashtags",
+		`(?:^|[^\w&#])(#[\p{L}\p{N}_][\p{L}\p{N}_-]*)`,
+	))
+
+	RegisterTextFilter(NewPatternFilter("numbers",
+		`[+-]?\d+(?:[.,:/-]\d+)*%?`,
+	))
+}
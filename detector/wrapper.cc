@@ -0,0 +1,132 @@
+#include "cld2/public/compact_lang_det.h"
+#include "cld2/public/encodings.h"
+#include "cld2/internal/lang_script.h"
+#include "wrapper.h"
+#ifdef CLD2_DYNAMIC_MODE
+#include "cld2/internal/cld2_dynamic_data_loader.h"
+#endif
+#include <stdlib.h>
+#include <string.h>
+
+// recognized_scripts fills in the codes and names of the scripts lang is recognized in, and
+// returns how many there are. UNKNOWN_LANGUAGE and TG_UNKNOWN_LANGUAGE are never reported
+// by CLD2, so they have none.
+static int recognized_scripts(CLD2::Language lang, const char *script_codes[4], const char *script_names[4]) {
+    int count = 0;
+    if (lang == CLD2::UNKNOWN_LANGUAGE || lang == CLD2::TG_UNKNOWN_LANGUAGE) {
+        return count;
+    }
+    for (int i = 0; i < 4; i++) {
+        CLD2::ULScript script = CLD2::LanguageRecognizedScript(lang, i);
+        if (script == CLD2::ULScript_Common) {
+            break;
+        }
+        script_codes[count] = CLD2::ULScriptCode(script);
+        if (script_names != NULL) {
+            script_names[count] = CLD2::ULScriptName(script);
+        }
+        count++;
+    }
+    return count;
+}
+
+extern "C" {
+    const char* detect_language_summary(const char *text, int length, const detection_hints *hints,
+                                        int is_plain_text, int with_chunks, language_summary *summary) {
+        bool isPlainText = is_plain_text;
+        bool isReliable = true;
+        int textBytes = 0;
+        int validPrefixBytes = 0;
+        CLD2::CLDHints cldHints = {
+            hints->content_language_hint,
+            hints->tld_hint,
+            hints->encoding_hint,
+            CLD2::UNKNOWN_LANGUAGE
+        };
+        // Left untouched by CLD2 when the text is not valid UTF-8
+        CLD2::Language language3[3] = {CLD2::UNKNOWN_LANGUAGE, CLD2::UNKNOWN_LANGUAGE, CLD2::UNKNOWN_LANGUAGE};
+        CLD2::ResultChunkVector resultChunks;
+        CLD2::Language lang;
+
+        if (hints->language_hint != NULL) {
+            cldHints.language_hint = CLD2::GetLanguageFromName(hints->language_hint);
+        }
+
+        memset(summary->percents, 0, sizeof(summary->percents));
+        memset(summary->normalized_scores, 0, sizeof(summary->normalized_scores));
+
+        lang = CLD2::ExtDetectLanguageSummaryCheckUTF8(text, length, isPlainText, &cldHints, 0,
+                                                       language3, summary->percents, summary->normalized_scores,
+                                                       with_chunks ? &resultChunks : NULL, &textBytes, &isReliable,
+                                                       &validPrefixBytes);
+
+        summary->text_bytes = textBytes;
+        summary->is_reliable = isReliable;
+        summary->valid_prefix_bytes = validPrefixBytes;
+        for (int i = 0; i < 3; i++) {
+            summary->codes[i] = CLD2::LanguageCode(language3[i]);
+        }
+
+        summary->chunks = NULL;
+        summary->num_chunks = 0;
+        if (with_chunks && !resultChunks.empty()) {
+            summary->chunks = (result_chunk*)malloc(resultChunks.size() * sizeof(result_chunk));
+            for (size_t i = 0; i < resultChunks.size(); i++) {
+                summary->chunks[i].offset = resultChunks[i].offset;
+                summary->chunks[i].bytes = resultChunks[i].bytes;
+                summary->chunks[i].code = CLD2::LanguageCode(static_cast<CLD2::Language>(resultChunks[i].lang1));
+            }
+            summary->num_chunks = resultChunks.size();
+        }
+
+        return CLD2::LanguageCode(lang);
+    }
+
+    const char* language_from_name(const char *name) {
+        return CLD2::LanguageCode(CLD2::GetLanguageFromName(name));
+    }
+
+    int language_scripts(const char *code, const char *script_codes[4], const char *script_names[4]) {
+        return recognized_scripts(CLD2::GetLanguageFromName(code), script_codes, script_names);
+    }
+
+    int num_languages() {
+        return CLD2::NUM_LANGUAGES;
+    }
+
+    void get_language_info(int lang, language_info *info) {
+        CLD2::Language language = static_cast<CLD2::Language>(lang);
+        info->code = CLD2::LanguageCode(language);
+        info->name = CLD2::LanguageName(language);
+        info->declared_name = CLD2::LanguageDeclaredName(language);
+        info->num_scripts = recognized_scripts(language, info->script_codes, NULL);
+    }
+
+    // load_data replaces the scoring tables with the dynamic data at data, which must stay
+    // allocated while it is loaded. The current tables are kept if data is invalid.
+    int load_data(const void *data, unsigned int length) {
+#ifdef CLD2_DYNAMIC_MODE
+        CLD2::ScoringTables* tables = CLD2DynamicDataLoader::loadDataRaw(data, length);
+        if (tables == NULL) {
+            return 0;
+        }
+        CLD2DynamicDataLoader::unloadDataRaw(&tables);
+        CLD2::loadDataFromRawAddress(data, length);
+        return CLD2::isDataLoaded();
+#else
+        return 0;
+#endif
+    }
+
+    int is_data_loaded() {
+        return CLD2::isDataLoaded();
+    }
+
+    int is_data_dynamic() {
+        return CLD2::isDataDynamic();
+    }
+
+    const char* detect_language_version() {
+        return CLD2::DetectLanguageVersion();
+    }
+}
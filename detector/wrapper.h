@@ -0,0 +1,55 @@
+#ifndef __WRAPPER_H
+#define __WRAPPER_H
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+typedef struct {
+    int offset;
+    int bytes;
+    const char* code;
+} result_chunk;
+
+typedef struct {
+    const char* codes[3];
+    int percents[3];
+    double normalized_scores[3];
+    int text_bytes;
+    int is_reliable;
+    int valid_prefix_bytes;  // less than the text's length when it is not valid UTF-8
+    result_chunk* chunks;  // malloc'd when requested, to be freed by the caller
+    int num_chunks;
+} language_summary;
+
+typedef struct {
+    const char* content_language_hint;
+    const char* tld_hint;
+    int encoding_hint;
+    const char* language_hint;
+} detection_hints;
+
+const char* detect_language_summary(const char *text, int length, const detection_hints *hints,
+                                    int is_plain_text, int with_chunks, language_summary *summary);
+typedef struct {
+    const char* code;
+    const char* name;
+    const char* declared_name;
+    const char* script_codes[4];
+    int num_scripts;
+} language_info;
+
+const char* language_from_name(const char *name);
+int language_scripts(const char *code, const char *script_codes[4], const char *script_names[4]);
+int num_languages();
+void get_language_info(int lang, language_info *info);
+
+int load_data(const void *data, unsigned int length);
+int is_data_loaded();
+int is_data_dynamic();
+const char* detect_language_version();
+
+#ifdef __cplusplus
+}
+#endif
+#endif
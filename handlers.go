@@ -1,16 +1,24 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
+	"context"
 	"errors"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
-	rj "github.com/bottlenose-inc/rapidjson" // faster json handling
+	"github.com/GolosChain/language-detector/detector" // CLD2 language detection
+	rj "github.com/bottlenose-inc/rapidjson"           // faster json handling
 )
 
+// ErrLineTooLong is returned by ReadLine for lines longer than its limit.
+var ErrLineTooLong = errors.New("Line too long")
+
 // SendErrorResponse sends a response with the provided error message and status code.
 func SendErrorResponse(w http.ResponseWriter, message string, status int) {
 	errorsCounter.Inc()
@@ -27,11 +35,32 @@ func SendErrorResponse(w http.ResponseWriter, message string, status int) {
 	}
 }
 
+// bodyLimitKey is the request context key of the route's body limit.
+type bodyLimitKey struct{}
+
+// WithBodyLimit sets the body limit of the requests handler receives, overriding
+// BODY_LIMIT_BYTES for its route.
+func WithBodyLimit(limit int, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		handler(w, r.WithContext(context.WithValue(r.Context(), bodyLimitKey{}, limit)))
+	}
+}
+
+// BodyLimit returns the body limit of r's route, in bytes.
+func BodyLimit(r *http.Request) int {
+	if limit, ok := r.Context().Value(bodyLimitKey{}).(int); ok {
+		return limit
+	}
+	return BODY_LIMIT_BYTES
+}
+
 // GetRequests is a generic function that parses properly formatted requests to an augmentation.
 // It ensures the correct Content-Type header is provided and ensures the request is properly
-// formatted. Requests are also truncated to BODY_LIMIT_BYTES to avoid huge requests causing problems.
+// formatted. Requests larger than the route's body limit are rejected to avoid huge requests
+// causing problems.
 func GetRequests(w http.ResponseWriter, r *http.Request) (*rj.Doc, error) {
 	var emptyMap *rj.Doc
+	limit := BodyLimit(r)
 
 	// Send error response if incorrect Content-Type is provided
 	if r.Header.Get("Content-Type") != "application/json" {
@@ -41,12 +70,23 @@ func GetRequests(w http.ResponseWriter, r *http.Request) (*rj.Doc, error) {
 		return emptyMap, errors.New("Content-Type must be set to application/json")
 	}
 
-	// Read body up to size of BODY_LIMIT_BYTES
-	body, err := ioutil.ReadAll(io.LimitReader(r.Body, BODY_LIMIT_BYTES))
-	if err != nil {
-		logger.Error("Error reading request body: " + err.Error())
-		SendErrorResponse(w, "Error reading request body", http.StatusInternalServerError)
-		return emptyMap, err
+	// Read body up to one byte past the limit, to tell whether it was exceeded
+	var body []byte
+	var err error
+	if r.ContentLength <= int64(limit) {
+		body, err = ioutil.ReadAll(io.LimitReader(r.Body, int64(limit)+1))
+		if err != nil {
+			logger.Error("Error reading request body: " + err.Error())
+			SendErrorResponse(w, "Error reading request body", http.StatusInternalServerError)
+			return emptyMap, err
+		}
+	}
+	if r.ContentLength > int64(limit) || len(body) > limit {
+		oversizeRequestsCounter.Inc()
+		message := "Request body exceeds the limit of " + strconv.Itoa(limit) + " bytes"
+		logger.Warning("Client request was too large", map[string]string{"limit": strconv.Itoa(limit)}, map[string]string{"Content-Length": strconv.FormatInt(r.ContentLength, 10)})
+		SendErrorResponse(w, message, http.StatusRequestEntityTooLarge)
+		return emptyMap, errors.New(message)
 	}
 	if err := r.Body.Close(); err != nil {
 		logger.Error("Error closing body: " + err.Error())
@@ -101,8 +141,545 @@ func Usage(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// detect language
+// Health sends the health check response, which reports whether CLD2 has a model to
+// detect languages with. It is answered with 503 Service Unavailable when CLD2 was built
+// with CLD2_DYNAMIC_MODE and has none.
+func Health(w http.ResponseWriter, r *http.Request) {
+	healthJson := rj.NewDoc()
+	defer healthJson.Free()
+	healthCt := healthJson.GetContainerNewObj()
+	status := http.StatusOK
+	dataLoaded, dataDynamic := detector.IsModelLoaded(), detector.IsModelDynamic()
+	if dataDynamic && !dataLoaded {
+		status = http.StatusServiceUnavailable
+		healthCt.AddValue("status", "unavailable")
+	} else {
+		healthCt.AddValue("status", "ok")
+	}
+	healthCt.AddValue("data_loaded", dataLoaded)
+	healthCt.AddValue("data_dynamic", dataDynamic)
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_, err := w.Write(healthJson.Bytes())
+	if err != nil {
+		logger.Error("Error writing health response: " + err.Error())
+	}
+}
+
+// Info sends the default and available engines, along with the CLD2 scoring table
+// variant in use and their version, as returned by DetectLanguageVersion.
+func Info(w http.ResponseWriter, r *http.Request) {
+	infoJson := rj.NewDoc()
+	defer infoJson.Free()
+	infoCt := infoJson.GetContainerNewObj()
+	infoCt.AddValue("engine", languageDetector.Engine.Name())
+	enginesCt := infoJson.NewContainerArray()
+	for _, name := range detector.EngineNames() {
+		if err := enginesCt.ArrayAppend(name); err != nil {
+			logger.Error("Error adding engine to info response: " + err.Error())
+		}
+	}
+	infoCt.AddMember("engines", enginesCt)
+	infoCt.AddValue("tables", Tables())
+	infoCt.AddValue("version", detector.Version())
+	infoCt.AddValue("data_dynamic", detector.IsModelDynamic())
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, err := w.Write(infoJson.Bytes())
+	if err != nil {
+		logger.Error("Error writing info response: " + err.Error())
+	}
+}
+
+// GetOptionalString returns the string value of request's key member. Missing and null
+// members are returned as an empty string, any other non-string value is an error.
+func GetOptionalString(request *rj.Container, key string) (string, error) {
+	member, err := request.GetMember(key)
+	if err != nil || member.GetType() == rj.TypeNull {
+		return "", nil
+	}
+	value, err := member.GetString()
+	if err != nil {
+		return "", errors.New(key + " must be a string")
+	}
+	return value, nil
+}
+
+// GetOptionalBool returns the boolean value of request's key member. Missing and null
+// members are returned as false, any other non-boolean value is an error.
+func GetOptionalBool(request *rj.Container, key string) (bool, error) {
+	member, err := request.GetMember(key)
+	if err != nil || member.GetType() == rj.TypeNull {
+		return false, nil
+	}
+	value, err := member.GetBool()
+	if err != nil {
+		return false, errors.New(key + " must be a boolean")
+	}
+	return value, nil
+}
+
+// GetOptionalInt returns the integer value of request's key member. Missing and null
+// members are returned as 0, any other non-integer value is an error.
+func GetOptionalInt(request *rj.Container, key string) (int, error) {
+	member, err := request.GetMember(key)
+	if err != nil || member.GetType() == rj.TypeNull {
+		return 0, nil
+	}
+	value, err := member.GetInt()
+	if err != nil || member.GetType() != rj.TypeNumber {
+		return 0, errors.New(key + " must be an integer")
+	}
+	return value, nil
+}
+
+// GetOptionalStringArray returns the string values of request's key member, and whether
+// it was set at all. Missing and null members are reported as not set, any value other
+// than an array of strings is an error.
+func GetOptionalStringArray(request *rj.Container, key string) ([]string, bool, error) {
+	member, err := request.GetMember(key)
+	if err != nil || member.GetType() == rj.TypeNull {
+		return nil, false, nil
+	}
+	if member.GetType() != rj.TypeArray {
+		return nil, true, errors.New(key + " must be an array of strings")
+	}
+	items, _, err := member.GetArray()
+	if err != nil {
+		return nil, true, errors.New(key + " must be an array of strings")
+	}
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		value, err := item.GetString()
+		if err != nil {
+			return nil, true, errors.New(key + " must be an array of strings")
+		}
+		values = append(values, value)
+	}
+	return values, true, nil
+}
+
+// GetHints reads the optional detection hints of a request object. They are validated
+// during detection.
+func GetHints(request *rj.Container) (detector.Hints, error) {
+	var hints detector.Hints
+	var err error
+
+	if hints.TLD, err = GetOptionalString(request, "tld_hint"); err != nil {
+		return hints, err
+	}
+	if hints.ContentLanguage, err = GetOptionalString(request, "content_language_hint"); err != nil {
+		return hints, err
+	}
+	if hints.Language, err = GetOptionalString(request, "language_hint"); err != nil {
+		return hints, err
+	}
+	if hints.Encoding, err = GetOptionalString(request, "encoding_hint"); err != nil {
+		return hints, err
+	}
+
+	return hints, nil
+}
+
+// GetThreshold reads a minimum length from request's key member as a detector.Options
+// threshold. Missing thresholds keep the server default, and 0 disables it.
+func GetThreshold(request *rj.Container, key string) (int, error) {
+	member, err := request.GetMember(key)
+	if err != nil || member.GetType() == rj.TypeNull {
+		return 0, nil
+	}
+	value, err := GetOptionalInt(request, key)
+	if err != nil || value < 0 {
+		return 0, errors.New(key + " must be a non-negative integer")
+	}
+	if value == 0 {
+		return -1, nil
+	}
+	return value, nil
+}
+
+// GetOptions reads the optional detection settings of a request object.
+func GetOptions(request *rj.Container) (detector.Options, error) {
+	var options detector.Options
+	var err error
+
+	if options.Hints, err = GetHints(request); err != nil {
+		return options, err
+	}
+	if options.IsHTML, err = GetOptionalBool(request, "is_html"); err != nil {
+		return options, err
+	}
+	if options.Segments, err = GetOptionalBool(request, "segments"); err != nil {
+		return options, err
+	}
+	if options.Encoding, err = GetOptionalString(request, "encoding"); err != nil {
+		return options, err
+	}
+	if options.MinLetters, err = GetThreshold(request, "min_letters"); err != nil {
+		return options, err
+	}
+	if options.MinTextBytes, err = GetThreshold(request, "min_text_bytes"); err != nil {
+		return options, err
+	}
+
+	if options.MinAllowedPercent, err = GetThreshold(request, "min_allowed_percent"); err != nil {
+		return options, err
+	}
+
+	// Without an allowed_languages key, the server default applies
+	allowedLanguages, found, err := GetOptionalStringArray(request, "allowed_languages")
+	if err != nil {
+		return options, err
+	}
+	if found {
+		options.AllowedLanguages = allowedLanguages
+	}
+
+	// Without an engine key, the detector's default engine is used
+	engineName, err := GetOptionalString(request, "engine")
+	if err != nil {
+		return options, err
+	}
+	if engineName != "" {
+		if options.Engine, err = detector.GetEngine(engineName); err != nil {
+			return options, err
+		}
+	}
+
+	// Without a preprocess key, the detector picks the default filters
+	filterNames, found, err := GetOptionalStringArray(request, "preprocess")
+	if err != nil {
+		return options, err
+	}
+	if found {
+		options.Filters, err = detector.GetTextFilters(filterNames)
+	}
+
+	return options, err
+}
+
+// GetDisplayLocale returns the locale of LanguageNames matching the request's
+// display_locale, trying it as is, lowercased and without its region, or an empty string
+// if there is none. An error is returned for locales without localized names.
+func GetDisplayLocale(request *rj.Container) (string, error) {
+	tag, err := GetOptionalString(request, "display_locale")
+	if err != nil || tag == "" {
+		return "", err
+	}
+	lower := strings.ToLower(tag)
+	primary := lower
+	if i := strings.IndexAny(lower, "-_"); i > 0 {
+		primary = lower[:i]
+	}
+	for _, locale := range []string{tag, lower, primary} {
+		if _, found := LanguageNames.Names[locale]; found {
+			return locale, nil
+		}
+	}
+	return "", errors.New("Unknown display locale: " + tag)
+}
+
+// AddDisplayNames adds the name of language code in displayLocale, and its native name,
+// to ct, if a display locale was requested. Languages without localized names keep name.
+func AddDisplayNames(ct *rj.Container, code string, name string, displayLocale string) {
+	if displayLocale == "" {
+		return
+	}
+	displayName, found := LanguageNames.Names[displayLocale][code]
+	if !found {
+		displayName = name
+	}
+	nativeName, found := LanguageNames.Endonyms[code]
+	if !found {
+		nativeName = name
+	}
+	ct.AddValue("display_name", displayName)
+	ct.AddValue("native_name", nativeName)
+}
+
+// AddLanguageCodes adds the ISO 639-2, ISO 639-3 and BCP 47 codes of language code, and
+// the ISO 15924 code of the script it was found in, to ct. Undetermined and unknown
+// languages get "und" and "Zzzz". The BCP 47 tag has a script subtag when the language
+// was not found in its main script, e.g. "sr-Cyrl".
+func AddLanguageCodes(ct *rj.Container, code string, script string) {
+	info, found := LanguageTable[code]
+	if !found {
+		info = LanguageInfo{ISO6392: UNDETERMINED_ISO_CODE, ISO6393: UNDETERMINED_ISO_CODE, BCP47: UNDETERMINED_ISO_CODE}
+	}
+	bcp47 := info.BCP47
+	if script == "" {
+		script = UNKNOWN_SCRIPT_CODE
+	} else if found && script != info.Script {
+		bcp47 += "-" + script
+	}
+	ct.AddValue("iso6392", info.ISO6392)
+	ct.AddValue("iso6393", info.ISO6393)
+	ct.AddValue("bcp47", bcp47)
+	ct.AddValue("script", script)
+}
+
+// GetText returns the text of a request object. It must be a string of at most
+// MAX_TEXT_BYTES bytes, with something other than whitespace in it. Otherwise an error
+// is returned along with the item status it calls for.
+func GetText(request *rj.Container) (string, string, error) {
+	text, err := request.GetMember("text")
+	if err != nil {
+		return "", STATUS_MISSING_TEXT, errors.New("Missing text key")
+	}
+	if text.GetType() == rj.TypeNull {
+		return "", STATUS_MISSING_TEXT, errors.New("text must not be null")
+	}
+
+	textStr, err := text.GetString()
+	if err != nil || text.GetType() != rj.TypeString {
+		return "", STATUS_INVALID_TEXT, errors.New("text must be a string")
+	}
+	if textStr == "" {
+		return "", STATUS_INVALID_TEXT, errors.New("text must not be empty")
+	}
+	if len(textStr) > MAX_TEXT_BYTES {
+		return "", STATUS_INVALID_TEXT, errors.New("text exceeds the limit of " + strconv.Itoa(MAX_TEXT_BYTES) + " bytes")
+	}
+	if strings.TrimSpace(textStr) == "" {
+		return "", STATUS_INVALID_TEXT, errors.New("text must not be only whitespace")
+	}
+
+	return textStr, STATUS_OK, nil
+}
+
+// CopyPassthrough copies the id and meta members of request, if any, verbatim to
+// response so that clients can match responses to their requests. IDs must be strings
+// or numbers, and meta must be an object.
+func CopyPassthrough(request *rj.Container, response *rj.Container) error {
+	id, err := request.GetMember("id")
+	if err == nil && id.GetType() != rj.TypeNull {
+		if id.GetType() != rj.TypeString && id.GetType() != rj.TypeNumber {
+			return errors.New("id must be a string or a number")
+		}
+		response.AddMember("id", id)
+	}
+
+	meta, err := request.GetMember("meta")
+	if err == nil && meta.GetType() != rj.TypeNull {
+		if meta.GetType() != rj.TypeObject {
+			return errors.New("meta must be an object")
+		}
+		response.AddMember("meta", meta)
+	}
+
+	return nil
+}
+
+// ReadLine reads the next line from reader, without its line ending. Lines longer
+// than limit bytes are skipped and reported with ErrLineTooLong. io.EOF is returned
+// once there are no lines left.
+func ReadLine(reader *bufio.Reader, limit int) ([]byte, error) {
+	var line []byte
+	tooLong := false
+	for {
+		chunk, err := reader.ReadSlice('\n')
+		if !tooLong {
+			line = append(line, chunk...)
+			if len(bytes.TrimRight(line, "\r\n")) > limit {
+				tooLong, line = true, nil
+			}
+		}
+		if err == bufio.ErrBufferFull {
+			continue
+		}
+		if err == io.EOF && (len(line) > 0 || tooLong) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		break
+	}
+
+	if tooLong {
+		return nil, ErrLineTooLong
+	}
+	return bytes.TrimRight(line, "\r\n"), nil
+}
+
+// SetItemError marks response as failed with status and message, and returns status.
+func SetItemError(response *rj.Container, status string, message string) string {
+	incUnsuccessfulCounter()
+	response.AddValue("status", status)
+	response.AddValue("error", message)
+	return status
+}
+
+// DetectRequest detects the language of a single request object and fills response,
+// created from doc, with the result or a per-item error. The item's status is set on
+// response and returned. An error is only returned if the response could not be built.
+func DetectRequest(ctx context.Context, doc *rj.Doc, request *rj.Container, response *rj.Container) (string, error) {
+	if err := CopyPassthrough(request, response); err != nil {
+		return SetItemError(response, STATUS_INVALID_REQUEST, err.Error()), nil
+	}
+
+	textStr, status, err := GetText(request)
+	if err != nil {
+		return SetItemError(response, status, err.Error()), nil
+	}
+
+	options, err := GetOptions(request)
+	if err != nil {
+		return SetItemError(response, STATUS_INVALID_REQUEST, err.Error()), nil
+	}
+
+	displayLocale, err := GetDisplayLocale(request)
+	if err != nil {
+		return SetItemError(response, STATUS_INVALID_REQUEST, err.Error()), nil
+	}
+
+	result, err := languageDetector.Detect(ctx, textStr, options)
+	if err != nil {
+		// Let clients know where their text stops being usable
+		if invalidErr, ok := err.(*detector.InvalidUTF8Error); ok {
+			invalidUTF8Counter.Inc()
+			response.AddValue("valid_prefix_bytes", invalidErr.ValidPrefixBytes)
+			return SetItemError(response, STATUS_INVALID_TEXT, err.Error()), nil
+		}
+		return SetItemError(response, STATUS_INVALID_REQUEST, err.Error()), nil
+	}
+
+	status = STATUS_OK
+	name, found := KnownLanguages[result.Code]
+
+	if result.TooShort {
+		name = UNDETERMINED_NAME
+		status = STATUS_TOO_SHORT
+		shortTextsCounter.Inc()
+	} else if result.Code == detector.UNDETERMINED_LANGUAGE_CODE {
+		// None of the allowed languages were found
+		name = UNDETERMINED_NAME
+		status = STATUS_UNKNOWN_LANGUAGE
+	} else if !found {
+		name = "Unknown"
+		status = STATUS_UNKNOWN_LANGUAGE
+		if result.Code != detector.UNKNOWN_LANGUAGE_CODE {
+			logger.Warning("Unknown response language code: " + result.Code)
+		}
+	}
+
+	response.AddValue("status", status)
+	response.AddValue("iso6391code", result.Code)
+	response.AddValue("name", name)
+	AddDisplayNames(response, result.Code, name, displayLocale)
+	AddLanguageCodes(response, result.Code, result.Script)
+	response.AddValue("reliable", result.Reliable)
+	response.AddValue("text_bytes", result.TextBytes)
+	if result.Encoding != "" {
+		response.AddValue("encoding", result.Encoding)
+	}
+
+	// Add up to three languages found in the text, with their share of it
+	languagesArray := doc.NewContainerArray()
+	for _, language := range result.Languages {
+		languageName, found := KnownLanguages[language.Code]
+		if !found {
+			languageName = "Unknown"
+		}
+		languageCt := doc.NewContainerObj()
+		languageCt.AddValue("iso6391code", language.Code)
+		languageCt.AddValue("name", languageName)
+		AddDisplayNames(languageCt, language.Code, languageName, displayLocale)
+		AddLanguageCodes(languageCt, language.Code, language.Script)
+		languageCt.AddValue("percent", language.Percent)
+		languageCt.AddValue("normalized_score", language.NormalizedScore)
+		err = languagesArray.ArrayAppendContainer(languageCt)
+		if err != nil {
+			incUnsuccessfulCounter()
+			return "", err
+		}
+	}
+	response.AddMember("languages", languagesArray)
+
+	// Add the language of each span of the text
+	if options.Segments {
+		segmentsArray := doc.NewContainerArray()
+		for _, segment := range result.Segments {
+			segmentName, found := KnownLanguages[segment.Code]
+			if !found {
+				segmentName = "Unknown"
+			}
+			segmentCt := doc.NewContainerObj()
+			segmentCt.AddValue("offset", segment.Offset)
+			segmentCt.AddValue("length", segment.Length)
+			segmentCt.AddValue("iso6391code", segment.Code)
+			segmentCt.AddValue("name", segmentName)
+			AddDisplayNames(segmentCt, segment.Code, segmentName, displayLocale)
+			err = segmentsArray.ArrayAppendContainer(segmentCt)
+			if err != nil {
+				incUnsuccessfulCounter()
+				return "", err
+			}
+		}
+		response.AddMember("segments", segmentsArray)
+	}
+
+	// Add what each engine found, when detecting with an ensemble
+	if len(result.Votes) > 0 {
+		votesArray := doc.NewContainerArray()
+		for _, vote := range result.Votes {
+			voteCt := doc.NewContainerObj()
+			voteCt.AddValue("engine", vote.Engine)
+			voteCt.AddValue("weight", vote.Weight)
+			voteCt.AddValue("iso6391code", vote.Code)
+			voteCt.AddValue("reliable", vote.Reliable)
+			voteLanguagesArray := doc.NewContainerArray()
+			for _, language := range vote.Languages {
+				languageCt := doc.NewContainerObj()
+				languageCt.AddValue("iso6391code", language.Code)
+				languageCt.AddValue("percent", language.Percent)
+				languageCt.AddValue("normalized_score", language.NormalizedScore)
+				err = voteLanguagesArray.ArrayAppendContainer(languageCt)
+				if err != nil {
+					incUnsuccessfulCounter()
+					return "", err
+				}
+			}
+			voteCt.AddMember("languages", voteLanguagesArray)
+			err = votesArray.ArrayAppendContainer(voteCt)
+			if err != nil {
+				incUnsuccessfulCounter()
+				return "", err
+			}
+		}
+		debugCt := doc.NewContainerObj()
+		debugCt.AddMember("votes", votesArray)
+		response.AddMember("debug", debugCt)
+	}
+
+	incLanguageCount(name)
+	incReliabilityCount(result.Reliable)
+
+	// Call logProcessed for every object that gets processed
+	incSuccessfulCounter()
+	logProcessed()
+
+	return status, nil
+}
+
+// detect language. Every item of the batch gets its own status, while the response is
+// sent with 200 OK. With the multi_status query parameter set, batches with items that
+// are not STATUS_OK are sent with 207 Multi-Status instead.
 func LanguageDetectorHandler(w http.ResponseWriter, r *http.Request) {
+	multiStatus := false
+	if r.URL.Query().Get("multi_status") != "" {
+		var err error
+		if multiStatus, err = strconv.ParseBool(r.URL.Query().Get("multi_status")); err != nil {
+			invalidRequestsCounter.Inc()
+			incUnsuccessfulCounter()
+			logger.Warning("Client multi_status was not a boolean: " + err.Error())
+			SendErrorResponse(w, "multi_status must be a boolean", http.StatusBadRequest)
+			return
+		}
+	}
+
 	requestJson, err := GetRequests(w, r)
 	if err != nil {
 		incUnsuccessfulCounter()
@@ -111,6 +688,9 @@ func LanguageDetectorHandler(w http.ResponseWriter, r *http.Request) {
 	defer requestJson.Free()
 	requestCt := requestJson.GetContainer()
 	if requestCt.GetType() == rj.TypeNull {
+		invalidRequestsCounter.Inc()
+		logger.Warning("Client request was null")
+		SendErrorResponse(w, "Unable to parse request - invalid JSON detected", http.StatusBadRequest)
 		return
 	}
 	requestsCt, err := requestCt.GetMember("request")
@@ -120,7 +700,15 @@ func LanguageDetectorHandler(w http.ResponseWriter, r *http.Request) {
 		SendErrorResponse(w, "Unable to parse request - invalid JSON detected", http.StatusBadRequest)
 		return
 	}
+
+	// Items get their own status, but the batch itself must be an array of them
 	requests, _, err := requestsCt.GetArray()
+	if err != nil {
+		invalidRequestsCounter.Inc()
+		logger.Warning("Client request was not an array: " + err.Error())
+		SendErrorResponse(w, "request must be an array", http.StatusBadRequest)
+		return
+	}
 
 	respCode := http.StatusOK
 	responses := rj.NewDoc()
@@ -131,37 +719,15 @@ func LanguageDetectorHandler(w http.ResponseWriter, r *http.Request) {
 	responsesArray, _ = responsesCt.GetMember("response")
 	for _, request := range requests {
 		response := responses.NewContainerObj()
-		text, err := request.GetMember("text")
-
+		status, err := DetectRequest(r.Context(), responses, request, response)
 		if err != nil {
-			incUnsuccessfulCounter()
-			response.AddValue("error", "Missing text key")
-			respCode = http.StatusBadRequest
-			err = responsesArray.ArrayAppendContainer(response)
-			if err != nil {
-				SendErrorResponse(w, err.Error(), http.StatusInternalServerError)
-				return
-			}
-			continue
+			SendErrorResponse(w, err.Error(), http.StatusInternalServerError)
+			return
 		}
-
-		textStr, err := text.GetString()
-		textStr = StripExtras(textStr)
-
-		code := Detect_language(textStr)
-		name, found := KnownLanguages[code]
-
-		if !found {
-			name = "Unknown"
-			respCode = http.StatusNonAuthoritativeInfo
-			logger.Warning("Unknown response language code: " + code)
+		if status != STATUS_OK && multiStatus {
+			respCode = http.StatusMultiStatus
 		}
 
-		response.AddValue("iso6391code", code)
-		response.AddValue("name", name)
-
-		incLanguageCount(name)
-
 		// Append newly generated response to responses
 		err = responsesArray.ArrayAppendContainer(response)
 		if err != nil {
@@ -169,10 +735,6 @@ func LanguageDetectorHandler(w http.ResponseWriter, r *http.Request) {
 			SendErrorResponse(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
-
-		// Call logProcessed for every object that gets processed
-		incSuccessfulCounter()
-		logProcessed()
 	}
 
 	// Send response
@@ -185,26 +747,83 @@ func LanguageDetectorHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func HasPrefix(word string, prefixes []string) bool {
-	for _, prefix := range prefixes {
-		if strings.HasPrefix(word, prefix) {
-			return true
+// StreamHandler detects the language of newline delimited JSON request objects, one per
+// line, and streams back a response object per line as each one is processed. There is
+// no limit on the size of the body, only on each line.
+func StreamHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Content-Type") != "application/x-ndjson" {
+		invalidRequestsCounter.Inc()
+		logger.Warning("Client request did not set Content-Type header to application/x-ndjson", map[string]string{"Content-Type": r.Header.Get("Content-Type")})
+		SendErrorResponse(w, "Content-Type must be set to application/x-ndjson", http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	// HTTP/1.x servers close the request body once the response is flushed, unless told
+	// to keep reading it. HTTP/2 always does, and reports that this is not supported.
+	_ = http.NewResponseController(w).EnableFullDuplex()
+
+	w.Header().Set("Content-Type", "application/x-ndjson; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	flusher, _ := w.(http.Flusher)
+
+	limit := BodyLimit(r)
+	reader := bufio.NewReader(r.Body)
+	for r.Context().Err() == nil {
+		line, err := ReadLine(reader, limit)
+		if err == io.EOF {
+			return
+		}
+		if err != nil && err != ErrLineTooLong {
+			// Let the client know the stream ends early, rather than cutting it silently
+			logger.Error("Error reading request body: " + err.Error())
+		} else if err == nil && len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+
+		responseJson := StreamResponse(r.Context(), line, err, limit)
+		if _, writeErr := w.Write(append(responseJson, '\n')); writeErr != nil {
+			logger.Error("Error writing stream response: " + writeErr.Error())
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		if err != nil && err != ErrLineTooLong {
+			return
 		}
 	}
-	return false
 }
 
-// remove mentions and links from text, as these can skew detection
-func StripExtras(text string) string {
-	var result string
+// StreamResponse builds the response line for a single line of a stream, given the
+// error reading it, if any, and the limit on lines. Errors other than ErrLineTooLong
+// end the stream, and their line reports them.
+func StreamResponse(ctx context.Context, line []byte, readErr error, limit int) []byte {
+	responseJson := rj.NewDoc()
+	defer responseJson.Free()
+	response := responseJson.GetContainerNewObj()
 
-	prefixes := []string{"@", "http"}
+	if readErr == ErrLineTooLong {
+		oversizeRequestsCounter.Inc()
+		SetItemError(response, STATUS_INVALID_REQUEST, "Line exceeds the limit of "+strconv.Itoa(limit)+" bytes")
+		return responseJson.Bytes()
+	}
+	if readErr != nil {
+		SetItemError(response, STATUS_INVALID_REQUEST, "Error reading request body: "+readErr.Error())
+		return responseJson.Bytes()
+	}
 
-	for _, word := range strings.Fields(text) {
-		if !HasPrefix(word, prefixes) {
-			result = result + word + " "
-		}
+	requestJson, err := rj.NewParsedJson(line)
+	defer requestJson.Free()
+	if err != nil || requestJson.GetContainer().GetType() != rj.TypeObject {
+		SetItemError(response, STATUS_INVALID_REQUEST, "Unable to parse line - invalid JSON object detected")
+		return responseJson.Bytes()
 	}
+	request := requestJson.GetContainer()
 
-	return result
+	if _, err = DetectRequest(ctx, responseJson, request, response); err != nil {
+		logger.Error("Error building stream response: " + err.Error())
+		response.AddValue("error", err.Error())
+	}
+	return responseJson.Bytes()
 }
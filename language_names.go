@@ -0,0 +1,2759 @@
+// Code generated by data/gen_names.go; DO NOT EDIT.
+
+package main
+
+// DefaultLanguageNames holds the localized language names of data/language_names.json.
+var DefaultLanguageNames = LanguageNamesData{
+	Version: 1,
+	Endonyms: map[string]string{
+		"af":      "Afrikaans",
+		"ak":      "Akan",
+		"am":      "አማርኛ",
+		"ar":      "العربية",
+		"as":      "অসমীয়া",
+		"az":      "Azərbaycan",
+		"be":      "Беларуская",
+		"bg":      "Български",
+		"bn":      "বাংলা",
+		"bo":      "བོད་སྐད་",
+		"br":      "Brezhoneg",
+		"bs":      "Bosanski",
+		"ca":      "Català",
+		"chr":     "ᏣᎳᎩ",
+		"cs":      "Čeština",
+		"cy":      "Cymraeg",
+		"da":      "Dansk",
+		"de":      "Deutsch",
+		"dz":      "རྫོང་ཁ",
+		"ee":      "Eʋegbe",
+		"el":      "Ελληνικά",
+		"en":      "English",
+		"eo":      "Esperanto",
+		"es":      "Español",
+		"et":      "Eesti",
+		"eu":      "Euskara",
+		"fa":      "فارسی",
+		"fi":      "Suomi",
+		"fo":      "Føroyskt",
+		"fr":      "Français",
+		"fy":      "Frysk",
+		"ga":      "Gaeilge",
+		"gd":      "Gàidhlig",
+		"gl":      "Galego",
+		"gu":      "ગુજરાતી",
+		"gv":      "Gaelg",
+		"ha":      "Hausa",
+		"haw":     "ʻŌlelo Hawaiʻi",
+		"hi":      "हिन्दी",
+		"hr":      "Hrvatski",
+		"hu":      "Magyar",
+		"hy":      "Հայերեն",
+		"id":      "Indonesia",
+		"ig":      "Igbo",
+		"is":      "Íslenska",
+		"it":      "Italiano",
+		"iw":      "עברית",
+		"ja":      "日本語",
+		"ka":      "Ქართული",
+		"kk":      "Қазақ тілі",
+		"kl":      "Kalaallisut",
+		"km":      "ខ្មែរ",
+		"kn":      "ಕನ್ನಡ",
+		"ko":      "한국어",
+		"ks":      "کٲشُر",
+		"ky":      "Кыргызча",
+		"lb":      "Lëtzebuergesch",
+		"lg":      "Luganda",
+		"ln":      "Lingála",
+		"lo":      "ລາວ",
+		"lt":      "Lietuvių",
+		"luo":     "Dholuo",
+		"lv":      "Latviešu",
+		"mfe":     "Kreol morisien",
+		"mg":      "Malagasy",
+		"mk":      "Македонски",
+		"ml":      "മലയാളം",
+		"mn":      "Монгол",
+		"mr":      "मराठी",
+		"ms":      "Melayu",
+		"mt":      "Malti",
+		"my":      "မြန်မာ",
+		"ne":      "नेपाली",
+		"nl":      "Nederlands",
+		"nn":      "Nynorsk",
+		"no":      "Norsk bokmål",
+		"om":      "Oromoo",
+		"or":      "ଓଡ଼ିଆ",
+		"os":      "Ирон",
+		"pa":      "ਪੰਜਾਬੀ",
+		"pl":      "Polski",
+		"ps":      "پښتو",
+		"pt":      "Português",
+		"qu":      "Runasimi",
+		"rm":      "Rumantsch",
+		"rn":      "Ikirundi",
+		"ro":      "Română",
+		"ru":      "Русский",
+		"rw":      "Kinyarwanda",
+		"sd":      "سنڌي",
+		"sg":      "Sängö",
+		"si":      "සිංහල",
+		"sk":      "Slovenčina",
+		"sl":      "Slovenščina",
+		"sn":      "ChiShona",
+		"so":      "Soomaali",
+		"sq":      "Shqip",
+		"sr":      "Српски",
+		"sr-ME":   "Srpskohrvatski",
+		"sv":      "Svenska",
+		"sw":      "Kiswahili",
+		"ta":      "தமிழ்",
+		"te":      "తెలుగు",
+		"tg":      "Тоҷикӣ",
+		"th":      "ไทย",
+		"ti":      "ትግርኛ",
+		"tk":      "Türkmen dili",
+		"tl":      "Filipino",
+		"to":      "Lea fakatonga",
+		"tr":      "Türkçe",
+		"tt":      "Татар",
+		"tw":      "Akan",
+		"ug":      "ئۇيغۇرچە",
+		"uk":      "Українська",
+		"ur":      "اردو",
+		"uz":      "O‘zbek",
+		"vi":      "Tiếng Việt",
+		"wo":      "Wolof",
+		"yi":      "ייִדיש",
+		"yo":      "Èdè Yorùbá",
+		"zh":      "中文",
+		"zh-Hant": "繁體中文",
+		"zu":      "IsiZulu",
+	},
+	Names: map[string]map[string]string{
+		"be": map[string]string{
+			"aa":    "Афарская",
+			"ab":    "Абхазская",
+			"af":    "Афрыкаанс",
+			"ak":    "Акан",
+			"am":    "Амхарская",
+			"ar":    "Арабская",
+			"as":    "Асамская",
+			"ay":    "Аймара",
+			"az":    "Азербайджанская",
+			"ba":    "Башкірская",
+			"be":    "Беларуская",
+			"bg":    "Балгарская",
+			"bh":    "Бхаджпуры",
+			"bi":    "Біслама",
+			"bn":    "Бенгальская",
+			"bo":    "Тыбецкая",
+			"br":    "Брэтонская",
+			"bs":    "Баснійская",
+			"ca":    "Каталанская",
+			"ceb":   "Себуана",
+			"chr":   "Чэрокі",
+			"co":    "Карсіканская",
+			"crs":   "Сэсэльва",
+			"cs":    "Чэшская",
+			"cy":    "Валійская",
+			"da":    "Дацкая",
+			"de":    "Нямецкая",
+			"dv":    "Мальдыўская",
+			"dz":    "Дзонг-кэ",
+			"ee":    "Эве",
+			"el":    "Грэчаская",
+			"en":    "Англійская",
+			"eo":    "Эсперанта",
+			"es":    "Іспанская",
+			"et":    "Эстонская",
+			"eu":    "Баскская",
+			"fa":    "Фарсі",
+			"fi":    "Фінская",
+			"fj":    "Фіджыйская",
+			"fo":    "Фарэрская",
+			"fr":    "Французская",
+			"fy":    "Заходняя фрызская",
+			"ga":    "Ірландская",
+			"gaa":   "Га",
+			"gd":    "Шатландская гэльская",
+			"gl":    "Галісійская",
+			"gn":    "Гуарані",
+			"gu":    "Гуджараці",
+			"gv":    "Мэнская",
+			"ha":    "Хауса",
+			"haw":   "Гавайская",
+			"hi":    "Хіндзі",
+			"hmn":   "Хмонг",
+			"hr":    "Харвацкая",
+			"ht":    "Гаіцянская крэольская",
+			"hu":    "Венгерская",
+			"hy":    "Армянская",
+			"ia":    "Інтэрлінгва",
+			"id":    "Інданезійская",
+			"ie":    "Інтэрлінгвэ",
+			"ig":    "Ігба",
+			"is":    "Ісландская",
+			"it":    "Італьянская",
+			"iu":    "Інуктытут",
+			"iw":    "Іўрыт",
+			"ja":    "Японская",
+			"jw":    "Яванская",
+			"ka":    "Грузінская",
+			"kha":   "Кхасі",
+			"kk":    "Казахская",
+			"kl":    "Грэнландская",
+			"km":    "Кхмерская",
+			"kn":    "Канада",
+			"ko":    "Карэйская",
+			"ks":    "Кашмірская",
+			"ku":    "Курдская",
+			"ky":    "Кіргізская",
+			"la":    "Лацінская",
+			"lb":    "Люксембургская",
+			"lg":    "Ганда",
+			"ln":    "Лінгала",
+			"lo":    "Лаоская",
+			"loz":   "Лозі",
+			"lt":    "Літоўская",
+			"lua":   "Луба-касаі",
+			"luo":   "Луо",
+			"lv":    "Латышская",
+			"mfe":   "Марысьен",
+			"mg":    "Малагасійская",
+			"mi":    "Маары",
+			"mk":    "Македонская",
+			"ml":    "Малаялам",
+			"mn":    "Мангольская",
+			"mr":    "Маратхі",
+			"ms":    "Малайская",
+			"mt":    "Мальтыйская",
+			"my":    "Бірманская",
+			"na":    "Науру",
+			"ne":    "Непальская",
+			"new":   "Неўары",
+			"nl":    "Нідэрландская",
+			"nn":    "Нарвежская (нюношк)",
+			"no":    "Нарвежская (букмол)",
+			"nr":    "Паўднёвая ндэбеле",
+			"nso":   "Паўночная сота",
+			"ny":    "Ньянджа",
+			"oc":    "Аксітанская",
+			"om":    "Арома",
+			"or":    "Орыя",
+			"os":    "Асецінская",
+			"pa":    "Панджабі",
+			"pam":   "Пампанга",
+			"pl":    "Польская",
+			"ps":    "Пушту",
+			"pt":    "Партугальская",
+			"qu":    "Кечуа",
+			"raj":   "Раджастханская",
+			"rm":    "Рэтараманская",
+			"rn":    "Рундзі",
+			"ro":    "Румынская",
+			"ru":    "Руская",
+			"rw":    "Руанда",
+			"sa":    "Санскрыт",
+			"sco":   "Шатландская",
+			"sd":    "Сіндхі",
+			"sg":    "Санга",
+			"si":    "Сінгальская",
+			"sk":    "Славацкая",
+			"sl":    "Славенская",
+			"sm":    "Самоа",
+			"sn":    "Шона",
+			"so":    "Самалі",
+			"sq":    "Албанская",
+			"sr":    "Сербская",
+			"sr-ME": "Сербская (Чарнагорыя)",
+			"ss":    "Суаці",
+			"st":    "Сесута",
+			"su":    "Сунда",
+			"sv":    "Шведская",
+			"sw":    "Суахілі",
+			"syr":   "Сірыйская",
+			"ta":    "Тамільская",
+			"te":    "Тэлугу",
+			"tg":    "Таджыкская",
+			"th":    "Тайская",
+			"ti":    "Тыгрынья",
+			"tk":    "Туркменская",
+			"tl":    "Філіпінская",
+			"tlh":   "Клінган",
+			"tn":    "Тсвана",
+			"to":    "Танганская",
+			"tr":    "Турэцкая",
+			"ts":    "Тсонга",
+			"tt":    "Татарская",
+			"tum":   "Тумбука",
+			"tw":    "Акан",
+			"ug":    "Уйгурская",
+			"uk":    "Украінская",
+			"ur":    "Урду",
+			"uz":    "Узбекская",
+			"ve":    "Венда",
+			"vi":    "В’етнамская",
+			"vo":    "Валапюк",
+			"war":   "Варай",
+			"wo":    "Валоф",
+			"xh":    "Коса",
+			"yi":    "Ідыш",
+			"yo":    "Ёруба",
+			"zh":    "Кітайская",
+			"zu":    "Зулу",
+		},
+		"de": map[string]string{
+			"aa":      "Afar",
+			"ab":      "Abchasisch",
+			"af":      "Afrikaans",
+			"ak":      "Akan",
+			"am":      "Amharisch",
+			"ar":      "Arabisch",
+			"as":      "Assamesisch",
+			"ay":      "Aymara",
+			"az":      "Aserbaidschanisch",
+			"ba":      "Baschkirisch",
+			"be":      "Weißrussisch",
+			"bg":      "Bulgarisch",
+			"bh":      "Bhodschpuri",
+			"bi":      "Bislama",
+			"bn":      "Bengalisch",
+			"bo":      "Tibetisch",
+			"br":      "Bretonisch",
+			"bs":      "Bosnisch",
+			"ca":      "Katalanisch",
+			"ceb":     "Cebuano",
+			"chr":     "Cherokee",
+			"co":      "Korsisch",
+			"crs":     "Seychellenkreol",
+			"cs":      "Tschechisch",
+			"cy":      "Walisisch",
+			"da":      "Dänisch",
+			"de":      "Deutsch",
+			"dv":      "Dhivehi",
+			"dz":      "Dzongkha",
+			"ee":      "Ewe",
+			"el":      "Griechisch",
+			"en":      "Englisch",
+			"eo":      "Esperanto",
+			"es":      "Spanisch",
+			"et":      "Estnisch",
+			"eu":      "Baskisch",
+			"fa":      "Persisch",
+			"fi":      "Finnisch",
+			"fj":      "Fidschi",
+			"fo":      "Färöisch",
+			"fr":      "Französisch",
+			"fy":      "Westfriesisch",
+			"ga":      "Irisch",
+			"gaa":     "Ga",
+			"gd":      "Schottisches Gälisch",
+			"gl":      "Galicisch",
+			"gn":      "Guarani",
+			"gu":      "Gujarati",
+			"gv":      "Manx",
+			"ha":      "Haussa",
+			"haw":     "Hawaiisch",
+			"hi":      "Hindi",
+			"hmn":     "Miao",
+			"hr":      "Kroatisch",
+			"ht":      "Haiti-Kreolisch",
+			"hu":      "Ungarisch",
+			"hy":      "Armenisch",
+			"ia":      "Interlingua",
+			"id":      "Indonesisch",
+			"ie":      "Interlingue",
+			"ig":      "Igbo",
+			"ik":      "Inupiak",
+			"is":      "Isländisch",
+			"it":      "Italienisch",
+			"iu":      "Inuktitut",
+			"iw":      "Hebräisch",
+			"ja":      "Japanisch",
+			"jw":      "Javanisch",
+			"ka":      "Georgisch",
+			"kha":     "Khasi",
+			"kk":      "Kasachisch",
+			"kl":      "Grönländisch",
+			"km":      "Khmer",
+			"kn":      "Kannada",
+			"ko":      "Koreanisch",
+			"kri":     "Krio",
+			"ks":      "Kaschmiri",
+			"ku":      "Kurdisch",
+			"ky":      "Kirgisisch",
+			"la":      "Latein",
+			"lb":      "Luxemburgisch",
+			"lg":      "Ganda",
+			"ln":      "Lingala",
+			"lo":      "Laotisch",
+			"loz":     "Lozi",
+			"lt":      "Litauisch",
+			"lua":     "Luba-Lulua",
+			"luo":     "Luo",
+			"lv":      "Lettisch",
+			"mfe":     "Morisyen",
+			"mg":      "Madagassisch",
+			"mi":      "Maori",
+			"mk":      "Mazedonisch",
+			"ml":      "Malayalam",
+			"mn":      "Mongolisch",
+			"mr":      "Marathi",
+			"ms":      "Malaiisch",
+			"mt":      "Maltesisch",
+			"my":      "Birmanisch",
+			"na":      "Nauruisch",
+			"ne":      "Nepalesisch",
+			"new":     "Newari",
+			"nl":      "Niederländisch",
+			"nn":      "Norwegisch Nynorsk",
+			"no":      "Norwegisch Bokmål",
+			"nr":      "Süd-Ndebele",
+			"nso":     "Nord-Sotho",
+			"ny":      "Nyanja",
+			"oc":      "Okzitanisch",
+			"om":      "Oromo",
+			"or":      "Oriya",
+			"os":      "Ossetisch",
+			"pa":      "Punjabi",
+			"pam":     "Pampanggan",
+			"pl":      "Polnisch",
+			"ps":      "Paschtu",
+			"pt":      "Portugiesisch",
+			"qu":      "Quechua",
+			"raj":     "Rajasthani",
+			"rm":      "Rätoromanisch",
+			"rn":      "Rundi",
+			"ro":      "Rumänisch",
+			"ru":      "Russisch",
+			"rw":      "Kinyarwanda",
+			"sa":      "Sanskrit",
+			"sco":     "Schottisch",
+			"sd":      "Sindhi",
+			"sg":      "Sango",
+			"si":      "Singhalesisch",
+			"sk":      "Slowakisch",
+			"sl":      "Slowenisch",
+			"sm":      "Samoanisch",
+			"sn":      "Shona",
+			"so":      "Somali",
+			"sq":      "Albanisch",
+			"sr":      "Serbisch",
+			"sr-ME":   "Serbisch (Montenegro)",
+			"ss":      "Swazi",
+			"st":      "Süd-Sotho",
+			"su":      "Sundanesisch",
+			"sv":      "Schwedisch",
+			"sw":      "Suaheli",
+			"syr":     "Syrisch",
+			"ta":      "Tamil",
+			"te":      "Telugu",
+			"tg":      "Tadschikisch",
+			"th":      "Thailändisch",
+			"ti":      "Tigrinya",
+			"tk":      "Turkmenisch",
+			"tl":      "Filipino",
+			"tlh":     "Klingonisch",
+			"tn":      "Tswana",
+			"to":      "Tongaisch",
+			"tr":      "Türkisch",
+			"ts":      "Tsonga",
+			"tt":      "Tatarisch",
+			"tum":     "Tumbuka",
+			"tw":      "Akan",
+			"ug":      "Uigurisch",
+			"uk":      "Ukrainisch",
+			"ur":      "Urdu",
+			"uz":      "Usbekisch",
+			"ve":      "Venda",
+			"vi":      "Vietnamesisch",
+			"vo":      "Volapük",
+			"war":     "Waray",
+			"wo":      "Wolof",
+			"xh":      "Xhosa",
+			"yi":      "Jiddisch",
+			"yo":      "Yoruba",
+			"za":      "Zhuang",
+			"zh":      "Chinesisch",
+			"zh-Hant": "Chinesisch (traditionell)",
+			"zu":      "Zulu",
+		},
+		"en": map[string]string{
+			"aa":      "Afar",
+			"ab":      "Abkhazian",
+			"af":      "Afrikaans",
+			"ak":      "Akan",
+			"am":      "Amharic",
+			"ar":      "Arabic",
+			"as":      "Assamese",
+			"ay":      "Aymara",
+			"az":      "Azerbaijani",
+			"ba":      "Bashkir",
+			"be":      "Belarusian",
+			"bg":      "Bulgarian",
+			"bh":      "Bhojpuri",
+			"bi":      "Bislama",
+			"bn":      "Bangla",
+			"bo":      "Tibetan",
+			"br":      "Breton",
+			"bs":      "Bosnian",
+			"ca":      "Catalan",
+			"ceb":     "Cebuano",
+			"chr":     "Cherokee",
+			"co":      "Corsican",
+			"crs":     "Seselwa Creole French",
+			"cs":      "Czech",
+			"cy":      "Welsh",
+			"da":      "Danish",
+			"de":      "German",
+			"dv":      "Divehi",
+			"dz":      "Dzongkha",
+			"ee":      "Ewe",
+			"el":      "Greek",
+			"en":      "English",
+			"eo":      "Esperanto",
+			"es":      "Spanish",
+			"et":      "Estonian",
+			"eu":      "Basque",
+			"fa":      "Persian",
+			"fi":      "Finnish",
+			"fj":      "Fijian",
+			"fo":      "Faroese",
+			"fr":      "French",
+			"fy":      "Western Frisian",
+			"ga":      "Irish",
+			"gaa":     "Ga",
+			"gd":      "Scottish Gaelic",
+			"gl":      "Galician",
+			"gn":      "Guarani",
+			"gu":      "Gujarati",
+			"gv":      "Manx",
+			"ha":      "Hausa",
+			"haw":     "Hawaiian",
+			"hi":      "Hindi",
+			"hmn":     "Hmong",
+			"hr":      "Croatian",
+			"ht":      "Haitian Creole",
+			"hu":      "Hungarian",
+			"hy":      "Armenian",
+			"ia":      "Interlingua",
+			"id":      "Indonesian",
+			"ie":      "Interlingue",
+			"ig":      "Igbo",
+			"ik":      "Inupiaq",
+			"is":      "Icelandic",
+			"it":      "Italian",
+			"iu":      "Inuktitut",
+			"iw":      "Hebrew",
+			"ja":      "Japanese",
+			"jw":      "Javanese",
+			"ka":      "Georgian",
+			"kha":     "Khasi",
+			"kk":      "Kazakh",
+			"kl":      "Kalaallisut",
+			"km":      "Khmer",
+			"kn":      "Kannada",
+			"ko":      "Korean",
+			"kri":     "Krio",
+			"ks":      "Kashmiri",
+			"ku":      "Kurdish",
+			"ky":      "Kyrgyz",
+			"la":      "Latin",
+			"lb":      "Luxembourgish",
+			"lg":      "Ganda",
+			"ln":      "Lingala",
+			"lo":      "Lao",
+			"loz":     "Lozi",
+			"lt":      "Lithuanian",
+			"lua":     "Luba-Lulua",
+			"luo":     "Luo",
+			"lv":      "Latvian",
+			"mfe":     "Morisyen",
+			"mg":      "Malagasy",
+			"mi":      "Maori",
+			"mk":      "Macedonian",
+			"ml":      "Malayalam",
+			"mn":      "Mongolian",
+			"mr":      "Marathi",
+			"ms":      "Malay",
+			"mt":      "Maltese",
+			"my":      "Burmese",
+			"na":      "Nauru",
+			"ne":      "Nepali",
+			"new":     "Newari",
+			"nl":      "Dutch",
+			"nn":      "Norwegian Nynorsk",
+			"no":      "Norwegian Bokmål",
+			"nr":      "South Ndebele",
+			"nso":     "Northern Sotho",
+			"ny":      "Nyanja",
+			"oc":      "Occitan",
+			"om":      "Oromo",
+			"or":      "Odia",
+			"os":      "Ossetic",
+			"pa":      "Punjabi",
+			"pam":     "Pampanga",
+			"pl":      "Polish",
+			"ps":      "Pashto",
+			"pt":      "Portuguese",
+			"qu":      "Quechua",
+			"raj":     "Rajasthani",
+			"rm":      "Romansh",
+			"rn":      "Rundi",
+			"ro":      "Romanian",
+			"ru":      "Russian",
+			"rw":      "Kinyarwanda",
+			"sa":      "Sanskrit",
+			"sco":     "Scots",
+			"sd":      "Sindhi",
+			"sg":      "Sango",
+			"si":      "Sinhala",
+			"sk":      "Slovak",
+			"sl":      "Slovenian",
+			"sm":      "Samoan",
+			"sn":      "Shona",
+			"so":      "Somali",
+			"sq":      "Albanian",
+			"sr":      "Serbian",
+			"sr-ME":   "Serbian (Montenegro)",
+			"ss":      "Swati",
+			"st":      "Southern Sotho",
+			"su":      "Sundanese",
+			"sv":      "Swedish",
+			"sw":      "Swahili",
+			"syr":     "Syriac",
+			"ta":      "Tamil",
+			"te":      "Telugu",
+			"tg":      "Tajik",
+			"th":      "Thai",
+			"ti":      "Tigrinya",
+			"tk":      "Turkmen",
+			"tl":      "Filipino",
+			"tlh":     "Klingon",
+			"tn":      "Tswana",
+			"to":      "Tongan",
+			"tr":      "Turkish",
+			"ts":      "Tsonga",
+			"tt":      "Tatar",
+			"tum":     "Tumbuka",
+			"tw":      "Akan",
+			"ug":      "Uyghur",
+			"uk":      "Ukrainian",
+			"ur":      "Urdu",
+			"uz":      "Uzbek",
+			"ve":      "Venda",
+			"vi":      "Vietnamese",
+			"vo":      "Volapük",
+			"war":     "Waray",
+			"wo":      "Wolof",
+			"xh":      "Xhosa",
+			"yi":      "Yiddish",
+			"yo":      "Yoruba",
+			"za":      "Zhuang",
+			"zh":      "Chinese",
+			"zh-Hant": "Traditional Chinese",
+			"zu":      "Zulu",
+		},
+		"es": map[string]string{
+			"aa":      "Afar",
+			"ab":      "Abjasio",
+			"af":      "Afrikáans",
+			"ak":      "Akan",
+			"am":      "Amárico",
+			"ar":      "Árabe",
+			"as":      "Asamés",
+			"ay":      "Aimara",
+			"az":      "Azerbaiyano",
+			"ba":      "Baskir",
+			"be":      "Bielorruso",
+			"bg":      "Búlgaro",
+			"bh":      "Bhoyapurí",
+			"bi":      "Bislama",
+			"bn":      "Bengalí",
+			"bo":      "Tibetano",
+			"br":      "Bretón",
+			"bs":      "Bosnio",
+			"ca":      "Catalán",
+			"ceb":     "Cebuano",
+			"chr":     "Cheroqui",
+			"co":      "Corso",
+			"crs":     "Criollo seychelense",
+			"cs":      "Checo",
+			"cy":      "Galés",
+			"da":      "Danés",
+			"de":      "Alemán",
+			"dv":      "Divehi",
+			"dz":      "Dzongkha",
+			"ee":      "Ewé",
+			"el":      "Griego",
+			"en":      "Inglés",
+			"eo":      "Esperanto",
+			"es":      "Español",
+			"et":      "Estonio",
+			"eu":      "Euskera",
+			"fa":      "Persa",
+			"fi":      "Finés",
+			"fj":      "Fiyiano",
+			"fo":      "Feroés",
+			"fr":      "Francés",
+			"fy":      "Frisón occidental",
+			"ga":      "Irlandés",
+			"gaa":     "Ga",
+			"gd":      "Gaélico escocés",
+			"gl":      "Gallego",
+			"gn":      "Guaraní",
+			"gu":      "Guyaratí",
+			"gv":      "Manés",
+			"ha":      "Hausa",
+			"haw":     "Hawaiano",
+			"hi":      "Hindi",
+			"hmn":     "Hmong",
+			"hr":      "Croata",
+			"ht":      "Criollo haitiano",
+			"hu":      "Húngaro",
+			"hy":      "Armenio",
+			"ia":      "Interlingua",
+			"id":      "Indonesio",
+			"ie":      "Interlingue",
+			"ig":      "Igbo",
+			"ik":      "Inupiaq",
+			"is":      "Islandés",
+			"it":      "Italiano",
+			"iu":      "Inuktitut",
+			"iw":      "Hebreo",
+			"ja":      "Japonés",
+			"jw":      "Javanés",
+			"ka":      "Georgiano",
+			"kha":     "Khasi",
+			"kk":      "Kazajo",
+			"kl":      "Groenlandés",
+			"km":      "Jemer",
+			"kn":      "Canarés",
+			"ko":      "Coreano",
+			"ks":      "Cachemiro",
+			"ku":      "Kurdo",
+			"ky":      "Kirguís",
+			"la":      "Latín",
+			"lb":      "Luxemburgués",
+			"lg":      "Ganda",
+			"ln":      "Lingala",
+			"lo":      "Lao",
+			"loz":     "Lozi",
+			"lt":      "Lituano",
+			"lua":     "Luba-lulua",
+			"luo":     "Luo",
+			"lv":      "Letón",
+			"mfe":     "Criollo mauriciano",
+			"mg":      "Malgache",
+			"mi":      "Maorí",
+			"mk":      "Macedonio",
+			"ml":      "Malayalam",
+			"mn":      "Mongol",
+			"mr":      "Maratí",
+			"ms":      "Malayo",
+			"mt":      "Maltés",
+			"my":      "Birmano",
+			"na":      "Nauruano",
+			"ne":      "Nepalí",
+			"new":     "Newari",
+			"nl":      "Neerlandés",
+			"nn":      "Noruego nynorsk",
+			"no":      "Noruego bokmal",
+			"nr":      "Ndebele meridional",
+			"nso":     "Sesotho septentrional",
+			"ny":      "Nyanja",
+			"oc":      "Occitano",
+			"om":      "Oromo",
+			"or":      "Oriya",
+			"os":      "Osético",
+			"pa":      "Panyabí",
+			"pam":     "Pampanga",
+			"pl":      "Polaco",
+			"ps":      "Pastún",
+			"pt":      "Portugués",
+			"qu":      "Quechua",
+			"raj":     "Rajasthani",
+			"rm":      "Romanche",
+			"rn":      "Kirundi",
+			"ro":      "Rumano",
+			"ru":      "Ruso",
+			"rw":      "Kinyarwanda",
+			"sa":      "Sánscrito",
+			"sco":     "Escocés",
+			"sd":      "Sindhi",
+			"sg":      "Sango",
+			"si":      "Cingalés",
+			"sk":      "Eslovaco",
+			"sl":      "Esloveno",
+			"sm":      "Samoano",
+			"sn":      "Shona",
+			"so":      "Somalí",
+			"sq":      "Albanés",
+			"sr":      "Serbio",
+			"sr-ME":   "Serbio (Montenegro)",
+			"ss":      "Suazi",
+			"st":      "Sesotho meridional",
+			"su":      "Sundanés",
+			"sv":      "Sueco",
+			"sw":      "Suajili",
+			"syr":     "Siriaco",
+			"ta":      "Tamil",
+			"te":      "Telugu",
+			"tg":      "Tayiko",
+			"th":      "Tailandés",
+			"ti":      "Tigriña",
+			"tk":      "Turcomano",
+			"tl":      "Filipino",
+			"tlh":     "Klingon",
+			"tn":      "Setsuana",
+			"to":      "Tongano",
+			"tr":      "Turco",
+			"ts":      "Tsonga",
+			"tt":      "Tártaro",
+			"tum":     "Tumbuka",
+			"tw":      "Akan",
+			"ug":      "Uigur",
+			"uk":      "Ucraniano",
+			"ur":      "Urdu",
+			"uz":      "Uzbeko",
+			"ve":      "Venda",
+			"vi":      "Vietnamita",
+			"vo":      "Volapük",
+			"war":     "Waray",
+			"wo":      "Wólof",
+			"xh":      "Xhosa",
+			"yi":      "Yidis",
+			"yo":      "Yoruba",
+			"za":      "Zhuang",
+			"zh":      "Chino",
+			"zh-Hant": "Chino tradicional",
+			"zu":      "Zulú",
+		},
+		"fr": map[string]string{
+			"aa":      "Afar",
+			"ab":      "Abkhaze",
+			"af":      "Afrikaans",
+			"ak":      "Akan",
+			"am":      "Amharique",
+			"ar":      "Arabe",
+			"as":      "Assamais",
+			"ay":      "Aymara",
+			"az":      "Azéri",
+			"ba":      "Bachkir",
+			"be":      "Biélorusse",
+			"bg":      "Bulgare",
+			"bh":      "Bhojpuri",
+			"bi":      "Bichelamar",
+			"bn":      "Bengali",
+			"bo":      "Tibétain",
+			"br":      "Breton",
+			"bs":      "Bosniaque",
+			"ca":      "Catalan",
+			"ceb":     "Cebuano",
+			"chr":     "Cherokee",
+			"co":      "Corse",
+			"crs":     "Créole seychellois",
+			"cs":      "Tchèque",
+			"cy":      "Gallois",
+			"da":      "Danois",
+			"de":      "Allemand",
+			"dv":      "Maldivien",
+			"dz":      "Dzongkha",
+			"ee":      "Éwé",
+			"el":      "Grec",
+			"en":      "Anglais",
+			"eo":      "Espéranto",
+			"es":      "Espagnol",
+			"et":      "Estonien",
+			"eu":      "Basque",
+			"fa":      "Persan",
+			"fi":      "Finnois",
+			"fj":      "Fidjien",
+			"fo":      "Féroïen",
+			"fr":      "Français",
+			"fy":      "Frison occidental",
+			"ga":      "Irlandais",
+			"gaa":     "Ga",
+			"gd":      "Gaélique écossais",
+			"gl":      "Galicien",
+			"gn":      "Guarani",
+			"gu":      "Goudjerati",
+			"gv":      "Mannois",
+			"ha":      "Haoussa",
+			"haw":     "Hawaïen",
+			"hi":      "Hindi",
+			"hmn":     "Hmong",
+			"hr":      "Croate",
+			"ht":      "Créole haïtien",
+			"hu":      "Hongrois",
+			"hy":      "Arménien",
+			"ia":      "Interlingua",
+			"id":      "Indonésien",
+			"ie":      "Interlingue",
+			"ig":      "Igbo",
+			"ik":      "Inupiaq",
+			"is":      "Islandais",
+			"it":      "Italien",
+			"iu":      "Inuktitut",
+			"iw":      "Hébreu",
+			"ja":      "Japonais",
+			"jw":      "Javanais",
+			"ka":      "Géorgien",
+			"kha":     "Khasi",
+			"kk":      "Kazakh",
+			"kl":      "Groenlandais",
+			"km":      "Khmer",
+			"kn":      "Kannada",
+			"ko":      "Coréen",
+			"kri":     "Krio",
+			"ks":      "Kashmiri",
+			"ku":      "Kurde",
+			"ky":      "Kirghize",
+			"la":      "Latin",
+			"lb":      "Luxembourgeois",
+			"lg":      "Ganda",
+			"ln":      "Lingala",
+			"lo":      "Lao",
+			"loz":     "Lozi",
+			"lt":      "Lituanien",
+			"lua":     "Luba-lulua",
+			"luo":     "Luo",
+			"lv":      "Letton",
+			"mfe":     "Créole mauricien",
+			"mg":      "Malgache",
+			"mi":      "Maori",
+			"mk":      "Macédonien",
+			"ml":      "Malayalam",
+			"mn":      "Mongol",
+			"mr":      "Marathe",
+			"ms":      "Malais",
+			"mt":      "Maltais",
+			"my":      "Birman",
+			"na":      "Nauruan",
+			"ne":      "Népalais",
+			"new":     "Newari",
+			"nl":      "Néerlandais",
+			"nn":      "Norvégien nynorsk",
+			"no":      "Norvégien bokmål",
+			"nr":      "Ndébélé du Sud",
+			"nso":     "Sotho du Nord",
+			"ny":      "Nyanja",
+			"oc":      "Occitan",
+			"om":      "Oromo",
+			"or":      "Oriya",
+			"os":      "Ossète",
+			"pa":      "Pendjabi",
+			"pam":     "Pampangan",
+			"pl":      "Polonais",
+			"ps":      "Pachto",
+			"pt":      "Portugais",
+			"qu":      "Quechua",
+			"raj":     "Rajasthani",
+			"rm":      "Romanche",
+			"rn":      "Roundi",
+			"ro":      "Roumain",
+			"ru":      "Russe",
+			"rw":      "Rwanda",
+			"sa":      "Sanskrit",
+			"sco":     "Écossais",
+			"sd":      "Sindhi",
+			"sg":      "Sangho",
+			"si":      "Cinghalais",
+			"sk":      "Slovaque",
+			"sl":      "Slovène",
+			"sm":      "Samoan",
+			"sn":      "Shona",
+			"so":      "Somali",
+			"sq":      "Albanais",
+			"sr":      "Serbe",
+			"sr-ME":   "Serbe (Monténégro)",
+			"ss":      "Swati",
+			"st":      "Sotho du Sud",
+			"su":      "Soundanais",
+			"sv":      "Suédois",
+			"sw":      "Swahili",
+			"syr":     "Syriaque",
+			"ta":      "Tamoul",
+			"te":      "Télougou",
+			"tg":      "Tadjik",
+			"th":      "Thaï",
+			"ti":      "Tigrigna",
+			"tk":      "Turkmène",
+			"tl":      "Filipino",
+			"tlh":     "Klingon",
+			"tn":      "Tswana",
+			"to":      "Tonguien",
+			"tr":      "Turc",
+			"ts":      "Tsonga",
+			"tt":      "Tatar",
+			"tum":     "Toumbouka",
+			"tw":      "Akan",
+			"ug":      "Ouïghour",
+			"uk":      "Ukrainien",
+			"ur":      "Ourdou",
+			"uz":      "Ouzbek",
+			"ve":      "Venda",
+			"vi":      "Vietnamien",
+			"vo":      "Volapuk",
+			"war":     "Waray",
+			"wo":      "Wolof",
+			"xh":      "Xhosa",
+			"yi":      "Yiddish",
+			"yo":      "Yoruba",
+			"za":      "Zhuang",
+			"zh":      "Chinois",
+			"zh-Hant": "Chinois traditionnel",
+			"zu":      "Zoulou",
+		},
+		"it": map[string]string{
+			"aa":      "Afar",
+			"ab":      "Abcaso",
+			"af":      "Afrikaans",
+			"ak":      "Akan",
+			"am":      "Amarico",
+			"ar":      "Arabo",
+			"as":      "Assamese",
+			"ay":      "Aymara",
+			"az":      "Azerbaigiano",
+			"ba":      "Baschiro",
+			"be":      "Bielorusso",
+			"bg":      "Bulgaro",
+			"bh":      "Bhojpuri",
+			"bi":      "Bislama",
+			"bn":      "Bengalese",
+			"bo":      "Tibetano",
+			"br":      "Bretone",
+			"bs":      "Bosniaco",
+			"ca":      "Catalano",
+			"ceb":     "Cebuano",
+			"chr":     "Cherokee",
+			"co":      "Corso",
+			"crs":     "Creolo delle Seychelles",
+			"cs":      "Ceco",
+			"cy":      "Gallese",
+			"da":      "Danese",
+			"de":      "Tedesco",
+			"dv":      "Divehi",
+			"dz":      "Dzongkha",
+			"ee":      "Ewe",
+			"el":      "Greco",
+			"en":      "Inglese",
+			"eo":      "Esperanto",
+			"es":      "Spagnolo",
+			"et":      "Estone",
+			"eu":      "Basco",
+			"fa":      "Persiano",
+			"fi":      "Finlandese",
+			"fj":      "Figiano",
+			"fo":      "Faroese",
+			"fr":      "Francese",
+			"fy":      "Frisone occidentale",
+			"ga":      "Irlandese",
+			"gaa":     "Ga",
+			"gd":      "Gaelico scozzese",
+			"gl":      "Galiziano",
+			"gn":      "Guaraní",
+			"gu":      "Gujarati",
+			"gv":      "Mannese",
+			"ha":      "Hausa",
+			"haw":     "Hawaiano",
+			"hi":      "Hindi",
+			"hmn":     "Hmong",
+			"hr":      "Croato",
+			"ht":      "Haitiano",
+			"hu":      "Ungherese",
+			"hy":      "Armeno",
+			"ia":      "Interlingua",
+			"id":      "Indonesiano",
+			"ie":      "Interlingue",
+			"ig":      "Igbo",
+			"ik":      "Inupiak",
+			"is":      "Islandese",
+			"it":      "Italiano",
+			"iu":      "Inuktitut",
+			"iw":      "Ebraico",
+			"ja":      "Giapponese",
+			"jw":      "Giavanese",
+			"ka":      "Georgiano",
+			"kha":     "Khasi",
+			"kk":      "Kazako",
+			"kl":      "Groenlandese",
+			"km":      "Khmer",
+			"kn":      "Kannada",
+			"ko":      "Coreano",
+			"ks":      "Kashmiri",
+			"ku":      "Curdo",
+			"ky":      "Chirghiso",
+			"la":      "Latino",
+			"lb":      "Lussemburghese",
+			"lg":      "Ganda",
+			"ln":      "Lingala",
+			"lo":      "Lao",
+			"loz":     "Lozi",
+			"lt":      "Lituano",
+			"lua":     "Luba-lulua",
+			"luo":     "Luo",
+			"lv":      "Lettone",
+			"mfe":     "Creolo mauriziano",
+			"mg":      "Malgascio",
+			"mi":      "Maori",
+			"mk":      "Macedone",
+			"ml":      "Malayalam",
+			"mn":      "Mongolo",
+			"mr":      "Marathi",
+			"ms":      "Malese",
+			"mt":      "Maltese",
+			"my":      "Birmano",
+			"na":      "Nauru",
+			"ne":      "Nepalese",
+			"new":     "Newari",
+			"nl":      "Olandese",
+			"nn":      "Norvegese nynorsk",
+			"no":      "Norvegese bokmål",
+			"nr":      "Ndebele del sud",
+			"nso":     "Sotho del nord",
+			"ny":      "Nyanja",
+			"oc":      "Occitano",
+			"om":      "Oromo",
+			"or":      "Oriya",
+			"os":      "Ossetico",
+			"pa":      "Punjabi",
+			"pam":     "Pampanga",
+			"pl":      "Polacco",
+			"ps":      "Pashto",
+			"pt":      "Portoghese",
+			"qu":      "Quechua",
+			"raj":     "Rajasthani",
+			"rm":      "Romancio",
+			"rn":      "Rundi",
+			"ro":      "Rumeno",
+			"ru":      "Russo",
+			"rw":      "Kinyarwanda",
+			"sa":      "Sanscrito",
+			"sco":     "Scozzese",
+			"sd":      "Sindhi",
+			"sg":      "Sango",
+			"si":      "Singalese",
+			"sk":      "Slovacco",
+			"sl":      "Sloveno",
+			"sm":      "Samoano",
+			"sn":      "Shona",
+			"so":      "Somalo",
+			"sq":      "Albanese",
+			"sr":      "Serbo",
+			"sr-ME":   "Serbo (Montenegro)",
+			"ss":      "Swati",
+			"st":      "Sotho del sud",
+			"su":      "Sundanese",
+			"sv":      "Svedese",
+			"sw":      "Swahili",
+			"syr":     "Siriaco",
+			"ta":      "Tamil",
+			"te":      "Telugu",
+			"tg":      "Tagico",
+			"th":      "Thai",
+			"ti":      "Tigrino",
+			"tk":      "Turcomanno",
+			"tl":      "Filippino",
+			"tlh":     "Klingon",
+			"tn":      "Tswana",
+			"to":      "Tongano",
+			"tr":      "Turco",
+			"ts":      "Tsonga",
+			"tt":      "Tataro",
+			"tum":     "Tumbuka",
+			"tw":      "Akan",
+			"ug":      "Uiguro",
+			"uk":      "Ucraino",
+			"ur":      "Urdu",
+			"uz":      "Uzbeco",
+			"ve":      "Venda",
+			"vi":      "Vietnamita",
+			"vo":      "Volapük",
+			"war":     "Waray",
+			"wo":      "Wolof",
+			"xh":      "Xhosa",
+			"yi":      "Yiddish",
+			"yo":      "Yoruba",
+			"za":      "Zhuang",
+			"zh":      "Cinese",
+			"zh-Hant": "Cinese tradizionale",
+			"zu":      "Zulu",
+		},
+		"ja": map[string]string{
+			"aa":      "アファル語",
+			"ab":      "アブハズ語",
+			"af":      "アフリカーンス語",
+			"ak":      "アカン語",
+			"am":      "アムハラ語",
+			"ar":      "アラビア語",
+			"as":      "アッサム語",
+			"ay":      "アイマラ語",
+			"az":      "アゼルバイジャン語",
+			"ba":      "バシキール語",
+			"be":      "ベラルーシ語",
+			"bg":      "ブルガリア語",
+			"bh":      "ボージュプリー語",
+			"bi":      "ビスラマ語",
+			"bn":      "ベンガル語",
+			"bo":      "チベット語",
+			"br":      "ブルトン語",
+			"bs":      "ボスニア語",
+			"ca":      "カタロニア語",
+			"ceb":     "セブアノ語",
+			"chr":     "チェロキー語",
+			"co":      "コルシカ語",
+			"crs":     "セーシェル・クレオール語",
+			"cs":      "チェコ語",
+			"cy":      "ウェールズ語",
+			"da":      "デンマーク語",
+			"de":      "ドイツ語",
+			"dv":      "ディベヒ語",
+			"dz":      "ゾンカ語",
+			"ee":      "エウェ語",
+			"el":      "ギリシャ語",
+			"en":      "英語",
+			"eo":      "エスペラント語",
+			"es":      "スペイン語",
+			"et":      "エストニア語",
+			"eu":      "バスク語",
+			"fa":      "ペルシア語",
+			"fi":      "フィンランド語",
+			"fj":      "フィジー語",
+			"fo":      "フェロー語",
+			"fr":      "フランス語",
+			"fy":      "西フリジア語",
+			"ga":      "アイルランド語",
+			"gaa":     "ガ語",
+			"gd":      "スコットランド・ゲール語",
+			"gl":      "ガリシア語",
+			"gn":      "グアラニー語",
+			"gu":      "グジャラート語",
+			"gv":      "マン島語",
+			"ha":      "ハウサ語",
+			"haw":     "ハワイ語",
+			"hi":      "ヒンディー語",
+			"hmn":     "フモン語",
+			"hr":      "クロアチア語",
+			"ht":      "ハイチ・クレオール語",
+			"hu":      "ハンガリー語",
+			"hy":      "アルメニア語",
+			"ia":      "インターリングア",
+			"id":      "インドネシア語",
+			"ie":      "インターリング",
+			"ig":      "イボ語",
+			"ik":      "イヌピアック語",
+			"is":      "アイスランド語",
+			"it":      "イタリア語",
+			"iu":      "イヌクウティトット語",
+			"iw":      "ヘブライ語",
+			"ja":      "日本語",
+			"jw":      "ジャワ語",
+			"ka":      "ジョージア語",
+			"kha":     "カシ語",
+			"kk":      "カザフ語",
+			"kl":      "グリーンランド語",
+			"km":      "クメール語",
+			"kn":      "カンナダ語",
+			"ko":      "韓国語",
+			"kri":     "クリオ語",
+			"ks":      "カシミール語",
+			"ku":      "クルド語",
+			"ky":      "キルギス語",
+			"la":      "ラテン語",
+			"lb":      "ルクセンブルク語",
+			"lg":      "ガンダ語",
+			"ln":      "リンガラ語",
+			"lo":      "ラオ語",
+			"loz":     "ロジ語",
+			"lt":      "リトアニア語",
+			"lua":     "ルバ・ルルア語",
+			"luo":     "ルオ語",
+			"lv":      "ラトビア語",
+			"mfe":     "モーリシャス・クレオール語",
+			"mg":      "マダガスカル語",
+			"mi":      "マオリ語",
+			"mk":      "マケドニア語",
+			"ml":      "マラヤーラム語",
+			"mn":      "モンゴル語",
+			"mr":      "マラーティー語",
+			"ms":      "マレー語",
+			"mt":      "マルタ語",
+			"my":      "ミャンマー語",
+			"na":      "ナウル語",
+			"ne":      "ネパール語",
+			"new":     "ネワール語",
+			"nl":      "オランダ語",
+			"nn":      "ノルウェー語(ニーノシュク)",
+			"no":      "ノルウェー語(ブークモール)",
+			"nr":      "南ンデベレ語",
+			"nso":     "北部ソト語",
+			"ny":      "ニャンジャ語",
+			"oc":      "オック語",
+			"om":      "オロモ語",
+			"or":      "オリヤー語",
+			"os":      "オセット語",
+			"pa":      "パンジャブ語",
+			"pam":     "パンパンガ語",
+			"pl":      "ポーランド語",
+			"ps":      "パシュトゥー語",
+			"pt":      "ポルトガル語",
+			"qu":      "ケチュア語",
+			"raj":     "ラージャスターン語",
+			"rm":      "ロマンシュ語",
+			"rn":      "ルンディ語",
+			"ro":      "ルーマニア語",
+			"ru":      "ロシア語",
+			"rw":      "キニアルワンダ語",
+			"sa":      "サンスクリット語",
+			"sco":     "スコットランド語",
+			"sd":      "シンド語",
+			"sg":      "サンゴ語",
+			"si":      "シンハラ語",
+			"sk":      "スロバキア語",
+			"sl":      "スロベニア語",
+			"sm":      "サモア語",
+			"sn":      "ショナ語",
+			"so":      "ソマリ語",
+			"sq":      "アルバニア語",
+			"sr":      "セルビア語",
+			"sr-ME":   "セルビア語 (モンテネグロ)",
+			"ss":      "スワジ語",
+			"st":      "南部ソト語",
+			"su":      "スンダ語",
+			"sv":      "スウェーデン語",
+			"sw":      "スワヒリ語",
+			"syr":     "シリア語",
+			"ta":      "タミル語",
+			"te":      "テルグ語",
+			"tg":      "タジク語",
+			"th":      "タイ語",
+			"ti":      "ティグリニア語",
+			"tk":      "トルクメン語",
+			"tl":      "フィリピノ語",
+			"tlh":     "クリンゴン語",
+			"tn":      "ツワナ語",
+			"to":      "トンガ語",
+			"tr":      "トルコ語",
+			"ts":      "ツォンガ語",
+			"tt":      "タタール語",
+			"tum":     "トゥンブカ語",
+			"tw":      "アカン語",
+			"ug":      "ウイグル語",
+			"uk":      "ウクライナ語",
+			"ur":      "ウルドゥー語",
+			"uz":      "ウズベク語",
+			"ve":      "ベンダ語",
+			"vi":      "ベトナム語",
+			"vo":      "ヴォラピュク語",
+			"war":     "ワライ語",
+			"wo":      "ウォロフ語",
+			"xh":      "コサ語",
+			"yi":      "イディッシュ語",
+			"yo":      "ヨルバ語",
+			"za":      "チワン語",
+			"zh":      "中国語",
+			"zh-Hant": "繁体中国語",
+			"zu":      "ズールー語",
+		},
+		"kk": map[string]string{
+			"aa":      "Афар тілі",
+			"ab":      "Абхаз тілі",
+			"af":      "Африкаанс тілі",
+			"ak":      "Акан тілі",
+			"am":      "Амхар тілі",
+			"ar":      "Араб тілі",
+			"as":      "Ассам тілі",
+			"ay":      "Аймара тілі",
+			"az":      "Әзірбайжан тілі",
+			"ba":      "Башқұрт тілі",
+			"be":      "Беларусь тілі",
+			"bg":      "Болгар тілі",
+			"bh":      "Бходжпури тілі",
+			"bi":      "Бислама тілі",
+			"bn":      "Бенгал тілі",
+			"bo":      "Тибет тілі",
+			"br":      "Бретон тілі",
+			"bs":      "Босния тілі",
+			"ca":      "Каталан тілі",
+			"ceb":     "Себуано тілі",
+			"chr":     "Чероки тілі",
+			"co":      "Корсика тілі",
+			"crs":     "Сейшельдік креол тілі",
+			"cs":      "Чех тілі",
+			"cy":      "Валлий тілі",
+			"da":      "Дат тілі",
+			"de":      "Неміс тілі",
+			"dv":      "Дивехи тілі",
+			"dz":      "Дзонг-кэ тілі",
+			"ee":      "Эве тілі",
+			"el":      "Грек тілі",
+			"en":      "Ағылшын тілі",
+			"eo":      "Эсперанто тілі",
+			"es":      "Испан тілі",
+			"et":      "Эстон тілі",
+			"eu":      "Баск тілі",
+			"fa":      "Парсы тілі",
+			"fi":      "Фин тілі",
+			"fj":      "Фиджи тілі",
+			"fo":      "Фарер тілі",
+			"fr":      "Француз тілі",
+			"fy":      "Батыс фриз тілі",
+			"ga":      "Ирланд тілі",
+			"gaa":     "Га тілі",
+			"gd":      "Шотландиялық гэль тілі",
+			"gl":      "Галисия тілі",
+			"gn":      "Гуарани тілі",
+			"gu":      "Гуджарати тілі",
+			"gv":      "Мэн тілі",
+			"ha":      "Хауса тілі",
+			"haw":     "Гавайи тілі",
+			"hi":      "Хинди тілі",
+			"hmn":     "Хмонг тілі",
+			"hr":      "Хорват тілі",
+			"ht":      "Гаити тілі",
+			"hu":      "Венгр тілі",
+			"hy":      "Армян тілі",
+			"ia":      "Интерлингва тілі",
+			"id":      "Индонезия тілі",
+			"ie":      "Интерлингве тілі",
+			"ig":      "Игбо тілі",
+			"is":      "Исланд тілі",
+			"it":      "Итальян тілі",
+			"iu":      "Инуктитут тілі",
+			"iw":      "Иврит тілі",
+			"ja":      "Жапон тілі",
+			"jw":      "Ява тілі",
+			"ka":      "Грузин тілі",
+			"kha":     "Кхаси тілі",
+			"kk":      "Қазақ тілі",
+			"kl":      "Калаалисут тілі",
+			"km":      "Кхмер тілі",
+			"kn":      "Каннада тілі",
+			"ko":      "Корей тілі",
+			"ks":      "Кашмир тілі",
+			"ku":      "Күрд тілі",
+			"ky":      "Қырғыз тілі",
+			"la":      "Латын тілі",
+			"lb":      "Люксембург тілі",
+			"lg":      "Ганда тілі",
+			"ln":      "Лингала тілі",
+			"lo":      "Лаос тілі",
+			"loz":     "Лози тілі",
+			"lt":      "Литва тілі",
+			"lua":     "Луба-лулуа тілі",
+			"luo":     "Луо тілі",
+			"lv":      "Латыш тілі",
+			"mfe":     "Морисиен тілі",
+			"mg":      "Малагаси тілі",
+			"mi":      "Маори тілі",
+			"mk":      "Македон тілі",
+			"ml":      "Малаялам тілі",
+			"mn":      "Моңғол тілі",
+			"mr":      "Маратхи тілі",
+			"ms":      "Малай тілі",
+			"mt":      "Мальта тілі",
+			"my":      "Бирма тілі",
+			"na":      "Науру тілі",
+			"ne":      "Непал тілі",
+			"new":     "Невар тілі",
+			"nl":      "Нидерланд тілі",
+			"nn":      "Норвегиялық нюнорск тілі",
+			"no":      "Норвегиялық букмол тілі",
+			"nr":      "Оңтүстік ндебеле тілі",
+			"nso":     "Солтүстік сото тілі",
+			"ny":      "Ньянджа тілі",
+			"oc":      "Окситан тілі",
+			"om":      "Оромо тілі",
+			"or":      "Ория тілі",
+			"os":      "Осетин тілі",
+			"pa":      "Пенджаб тілі",
+			"pam":     "Пампанга тілі",
+			"pl":      "Поляк тілі",
+			"ps":      "Пушту тілі",
+			"pt":      "Португал тілі",
+			"qu":      "Кечуа тілі",
+			"rm":      "Романш тілі",
+			"rn":      "Рунди тілі",
+			"ro":      "Румын тілі",
+			"ru":      "Орыс тілі",
+			"rw":      "Киньяруанда тілі",
+			"sa":      "Санскрит тілі",
+			"sco":     "Шотланд тілі",
+			"sd":      "Синдхи тілі",
+			"sg":      "Санго тілі",
+			"si":      "Сингал тілі",
+			"sk":      "Словак тілі",
+			"sl":      "Словен тілі",
+			"sm":      "Самоа тілі",
+			"sn":      "Шона тілі",
+			"so":      "Сомали тілі",
+			"sq":      "Албан тілі",
+			"sr":      "Серб тілі",
+			"sr-ME":   "Серб тілі (Черногория)",
+			"ss":      "Свати тілі",
+			"st":      "Сесото тілі",
+			"su":      "Сундан тілі",
+			"sv":      "Швед тілі",
+			"sw":      "Суахили тілі",
+			"syr":     "Сирия тілі",
+			"ta":      "Тамил тілі",
+			"te":      "Телугу тілі",
+			"tg":      "Тәжік тілі",
+			"th":      "Тай тілі",
+			"ti":      "Тигринья тілі",
+			"tk":      "Түрікмен тілі",
+			"tl":      "Филиппин тілі",
+			"tlh":     "Клингон тілі",
+			"tn":      "Тсвана тілі",
+			"to":      "Тонган тілі",
+			"tr":      "Түрік тілі",
+			"ts":      "Тсонга тілі",
+			"tt":      "Татар тілі",
+			"tum":     "Тумбука тілі",
+			"tw":      "Акан тілі",
+			"ug":      "Ұйғыр тілі",
+			"uk":      "Украин тілі",
+			"ur":      "Урду тілі",
+			"uz":      "Өзбек тілі",
+			"ve":      "Венда тілі",
+			"vi":      "Вьетнам тілі",
+			"vo":      "Волапюк тілі",
+			"war":     "Варай тілі",
+			"wo":      "Волоф тілі",
+			"xh":      "Кхоса тілі",
+			"yi":      "Идиш тілі",
+			"yo":      "Йоруба тілі",
+			"zh":      "Қытай тілі",
+			"zh-Hant": "Дәстүрлі қытай тілі",
+			"zu":      "Зулу тілі",
+		},
+		"ko": map[string]string{
+			"aa":    "아파르어",
+			"ab":    "압카즈어",
+			"af":    "아프리칸스어",
+			"ak":    "아칸어",
+			"am":    "암하라어",
+			"ar":    "아랍어",
+			"as":    "아삼어",
+			"ay":    "아이마라어",
+			"az":    "아제르바이잔어",
+			"ba":    "바슈키르어",
+			"be":    "벨라루스어",
+			"bg":    "불가리아어",
+			"bh":    "호즈푸리어",
+			"bi":    "비슬라마어",
+			"bn":    "벵골어",
+			"bo":    "티베트어",
+			"br":    "브르타뉴어",
+			"bs":    "보스니아어",
+			"ca":    "카탈로니아어",
+			"ceb":   "세부아노어",
+			"chr":   "체로키어",
+			"co":    "코르시카어",
+			"crs":   "세이셸 크리올 프랑스어",
+			"cs":    "체코어",
+			"cy":    "웨일스어",
+			"da":    "덴마크어",
+			"de":    "독일어",
+			"dv":    "디베히어",
+			"dz":    "종카어",
+			"ee":    "에웨어",
+			"el":    "그리스어",
+			"en":    "영어",
+			"eo":    "에스페란토어",
+			"es":    "스페인어",
+			"et":    "에스토니아어",
+			"eu":    "바스크어",
+			"fa":    "페르시아어",
+			"fi":    "핀란드어",
+			"fj":    "피지어",
+			"fo":    "페로어",
+			"fr":    "프랑스어",
+			"fy":    "서부 프리지아어",
+			"ga":    "아일랜드어",
+			"gaa":   "가어",
+			"gd":    "스코틀랜드 게일어",
+			"gl":    "갈리시아어",
+			"gn":    "과라니어",
+			"gu":    "구자라트어",
+			"gv":    "맹크스어",
+			"ha":    "하우사어",
+			"haw":   "하와이어",
+			"hi":    "힌디어",
+			"hmn":   "히몸어",
+			"hr":    "크로아티아어",
+			"ht":    "아이티어",
+			"hu":    "헝가리어",
+			"hy":    "아르메니아어",
+			"ia":    "인터링구아",
+			"id":    "인도네시아어",
+			"ie":    "인테르링구에",
+			"ig":    "이그보어",
+			"ik":    "이누피아크어",
+			"is":    "아이슬란드어",
+			"it":    "이탈리아어",
+			"iu":    "이눅티투트어",
+			"iw":    "히브리어",
+			"ja":    "일본어",
+			"jw":    "자바어",
+			"ka":    "조지아어",
+			"kha":   "카시어",
+			"kk":    "카자흐어",
+			"kl":    "그린란드어",
+			"km":    "크메르어",
+			"kn":    "칸나다어",
+			"ko":    "한국어",
+			"ks":    "카슈미르어",
+			"ku":    "쿠르드어",
+			"ky":    "키르기스어",
+			"la":    "라틴어",
+			"lb":    "룩셈부르크어",
+			"lg":    "간다어",
+			"ln":    "링갈라어",
+			"lo":    "라오어",
+			"loz":   "로지어",
+			"lt":    "리투아니아어",
+			"lua":   "루바-룰루아어",
+			"luo":   "루오어",
+			"lv":    "라트비아어",
+			"mfe":   "모리스얀어",
+			"mg":    "말라가시어",
+			"mi":    "마오리어",
+			"mk":    "마케도니아어",
+			"ml":    "말라얄람어",
+			"mn":    "몽골어",
+			"mr":    "마라티어",
+			"ms":    "말레이어",
+			"mt":    "몰타어",
+			"my":    "버마어",
+			"na":    "나우루어",
+			"ne":    "네팔어",
+			"new":   "네와르어",
+			"nl":    "네덜란드어",
+			"nn":    "노르웨이어(니노르스크)",
+			"no":    "노르웨이어(보크말)",
+			"nr":    "남부 은데벨레어",
+			"nso":   "북부 소토어",
+			"ny":    "냔자어",
+			"oc":    "오크어",
+			"om":    "오로모어",
+			"or":    "오리야어",
+			"os":    "오세트어",
+			"pa":    "펀잡어",
+			"pam":   "팜팡가어",
+			"pl":    "폴란드어",
+			"ps":    "파슈토어",
+			"pt":    "포르투갈어",
+			"qu":    "케추아어",
+			"raj":   "라자스탄어",
+			"rm":    "로만시어",
+			"rn":    "룬디어",
+			"ro":    "루마니아어",
+			"ru":    "러시아어",
+			"rw":    "르완다어",
+			"sa":    "산스크리트어",
+			"sco":   "스코틀랜드어",
+			"sd":    "신디어",
+			"sg":    "산고어",
+			"si":    "스리랑카어",
+			"sk":    "슬로바키아어",
+			"sl":    "슬로베니아어",
+			"sm":    "사모아어",
+			"sn":    "쇼나어",
+			"so":    "소말리아어",
+			"sq":    "알바니아어",
+			"sr":    "세르비아어",
+			"sr-ME": "세르비아어 (몬테네그로)",
+			"ss":    "시스와티어",
+			"st":    "남부 소토어",
+			"su":    "순다어",
+			"sv":    "스웨덴어",
+			"sw":    "스와힐리어",
+			"syr":   "시리아어",
+			"ta":    "타밀어",
+			"te":    "텔루구어",
+			"tg":    "타지크어",
+			"th":    "태국어",
+			"ti":    "티그리냐어",
+			"tk":    "투르크멘어",
+			"tl":    "필리핀어",
+			"tlh":   "클링온어",
+			"tn":    "츠와나어",
+			"to":    "통가어",
+			"tr":    "터키어",
+			"ts":    "총가어",
+			"tt":    "타타르어",
+			"tum":   "툼부카어",
+			"tw":    "아칸어",
+			"ug":    "위구르어",
+			"uk":    "우크라이나어",
+			"ur":    "우르두어",
+			"uz":    "우즈베크어",
+			"ve":    "벤다어",
+			"vi":    "베트남어",
+			"vo":    "볼라퓌크어",
+			"war":   "와라이어",
+			"wo":    "월로프어",
+			"xh":    "코사어",
+			"yi":    "이디시어",
+			"yo":    "요루바어",
+			"za":    "주앙어",
+			"zh":    "중국어",
+			"zu":    "줄루어",
+		},
+		"pl": map[string]string{
+			"aa":      "Afar",
+			"ab":      "Abchaski",
+			"af":      "Afrikaans",
+			"ak":      "Akan",
+			"am":      "Amharski",
+			"ar":      "Arabski",
+			"as":      "Asamski",
+			"ay":      "Ajmara",
+			"az":      "Azerbejdżański",
+			"ba":      "Baszkirski",
+			"be":      "Białoruski",
+			"bg":      "Bułgarski",
+			"bh":      "Bhodźpuri",
+			"bi":      "Bislama",
+			"bn":      "Bengalski",
+			"bo":      "Tybetański",
+			"br":      "Bretoński",
+			"bs":      "Bośniacki",
+			"ca":      "Kataloński",
+			"ceb":     "Cebuano",
+			"chr":     "Czirokeski",
+			"co":      "Korsykański",
+			"crs":     "Kreolski seszelski",
+			"cs":      "Czeski",
+			"cy":      "Walijski",
+			"da":      "Duński",
+			"de":      "Niemiecki",
+			"dv":      "Malediwski",
+			"dz":      "Dzongkha",
+			"ee":      "Ewe",
+			"el":      "Grecki",
+			"en":      "Angielski",
+			"eo":      "Esperanto",
+			"es":      "Hiszpański",
+			"et":      "Estoński",
+			"eu":      "Baskijski",
+			"fa":      "Perski",
+			"fi":      "Fiński",
+			"fj":      "Fidżijski",
+			"fo":      "Farerski",
+			"fr":      "Francuski",
+			"fy":      "Zachodniofryzyjski",
+			"ga":      "Irlandzki",
+			"gaa":     "Ga",
+			"gd":      "Szkocki gaelicki",
+			"gl":      "Galicyjski",
+			"gn":      "Guarani",
+			"gu":      "Gudżarati",
+			"gv":      "Manx",
+			"ha":      "Hausa",
+			"haw":     "Hawajski",
+			"hi":      "Hindi",
+			"hmn":     "Hmong",
+			"hr":      "Chorwacki",
+			"ht":      "Kreolski haitański",
+			"hu":      "Węgierski",
+			"hy":      "Ormiański",
+			"ia":      "Interlingua",
+			"id":      "Indonezyjski",
+			"ie":      "Interlingue",
+			"ig":      "Igbo",
+			"ik":      "Inupiak",
+			"is":      "Islandzki",
+			"it":      "Włoski",
+			"iu":      "Inuktitut",
+			"iw":      "Hebrajski",
+			"ja":      "Japoński",
+			"jw":      "Jawajski",
+			"ka":      "Gruziński",
+			"kha":     "Khasi",
+			"kk":      "Kazachski",
+			"kl":      "Grenlandzki",
+			"km":      "Khmerski",
+			"kn":      "Kannada",
+			"ko":      "Koreański",
+			"kri":     "Krio",
+			"ks":      "Kaszmirski",
+			"ku":      "Kurdyjski",
+			"ky":      "Kirgiski",
+			"la":      "Łaciński",
+			"lb":      "Luksemburski",
+			"lg":      "Ganda",
+			"ln":      "Lingala",
+			"lo":      "Laotański",
+			"loz":     "Lozi",
+			"lt":      "Litewski",
+			"lua":     "Luba-lulua",
+			"luo":     "Luo",
+			"lv":      "Łotewski",
+			"mfe":     "Kreolski Mauritiusa",
+			"mg":      "Malgaski",
+			"mi":      "Maoryjski",
+			"mk":      "Macedoński",
+			"ml":      "Malajalam",
+			"mn":      "Mongolski",
+			"mr":      "Marathi",
+			"ms":      "Malajski",
+			"mt":      "Maltański",
+			"my":      "Birmański",
+			"na":      "Nauru",
+			"ne":      "Nepalski",
+			"new":     "Newarski",
+			"nl":      "Niderlandzki",
+			"nn":      "Norweski (nynorsk)",
+			"no":      "Norweski (bokmål)",
+			"nr":      "Ndebele południowy",
+			"nso":     "Sotho północny",
+			"ny":      "Njandża",
+			"oc":      "Oksytański",
+			"om":      "Oromo",
+			"or":      "Orija",
+			"os":      "Osetyjski",
+			"pa":      "Pendżabski",
+			"pam":     "Pampango",
+			"pl":      "Polski",
+			"ps":      "Paszto",
+			"pt":      "Portugalski",
+			"qu":      "Keczua",
+			"raj":     "Radźasthani",
+			"rm":      "Retoromański",
+			"rn":      "Rundi",
+			"ro":      "Rumuński",
+			"ru":      "Rosyjski",
+			"rw":      "Kinya-ruanda",
+			"sa":      "Sanskryt",
+			"sco":     "Scots",
+			"sd":      "Sindhi",
+			"sg":      "Sango",
+			"si":      "Syngaleski",
+			"sk":      "Słowacki",
+			"sl":      "Słoweński",
+			"sm":      "Samoański",
+			"sn":      "Shona",
+			"so":      "Somalijski",
+			"sq":      "Albański",
+			"sr":      "Serbski",
+			"sr-ME":   "Serbski (Czarnogóra)",
+			"ss":      "Suazi",
+			"st":      "Sotho południowy",
+			"su":      "Sundajski",
+			"sv":      "Szwedzki",
+			"sw":      "Suahili",
+			"syr":     "Syryjski",
+			"ta":      "Tamilski",
+			"te":      "Telugu",
+			"tg":      "Tadżycki",
+			"th":      "Tajski",
+			"ti":      "Tigrinia",
+			"tk":      "Turkmeński",
+			"tl":      "Filipino",
+			"tlh":     "Klingoński",
+			"tn":      "Setswana",
+			"to":      "Tonga",
+			"tr":      "Turecki",
+			"ts":      "Tsonga",
+			"tt":      "Tatarski",
+			"tum":     "Tumbuka",
+			"tw":      "Akan",
+			"ug":      "Ujgurski",
+			"uk":      "Ukraiński",
+			"ur":      "Urdu",
+			"uz":      "Uzbecki",
+			"ve":      "Venda",
+			"vi":      "Wietnamski",
+			"vo":      "Wolapik",
+			"war":     "Waraj",
+			"wo":      "Wolof",
+			"xh":      "Khosa",
+			"yi":      "Jidysz",
+			"yo":      "Joruba",
+			"za":      "Czuang",
+			"zh":      "Chiński",
+			"zh-Hant": "Chiński tradycyjny",
+			"zu":      "Zulu",
+		},
+		"pt": map[string]string{
+			"aa":      "Afar",
+			"ab":      "Abcázio",
+			"af":      "Africâner",
+			"ak":      "Akan",
+			"am":      "Amárico",
+			"ar":      "Árabe",
+			"as":      "Assamês",
+			"ay":      "Aimará",
+			"az":      "Azerbaijano",
+			"ba":      "Bashkir",
+			"be":      "Bielorrusso",
+			"bg":      "Búlgaro",
+			"bh":      "Bhojpuri",
+			"bi":      "Bislamá",
+			"bn":      "Bengali",
+			"bo":      "Tibetano",
+			"br":      "Bretão",
+			"bs":      "Bósnio",
+			"ca":      "Catalão",
+			"ceb":     "Cebuano",
+			"chr":     "Cherokee",
+			"co":      "Corso",
+			"crs":     "Crioulo francês seichelense",
+			"cs":      "Tcheco",
+			"cy":      "Galês",
+			"da":      "Dinamarquês",
+			"de":      "Alemão",
+			"dv":      "Divehi",
+			"dz":      "Dzonga",
+			"ee":      "Eve",
+			"el":      "Grego",
+			"en":      "Inglês",
+			"eo":      "Esperanto",
+			"es":      "Espanhol",
+			"et":      "Estoniano",
+			"eu":      "Basco",
+			"fa":      "Persa",
+			"fi":      "Finlandês",
+			"fj":      "Fijiano",
+			"fo":      "Feroês",
+			"fr":      "Francês",
+			"fy":      "Frísio ocidental",
+			"ga":      "Irlandês",
+			"gaa":     "Ga",
+			"gd":      "Gaélico escocês",
+			"gl":      "Galego",
+			"gn":      "Guarani",
+			"gu":      "Guzerate",
+			"gv":      "Manx",
+			"ha":      "Hauçá",
+			"haw":     "Havaiano",
+			"hi":      "Híndi",
+			"hmn":     "Hmong",
+			"hr":      "Croata",
+			"ht":      "Haitiano",
+			"hu":      "Húngaro",
+			"hy":      "Armênio",
+			"ia":      "Interlíngua",
+			"id":      "Indonésio",
+			"ie":      "Interlingue",
+			"ig":      "Igbo",
+			"ik":      "Inupiaque",
+			"is":      "Islandês",
+			"it":      "Italiano",
+			"iu":      "Inuktitut",
+			"iw":      "Hebraico",
+			"ja":      "Japonês",
+			"jw":      "Javanês",
+			"ka":      "Georgiano",
+			"kha":     "Khasi",
+			"kk":      "Cazaque",
+			"kl":      "Groenlandês",
+			"km":      "Khmer",
+			"kn":      "Canarim",
+			"ko":      "Coreano",
+			"ks":      "Caxemira",
+			"ku":      "Curdo",
+			"ky":      "Quirguiz",
+			"la":      "Latim",
+			"lb":      "Luxemburguês",
+			"lg":      "Luganda",
+			"ln":      "Lingala",
+			"lo":      "Laosiano",
+			"loz":     "Lozi",
+			"lt":      "Lituano",
+			"lua":     "Luba-lulua",
+			"luo":     "Luo",
+			"lv":      "Letão",
+			"mfe":     "Morisyen",
+			"mg":      "Malgaxe",
+			"mi":      "Maori",
+			"mk":      "Macedônio",
+			"ml":      "Malaiala",
+			"mn":      "Mongol",
+			"mr":      "Marati",
+			"ms":      "Malaio",
+			"mt":      "Maltês",
+			"my":      "Birmanês",
+			"na":      "Nauruano",
+			"ne":      "Nepalês",
+			"new":     "Newari",
+			"nl":      "Holandês",
+			"nn":      "Nynorsk norueguês",
+			"no":      "Bokmål norueguês",
+			"nr":      "Ndebele do sul",
+			"nso":     "Soto setentrional",
+			"ny":      "Nianja",
+			"oc":      "Occitânico",
+			"om":      "Oromo",
+			"or":      "Oriá",
+			"os":      "Osseto",
+			"pa":      "Panjabi",
+			"pam":     "Pampanga",
+			"pl":      "Polonês",
+			"ps":      "Pashto",
+			"pt":      "Português",
+			"qu":      "Quíchua",
+			"raj":     "Rajastani",
+			"rm":      "Romanche",
+			"rn":      "Rundi",
+			"ro":      "Romeno",
+			"ru":      "Russo",
+			"rw":      "Quiniaruanda",
+			"sa":      "Sânscrito",
+			"sco":     "Scots",
+			"sd":      "Sindi",
+			"sg":      "Sango",
+			"si":      "Cingalês",
+			"sk":      "Eslovaco",
+			"sl":      "Esloveno",
+			"sm":      "Samoano",
+			"sn":      "Xona",
+			"so":      "Somali",
+			"sq":      "Albanês",
+			"sr":      "Sérvio",
+			"sr-ME":   "Sérvio (Montenegro)",
+			"ss":      "Suázi",
+			"st":      "Soto do sul",
+			"su":      "Sundanês",
+			"sv":      "Sueco",
+			"sw":      "Suaíli",
+			"syr":     "Siríaco",
+			"ta":      "Tâmil",
+			"te":      "Télugo",
+			"tg":      "Tadjique",
+			"th":      "Tailandês",
+			"ti":      "Tigrínia",
+			"tk":      "Turcomeno",
+			"tl":      "Filipino",
+			"tlh":     "Klingon",
+			"tn":      "Tswana",
+			"to":      "Tonganês",
+			"tr":      "Turco",
+			"ts":      "Tsonga",
+			"tt":      "Tártaro",
+			"tum":     "Tumbuka",
+			"tw":      "Akan",
+			"ug":      "Uigur",
+			"uk":      "Ucraniano",
+			"ur":      "Urdu",
+			"uz":      "Uzbeque",
+			"ve":      "Venda",
+			"vi":      "Vietnamita",
+			"vo":      "Volapuque",
+			"war":     "Waray",
+			"wo":      "Uolofe",
+			"xh":      "Xhosa",
+			"yi":      "Iídiche",
+			"yo":      "Iorubá",
+			"za":      "Zhuang",
+			"zh":      "Chinês",
+			"zh-Hant": "Chinês tradicional",
+			"zu":      "Zulu",
+		},
+		"ru": map[string]string{
+			"aa":      "Афарский",
+			"ab":      "Абхазский",
+			"af":      "Африкаанс",
+			"ak":      "Акан",
+			"am":      "Амхарский",
+			"ar":      "Арабский",
+			"as":      "Ассамский",
+			"ay":      "Аймара",
+			"az":      "Азербайджанский",
+			"ba":      "Башкирский",
+			"be":      "Белорусский",
+			"bg":      "Болгарский",
+			"bh":      "Бходжпури",
+			"bi":      "Бислама",
+			"bn":      "Бенгальский",
+			"bo":      "Тибетский",
+			"br":      "Бретонский",
+			"bs":      "Боснийский",
+			"ca":      "Каталанский",
+			"ceb":     "Себуано",
+			"chr":     "Чероки",
+			"co":      "Корсиканский",
+			"crs":     "Сейшельский креольский",
+			"cs":      "Чешский",
+			"cy":      "Валлийский",
+			"da":      "Датский",
+			"de":      "Немецкий",
+			"dv":      "Мальдивский",
+			"dz":      "Дзонг-кэ",
+			"ee":      "Эве",
+			"el":      "Греческий",
+			"en":      "Английский",
+			"eo":      "Эсперанто",
+			"es":      "Испанский",
+			"et":      "Эстонский",
+			"eu":      "Баскский",
+			"fa":      "Персидский",
+			"fi":      "Финский",
+			"fj":      "Фиджи",
+			"fo":      "Фарерский",
+			"fr":      "Французский",
+			"fy":      "Западнофризский",
+			"ga":      "Ирландский",
+			"gaa":     "Га",
+			"gd":      "Гэльский",
+			"gl":      "Галисийский",
+			"gn":      "Гуарани",
+			"gu":      "Гуджарати",
+			"gv":      "Мэнский",
+			"ha":      "Хауса",
+			"haw":     "Гавайский",
+			"hi":      "Хинди",
+			"hmn":     "Хмонг",
+			"hr":      "Хорватский",
+			"ht":      "Гаитянский",
+			"hu":      "Венгерский",
+			"hy":      "Армянский",
+			"ia":      "Интерлингва",
+			"id":      "Индонезийский",
+			"ie":      "Интерлингве",
+			"ig":      "Игбо",
+			"ik":      "Инупиак",
+			"is":      "Исландский",
+			"it":      "Итальянский",
+			"iu":      "Инуктитут",
+			"iw":      "Иврит",
+			"ja":      "Японский",
+			"jw":      "Яванский",
+			"ka":      "Грузинский",
+			"kha":     "Кхаси",
+			"kk":      "Казахский",
+			"kl":      "Гренландский",
+			"km":      "Кхмерский",
+			"kn":      "Каннада",
+			"ko":      "Корейский",
+			"ks":      "Кашмири",
+			"ku":      "Курдский",
+			"ky":      "Киргизский",
+			"la":      "Латинский",
+			"lb":      "Люксембургский",
+			"lg":      "Ганда",
+			"ln":      "Лингала",
+			"lo":      "Лаосский",
+			"loz":     "Лози",
+			"lt":      "Литовский",
+			"lua":     "Луба-лулуа",
+			"luo":     "Луо",
+			"lv":      "Латышский",
+			"mfe":     "Маврикийский креольский",
+			"mg":      "Малагасийский",
+			"mi":      "Маори",
+			"mk":      "Македонский",
+			"ml":      "Малаялам",
+			"mn":      "Монгольский",
+			"mr":      "Маратхи",
+			"ms":      "Малайский",
+			"mt":      "Мальтийский",
+			"my":      "Бирманский",
+			"na":      "Науру",
+			"ne":      "Непальский",
+			"new":     "Неварский",
+			"nl":      "Нидерландский",
+			"nn":      "Нюнорск",
+			"no":      "Норвежский букмол",
+			"nr":      "Южный ндебеле",
+			"nso":     "Северный сото",
+			"ny":      "Ньянджа",
+			"oc":      "Окситанский",
+			"om":      "Оромо",
+			"or":      "Ория",
+			"os":      "Осетинский",
+			"pa":      "Панджаби",
+			"pam":     "Пампанга",
+			"pl":      "Польский",
+			"ps":      "Пушту",
+			"pt":      "Португальский",
+			"qu":      "Кечуа",
+			"raj":     "Раджастхани",
+			"rm":      "Романшский",
+			"rn":      "Рунди",
+			"ro":      "Румынский",
+			"ru":      "Русский",
+			"rw":      "Киньяруанда",
+			"sa":      "Санскрит",
+			"sco":     "Шотландский",
+			"sd":      "Синдхи",
+			"sg":      "Санго",
+			"si":      "Сингальский",
+			"sk":      "Словацкий",
+			"sl":      "Словенский",
+			"sm":      "Самоанский",
+			"sn":      "Шона",
+			"so":      "Сомали",
+			"sq":      "Албанский",
+			"sr":      "Сербский",
+			"sr-ME":   "Сербский (Черногория)",
+			"ss":      "Свази",
+			"st":      "Южный сото",
+			"su":      "Сунданский",
+			"sv":      "Шведский",
+			"sw":      "Суахили",
+			"syr":     "Сирийский",
+			"ta":      "Тамильский",
+			"te":      "Телугу",
+			"tg":      "Таджикский",
+			"th":      "Тайский",
+			"ti":      "Тигринья",
+			"tk":      "Туркменский",
+			"tl":      "Филиппинский",
+			"tlh":     "Клингонский",
+			"tn":      "Тсвана",
+			"to":      "Тонганский",
+			"tr":      "Турецкий",
+			"ts":      "Тсонга",
+			"tt":      "Татарский",
+			"tum":     "Тумбука",
+			"tw":      "Акан",
+			"ug":      "Уйгурский",
+			"uk":      "Украинский",
+			"ur":      "Урду",
+			"uz":      "Узбекский",
+			"ve":      "Венда",
+			"vi":      "Вьетнамский",
+			"vo":      "Волапюк",
+			"war":     "Варай",
+			"wo":      "Волоф",
+			"xh":      "Коса",
+			"yi":      "Идиш",
+			"yo":      "Йоруба",
+			"za":      "Чжуань",
+			"zh":      "Китайский",
+			"zh-Hant": "Китайский, традиционное письмо",
+			"zu":      "Зулу",
+		},
+		"tr": map[string]string{
+			"aa":      "Afar",
+			"ab":      "Abhazca",
+			"af":      "Afrikaanca",
+			"ak":      "Akan",
+			"am":      "Amharca",
+			"ar":      "Arapça",
+			"as":      "Assamca",
+			"ay":      "Aymara",
+			"az":      "Azerice",
+			"ba":      "Başkırtça",
+			"be":      "Belarusça",
+			"bg":      "Bulgarca",
+			"bh":      "Arayanice",
+			"bi":      "Bislama",
+			"bn":      "Bengalce",
+			"bo":      "Tibetçe",
+			"br":      "Bretonca",
+			"bs":      "Boşnakça",
+			"ca":      "Katalanca",
+			"ceb":     "Sebuano dili",
+			"chr":     "Çerokice",
+			"co":      "Korsikaca",
+			"crs":     "Seselwa Kreole Fransızcası",
+			"cs":      "Çekçe",
+			"cy":      "Galce",
+			"da":      "Danca",
+			"de":      "Almanca",
+			"dv":      "Divehi dili",
+			"dz":      "Dzongkha",
+			"ee":      "Ewe",
+			"el":      "Yunanca",
+			"en":      "İngilizce",
+			"eo":      "Esperanto",
+			"es":      "İspanyolca",
+			"et":      "Estonca",
+			"eu":      "Baskça",
+			"fa":      "Farsça",
+			"fi":      "Fince",
+			"fj":      "Fiji Dili",
+			"fo":      "Faroe Dili",
+			"fr":      "Fransızca",
+			"fy":      "Batı Frizcesi",
+			"ga":      "İrlandaca",
+			"gaa":     "Ga dili",
+			"gd":      "İskoç Gaelcesi",
+			"gl":      "Galiçyaca",
+			"gn":      "Guarani dili",
+			"gu":      "Güceratça",
+			"gv":      "Man dili",
+			"ha":      "Hausa dili",
+			"haw":     "Hawaii dili",
+			"hi":      "Hintçe",
+			"hmn":     "Hmong",
+			"hr":      "Hırvatça",
+			"ht":      "Haiti Kreyolu",
+			"hu":      "Macarca",
+			"hy":      "Ermenice",
+			"ia":      "Interlingua",
+			"id":      "Endonezce",
+			"ie":      "Interlingue",
+			"ig":      "İbo dili",
+			"ik":      "İnyupikçe",
+			"is":      "İzlandaca",
+			"it":      "İtalyanca",
+			"iu":      "İnuktitut dili",
+			"iw":      "İbranice",
+			"ja":      "Japonca",
+			"jw":      "Cava Dili",
+			"ka":      "Gürcüce",
+			"kha":     "Khasi dili",
+			"kk":      "Kazakça",
+			"kl":      "Grönland dili",
+			"km":      "Khmer dili",
+			"kn":      "Kannada dili",
+			"ko":      "Korece",
+			"kri":     "Krio",
+			"ks":      "Keşmir dili",
+			"ku":      "Kürtçe",
+			"ky":      "Kırgızca",
+			"la":      "Latince",
+			"lb":      "Lüksemburgca",
+			"lg":      "Ganda",
+			"ln":      "Lingala",
+			"lo":      "Lao dili",
+			"loz":     "Lozi",
+			"lt":      "Litvanca",
+			"lua":     "Luba-Lulua",
+			"luo":     "Luo",
+			"lv":      "Letonca",
+			"mfe":     "Morisyen",
+			"mg":      "Malgaşça",
+			"mi":      "Maori dili",
+			"mk":      "Makedonca",
+			"ml":      "Malayalam dili",
+			"mn":      "Moğolca",
+			"mr":      "Marathi dili",
+			"ms":      "Malayca",
+			"mt":      "Maltaca",
+			"my":      "Birman dili",
+			"na":      "Nauru dili",
+			"ne":      "Nepalce",
+			"new":     "Nevari",
+			"nl":      "Felemenkçe",
+			"nn":      "Norveççe Nynorsk",
+			"no":      "Norveççe Bokmål",
+			"nr":      "Güney Ndebele",
+			"nso":     "Kuzey Sotho dili",
+			"ny":      "Nyanja",
+			"oc":      "Oksitan dili",
+			"om":      "Oromo dili",
+			"or":      "Oriya Dili",
+			"os":      "Osetçe",
+			"pa":      "Pencapça",
+			"pam":     "Pampanga",
+			"pl":      "Lehçe",
+			"ps":      "Peştuca",
+			"pt":      "Portekizce",
+			"qu":      "Keçuva dili",
+			"raj":     "Rajasthani",
+			"rm":      "Romanşça",
+			"rn":      "Kirundi",
+			"ro":      "Rumence",
+			"ru":      "Rusça",
+			"rw":      "Kinyarwanda",
+			"sa":      "Sanskrit",
+			"sco":     "İskoçça",
+			"sd":      "Sindhi dili",
+			"sg":      "Sango",
+			"si":      "Sinhali dili",
+			"sk":      "Slovakça",
+			"sl":      "Slovence",
+			"sm":      "Samoa dili",
+			"sn":      "Shona",
+			"so":      "Somalice",
+			"sq":      "Arnavutça",
+			"sr":      "Sırpça",
+			"sr-ME":   "Sırpça (Karadağ)",
+			"ss":      "Sisvati",
+			"st":      "Güney Sotho dili",
+			"su":      "Sunda Dili",
+			"sv":      "İsveççe",
+			"sw":      "Svahili dili",
+			"syr":     "Süryanice",
+			"ta":      "Tamilce",
+			"te":      "Telugu dili",
+			"tg":      "Tacikçe",
+			"th":      "Tayca",
+			"ti":      "Tigrinya dili",
+			"tk":      "Türkmence",
+			"tl":      "Filipince",
+			"tlh":     "Klingonca",
+			"tn":      "Setsvana",
+			"to":      "Tonga dili",
+			"tr":      "Türkçe",
+			"ts":      "Tsonga",
+			"tt":      "Tatarca",
+			"tum":     "Tumbuka",
+			"tw":      "Akan",
+			"ug":      "Uygurca",
+			"uk":      "Ukraynaca",
+			"ur":      "Urduca",
+			"uz":      "Özbekçe",
+			"ve":      "Venda dili",
+			"vi":      "Vietnamca",
+			"vo":      "Volapük",
+			"war":     "Varay",
+			"wo":      "Volofça",
+			"xh":      "Zosa dili",
+			"yi":      "Yidiş",
+			"yo":      "Yorubaca",
+			"za":      "Zhuangca",
+			"zh":      "Çince",
+			"zh-Hant": "Geleneksel Çince",
+			"zu":      "Zuluca",
+		},
+		"uk": map[string]string{
+			"aa":      "Афарська",
+			"ab":      "Абхазька",
+			"af":      "Африкаанс",
+			"ak":      "Акан",
+			"am":      "Амхарська",
+			"ar":      "Арабська",
+			"as":      "Ассамська",
+			"ay":      "Аймара",
+			"az":      "Азербайджанська",
+			"ba":      "Башкирська",
+			"be":      "Білоруська",
+			"bg":      "Болгарська",
+			"bh":      "Бходжпурі",
+			"bi":      "Біслама",
+			"bn":      "Банґла",
+			"bo":      "Тибетська",
+			"br":      "Бретонська",
+			"bs":      "Боснійська",
+			"ca":      "Каталонська",
+			"ceb":     "Себуанська",
+			"chr":     "Черокі",
+			"co":      "Корсиканська",
+			"crs":     "Сейшельська креольська",
+			"cs":      "Чеська",
+			"cy":      "Валлійська",
+			"da":      "Данська",
+			"de":      "Німецька",
+			"dv":      "Дівехі",
+			"dz":      "Дзонг-ке",
+			"ee":      "Еве",
+			"el":      "Грецька",
+			"en":      "Англійська",
+			"eo":      "Есперанто",
+			"es":      "Іспанська",
+			"et":      "Естонська",
+			"eu":      "Баскська",
+			"fa":      "Перська",
+			"fi":      "Фінська",
+			"fj":      "Фіджі",
+			"fo":      "Фарерська",
+			"fr":      "Французька",
+			"fy":      "Західнофризька",
+			"ga":      "Ірландська",
+			"gaa":     "Га",
+			"gd":      "Гаельська",
+			"gl":      "Галісійська",
+			"gn":      "Гуарані",
+			"gu":      "Гуджараті",
+			"gv":      "Менкська",
+			"ha":      "Хауса",
+			"haw":     "Гавайська",
+			"hi":      "Гінді",
+			"hmn":     "Хмонг",
+			"hr":      "Хорватська",
+			"ht":      "Гаїтянська",
+			"hu":      "Угорська",
+			"hy":      "Вірменська",
+			"ia":      "Інтерлінгва",
+			"id":      "Індонезійська",
+			"ie":      "Інтерлінгве",
+			"ig":      "Ігбо",
+			"ik":      "Інупіак",
+			"is":      "Ісландська",
+			"it":      "Італійська",
+			"iu":      "Інуктітут",
+			"iw":      "Іврит",
+			"ja":      "Японська",
+			"jw":      "Яванська",
+			"ka":      "Грузинська",
+			"kha":     "Кхасі",
+			"kk":      "Казахська",
+			"kl":      "Калааллісут",
+			"km":      "Кхмерська",
+			"kn":      "Каннада",
+			"ko":      "Корейська",
+			"ks":      "Кашмірська",
+			"ku":      "Курдська",
+			"ky":      "Киргизька",
+			"la":      "Латинська",
+			"lb":      "Люксембурзька",
+			"lg":      "Ганда",
+			"ln":      "Лінгала",
+			"lo":      "Лаоська",
+			"loz":     "Лозі",
+			"lt":      "Литовська",
+			"lua":     "Луба-лулуа",
+			"luo":     "Луо",
+			"lv":      "Латвійська",
+			"mfe":     "Маврикійська креольська",
+			"mg":      "Малагасійська",
+			"mi":      "Маорі",
+			"mk":      "Македонська",
+			"ml":      "Малаялам",
+			"mn":      "Монгольська",
+			"mr":      "Маратхі",
+			"ms":      "Малайська",
+			"mt":      "Мальтійська",
+			"my":      "Бірманська",
+			"na":      "Науру",
+			"ne":      "Непальська",
+			"new":     "Неварі",
+			"nl":      "Нідерландська",
+			"nn":      "Норвезька (нюношк)",
+			"no":      "Норвезька (букмол)",
+			"nr":      "Ндебелє південна",
+			"nso":     "Північна сото",
+			"ny":      "Ньянджа",
+			"oc":      "Окситанська",
+			"om":      "Оромо",
+			"or":      "Одія",
+			"os":      "Осетинська",
+			"pa":      "Панджабі",
+			"pam":     "Пампанга",
+			"pl":      "Польська",
+			"ps":      "Пушту",
+			"pt":      "Портуґальська",
+			"qu":      "Кечуа",
+			"raj":     "Раджастхані",
+			"rm":      "Ретороманська",
+			"rn":      "Рунді",
+			"ro":      "Румунська",
+			"ru":      "Російська",
+			"rw":      "Кіньяруанда",
+			"sa":      "Санскрит",
+			"sco":     "Шотландська",
+			"sd":      "Сіндхі",
+			"sg":      "Санго",
+			"si":      "Сингальська",
+			"sk":      "Словацька",
+			"sl":      "Словенська",
+			"sm":      "Самоанська",
+			"sn":      "Шона",
+			"so":      "Сомалі",
+			"sq":      "Албанська",
+			"sr":      "Сербська",
+			"sr-ME":   "Сербська (Чорногорія)",
+			"ss":      "Сісваті",
+			"st":      "Сото південна",
+			"su":      "Сунданська",
+			"sv":      "Шведська",
+			"sw":      "Суахілі",
+			"syr":     "Сирійська",
+			"ta":      "Тамільська",
+			"te":      "Телугу",
+			"tg":      "Таджицька",
+			"th":      "Тайська",
+			"ti":      "Тигринья",
+			"tk":      "Туркменська",
+			"tl":      "Філіппінська",
+			"tlh":     "Клінгонська",
+			"tn":      "Тсвана",
+			"to":      "Тонґанська",
+			"tr":      "Турецька",
+			"ts":      "Тсонга",
+			"tt":      "Татарська",
+			"tum":     "Тумбука",
+			"tw":      "Акан",
+			"ug":      "Уйгурська",
+			"uk":      "Українська",
+			"ur":      "Урду",
+			"uz":      "Узбецька",
+			"ve":      "Венда",
+			"vi":      "Вʼєтнамська",
+			"vo":      "Волапʼюк",
+			"war":     "Варай",
+			"wo":      "Волоф",
+			"xh":      "Кхоса",
+			"yi":      "Їдиш",
+			"yo":      "Йоруба",
+			"za":      "Чжуан",
+			"zh":      "Китайська",
+			"zh-Hant": "Китайська (традиційне письмо)",
+			"zu":      "Зулуська",
+		},
+		"zh": map[string]string{
+			"aa":      "阿法尔语",
+			"ab":      "阿布哈西亚语",
+			"af":      "南非荷兰语",
+			"ak":      "阿肯语",
+			"am":      "阿姆哈拉语",
+			"ar":      "阿拉伯语",
+			"as":      "阿萨姆语",
+			"ay":      "艾马拉语",
+			"az":      "阿塞拜疆语",
+			"ba":      "巴什基尔语",
+			"be":      "白俄罗斯语",
+			"bg":      "保加利亚语",
+			"bh":      "博杰普尔语",
+			"bi":      "比斯拉马语",
+			"bn":      "孟加拉语",
+			"bo":      "藏语",
+			"br":      "布列塔尼语",
+			"bs":      "波斯尼亚语",
+			"ca":      "加泰罗尼亚语",
+			"ceb":     "宿务语",
+			"chr":     "切罗基语",
+			"co":      "科西嘉语",
+			"crs":     "塞舌尔克里奥尔语",
+			"cs":      "捷克语",
+			"cy":      "威尔士语",
+			"da":      "丹麦语",
+			"de":      "德语",
+			"dv":      "迪维西语",
+			"dz":      "宗卡语",
+			"ee":      "埃维语",
+			"el":      "希腊语",
+			"en":      "英语",
+			"eo":      "世界语",
+			"es":      "西班牙语",
+			"et":      "爱沙尼亚语",
+			"eu":      "巴斯克语",
+			"fa":      "波斯语",
+			"fi":      "芬兰语",
+			"fj":      "斐济语",
+			"fo":      "法罗语",
+			"fr":      "法语",
+			"fy":      "西弗里西亚语",
+			"ga":      "爱尔兰语",
+			"gaa":     "加族语",
+			"gd":      "苏格兰盖尔语",
+			"gl":      "加利西亚语",
+			"gn":      "瓜拉尼语",
+			"gu":      "古吉拉特语",
+			"gv":      "马恩语",
+			"ha":      "豪萨语",
+			"haw":     "夏威夷语",
+			"hi":      "印地语",
+			"hmn":     "苗语",
+			"hr":      "克罗地亚语",
+			"ht":      "海地克里奥尔语",
+			"hu":      "匈牙利语",
+			"hy":      "亚美尼亚语",
+			"ia":      "国际语",
+			"id":      "印度尼西亚语",
+			"ie":      "国际文字（E）",
+			"ig":      "伊博语",
+			"ik":      "伊努皮克语",
+			"is":      "冰岛语",
+			"it":      "意大利语",
+			"iu":      "因纽特语",
+			"iw":      "希伯来语",
+			"ja":      "日语",
+			"jw":      "爪哇语",
+			"ka":      "格鲁吉亚语",
+			"kha":     "卡西语",
+			"kk":      "哈萨克语",
+			"kl":      "格陵兰语",
+			"km":      "高棉语",
+			"kn":      "卡纳达语",
+			"ko":      "韩语",
+			"ks":      "克什米尔语",
+			"ku":      "库尔德语",
+			"ky":      "柯尔克孜语",
+			"la":      "拉丁语",
+			"lb":      "卢森堡语",
+			"lg":      "卢干达语",
+			"ln":      "林加拉语",
+			"lo":      "老挝语",
+			"loz":     "洛齐语",
+			"lt":      "立陶宛语",
+			"lua":     "卢巴-卢拉语",
+			"luo":     "卢欧语",
+			"lv":      "拉脱维亚语",
+			"mfe":     "毛里求斯克里奥尔语",
+			"mg":      "马拉加斯语",
+			"mi":      "毛利语",
+			"mk":      "马其顿语",
+			"ml":      "马拉雅拉姆语",
+			"mn":      "蒙古语",
+			"mr":      "马拉地语",
+			"ms":      "马来语",
+			"mt":      "马耳他语",
+			"my":      "缅甸语",
+			"na":      "瑙鲁语",
+			"ne":      "尼泊尔语",
+			"new":     "尼瓦尔语",
+			"nl":      "荷兰语",
+			"nn":      "挪威尼诺斯克语",
+			"no":      "书面挪威语",
+			"nr":      "南恩德贝勒语",
+			"nso":     "北索托语",
+			"ny":      "齐切瓦语",
+			"oc":      "奥克语",
+			"om":      "奥罗莫语",
+			"or":      "奥里亚语",
+			"os":      "奥塞梯语",
+			"pa":      "旁遮普语",
+			"pam":     "邦板牙语",
+			"pl":      "波兰语",
+			"ps":      "普什图语",
+			"pt":      "葡萄牙语",
+			"qu":      "克丘亚语",
+			"raj":     "拉贾斯坦语",
+			"rm":      "罗曼什语",
+			"rn":      "隆迪语",
+			"ro":      "罗马尼亚语",
+			"ru":      "俄语",
+			"rw":      "卢旺达语",
+			"sa":      "梵语",
+			"sco":     "苏格兰语",
+			"sd":      "信德语",
+			"sg":      "桑戈语",
+			"si":      "僧伽罗语",
+			"sk":      "斯洛伐克语",
+			"sl":      "斯洛文尼亚语",
+			"sm":      "萨摩亚语",
+			"sn":      "绍纳语",
+			"so":      "索马里语",
+			"sq":      "阿尔巴尼亚语",
+			"sr":      "塞尔维亚语",
+			"sr-ME":   "塞尔维亚语 (黑山)",
+			"ss":      "斯瓦蒂语",
+			"st":      "南索托语",
+			"su":      "巽他语",
+			"sv":      "瑞典语",
+			"sw":      "斯瓦希里语",
+			"syr":     "叙利亚语",
+			"ta":      "泰米尔语",
+			"te":      "泰卢固语",
+			"tg":      "塔吉克语",
+			"th":      "泰语",
+			"ti":      "提格利尼亚语",
+			"tk":      "土库曼语",
+			"tl":      "菲律宾语",
+			"tlh":     "克林贡语",
+			"tn":      "茨瓦纳语",
+			"to":      "汤加语",
+			"tr":      "土耳其语",
+			"ts":      "聪加语",
+			"tt":      "鞑靼语",
+			"tum":     "通布卡语",
+			"tw":      "阿肯语",
+			"ug":      "维吾尔语",
+			"uk":      "乌克兰语",
+			"ur":      "乌尔都语",
+			"uz":      "乌兹别克语",
+			"ve":      "文达语",
+			"vi":      "越南语",
+			"vo":      "沃拉普克语",
+			"war":     "瓦瑞语",
+			"wo":      "沃洛夫语",
+			"xh":      "科萨语",
+			"yi":      "意第绪语",
+			"yo":      "约鲁巴语",
+			"za":      "壮语",
+			"zh":      "中文",
+			"zh-Hant": "繁体中文",
+			"zu":      "祖鲁语",
+		},
+	},
+}
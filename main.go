@@ -1,23 +1,21 @@
 package main
 
-// #cgo CFLAGS: -I. -fpic
-// #cgo LDFLAGS: -L. -lcld2 wrapper.a
-// #include <stdlib.h>
-// #include "wrapper.h"
-import "C"
-
 import (
-	"unsafe"
-
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
 	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
+	"github.com/GolosChain/language-detector/detector"          // CLD2 language detection
 	bnLogger "github.com/bottlenose-inc/go-common-tools/logger" // go-common-tools bunyan-style logger package
 	"github.com/bottlenose-inc/go-common-tools/metrics"         // go-common-tools Prometheus metrics package
 	rj "github.com/bottlenose-inc/rapidjson"                    // faster json handling
@@ -25,11 +23,14 @@ import (
 	"github.com/prometheus/client_golang/prometheus"            // Prometheus client library
 )
 
+//go:generate go run data/gen_codes.go
+//go:generate go run data/gen_names.go
+//go:generate go run data/gen_ngrams.go
+
 const (
 	AUGMENTATION_NAME = "language_detector"
 	PROMETHEUS_NAME   = "language_detector"
-	BODY_LIMIT_BYTES  = 1048576 // Truncates incoming requests to 1 mb
-	OBJECTS_PER_LOG   = 1000    // Number of objects processed per throughput log message
+	OBJECTS_PER_LOG   = 1000 // Number of objects processed per throughput log message
 
 	USAGE_STRING = `{
   "result": {
@@ -37,47 +38,282 @@ const (
     "name": "language-detector",
     "description": "Determine language code from text",
     "in": {
+      "id": {
+        "type": ["string", "number"]
+      },
+      "meta": {
+        "type": "object"
+      },
       "text": {
+        "type": "string",
+        "description": "Required. Must be a string that is not empty or only whitespace, of at most MAX_TEXT_BYTES bytes (102400 unless configured)."
+      },
+      "tld_hint": {
+        "type": "string"
+      },
+      "content_language_hint": {
+        "type": "string"
+      },
+      "language_hint": {
+        "type": "string"
+      },
+      "encoding_hint": {
+        "type": "string"
+      },
+      "encoding": {
+        "type": "string"
+      },
+      "segments": {
+        "type": "boolean"
+      },
+      "is_html": {
+        "type": "boolean"
+      },
+      "preprocess": {
+        "type": "array",
+        "items": {
+          "type": "string"
+        }
+      },
+      "min_letters": {
+        "type": "integer"
+      },
+      "min_text_bytes": {
+        "type": "integer"
+      },
+      "allowed_languages": {
+        "type": "array",
+        "items": {
+          "type": "string"
+        }
+      },
+      "min_allowed_percent": {
+        "type": "integer"
+      },
+      "display_locale": {
+        "type": "string"
+      },
+      "engine": {
         "type": "string"
       }
     },
     "out": {
+      "id": {
+        "type": ["string", "number"]
+      },
+      "meta": {
+        "type": "object"
+      },
+      "status": {
+        "type": "string",
+        "enum": ["ok", "unknown_language", "too_short", "missing_text", "invalid_text", "invalid_request"]
+      },
+      "error": {
+        "type": "string"
+      },
       "iso6391code": {
         "type": "string"
       },
       "name" : {
         "type" : "string"
+      },
+      "display_name": {
+        "type": "string"
+      },
+      "native_name": {
+        "type": "string"
+      },
+      "iso6392": {
+        "type": "string"
+      },
+      "iso6393": {
+        "type": "string"
+      },
+      "bcp47": {
+        "type": "string"
+      },
+      "script": {
+        "type": "string"
+      },
+      "reliable": {
+        "type": "boolean"
+      },
+      "text_bytes": {
+        "type": "integer"
+      },
+      "encoding": {
+        "type": "string"
+      },
+      "languages": {
+        "type": "array",
+        "items": {
+          "iso6391code": {
+            "type": "string"
+          },
+          "name": {
+            "type": "string"
+          },
+          "display_name": {
+            "type": "string"
+          },
+          "native_name": {
+            "type": "string"
+          },
+          "iso6392": {
+            "type": "string"
+          },
+          "iso6393": {
+            "type": "string"
+          },
+          "bcp47": {
+            "type": "string"
+          },
+          "script": {
+            "type": "string"
+          },
+          "percent": {
+            "type": "integer"
+          },
+          "normalized_score": {
+            "type": "number"
+          }
+        }
+      },
+      "segments": {
+        "type": "array",
+        "items": {
+          "offset": {
+            "type": "integer"
+          },
+          "length": {
+            "type": "integer"
+          },
+          "iso6391code": {
+            "type": "string"
+          },
+          "name": {
+            "type": "string"
+          },
+          "display_name": {
+            "type": "string"
+          },
+          "native_name": {
+            "type": "string"
+          }
+        }
+      },
+      "debug": {
+        "type": "object",
+        "description": "Set by the ensemble engine, with what each of its engines found.",
+        "votes": {
+          "type": "array",
+          "items": {
+            "engine": {
+              "type": "string"
+            },
+            "weight": {
+              "type": "number"
+            },
+            "iso6391code": {
+              "type": "string"
+            },
+            "reliable": {
+              "type": "boolean"
+            },
+            "languages": {
+              "type": "array",
+              "items": {
+                "iso6391code": {
+                  "type": "string"
+                },
+                "percent": {
+                  "type": "integer"
+                },
+                "normalized_score": {
+                  "type": "number"
+                }
+              }
+            }
+          }
+        }
       }
     }
   }
 }`
 
-	LANG_FILE = "data/cld_codes.json"
+	LANG_NAMES_VERSION = 1 // Format version of LANG_NAMES_FILE that can be loaded
+
+	// Per-item statuses
+	STATUS_OK               = "ok"               // Language detected
+	STATUS_UNKNOWN_LANGUAGE = "unknown_language" // Text is in no known language
+	STATUS_TOO_SHORT        = "too_short"        // Text has too few letters to detect a language
+	STATUS_MISSING_TEXT     = "missing_text"     // Request object has no text key
+	STATUS_INVALID_TEXT     = "invalid_text"     // Text cannot be detected, e.g. it is not valid UTF-8
+	STATUS_INVALID_REQUEST  = "invalid_request"  // Request object has invalid options
+
+	UNDETERMINED_NAME     = "Undetermined" // Name of detector.UNDETERMINED_LANGUAGE_CODE
+	UNDETERMINED_ISO_CODE = "und"          // ISO 639 and BCP 47 code of undetermined and unknown languages
+	UNKNOWN_SCRIPT_CODE   = "Zzzz"         // ISO 15924 code of undetermined scripts
 )
 
 var (
 	LISTEN_PORT     = 3000  // Can be overwritten with the LISTEN_PORT env var
 	PROMETHEUS_PORT = 30000 // Can be overwritten with the PROMETHEUS_PORT env var
 
+	BODY_LIMIT_BYTES        = 1048576 // Larger requests are rejected. Can be overwritten with the BODY_LIMIT_BYTES env var
+	STREAM_LINE_LIMIT_BYTES = 0       // Limit on each line of POST /stream, BODY_LIMIT_BYTES if 0. Can be overwritten with the STREAM_LINE_LIMIT_BYTES env var
+	MAX_TEXT_BYTES          = 102400  // Longer texts are rejected. Can be overwritten with the MAX_TEXT_BYTES env var
+
+	// Language tables are compiled in from the files in data/, generated by go generate.
+	// They can be overwritten with files in the same format with the env vars.
+	LANG_FILE       = "" // Languages and their codes, CLD2LanguageTable if empty
+	LANG_NAMES_FILE = "" // Localized language names, DefaultLanguageNames if empty
+
+	// N-gram engine model, as saved by cmd/train-ngrams, detector.DefaultNgramModel if
+	// empty. Set with the NGRAM_MODEL_FILE env var.
+	NGRAM_MODEL_FILE = ""
+
+	// CLD2 built with CLD2_DYNAMIC_MODE (the cld2_dynamic build tag) loads its model from
+	// CLD2_DATA_FILE at startup, and again on SIGHUP. Set with the CLD2_DATA_FILE env var.
+	CLD2_DATA_FILE = ""
+	CLD2_TABLES    = detector.TABLES_CHROME // Table variant of CLD2_DATA_FILE, reported by GET /info. Can be overwritten with the CLD2_TABLES env var
+
 	numProcessed               = 0
 	startTime                  = time.Now()
 	totalRequestsCounter       prometheus.Counter
 	invalidRequestsCounter     prometheus.Counter
+	oversizeRequestsCounter    prometheus.Counter
+	shortTextsCounter          prometheus.Counter
+	invalidUTF8Counter         prometheus.Counter
 	objsProcessedCounterVector *prometheus.CounterVec
 	resultLangCounterVector    *prometheus.CounterVec
+	reliabilityCounterVector   *prometheus.CounterVec
 	requestDurationCounter     prometheus.Counter
 	errorsCounter              prometheus.Counter
 
-	notFound       []byte
-	usage          []byte
-	logger         *bnLogger.Logger
-	KnownLanguages = make(map[string]string)
+	notFound         []byte
+	usage            []byte
+	logger           *bnLogger.Logger
+	languageDetector = detector.New()
+	KnownLanguages   = languageNames(CLD2LanguageTable) // Name of each language code in LanguageTable
+	LanguageTable    = CLD2LanguageTable
+	LanguageNames    = DefaultLanguageNames
 )
 
-func Detect_language(text string) string {
-	cStr := C.CString(text)
-	defer C.free(unsafe.Pointer(cStr))
-	return C.GoString(C.detect_language(cStr))
+// LanguageInfo describes a language code of the language table.
+type LanguageInfo struct {
+	Name    string `json:"name"`
+	ISO6392 string `json:"iso6392"` // ISO 639-2 bibliographic code
+	ISO6393 string `json:"iso6393"` // ISO 639-3 code
+	BCP47   string `json:"bcp47"`   // Normalized BCP 47 tag
+	Script  string `json:"script"`  // ISO 15924 code of the main script CLD2 recognizes the language in
+}
+
+// LanguageNamesData holds localized language names, as in data/language_names.json.
+type LanguageNamesData struct {
+	Version  int                          `json:"version"`
+	Endonyms map[string]string            `json:"endonyms"` // Native name of each language code
+	Names    map[string]map[string]string `json:"names"`    // Name of each language code, by display locale
 }
 
 func main() {
@@ -113,16 +349,136 @@ func main() {
 		}
 	}
 
-	// load known languages/codes
-	langFile, err := ioutil.ReadFile(LANG_FILE)
-	if err != nil {
-		logger.Fatal("File error: " + err.Error())
-		os.Exit(1)
+	// Set request size limits based on env, if provided
+	if os.Getenv("BODY_LIMIT_BYTES") != "" {
+		if limit, err := strconv.Atoi(os.Getenv("BODY_LIMIT_BYTES")); err != nil || limit <= 0 {
+			logger.Warning("Invalid body limit provided, continuing with default", map[string]string{"provided": os.Getenv("BODY_LIMIT_BYTES")}, map[string]string{"default": strconv.Itoa(BODY_LIMIT_BYTES)})
+		} else {
+			BODY_LIMIT_BYTES = limit
+		}
 	}
-	err = json.Unmarshal(langFile, &KnownLanguages)
-	if err != nil {
-		logger.Fatal("Error loading known languages: " + err.Error())
-		os.Exit(1)
+	if os.Getenv("STREAM_LINE_LIMIT_BYTES") != "" {
+		if limit, err := strconv.Atoi(os.Getenv("STREAM_LINE_LIMIT_BYTES")); err != nil || limit <= 0 {
+			logger.Warning("Invalid stream line limit provided, continuing with default", map[string]string{"provided": os.Getenv("STREAM_LINE_LIMIT_BYTES")}, map[string]string{"default": strconv.Itoa(BODY_LIMIT_BYTES)})
+		} else {
+			STREAM_LINE_LIMIT_BYTES = limit
+		}
+	}
+
+	// Set text length limit based on env, if provided
+	if os.Getenv("MAX_TEXT_BYTES") != "" {
+		if limit, err := strconv.Atoi(os.Getenv("MAX_TEXT_BYTES")); err != nil || limit <= 0 {
+			logger.Warning("Invalid text limit provided, continuing with default", map[string]string{"provided": os.Getenv("MAX_TEXT_BYTES")}, map[string]string{"default": strconv.Itoa(MAX_TEXT_BYTES)})
+		} else {
+			MAX_TEXT_BYTES = limit
+		}
+	}
+
+	// Set minimum text lengths based on env, if provided
+	if os.Getenv("MIN_LETTERS") != "" {
+		if minLetters, err := strconv.Atoi(os.Getenv("MIN_LETTERS")); err != nil || minLetters < 0 {
+			logger.Warning("Invalid minimum letters provided, continuing with default", map[string]string{"provided": os.Getenv("MIN_LETTERS")}, map[string]string{"default": strconv.Itoa(languageDetector.MinLetters)})
+		} else {
+			languageDetector.MinLetters = minLetters
+		}
+	}
+	if os.Getenv("MIN_TEXT_BYTES") != "" {
+		if minTextBytes, err := strconv.Atoi(os.Getenv("MIN_TEXT_BYTES")); err != nil || minTextBytes < 0 {
+			logger.Warning("Invalid minimum text bytes provided, continuing with default", map[string]string{"provided": os.Getenv("MIN_TEXT_BYTES")}, map[string]string{"default": strconv.Itoa(languageDetector.MinTextBytes)})
+		} else {
+			languageDetector.MinTextBytes = minTextBytes
+		}
+	}
+
+	// Set allowed languages based on env, if provided
+	if os.Getenv("ALLOWED_LANGUAGES") != "" {
+		allowedLanguages := strings.Split(os.Getenv("ALLOWED_LANGUAGES"), ",")
+		if _, err := detector.AllowedLanguageSet(allowedLanguages); err != nil {
+			logger.Warning("Invalid allowed languages provided, continuing with all languages allowed", map[string]string{"provided": os.Getenv("ALLOWED_LANGUAGES")}, map[string]string{"error": err.Error()})
+		} else {
+			languageDetector.AllowedLanguages = allowedLanguages
+		}
+	}
+	if os.Getenv("MIN_ALLOWED_PERCENT") != "" {
+		if minPercent, err := strconv.Atoi(os.Getenv("MIN_ALLOWED_PERCENT")); err != nil || minPercent < 0 || minPercent > 100 {
+			logger.Warning("Invalid minimum allowed language percent provided, continuing with default", map[string]string{"provided": os.Getenv("MIN_ALLOWED_PERCENT")}, map[string]string{"default": strconv.Itoa(languageDetector.MinAllowedPercent)})
+		} else {
+			languageDetector.MinAllowedPercent = minPercent
+		}
+	}
+
+	// Set default preprocessing filters based on env, if provided
+	if os.Getenv("PREPROCESS") != "" {
+		if filters, err := detector.GetTextFilters(strings.Split(os.Getenv("PREPROCESS"), ",")); err != nil {
+			logger.Warning("Invalid preprocess filters provided, continuing with default", map[string]string{"provided": os.Getenv("PREPROCESS")}, map[string]string{"default": detector.DEFAULT_PREPROCESS})
+		} else {
+			languageDetector.DefaultFilters = filters
+		}
+	}
+
+	// Load known languages/codes and their localized names from env, if provided
+	if os.Getenv("LANG_FILE") != "" {
+		LANG_FILE = os.Getenv("LANG_FILE")
+		if err = LoadLanguages(LANG_FILE); err != nil {
+			logger.Fatal("Error loading known languages: " + err.Error())
+			os.Exit(1)
+		}
+	}
+	if os.Getenv("LANG_NAMES_FILE") != "" {
+		LANG_NAMES_FILE = os.Getenv("LANG_NAMES_FILE")
+		if err = LoadLanguageNames(LANG_NAMES_FILE); err != nil {
+			logger.Fatal("Error loading language names: " + err.Error())
+			os.Exit(1)
+		}
+	}
+
+	// Replace the n-gram engine's model from env, if provided
+	if os.Getenv("NGRAM_MODEL_FILE") != "" {
+		NGRAM_MODEL_FILE = os.Getenv("NGRAM_MODEL_FILE")
+		if err = LoadNgramModel(NGRAM_MODEL_FILE); err != nil {
+			logger.Fatal("Error loading n-gram model: " + err.Error())
+			os.Exit(1)
+		}
+	}
+
+	// Set ensemble engine weights based on env, if provided
+	if os.Getenv("ENSEMBLE_WEIGHTS") != "" {
+		if ensemble, err := NewEnsembleEngine(os.Getenv("ENSEMBLE_WEIGHTS")); err != nil {
+			logger.Warning("Invalid ensemble weights provided, continuing with all engines weighted equally", map[string]string{"provided": os.Getenv("ENSEMBLE_WEIGHTS")}, map[string]string{"error": err.Error()})
+		} else {
+			detector.RegisterEngine(ensemble)
+		}
+	}
+
+	// Set default detection engine based on env, if provided
+	if os.Getenv("ENGINE") != "" {
+		if engine, err := detector.GetEngine(os.Getenv("ENGINE")); err != nil {
+			logger.Warning("Invalid engine provided, continuing with default", map[string]string{"provided": os.Getenv("ENGINE")}, map[string]string{"default": languageDetector.Engine.Name()})
+		} else {
+			languageDetector.Engine = engine
+		}
+	}
+
+	// Load the CLD2 model from env, when it is not compiled in, and reload it on SIGHUP
+	CLD2_DATA_FILE = os.Getenv("CLD2_DATA_FILE")
+	if detector.IsModelDynamic() {
+		if CLD2_DATA_FILE == "" {
+			logger.Fatal("CLD2 is built with CLD2_DYNAMIC_MODE, CLD2_DATA_FILE must be provided")
+			os.Exit(1)
+		}
+		if err = detector.LoadModel(CLD2_DATA_FILE); err != nil {
+			logger.Fatal("Error loading CLD2 model: " + err.Error())
+			os.Exit(1)
+		}
+		go ReloadModelOnSignal(syscall.SIGHUP)
+
+		if tables := os.Getenv("CLD2_TABLES"); tables == detector.TABLES_CHROME || tables == detector.TABLES_FULL {
+			CLD2_TABLES = tables
+		} else if tables != "" {
+			logger.Warning("Invalid CLD2 tables provided, continuing with default", map[string]string{"provided": tables}, map[string]string{"default": CLD2_TABLES})
+		}
+	} else if CLD2_DATA_FILE != "" {
+		logger.Warning("CLD2 model is compiled in, ignoring CLD2_DATA_FILE", map[string]string{"provided": CLD2_DATA_FILE})
 	}
 
 	// Start HTTP server
@@ -133,16 +489,177 @@ func main() {
 	}
 }
 
+// ReloadModelOnSignal loads the CLD2 model from CLD2_DATA_FILE again each time the
+// process receives sig, keeping the current model if it fails. Requests in progress are
+// detected with the model they started with.
+func ReloadModelOnSignal(sig os.Signal) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, sig)
+	for range signals {
+		start := time.Now()
+		if err := detector.LoadModel(CLD2_DATA_FILE); err != nil {
+			logger.Error("Error reloading CLD2 model, continuing with the current one: "+err.Error(), map[string]string{"path": CLD2_DATA_FILE})
+			continue
+		}
+		logger.Info("Reloaded CLD2 model in "+time.Since(start).String(), map[string]string{"path": CLD2_DATA_FILE})
+	}
+}
+
+// Tables returns the CLD2 scoring table variant in use, the one linked or, for
+// CLD2_DYNAMIC_MODE builds, CLD2_TABLES.
+func Tables() string {
+	if detector.IsModelDynamic() {
+		return CLD2_TABLES
+	}
+	return detector.Tables()
+}
+
+// NewEnsembleEngine returns an ensemble of the engines listed in spec, a comma-separated
+// list of engine names, each optionally followed by a colon and its weight (1 if
+// omitted), e.g. "cld2:2,ngram".
+func NewEnsembleEngine(spec string) (*detector.EnsembleEngine, error) {
+	weights := make(map[string]float64)
+	for _, item := range strings.Split(spec, ",") {
+		name, weight := strings.TrimSpace(item), 1.0
+		if i := strings.Index(name, ":"); i >= 0 {
+			var err error
+			if weight, err = strconv.ParseFloat(strings.TrimSpace(name[i+1:]), 64); err != nil {
+				return nil, errors.New("Invalid weight for engine " + name[:i] + ": " + name[i+1:])
+			}
+			name = strings.TrimSpace(name[:i])
+		}
+		if _, found := weights[name]; found {
+			return nil, errors.New("Duplicate engine: " + name)
+		}
+		weights[name] = weight
+	}
+	return detector.NewEnsembleEngine(weights)
+}
+
+// LoadNgramModel registers an n-gram engine with the model at path in place of the
+// current one, which languageDetector also stops using. An error is returned if the
+// model has languages LanguageTable lacks.
+func LoadNgramModel(path string) error {
+	model, err := detector.LoadNgramModel(path)
+	if err != nil {
+		return err
+	}
+	for code := range model.Profiles {
+		if _, found := LanguageTable[code]; !found {
+			return errors.New("Invalid n-gram model " + path + ": unknown language " + code)
+		}
+	}
+
+	engine := detector.NewNgramEngine(model)
+	if current, err := detector.GetEngine(detector.NGRAM_ENGINE); err == nil && languageDetector.Engine == current {
+		languageDetector.Engine = engine
+	}
+	detector.RegisterEngine(engine)
+	return nil
+}
+
+// LoadLanguages replaces LanguageTable and KnownLanguages with the language table at path.
+func LoadLanguages(path string) error {
+	langFile, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	table, err := ParseLanguageTable(langFile)
+	if err != nil {
+		return errors.New("Invalid language table " + path + ": " + err.Error())
+	}
+	LanguageTable = table
+	KnownLanguages = languageNames(table)
+	return nil
+}
+
+// ParseLanguageTable parses a language table in the format of data/cld_codes.json. An
+// error is returned if a code appears twice, has no name, or is never emitted by CLD2.
+// Codes are not checked against CLD2 in builds without it, where the languages of the
+// n-gram models loaded with NGRAM_MODEL_FILE are checked against the table instead.
+func ParseLanguageTable(data []byte) (map[string]LanguageInfo, error) {
+	detected := make(map[string]bool)
+	for _, language := range detector.CLD2Languages() {
+		if len(language.Scripts) > 0 {
+			detected[language.Code] = true
+		}
+	}
+
+	// Decode the table one entry at a time, as duplicate keys would otherwise go unnoticed
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if token, err := decoder.Token(); err != nil || token != json.Delim('{') {
+		return nil, errors.New("Language table must be a JSON object")
+	}
+	table := make(map[string]LanguageInfo)
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		code := token.(string)
+		var info LanguageInfo
+		if err = decoder.Decode(&info); err != nil {
+			return nil, errors.New("Invalid entry for language code " + code + ": " + err.Error())
+		}
+		if _, found := table[code]; found {
+			return nil, errors.New("Duplicate language code: " + code)
+		}
+		if len(detected) > 0 && !detected[code] {
+			return nil, errors.New("Language code " + code + " is never emitted by CLD2")
+		}
+		if info.Name == "" {
+			return nil, errors.New("Missing name for language code " + code)
+		}
+		table[code] = info
+	}
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+	return table, nil
+}
+
+// languageNames returns the name of each language code in table.
+func languageNames(table map[string]LanguageInfo) map[string]string {
+	names := make(map[string]string, len(table))
+	for code, info := range table {
+		names[code] = info.Name
+	}
+	return names
+}
+
+// LoadLanguageNames loads LanguageNames from path, which must be in the LANG_NAMES_VERSION
+// format.
+func LoadLanguageNames(path string) error {
+	namesFile, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var names LanguageNamesData
+	if err = json.Unmarshal(namesFile, &names); err != nil {
+		return err
+	}
+	if names.Version != LANG_NAMES_VERSION {
+		return errors.New("Unsupported version " + strconv.Itoa(names.Version) + " of " + path + ", expected " + strconv.Itoa(LANG_NAMES_VERSION))
+	}
+	LanguageNames = names
+	return nil
+}
+
 // Initialize prometheus metrics
 func InitMetrics() {
 	var emptyMap map[string]string
 	totalRequestsCounter, _ = metrics.CreateCounter("augmentation_requests_total", "", "", "The total number of requests received.", emptyMap)
 	invalidRequestsCounter, _ = metrics.CreateCounter("augmentation_invalid_requests_total", "", "", "The total number of invalid requests received.", emptyMap)
+	oversizeRequestsCounter, _ = metrics.CreateCounter("augmentation_oversize_requests_total", "", "", "The total number of requests rejected for exceeding the body limit.", emptyMap)
+	invalidUTF8Counter, _ = metrics.CreateCounter("augmentation_invalid_utf8_objects_total", "", "", "The total number of objects with text that is not valid UTF-8.", emptyMap)
 	requestDurationCounter, _ = metrics.CreateCounter("augmentation_request_duration_milliseconds", "", "", "The total amount of time spent processing requests.", emptyMap)
+	shortTextsCounter, _ = metrics.CreateCounter("augmentation_short_texts_total", "", "", "The total number of objects with texts below the minimum length.", emptyMap)
 	errorsCounter, _ = metrics.CreateCounter("augmentation_errors_logged_total", "", "", "The total number of errors logged.", emptyMap)
 	objsProcessedCounterVector, _ = metrics.CreateCounterVector("augmentation_objects_processed_total", "", "", "The total number of objects processed.", emptyMap, []string{"status"})
 	metrics.InitCounterVector(objsProcessedCounterVector, []string{"successful", "unsuccessful"})
 	resultLangCounterVector, _ = metrics.CreateCounterVector("augmentation_detected_language", "", "", "Counts of languages detected.", emptyMap, []string{"language"})
+	reliabilityCounterVector, _ = metrics.CreateCounterVector("augmentation_detected_language_reliability", "", "", "Counts of detections by reliability.", emptyMap, []string{"reliability"})
+	metrics.InitCounterVector(reliabilityCounterVector, []string{"reliable", "unreliable"})
 }
 
 // GenerateResponses prepares the usage and 404 responses. They can then just be returned,
@@ -167,10 +684,18 @@ func GenerateResponses() {
 
 // Initialize router and define routes
 func getRouter() *mux.Router {
+	streamLineLimit := STREAM_LINE_LIMIT_BYTES
+	if streamLineLimit <= 0 {
+		streamLineLimit = BODY_LIMIT_BYTES
+	}
+
 	router := mux.NewRouter().StrictSlash(true)
 	router.NotFoundHandler = HandlerWrapper(NotFound)
 	router.Methods("GET").Path("/").Handler(HandlerWrapper(Usage))
-	router.Methods("POST").Path("/").Handler(HandlerWrapper(LanguageDetectorHandler))
+	router.Methods("GET").Path("/health").Handler(HandlerWrapper(Health))
+	router.Methods("GET").Path("/info").Handler(HandlerWrapper(Info))
+	router.Methods("POST").Path("/").Handler(HandlerWrapper(WithBodyLimit(BODY_LIMIT_BYTES, LanguageDetectorHandler)))
+	router.Methods("POST").Path("/stream").Handler(HandlerWrapper(WithBodyLimit(streamLineLimit, StreamHandler)))
 	return router
 }
 
@@ -204,6 +729,20 @@ func incLanguageCount(language string) {
 	}
 }
 
+// incReliabilityCount increments reliabilityCounterVector's reliable or unreliable count.
+func incReliabilityCount(reliable bool) {
+	reliability := "unreliable"
+	if reliable {
+		reliability = "reliable"
+	}
+	counter, err := reliabilityCounterVector.GetMetricWithLabelValues(reliability)
+	if err != nil {
+		logger.Error("Incrementing " + reliability + " detections counter failed: " + err.Error())
+	} else {
+		counter.Inc()
+	}
+}
+
 // logProcessed logs throughput every numProcessed objects. Throughput is rounded for
 // slightly prettier output.
 func logProcessed() {
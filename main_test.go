@@ -1,15 +1,24 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
+	"testing/iotest"
 
+	"github.com/GolosChain/language-detector/detector"             // CLD2 language detection
 	irukaLogger "github.com/bottlenose-inc/go-common-tools/logger" // go-common-tools bunyan-style logger package
 	"github.com/bottlenose-inc/go-common-tools/metrics"            // go-common-tools Prometheus metrics package
 	"github.com/stretchr/testify/assert"                           // Assertion package
@@ -20,6 +29,68 @@ var (
 	serverUrl string
 )
 
+// detectionResponses mirrors the body returned by POST /.
+type detectionResponses struct {
+	Response []struct {
+		Id          interface{}            `json:"id"`
+		Meta        map[string]interface{} `json:"meta"`
+		Status      string                 `json:"status"`
+		Iso6391Code string                 `json:"iso6391code"`
+		Name        string                 `json:"name"`
+		DisplayName string                 `json:"display_name"`
+		NativeName  string                 `json:"native_name"`
+		ISO6392     string                 `json:"iso6392"`
+		ISO6393     string                 `json:"iso6393"`
+		BCP47       string                 `json:"bcp47"`
+		Script      string                 `json:"script"`
+		Reliable    bool                   `json:"reliable"`
+		TextBytes   int                    `json:"text_bytes"`
+		Encoding    string                 `json:"encoding"`
+		Error       string                 `json:"error"`
+		ValidPrefix int                    `json:"valid_prefix_bytes"`
+		Languages   []struct {
+			Iso6391Code     string  `json:"iso6391code"`
+			Name            string  `json:"name"`
+			DisplayName     string  `json:"display_name"`
+			NativeName      string  `json:"native_name"`
+			ISO6392         string  `json:"iso6392"`
+			ISO6393         string  `json:"iso6393"`
+			BCP47           string  `json:"bcp47"`
+			Script          string  `json:"script"`
+			Percent         int     `json:"percent"`
+			NormalizedScore float64 `json:"normalized_score"`
+		} `json:"languages"`
+		Segments []struct {
+			Offset      int    `json:"offset"`
+			Length      int    `json:"length"`
+			Iso6391Code string `json:"iso6391code"`
+			Name        string `json:"name"`
+		} `json:"segments"`
+		Debug struct {
+			Votes []struct {
+				Engine      string  `json:"engine"`
+				Weight      float64 `json:"weight"`
+				Iso6391Code string  `json:"iso6391code"`
+				Reliable    bool    `json:"reliable"`
+				Languages   []struct {
+					Iso6391Code string `json:"iso6391code"`
+					Percent     int    `json:"percent"`
+				} `json:"languages"`
+			} `json:"votes"`
+		} `json:"debug"`
+	} `json:"response"`
+}
+
+// detectCode returns the language code languageDetector finds for text, without preprocessing.
+func detectCode(text string) string {
+	return detectCodeWith(languageDetector.Engine, text)
+}
+
+func detectCodeWith(engine detector.Engine, text string) string {
+	result, _ := languageDetector.Detect(context.Background(), text, detector.Options{Engine: engine, Filters: []detector.TextFilter{}})
+	return result.Code
+}
+
 func TestMain(m *testing.M) {
 	server = httptest.NewServer(getRouter())
 	serverUrl = fmt.Sprintf("%s/", server.URL)
@@ -30,16 +101,13 @@ func TestMain(m *testing.M) {
 	go metrics.StartPrometheusMetricsServer(AUGMENTATION_NAME+"-test", logger, PROMETHEUS_PORT)
 	InitMetrics()
 
-	// load data file
-	langFile, err := ioutil.ReadFile(LANG_FILE)
-	if err != nil {
-		logger.Fatal("File error: " + err.Error())
-		os.Exit(1)
-	}
-	err = json.Unmarshal(langFile, &KnownLanguages)
-	if err != nil {
-		logger.Fatal("File loading error: " + err.Error())
-		os.Exit(1)
+	// CLD2 built with CLD2_DYNAMIC_MODE detects nothing until its model is loaded
+	if detector.IsModelDynamic() {
+		CLD2_DATA_FILE = os.Getenv("CLD2_DATA_FILE")
+		if err := detector.LoadModel(CLD2_DATA_FILE); err != nil {
+			fmt.Println("CLD2_DATA_FILE must be set to a CLD2 model to test the cld2_dynamic build: " + err.Error())
+			os.Exit(1)
+		}
 	}
 
 	// Prepare responses
@@ -62,7 +130,7 @@ func TestUsage(t *testing.T) {
 	body, err := ioutil.ReadAll(resp.Body)
 	assert.Nil(t, err, "should not error reading response")
 	assert.Equal(t, 200, resp.StatusCode, "response status code should be 200")
-	expected := `{"result":{"id":"language-detector","name":"language-detector","description":"Determine language code from text","in":{"text":{"type":"string"}},"out":{"iso6391code":{"type":"string"},"name":{"type":"string"}}}}`
+	expected := `{"result":{"id":"language-detector","name":"language-detector","description":"Determine language code from text","in":{"id":{"type":["string","number"]},"meta":{"type":"object"},"text":{"type":"string","description":"Required. Must be a string that is not empty or only whitespace, of at most MAX_TEXT_BYTES bytes (102400 unless configured)."},"tld_hint":{"type":"string"},"content_language_hint":{"type":"string"},"language_hint":{"type":"string"},"encoding_hint":{"type":"string"},"encoding":{"type":"string"},"segments":{"type":"boolean"},"is_html":{"type":"boolean"},"preprocess":{"type":"array","items":{"type":"string"}},"min_letters":{"type":"integer"},"min_text_bytes":{"type":"integer"},"allowed_languages":{"type":"array","items":{"type":"string"}},"min_allowed_percent":{"type":"integer"},"display_locale":{"type":"string"},"engine":{"type":"string"}},"out":{"id":{"type":["string","number"]},"meta":{"type":"object"},"status":{"type":"string","enum":["ok","unknown_language","too_short","missing_text","invalid_text","invalid_request"]},"error":{"type":"string"},"iso6391code":{"type":"string"},"name":{"type":"string"},"display_name":{"type":"string"},"native_name":{"type":"string"},"iso6392":{"type":"string"},"iso6393":{"type":"string"},"bcp47":{"type":"string"},"script":{"type":"string"},"reliable":{"type":"boolean"},"text_bytes":{"type":"integer"},"encoding":{"type":"string"},"languages":{"type":"array","items":{"iso6391code":{"type":"string"},"name":{"type":"string"},"display_name":{"type":"string"},"native_name":{"type":"string"},"iso6392":{"type":"string"},"iso6393":{"type":"string"},"bcp47":{"type":"string"},"script":{"type":"string"},"percent":{"type":"integer"},"normalized_score":{"type":"number"}}},"segments":{"type":"array","items":{"offset":{"type":"integer"},"length":{"type":"integer"},"iso6391code":{"type":"string"},"name":{"type":"string"},"display_name":{"type":"string"},"native_name":{"type":"string"}}},"debug":{"type":"object","description":"Set by the ensemble engine, with what each of its engines found.","votes":{"type":"array","items":{"engine":{"type":"string"},"weight":{"type":"number"},"iso6391code":{"type":"string"},"reliable":{"type":"boolean"},"languages":{"type":"array","items":{"iso6391code":{"type":"string"},"percent":{"type":"integer"},"normalized_score":{"type":"number"}}}}}}}}}`
 
 	assert.Equal(t, []byte(expected), body, "usage information should match")
 }
@@ -83,6 +151,38 @@ func TestNotFound(t *testing.T) {
 	assert.Equal(t, []byte(expected), body, "not found response should match")
 }
 
+func TestHealth(t *testing.T) {
+	fmt.Println(">> Testing GET /health (health check)...")
+
+	// Perform request
+	resp, err := http.Get(serverUrl + "health")
+	assert.Nil(t, err, "request should not error")
+	defer resp.Body.Close()
+
+	// Read response
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err, "should not error reading response")
+	assert.Equal(t, 200, resp.StatusCode, "response status code should be 200")
+	expected := `{"status":"ok","data_loaded":true,"data_dynamic":` + strconv.FormatBool(detector.IsModelDynamic()) + `}`
+	assert.Equal(t, []byte(expected), body, "health response should match")
+}
+
+func TestInfo(t *testing.T) {
+	fmt.Println(">> Testing GET /info (CLD2 tables)...")
+
+	// Perform request
+	resp, err := http.Get(serverUrl + "info")
+	assert.Nil(t, err, "request should not error")
+	defer resp.Body.Close()
+
+	// Read response
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err, "should not error reading response")
+	assert.Equal(t, 200, resp.StatusCode, "response status code should be 200")
+	expected := `{"engine":"cld2","engines":["cld2","ensemble","ngram"],"tables":"` + Tables() + `","version":"` + detector.Version() + `","data_dynamic":` + strconv.FormatBool(detector.IsModelDynamic()) + `}`
+	assert.Equal(t, []byte(expected), body, "info response should match")
+}
+
 func TestBadJson(t *testing.T) {
 	fmt.Println(">> Testing POST / (with bad JSON)...")
 
@@ -102,6 +202,65 @@ func TestBadJson(t *testing.T) {
 	assert.Equal(t, []byte(expected), body, "not found response should match")
 }
 
+func TestInvalidBatch(t *testing.T) {
+	fmt.Println(">> Testing POST / with an invalid batch...")
+
+	for body, expected := range map[string]string{
+		`{"request": {"text": "This is not a batch."}}`: `{"error":"request must be an array"}`,
+		`{"request": "This is not a batch."}`:           `{"error":"request must be an array"}`,
+		`{"request": null}`:                             `{"error":"request must be an array"}`,
+		`{"text": "This is not a batch."}`:              `{"error":"Unable to parse request - invalid JSON detected"}`,
+		`null`:                                          `{"error":"Unable to parse request - invalid JSON detected"}`,
+	} {
+		resp, err := http.Post(serverUrl, "application/json", strings.NewReader(body))
+		assert.Nil(t, err, "request should not error")
+		defer resp.Body.Close()
+
+		responseBody, err := ioutil.ReadAll(resp.Body)
+		assert.Nil(t, err, "should not error reading response")
+		assert.Equal(t, 400, resp.StatusCode, "response status code should be 400 for "+body)
+		assert.Equal(t, expected, string(responseBody), "response should match for "+body)
+	}
+}
+
+func TestOversizeRequest(t *testing.T) {
+	fmt.Println(">> Testing POST with a body over the limit...")
+
+	handler := WithBodyLimit(64, LanguageDetectorHandler)
+	expected := `{"error":"Request body exceeds the limit of 64 bytes"}`
+
+	// Declared and undeclared lengths are both caught
+	for _, contentLength := range []int64{0, -1} {
+		request := httptest.NewRequest("POST", "/", strings.NewReader(`{"request": [{"text": "This is an example input message that is too long."}]}`))
+		request.Header.Set("Content-Type", "application/json")
+		if contentLength < 0 {
+			request.ContentLength = contentLength
+		}
+		recorder := httptest.NewRecorder()
+		handler(recorder, request)
+		assert.Equal(t, 413, recorder.Code, "response status code should be 413")
+		assert.Equal(t, expected, recorder.Body.String(), "response should contain the limit")
+	}
+
+	// Bodies right at the limit are accepted
+	request := httptest.NewRequest("POST", "/", strings.NewReader(`{"request": [{"text": "An example input message, just right."}]}`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	handler(recorder, request)
+	assert.Equal(t, 64, int(request.ContentLength))
+	assert.Equal(t, 200, recorder.Code, "response status code should be 200")
+
+	// Streams are limited per line
+	request = httptest.NewRequest("POST", "/stream", strings.NewReader(`{"text": "This is an example input message that is too long."}`+"\n"+`{"text": "Short enough."}`))
+	request.Header.Set("Content-Type", "application/x-ndjson")
+	recorder = httptest.NewRecorder()
+	WithBodyLimit(32, StreamHandler)(recorder, request)
+	assert.Equal(t, 200, recorder.Code, "response status code should be 200")
+	lines := strings.Split(strings.TrimSpace(recorder.Body.String()), "\n")
+	assert.Equal(t, 2, len(lines), "response should contain a line per request line")
+	assert.Equal(t, `{"error":"Line exceeds the limit of 32 bytes"}`, lines[0])
+}
+
 func TestMissingTextKey(t *testing.T) {
 	fmt.Println(">> Testing POST with input missing text key...")
 
@@ -116,11 +275,237 @@ func TestMissingTextKey(t *testing.T) {
 	// read response
 	body, err := ioutil.ReadAll(resp.Body)
 	assert.Nil(t, err, "should not error reading response")
-	assert.Equal(t, 400, resp.StatusCode, "response status code should be 200")
-	expected := `{"response":[{"error":"Missing text key"}]}`
+	assert.Equal(t, 200, resp.StatusCode, "response status code should be 200")
+	expected := `{"response":[{"status":"missing_text","error":"Missing text key"}]}`
 	assert.Equal(t, []byte(expected), body, "response should match")
 }
 
+func TestItemStatuses(t *testing.T) {
+	fmt.Println(">> Testing POST item statuses and batch status codes...")
+
+	// Spanish stands in for a language without a known name
+	spanish := KnownLanguages["es"]
+	delete(KnownLanguages, "es")
+	defer func() { KnownLanguages["es"] = spanish }()
+
+	items := map[string]string{
+		STATUS_OK:               `{"text": "Сегодня мы расскажем о том, как работает блокчейн и почему он так важен для всех нас."}`,
+		STATUS_UNKNOWN_LANGUAGE: `{"text": "para poner este importante proyecto en práctica"}`,
+		STATUS_TOO_SHORT:        `{"text": "12345 !!!"}`,
+		STATUS_MISSING_TEXT:     `{"bad_text": "This is an invalid input test."}`,
+		STATUS_INVALID_TEXT:     "{\"text\": \"Golos: \xd1\xe5\xe3\xee\xe4\xed\xff\"}",
+		STATUS_INVALID_REQUEST:  `{"text": "para poner este importante proyecto en práctica", "language_hint": "klingon"}`,
+	}
+
+	for _, test := range []struct {
+		statuses    []string
+		multiStatus string
+		code        int
+	}{
+		{[]string{}, "", 200},
+		{[]string{}, "true", 200},
+		{[]string{STATUS_OK}, "", 200},
+		{[]string{STATUS_OK}, "true", 200},
+		{[]string{STATUS_OK, STATUS_OK}, "true", 200},
+		{[]string{STATUS_UNKNOWN_LANGUAGE}, "", 200},
+		{[]string{STATUS_UNKNOWN_LANGUAGE}, "true", 207},
+		{[]string{STATUS_TOO_SHORT}, "", 200},
+		{[]string{STATUS_TOO_SHORT}, "true", 207},
+		{[]string{STATUS_MISSING_TEXT}, "", 200},
+		{[]string{STATUS_MISSING_TEXT}, "true", 207},
+		{[]string{STATUS_INVALID_TEXT}, "", 200},
+		{[]string{STATUS_INVALID_TEXT}, "true", 207},
+		{[]string{STATUS_INVALID_REQUEST}, "", 200},
+		{[]string{STATUS_INVALID_REQUEST}, "true", 207},
+		{[]string{STATUS_OK, STATUS_UNKNOWN_LANGUAGE, STATUS_TOO_SHORT, STATUS_MISSING_TEXT, STATUS_INVALID_TEXT, STATUS_INVALID_REQUEST}, "", 200},
+		{[]string{STATUS_OK, STATUS_UNKNOWN_LANGUAGE, STATUS_TOO_SHORT, STATUS_MISSING_TEXT, STATUS_INVALID_TEXT, STATUS_INVALID_REQUEST}, "false", 200},
+		{[]string{STATUS_OK, STATUS_UNKNOWN_LANGUAGE, STATUS_TOO_SHORT, STATUS_MISSING_TEXT, STATUS_INVALID_TEXT, STATUS_INVALID_REQUEST}, "true", 207},
+		{[]string{STATUS_INVALID_REQUEST, STATUS_OK}, "1", 207},
+		{[]string{STATUS_OK}, "maybe", 400},
+	} {
+		// prepare request
+		requests := []string{}
+		for _, status := range test.statuses {
+			requests = append(requests, items[status])
+		}
+		reader := strings.NewReader(`{"request": [` + strings.Join(requests, ",") + `]}`)
+		url := serverUrl
+		if test.multiStatus != "" {
+			url += "?multi_status=" + test.multiStatus
+		}
+		description := fmt.Sprintf("%v with multi_status=%q", test.statuses, test.multiStatus)
+
+		// perform request
+		resp, err := http.Post(url, "application/json", reader)
+		assert.Nil(t, err, "request should not error")
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		assert.Nil(t, err, "should not error reading response")
+		assert.Equal(t, test.code, resp.StatusCode, "response status code should match for "+description)
+		if test.code == 400 {
+			assert.Equal(t, `{"error":"multi_status must be a boolean"}`, string(body))
+			continue
+		}
+
+		var responses detectionResponses
+		assert.Nil(t, json.Unmarshal(body, &responses), "response should be valid JSON")
+		assert.Equal(t, len(test.statuses), len(responses.Response), "response should contain a result per item for "+description)
+		for i, status := range test.statuses {
+			assert.Equal(t, status, responses.Response[i].Status, "item status should match for "+description)
+			if status == STATUS_OK || status == STATUS_UNKNOWN_LANGUAGE || status == STATUS_TOO_SHORT {
+				assert.Equal(t, "", responses.Response[i].Error, "item should not have an error for "+description)
+			} else {
+				assert.NotEqual(t, "", responses.Response[i].Error, "item should have an error for "+description)
+			}
+		}
+	}
+}
+
+func TestTextValidation(t *testing.T) {
+	fmt.Println(">> Testing POST with invalid text values...")
+
+	maxTextBytes := MAX_TEXT_BYTES
+	MAX_TEXT_BYTES = 64
+	defer func() { MAX_TEXT_BYTES = maxTextBytes }()
+
+	for _, test := range []struct {
+		item   string
+		status string
+		error  string
+	}{
+		{`{"text": "para poner este importante proyecto en práctica"}`, STATUS_OK, ""},
+		{`{"bad_text": "para poner este importante proyecto en práctica"}`, STATUS_MISSING_TEXT, "Missing text key"},
+		{`{"text": null}`, STATUS_MISSING_TEXT, "text must not be null"},
+		{`{"text": 42}`, STATUS_INVALID_TEXT, "text must be a string"},
+		{`{"text": true}`, STATUS_INVALID_TEXT, "text must be a string"},
+		{`{"text": {"body": "para poner este importante proyecto"}}`, STATUS_INVALID_TEXT, "text must be a string"},
+		{`{"text": ["para poner este importante proyecto"]}`, STATUS_INVALID_TEXT, "text must be a string"},
+		{`{"text": ""}`, STATUS_INVALID_TEXT, "text must not be empty"},
+		{`{"text": " \n\t\r "}`, STATUS_INVALID_TEXT, "text must not be only whitespace"},
+		{`{"text": "\u00a0\u2003"}`, STATUS_INVALID_TEXT, "text must not be only whitespace"},
+		{`{"text": "` + strings.Repeat("a", 65) + `"}`, STATUS_INVALID_TEXT, "text exceeds the limit of 64 bytes"},
+		{`{"text": "` + strings.Repeat("я", 33) + `"}`, STATUS_INVALID_TEXT, "text exceeds the limit of 64 bytes"},
+	} {
+		// perform request
+		resp, err := http.Post(serverUrl, "application/json", strings.NewReader(`{"request": [`+test.item+`]}`))
+		assert.Nil(t, err, "request should not error")
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		assert.Nil(t, err, "should not error reading response")
+		assert.Equal(t, 200, resp.StatusCode, "response status code should be 200")
+
+		var responses detectionResponses
+		assert.Nil(t, json.Unmarshal(body, &responses), "response should be valid JSON")
+		assert.Equal(t, 1, len(responses.Response), "response should contain one result")
+		assert.Equal(t, test.status, responses.Response[0].Status, "status should match for "+test.item)
+		assert.Equal(t, test.error, responses.Response[0].Error, "error should match for "+test.item)
+	}
+}
+
+func TestLanguageTable(t *testing.T) {
+	fmt.Println(">> Testing the language table against CLD2...")
+
+	// The generated JSON and Go tables must be the same
+	langFile, err := ioutil.ReadFile("data/cld_codes.json")
+	assert.Nil(t, err, "should not error reading the language table")
+	table, err := ParseLanguageTable(langFile)
+	assert.Nil(t, err, "the language table should be valid")
+	assert.Equal(t, CLD2LanguageTable, table, "data/cld_codes.json should match CLD2LanguageTable")
+	namesFile, err := ioutil.ReadFile("data/language_names.json")
+	assert.Nil(t, err, "should not error reading the language names")
+	var names LanguageNamesData
+	assert.Nil(t, json.Unmarshal(namesFile, &names), "the language names should be valid JSON")
+	assert.Equal(t, DefaultLanguageNames, names, "data/language_names.json should match DefaultLanguageNames")
+
+	// Every language the linked CLD2 library detects must be in the table, in its main script
+	detected := make(map[string]bool)
+	for _, language := range detector.CLD2Languages() {
+		if len(language.Scripts) == 0 {
+			continue
+		}
+		detected[language.Code] = true
+		info, found := CLD2LanguageTable[language.Code]
+		if assert.True(t, found, language.Code+" should be in the language table") {
+			assert.Equal(t, language.Scripts[0], info.Script, "script of "+language.Code)
+		}
+	}
+	for code := range CLD2LanguageTable {
+		assert.True(t, detected[code], code+" should be detected by CLD2")
+	}
+}
+
+func TestParseLanguageTable(t *testing.T) {
+	fmt.Println(">> Testing language table overrides...")
+
+	table, err := ParseLanguageTable([]byte(`{"ru": {"name": "Russian", "iso6393": "rus"}, "sr": {"name": "Serbian"}}`))
+	assert.Nil(t, err, "valid table should not error")
+	assert.Equal(t, map[string]LanguageInfo{"ru": {Name: "Russian", ISO6393: "rus"}, "sr": {Name: "Serbian"}}, table)
+
+	_, err = ParseLanguageTable([]byte(`{"ru": {"name": "Russian"}, "ru": {"name": "Русский"}}`))
+	assert.Equal(t, "Duplicate language code: ru", err.Error())
+	_, err = ParseLanguageTable([]byte(`{"ru": {"name": "Russian"}, "mo": {"name": "Moldavian"}}`))
+	assert.Equal(t, "Language code mo is never emitted by CLD2", err.Error())
+	_, err = ParseLanguageTable([]byte(`{"un": {"name": "Unknown"}}`))
+	assert.Equal(t, "Language code un is never emitted by CLD2", err.Error())
+	_, err = ParseLanguageTable([]byte(`{"ru": {"iso6393": "rus"}}`))
+	assert.Equal(t, "Missing name for language code ru", err.Error())
+	_, err = ParseLanguageTable([]byte(`{"ru": "Russian"}`))
+	assert.Contains(t, err.Error(), "Invalid entry for language code ru")
+	_, err = ParseLanguageTable([]byte(`["ru"]`))
+	assert.Equal(t, "Language table must be a JSON object", err.Error())
+}
+
+func TestLoadNgramModel(t *testing.T) {
+	fmt.Println(">> Testing n-gram model overrides...")
+
+	dir, err := ioutil.TempDir("", "ngram_model")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	defer detector.RegisterEngine(detector.NewNgramEngine(detector.DefaultNgramModel))
+
+	// The n-gram engine is replaced with one detecting the model's languages
+	path := filepath.Join(dir, "model.json.gz")
+	model := detector.NgramModel{Version: detector.NGRAM_MODEL_VERSION, Profiles: map[string]detector.NgramProfile{
+		"ru": detector.TrainNgramProfile([]string{"Сегодня мы расскажем о блокчейне"}, 0),
+		"uk": detector.TrainNgramProfile([]string{"Сьогодні ми розповімо про блокчейн"}, 0),
+	}}
+	assert.Nil(t, detector.SaveNgramModel(model, path))
+	assert.Nil(t, LoadNgramModel(path))
+	engine, err := detector.GetEngine(detector.NGRAM_ENGINE)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ru", "uk"}, engine.(*detector.NgramEngine).Languages())
+
+	// Models with languages missing from the language table are rejected
+	model.Profiles["tlh"] = detector.TrainNgramProfile([]string{"nuqneH"}, 0)
+	assert.Nil(t, detector.SaveNgramModel(model, path))
+	err = LoadNgramModel(path)
+	assert.Equal(t, "Invalid n-gram model "+path+": unknown language tlh", err.Error())
+	engine, _ = detector.GetEngine(detector.NGRAM_ENGINE)
+	assert.Equal(t, []string{"ru", "uk"}, engine.(*detector.NgramEngine).Languages(), "engine should be kept")
+
+	// A default n-gram engine is replaced too, as in builds without cgo. This model
+	// takes Russian for Ukrainian, which the built-in one would not.
+	defaultEngine := languageDetector.Engine
+	defer func() { languageDetector.Engine = defaultEngine }()
+	languageDetector.Engine = engine
+	model = detector.NgramModel{Version: detector.NGRAM_MODEL_VERSION, Profiles: map[string]detector.NgramProfile{
+		"uk": detector.TrainNgramProfile([]string{"Сегодня мы расскажем о блокчейне"}, 0),
+	}}
+	assert.Nil(t, detector.SaveNgramModel(model, path))
+	assert.Nil(t, LoadNgramModel(path))
+
+	resp, err := http.Post(serverUrl, "application/json", strings.NewReader(`{"request": [{"text": "Сегодня мы расскажем о блокчейне"}]}`))
+	assert.Nil(t, err, "request should not error")
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err, "should not error reading response")
+	var responses detectionResponses
+	assert.Nil(t, json.Unmarshal(body, &responses), "response should be valid JSON")
+	if assert.Equal(t, 1, len(responses.Response)) {
+		assert.Equal(t, "uk", responses.Response[0].Iso6391Code, "default engine should use the loaded model")
+	}
+}
+
 func TestValidInput(t *testing.T) {
 	fmt.Println(">> Testing POST with valid input...")
 
@@ -137,173 +522,800 @@ func TestValidInput(t *testing.T) {
 	assert.Nil(t, err, "should not error reading response")
 	assert.Equal(t, 200, resp.StatusCode, "response status code should be 200")
 
-	expected := `{"response":[{"iso6391code":"en","name":"English"}]}`
-	assert.Equal(t, []byte(expected), body, "response should match")
+	var responses detectionResponses
+	assert.Nil(t, json.Unmarshal(body, &responses), "response should be valid JSON")
+	assert.Equal(t, 1, len(responses.Response), "response should contain one result")
+	assert.Equal(t, "en", responses.Response[0].Iso6391Code)
+	assert.Equal(t, "English", responses.Response[0].Name)
+	assert.True(t, responses.Response[0].Reliable, "detection should be reliable")
+	assert.True(t, responses.Response[0].TextBytes > 0, "text_bytes should be positive")
+	assert.True(t, len(responses.Response[0].Languages) > 0, "response should contain at least one language")
+	assert.Equal(t, "en", responses.Response[0].Languages[0].Iso6391Code)
+	assert.Equal(t, "English", responses.Response[0].Languages[0].Name)
+	assert.True(t, responses.Response[0].Languages[0].Percent > 0, "percent should be positive")
 }
 
-func TestLanguageDetection(t *testing.T) {
-	fmt.Println("Testing language detection accuracy")
-	// Tests ported from node langugage-detector code
+func TestPassthrough(t *testing.T) {
+	fmt.Println(">> Testing POST with ids and meta...")
 
-	testText := "para poner este importante proyecto en práctica"
-	code := Detect_language(testText)
-	assert.Equal(t, "es", code)
-	name, found := KnownLanguages[code]
-	assert.Equal(t, true, found)
-	assert.Equal(t, "Spanish", name)
+	// prepare request
+	reader := strings.NewReader(`{"request": [
+		{"id": "post-1", "meta": {"author": "golos", "tags": ["ru", "blockchain"]}, "text": "para poner este importante proyecto en práctica"},
+		{"id": 2, "bad_text": "This is an invalid input test."},
+		{"id": ["post", 3], "text": "para poner este importante proyecto en práctica"},
+		{"id": 4, "meta": "golos", "text": "para poner este importante proyecto en práctica"},
+		{"text": "para poner este importante proyecto en práctica"}
+	]}`)
 
-	testText = "this is a test of the Emergency text categorizing system."
-	code = Detect_language(testText)
-	assert.Equal(t, "en", code)
-	name, found = KnownLanguages[code]
-	assert.Equal(t, true, found)
-	assert.Equal(t, "English", name)
+	// perform request
+	resp, err := http.Post(serverUrl, "application/json", reader)
+	assert.Nil(t, err, "request should not error")
+	defer resp.Body.Close()
 
-	testText = "serait(désigné peu après PDG d'Antenne 2 et de FR 3. Pas même lui ! Le"
-	code = Detect_language(testText)
-	assert.Equal(t, "fr", code)
-	name, found = KnownLanguages[code]
-	assert.Equal(t, true, found)
-	assert.Equal(t, "French", name)
+	// read response
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err, "should not error reading response")
+	assert.Equal(t, 200, resp.StatusCode, "response status code should be 200")
 
-	testText = "studio dell'uomo interiore? La scienza del cuore umano, che"
-	code = Detect_language(testText)
-	assert.Equal(t, "it", code)
-	name, found = KnownLanguages[code]
-	assert.Equal(t, true, found)
-	assert.Equal(t, "Italian", name)
+	var responses detectionResponses
+	assert.Nil(t, json.Unmarshal(body, &responses), "response should be valid JSON")
+	assert.Equal(t, 5, len(responses.Response), "response should contain five results")
+	assert.Equal(t, "post-1", responses.Response[0].Id)
+	assert.Equal(t, map[string]interface{}{"author": "golos", "tags": []interface{}{"ru", "blockchain"}}, responses.Response[0].Meta)
+	assert.Equal(t, "es", responses.Response[0].Iso6391Code)
+	assert.Equal(t, float64(2), responses.Response[1].Id)
+	assert.Equal(t, "Missing text key", responses.Response[1].Error)
+	assert.Equal(t, "id must be a string or a number", responses.Response[2].Error)
+	assert.Equal(t, "meta must be an object", responses.Response[3].Error)
+	assert.Nil(t, responses.Response[4].Id)
+	assert.Nil(t, responses.Response[4].Meta)
+	assert.Equal(t, "es", responses.Response[4].Iso6391Code)
+}
 
-	testText = "taiate pe din doua, in care vezi stralucind brun  sau violet cristalele interioare"
-	code = Detect_language(testText)
-	assert.Equal(t, "ro", code)
-	name, found = KnownLanguages[code]
-	assert.Equal(t, true, found)
-	assert.Equal(t, "Romanian", name)
+func TestDisplayLocale(t *testing.T) {
+	fmt.Println(">> Testing POST with display locales...")
 
-	testText = "na porozumieniu, na ³±czeniu si³ i ¶rodków. Dlatego szukam ludzi, którzy"
-	code = Detect_language(testText)
-	assert.Equal(t, "pl", code)
-	name, found = KnownLanguages[code]
-	assert.Equal(t, true, found)
-	assert.Equal(t, "Polish", name)
+	// prepare request
+	reader := strings.NewReader(`{"request": [
+		{"text": "Сегодня мы расскажем о том, как работает блокчейн и почему он так важен для всех нас."},
+		{"text": "Сегодня мы расскажем о том, как работает блокчейн и почему он так важен для всех нас.", "display_locale": "ru"},
+		{"text": "para poner este importante proyecto en práctica", "display_locale": "de-AT"},
+		{"text": "para poner este importante proyecto en práctica", "display_locale": "klingon"},
+		{"text": "para poner este importante proyecto en práctica", "display_locale": 7}
+	]}`)
 
-	testText = "sagt Hühsam das war bei Über eine Annonce in einem Frankfurter der Töpfer ein. Anhand von gefundenen gut kennt, hatte ihm die wahren Tatsachen Sechzehn Adorno-Schüler erinnern und daß ein Weiterdenken der Theorie für ihre Festlegung sind drei Jahre Erschütterung Einblick in die Abhängigkeit(der Bauarbeiten sei"
-	code = Detect_language(testText)
-	assert.Equal(t, "de", code)
-	name, found = KnownLanguages[code]
-	assert.Equal(t, true, found)
-	assert.Equal(t, "German", name)
+	// perform request
+	resp, err := http.Post(serverUrl, "application/json", reader)
+	assert.Nil(t, err, "request should not error")
+	defer resp.Body.Close()
 
-	testText = "esôzéseket egy kissé túlméretezte, ebbôl kifolyólag a Földet egy hatalmas árvíz mosta el"
-	code = Detect_language(testText)
-	assert.Equal(t, "hu", code)
-	name, found = KnownLanguages[code]
-	assert.Equal(t, true, found)
-	assert.Equal(t, "Hungarian", name)
+	// read response
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err, "should not error reading response")
+	assert.Equal(t, 200, resp.StatusCode, "response status code should be 200")
 
-	testText = "koulun arkistoihin pölyttymään, vaan nuoret saavat itse vaikuttaa ajatustensa eteenpäinviemiseen esimerkiksi"
-	code = Detect_language(testText)
-	assert.Equal(t, "fi", code)
-	name, found = KnownLanguages[code]
-	assert.Equal(t, true, found)
-	assert.Equal(t, "Finnish", name)
+	var responses detectionResponses
+	assert.Nil(t, json.Unmarshal(body, &responses), "response should be valid JSON")
+	assert.Equal(t, 5, len(responses.Response), "response should contain five results")
+	assert.Equal(t, "Russian", responses.Response[0].Name)
+	assert.Equal(t, "", responses.Response[0].DisplayName, "display name should only be added on request")
+	assert.Equal(t, "", responses.Response[0].NativeName, "native name should only be added on request")
+	assert.Equal(t, "Russian", responses.Response[1].Name)
+	assert.Equal(t, "Русский", responses.Response[1].DisplayName)
+	assert.Equal(t, "Русский", responses.Response[1].NativeName)
+	assert.Equal(t, "Русский", responses.Response[1].Languages[0].DisplayName)
+	assert.Equal(t, "Spanish", responses.Response[2].Name)
+	assert.Equal(t, "Spanisch", responses.Response[2].DisplayName)
+	assert.Equal(t, "Español", responses.Response[2].NativeName)
+	assert.Equal(t, STATUS_INVALID_REQUEST, responses.Response[3].Status)
+	assert.Equal(t, "Unknown display locale: klingon", responses.Response[3].Error)
+	assert.Equal(t, "display_locale must be a string", responses.Response[4].Error)
+}
 
-	testText = "tegen de kabinetsplannen. Een speciaal in het leven geroepen Landelijk"
-	code = Detect_language(testText)
-	assert.Equal(t, "nl", code)
-	name, found = KnownLanguages[code]
-	assert.Equal(t, true, found)
-	assert.Equal(t, "Dutch", name)
+func TestLanguageCodes(t *testing.T) {
+	fmt.Println(">> Testing POST for ISO 639, BCP 47 and script codes...")
 
-	testText = "viksomhed, 58 pct. har et arbejde eller er under uddannelse, 76 pct. forsørges ikke længere af Kolding"
-	code = Detect_language(testText)
-	assert.Equal(t, "da", code)
-	name, found = KnownLanguages[code]
-	assert.Equal(t, true, found)
-	assert.Equal(t, "Danish", name)
+	// prepare request
+	reader := strings.NewReader(`{"request": [
+		{"text": "Сегодня мы расскажем о том, как работает блокчейн и почему он так важен для всех нас."},
+		{"text": "serait(désigné peu après PDG d'Antenne 2 et de FR 3. Pas même lui ! Le"},
+		{"text": "Србија је држава у југоисточној Европи, на централном делу Балканског полуострва. Главни град Србије је Београд."},
+		{"text": "12345 !!!"}
+	]}`)
 
-	testText = "datují rokem 1862.  Naprosto zakázán byl v pocitech smutku, beznadìje èi jiné"
-	code = Detect_language(testText)
-	assert.Equal(t, "cs", code)
-	name, found = KnownLanguages[code]
-	assert.Equal(t, true, found)
-	assert.Equal(t, "Czech", name)
+	// perform request
+	resp, err := http.Post(serverUrl, "application/json", reader)
+	assert.Nil(t, err, "request should not error")
+	defer resp.Body.Close()
 
-	testText = "hovedstaden Nanjings fall i desember ble byens innbyggere utsatt for et seks"
-	code = Detect_language(testText)
-	assert.Equal(t, "no", code)
-	name, found = KnownLanguages[code]
-	assert.Equal(t, true, found)
-	assert.Equal(t, "Norwegian", name)
+	// read response
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err, "should not error reading response")
+	assert.Equal(t, 200, resp.StatusCode, "response status code should be 200")
 
-	testText = "popular. Segundo o seu biógrafo, a Maria Adelaide auxiliava muita gente"
-	code = Detect_language(testText)
-	assert.Equal(t, "pt", code)
-	name, found = KnownLanguages[code]
-	assert.Equal(t, true, found)
-	assert.Equal(t, "Portuguese", name)
+	var responses detectionResponses
+	assert.Nil(t, json.Unmarshal(body, &responses), "response should be valid JSON")
+	assert.Equal(t, 4, len(responses.Response), "response should contain four results")
+	assert.Equal(t, "rus", responses.Response[0].ISO6392)
+	assert.Equal(t, "rus", responses.Response[0].ISO6393)
+	assert.Equal(t, "ru", responses.Response[0].BCP47)
+	assert.Equal(t, "Cyrl", responses.Response[0].Script)
+	assert.Equal(t, "Cyrl", responses.Response[0].Languages[0].Script)
+	assert.Equal(t, "fre", responses.Response[1].ISO6392)
+	assert.Equal(t, "fra", responses.Response[1].ISO6393)
+	assert.Equal(t, "fr", responses.Response[1].BCP47)
+	assert.Equal(t, "Latn", responses.Response[1].Script)
 
-	testText = "TaffyDB finders looking nice so far! Testing this long sentence."
-	code = Detect_language(testText)
-	assert.Equal(t, "en", code)
-	name, found = KnownLanguages[code]
-	assert.Equal(t, true, found)
-	assert.Equal(t, "English", name)
+	// Serbian is mostly recognized in Latin script, so Cyrillic gets a script subtag
+	assert.Equal(t, "sr", responses.Response[2].Iso6391Code)
+	assert.Equal(t, "srp", responses.Response[2].ISO6393)
+	assert.Equal(t, "sr-Cyrl", responses.Response[2].BCP47)
+	assert.Equal(t, "Cyrl", responses.Response[2].Script)
 
-	testText = "Och så ska vi prova lite svenska, som också borde fungera utan problem."
-	code = Detect_language(testText)
-	assert.Equal(t, "sv", code)
-	name, found = KnownLanguages[code]
-	assert.Equal(t, true, found)
-	assert.Equal(t, "Swedish", name)
+	assert.Equal(t, "und", responses.Response[3].ISO6392)
+	assert.Equal(t, "und", responses.Response[3].ISO6393)
+	assert.Equal(t, "und", responses.Response[3].BCP47)
+	assert.Equal(t, "Zzzz", responses.Response[3].Script)
+}
+
+func TestUnreliableInput(t *testing.T) {
+	fmt.Println(">> Testing POST with too little text to be reliable...")
+
+	// prepare request
+	reader := strings.NewReader(`{"request": [{"text": "ok"}]}`)
+
+	// perform request
+	resp, err := http.Post(serverUrl, "application/json", reader)
+	assert.Nil(t, err, "request should not error")
+	defer resp.Body.Close()
+
+	// read response
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err, "should not error reading response")
+
+	var responses detectionResponses
+	assert.Nil(t, json.Unmarshal(body, &responses), "response should be valid JSON")
+	assert.Equal(t, 1, len(responses.Response), "response should contain one result")
+	assert.False(t, responses.Response[0].Reliable, "detection should not be reliable")
+	assert.True(t, responses.Response[0].TextBytes < 10, "text_bytes should be small")
+}
+
+func TestShortTexts(t *testing.T) {
+	fmt.Println(">> Testing POST with minimum text lengths...")
+
+	// A server default that every text falls below
+	languageDetector.MinLetters = 1000
+	defer func() { languageDetector.MinLetters = 0 }()
+
+	// prepare request
+	reader := strings.NewReader(`{"request": [
+		{"text": "Сегодня мы расскажем о том, как работает блокчейн."},
+		{"text": "Сегодня мы расскажем о том, как работает блокчейн.", "min_letters": 0},
+		{"text": "Сегодня мы расскажем о том, как работает блокчейн.", "min_letters": 10, "min_text_bytes": 1000},
+		{"text": "Сегодня мы расскажем о том, как работает блокчейн.", "min_letters": -1},
+		{"text": "Сегодня мы расскажем о том, как работает блокчейн.", "min_text_bytes": "10"}
+	]}`)
+
+	// perform request
+	resp, err := http.Post(serverUrl, "application/json", reader)
+	assert.Nil(t, err, "request should not error")
+	defer resp.Body.Close()
+
+	// read response
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err, "should not error reading response")
+	assert.Equal(t, 200, resp.StatusCode, "response status code should be 200")
+
+	var responses detectionResponses
+	assert.Nil(t, json.Unmarshal(body, &responses), "response should be valid JSON")
+	assert.Equal(t, 5, len(responses.Response), "response should contain five results")
+	assert.Equal(t, STATUS_TOO_SHORT, responses.Response[0].Status)
+	assert.Equal(t, "und", responses.Response[0].Iso6391Code)
+	assert.Equal(t, "Undetermined", responses.Response[0].Name)
+	assert.False(t, responses.Response[0].Reliable, "undetermined text should not be reliable")
+	assert.Equal(t, 0, len(responses.Response[0].Languages), "undetermined text should have no languages")
+	assert.Equal(t, STATUS_OK, responses.Response[1].Status)
+	assert.Equal(t, "ru", responses.Response[1].Iso6391Code)
+	assert.Equal(t, STATUS_TOO_SHORT, responses.Response[2].Status)
+	assert.Equal(t, "min_letters must be a non-negative integer", responses.Response[3].Error)
+	assert.Equal(t, "min_text_bytes must be a non-negative integer", responses.Response[4].Error)
+}
+
+func TestAllowedLanguages(t *testing.T) {
+	fmt.Println(">> Testing POST with allowed languages...")
+
+	// A server default that only allows German
+	languageDetector.AllowedLanguages = []string{"de"}
+	defer func() { languageDetector.AllowedLanguages = nil }()
+
+	// prepare request
+	text := "Сегодня мы расскажем о том, как работает блокчейн и почему он так важен для всех нас. Today we will talk about the blockchain."
+	request, _ := json.Marshal(map[string]interface{}{
+		"request": []map[string]interface{}{
+			{"text": text},
+			{"text": text, "allowed_languages": []string{}},
+			{"text": text, "allowed_languages": []string{"en", "uk", "be"}},
+			{"text": text, "allowed_languages": []string{"en"}, "min_allowed_percent": 90},
+			{"text": text, "allowed_languages": []string{"klingon"}},
+			{"text": text, "allowed_languages": "en"},
+		},
+	})
+
+	// perform request
+	resp, err := http.Post(serverUrl, "application/json", strings.NewReader(string(request)))
+	assert.Nil(t, err, "request should not error")
+	defer resp.Body.Close()
+
+	// read response
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err, "should not error reading response")
+	assert.Equal(t, 200, resp.StatusCode, "response status code should be 200")
+
+	var responses detectionResponses
+	assert.Nil(t, json.Unmarshal(body, &responses), "response should be valid JSON")
+	assert.Equal(t, 6, len(responses.Response), "response should contain six results")
+	assert.Equal(t, STATUS_UNKNOWN_LANGUAGE, responses.Response[0].Status)
+	assert.Equal(t, "und", responses.Response[0].Iso6391Code)
+	assert.Equal(t, "Undetermined", responses.Response[0].Name)
+	assert.Equal(t, "ru", responses.Response[1].Iso6391Code)
+	assert.Equal(t, "en", responses.Response[2].Iso6391Code)
+	assert.Equal(t, "English", responses.Response[2].Name)
+	assert.False(t, responses.Response[2].Reliable, "replaced language should not be reliable")
+	assert.Equal(t, "und", responses.Response[3].Iso6391Code)
+	assert.Equal(t, "Unknown allowed language: klingon", responses.Response[4].Error)
+	assert.Equal(t, "allowed_languages must be an array of strings", responses.Response[5].Error)
+}
+
+func TestMixedLanguages(t *testing.T) {
+	fmt.Println(">> Testing POST with mixed language input...")
+
+	// prepare request
+	reader := strings.NewReader(`{"request": [{"text": "Сегодня мы расскажем о том, как работает блокчейн и почему он так важен для всех нас. Today we will talk about how the blockchain works and why it matters so much for all of us."}]}`)
+
+	// perform request
+	resp, err := http.Post(serverUrl, "application/json", reader)
+	assert.Nil(t, err, "request should not error")
+	defer resp.Body.Close()
+
+	// read response
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err, "should not error reading response")
+	assert.Equal(t, 200, resp.StatusCode, "response status code should be 200")
+
+	var responses detectionResponses
+	assert.Nil(t, json.Unmarshal(body, &responses), "response should be valid JSON")
+	assert.Equal(t, 1, len(responses.Response), "response should contain one result")
+	codes := []string{}
+	for _, language := range responses.Response[0].Languages {
+		codes = append(codes, language.Iso6391Code)
+	}
+	assert.Contains(t, codes, "ru", "Russian should be among the detected languages")
+	assert.Contains(t, codes, "en", "English should be among the detected languages")
+}
+
+func TestDetectionHints(t *testing.T) {
+	fmt.Println(">> Testing POST with detection hints...")
+
+	// prepare request
+	reader := strings.NewReader(`{"request": [
+		{"text": "para poner este importante proyecto en práctica", "tld_hint": "es", "content_language_hint": "es,en", "language_hint": "es", "encoding_hint": "utf-8"},
+		{"text": "para poner este importante proyecto en práctica", "tld_hint": "spain"},
+		{"text": "para poner este importante proyecto en práctica", "content_language_hint": "es,qq-bogus"},
+		{"text": "para poner este importante proyecto en práctica", "language_hint": "klingon"},
+		{"text": "para poner este importante proyecto en práctica", "encoding_hint": "ebcdic"},
+		{"text": "para poner este importante proyecto en práctica", "language_hint": 5}
+	]}`)
+
+	// perform request
+	resp, err := http.Post(serverUrl, "application/json", reader)
+	assert.Nil(t, err, "request should not error")
+	defer resp.Body.Close()
+
+	// read response
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err, "should not error reading response")
+	assert.Equal(t, 200, resp.StatusCode, "response status code should be 200")
+
+	var responses detectionResponses
+	assert.Nil(t, json.Unmarshal(body, &responses), "response should be valid JSON")
+	assert.Equal(t, 6, len(responses.Response), "response should contain six results")
+	assert.Equal(t, "es", responses.Response[0].Iso6391Code)
+	assert.Equal(t, "", responses.Response[0].Error)
+	assert.Equal(t, "Invalid tld_hint: spain", responses.Response[1].Error)
+	assert.Equal(t, "Unknown content_language_hint tag: qq-bogus", responses.Response[2].Error)
+	assert.Equal(t, "Unknown language_hint: klingon", responses.Response[3].Error)
+	assert.Equal(t, "Unknown encoding_hint: ebcdic", responses.Response[4].Error)
+	assert.Equal(t, "language_hint must be a string", responses.Response[5].Error)
+}
+
+func TestEngines(t *testing.T) {
+	fmt.Println(">> Testing POST with detection engines...")
+
+	// prepare request
+	reader := strings.NewReader(`{"request": [
+		{"text": "Сегодня мы расскажем о том, как работает блокчейн и почему он так важен для всех нас."},
+		{"text": "Сегодня мы расскажем о том, как работает блокчейн и почему он так важен для всех нас.", "engine": "cld2"},
+		{"text": "Сегодня мы расскажем о том, как работает блокчейн и почему он так важен для всех нас.", "engine": "ngram"},
+		{"text": "Сегодня мы расскажем о том, как работает блокчейн и почему он так важен для всех нас.", "engine": "ensemble"},
+		{"text": "Сегодня мы расскажем о том, как работает блокчейн и почему он так важен для всех нас.", "engine": "klingon"},
+		{"text": "Сегодня мы расскажем о том, как работает блокчейн и почему он так важен для всех нас.", "engine": 5}
+	]}`)
+
+	// perform request
+	resp, err := http.Post(serverUrl, "application/json", reader)
+	assert.Nil(t, err, "request should not error")
+	defer resp.Body.Close()
+
+	// read response
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err, "should not error reading response")
+	assert.Equal(t, 200, resp.StatusCode, "response status code should be 200")
+
+	var responses detectionResponses
+	assert.Nil(t, json.Unmarshal(body, &responses), "response should be valid JSON")
+	assert.Equal(t, 6, len(responses.Response), "response should contain six results")
+	for i := 0; i < 4; i++ {
+		assert.Equal(t, STATUS_OK, responses.Response[i].Status)
+		assert.Equal(t, "ru", responses.Response[i].Iso6391Code)
+		assert.Equal(t, "Cyrl", responses.Response[i].Script)
+	}
+	assert.Equal(t, 0, len(responses.Response[0].Debug.Votes), "only the ensemble engine should vote")
+
+	// The ensemble reports what each of its engines found
+	votes := responses.Response[3].Debug.Votes
+	assert.Equal(t, 2, len(votes), "ensemble should hold a vote per engine")
+	for i, engineName := range []string{"cld2", "ngram"} {
+		assert.Equal(t, engineName, votes[i].Engine)
+		assert.Equal(t, 1.0, votes[i].Weight)
+		assert.Equal(t, "ru", votes[i].Iso6391Code)
+		assert.Equal(t, "ru", votes[i].Languages[0].Iso6391Code)
+	}
+	assert.True(t, responses.Response[3].Reliable, "ensemble should be reliable when its engines agree")
+
+	assert.Equal(t, STATUS_INVALID_REQUEST, responses.Response[4].Status)
+	assert.Equal(t, "Unknown engine: klingon", responses.Response[4].Error)
+	assert.Equal(t, "engine must be a string", responses.Response[5].Error)
+}
+
+func TestEnsembleWeights(t *testing.T) {
+	fmt.Println(">> Testing ensemble weights parsing...")
+
+	ensemble, err := NewEnsembleEngine("cld2:2, ngram")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]float64{"cld2": 2, "ngram": 1}, ensemble.Weights())
+	assert.Equal(t, "ensemble", ensemble.Name())
+
+	for spec, message := range map[string]string{
+		"cld2:heavy":       "Invalid weight for engine cld2: heavy",
+		"cld2:2,klingon:1": "Unknown engine: klingon",
+		"cld2,cld2:2":      "Duplicate engine: cld2",
+		"cld2:0":           "Invalid weight for engine cld2: 0",
+		"ngram,ensemble":   "Ensemble cannot include engine ensemble",
+	} {
+		_, err = NewEnsembleEngine(spec)
+		if assert.NotNil(t, err, spec) {
+			assert.Equal(t, message, err.Error())
+		}
+	}
+}
+
+func TestInvalidUTF8(t *testing.T) {
+	fmt.Println(">> Testing POST with invalid UTF-8 text...")
+
+	// prepare request, with cp1251 encoded text in the second item
+	reader := strings.NewReader("{\"request\": [{\"text\": \"Сегодня мы расскажем о том, как работает блокчейн.\"}, {\"text\": \"Golos: \xd1\xe5\xe3\xee\xe4\xed\xff\"}]}")
+
+	// perform request
+	resp, err := http.Post(serverUrl, "application/json", reader)
+	assert.Nil(t, err, "request should not error")
+	defer resp.Body.Close()
+
+	// read response
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err, "should not error reading response")
+	assert.Equal(t, 200, resp.StatusCode, "response status code should be 200")
+
+	var responses detectionResponses
+	assert.Nil(t, json.Unmarshal(body, &responses), "response should be valid JSON")
+	assert.Equal(t, 2, len(responses.Response), "response should contain two results")
+	assert.Equal(t, "ru", responses.Response[0].Iso6391Code)
+	assert.Equal(t, "", responses.Response[0].Error)
+	assert.Equal(t, "Invalid UTF-8 text at byte 7", responses.Response[1].Error)
+	assert.Equal(t, 7, responses.Response[1].ValidPrefix)
+	assert.Equal(t, "", responses.Response[1].Iso6391Code)
+}
+
+func TestEncodings(t *testing.T) {
+	fmt.Println(">> Testing POST with legacy encodings...")
+
+	// prepare request, with the same cp1251 encoded text declared, guessed and undeclared
+	cp1251 := "\xd1\xe5\xe3\xee\xe4\xed\xff \xec\xfb \xf0\xe0\xf1\xf1\xea\xe0\xe6\xe5\xec \xee \xf2\xee\xec, \xea\xe0\xea \xf0\xe0\xe1\xee\xf2\xe0\xe5\xf2 \xe1\xeb\xee\xea\xf7\xe5\xe9\xed."
+	reader := strings.NewReader(`{"request": [
+		{"text": "` + cp1251 + `", "encoding": "windows-1251"},
+		{"text": "` + cp1251 + `", "encoding": "auto"},
+		{"text": "` + cp1251 + `"},
+		{"text": "` + cp1251 + `", "encoding": "ebcdic"}
+	]}`)
+
+	// perform request
+	resp, err := http.Post(serverUrl, "application/json", reader)
+	assert.Nil(t, err, "request should not error")
+	defer resp.Body.Close()
+
+	// read response
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err, "should not error reading response")
+	assert.Equal(t, 200, resp.StatusCode, "response status code should be 200")
+
+	var responses detectionResponses
+	assert.Nil(t, json.Unmarshal(body, &responses), "response should be valid JSON")
+	assert.Equal(t, 4, len(responses.Response), "response should contain four results")
+	assert.Equal(t, "ru", responses.Response[0].Iso6391Code)
+	assert.Equal(t, "windows-1251", responses.Response[0].Encoding)
+	assert.Equal(t, "ru", responses.Response[1].Iso6391Code)
+	assert.Equal(t, "windows-1251", responses.Response[1].Encoding)
+	assert.Equal(t, "Invalid UTF-8 text at byte 0", responses.Response[2].Error)
+	assert.Equal(t, "Unknown encoding: ebcdic", responses.Response[3].Error)
+}
+
+func TestSegments(t *testing.T) {
+	fmt.Println(">> Testing POST with segments requested...")
+
+	russian := "Сегодня мы расскажем о том, как работает блокчейн и почему он так важен для всех нас. "
+	english := "@golos Today we will talk about how the blockchain works and why it matters so much for all of us."
+	text := russian + english
+
+	// prepare request
+	request, _ := json.Marshal(map[string]interface{}{
+		"request": []map[string]interface{}{{"text": text, "segments": true}, {"text": text}},
+	})
+
+	// perform request
+	resp, err := http.Post(serverUrl, "application/json", strings.NewReader(string(request)))
+	assert.Nil(t, err, "request should not error")
+	defer resp.Body.Close()
+
+	// read response
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err, "should not error reading response")
+	assert.Equal(t, 200, resp.StatusCode, "response status code should be 200")
+
+	var responses detectionResponses
+	assert.Nil(t, json.Unmarshal(body, &responses), "response should be valid JSON")
+	assert.Equal(t, 2, len(responses.Response), "response should contain two results")
+	assert.Equal(t, 0, len(responses.Response[1].Segments), "segments should only be returned when requested")
+
+	// Segments should cover the original text, mention included, without gaps
+	segments := responses.Response[0].Segments
+	assert.True(t, len(segments) >= 2, "response should contain at least two segments")
+	end := 0
+	codes := map[string]string{}
+	for _, segment := range segments {
+		assert.Equal(t, end, segment.Offset, "segments should be contiguous")
+		end = segment.Offset + segment.Length
+		codes[segment.Iso6391Code] = text[segment.Offset:end]
+	}
+	assert.Equal(t, len(text), end, "segments should cover the whole text")
+	assert.Contains(t, codes["ru"], "блокчейн", "Russian segment should contain the Russian text")
+	assert.Contains(t, codes["en"], "blockchain", "English segment should contain the English text")
+}
+
+func TestPreprocessRequests(t *testing.T) {
+	fmt.Println(">> Testing POST with preprocessing selected...")
+
+	// prepare request
+	code := "```\nfunction main() { return console.log(\"this is some code in the post\"); }\n```\n"
+	text := code + "Сегодня мы расскажем о том, как работает блокчейн. ![](https://golos.io/image.png)"
+	request, _ := json.Marshal(map[string]interface{}{
+		"request": []map[string]interface{}{
+			{"text": text},
+			{"text": text, "preprocess": []string{"code", "markdown"}},
+			{"text": text, "preprocess": []string{"code", "bogus"}},
+			{"text": text, "preprocess": "code"},
+		},
+	})
+
+	// perform request
+	resp, err := http.Post(serverUrl, "application/json", strings.NewReader(string(request)))
+	assert.Nil(t, err, "request should not error")
+	defer resp.Body.Close()
+
+	// read response
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err, "should not error reading response")
+	assert.Equal(t, 200, resp.StatusCode, "response status code should be 200")
+
+	var responses detectionResponses
+	assert.Nil(t, json.Unmarshal(body, &responses), "response should be valid JSON")
+	assert.Equal(t, 4, len(responses.Response), "response should contain four results")
+	assert.Equal(t, "ru", responses.Response[0].Iso6391Code)
+	assert.Equal(t, "ru", responses.Response[1].Iso6391Code)
+	assert.Equal(t, "Unknown preprocess filter: bogus", responses.Response[2].Error)
+	assert.Equal(t, "preprocess must be an array of strings", responses.Response[3].Error)
+}
+
+func TestHtmlInput(t *testing.T) {
+	fmt.Println(">> Testing POST with HTML input...")
+
+	french, err := ioutil.ReadFile("cld2/docs/a_little_french_test_input.html")
+	assert.Nil(t, err, "should not error reading fixture")
+	version, err := ioutil.ReadFile("cld2/docs/test_version.html")
+	assert.Nil(t, err, "should not error reading fixture")
+	markup := `<div class="container main-content wrapper"><p style="color: red; font-weight: bold">para poner este importante proyecto en práctica</p></div>`
+
+	// prepare request
+	request, _ := json.Marshal(map[string]interface{}{
+		"request": []map[string]interface{}{
+			{"text": string(french), "is_html": true},
+			{"text": `<html lang="fr"><body>` + string(french) + `</body></html>`, "is_html": true},
+			{"text": `<html><head><meta http-equiv="content-language" content="fr"></head><body>` + string(french) + `</body></html>`, "is_html": true},
+			{"text": markup, "is_html": true},
+			{"text": string(version), "is_html": true},
+			{"text": string(version)},
+		},
+	})
+
+	// perform request
+	resp, err := http.Post(serverUrl, "application/json", strings.NewReader(string(request)))
+	assert.Nil(t, err, "request should not error")
+	defer resp.Body.Close()
+
+	// read response
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err, "should not error reading response")
+
+	var responses detectionResponses
+	assert.Nil(t, json.Unmarshal(body, &responses), "response should be valid JSON")
+	assert.Equal(t, 6, len(responses.Response), "response should contain six results")
+	assert.Equal(t, "fr", responses.Response[0].Iso6391Code)
+	assert.Equal(t, "fr", responses.Response[1].Iso6391Code)
+	assert.Equal(t, "fr", responses.Response[2].Iso6391Code)
+	assert.Equal(t, "es", responses.Response[3].Iso6391Code)
+
+	// Markup is not scored in HTML mode
+	assert.True(t, responses.Response[4].TextBytes < responses.Response[5].TextBytes, "HTML mode should skip tags")
+}
+
+func TestStream(t *testing.T) {
+	fmt.Println(">> Testing POST /stream...")
+
+	// prepare request, one object per line
+	reader := strings.NewReader(`{"id": "post-1", "text": "para poner este importante proyecto en práctica"}
+
+{"id": 2, "text": "Сегодня мы расскажем о том, как работает блокчейн и почему он так важен для всех нас."}
+{"id": 3, "text": "para poner este importante proyecto en práctica"
+{"id": 4, "bad_text": "This is an invalid input test."}
+{"id": {"post": 5}, "text": "para poner este importante proyecto en práctica"}
+["not", "an", "object"]
+{"text": "para poner este importante proyecto en práctica"}`)
+
+	// perform request
+	resp, err := http.Post(serverUrl+"stream", "application/x-ndjson", reader)
+	assert.Nil(t, err, "request should not error")
+	defer resp.Body.Close()
+
+	// read response
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err, "should not error reading response")
+	assert.Equal(t, 200, resp.StatusCode, "response status code should be 200")
+	assert.Equal(t, "application/x-ndjson; charset=utf-8", resp.Header.Get("Content-Type"))
+
+	lines := strings.Split(strings.TrimSuffix(string(body), "\n"), "\n")
+	assert.Equal(t, 7, len(lines), "response should contain a line per request line")
+	responses := make([]struct {
+		Id          interface{} `json:"id"`
+		Iso6391Code string      `json:"iso6391code"`
+		Error       string      `json:"error"`
+	}, len(lines))
+	for i, line := range lines {
+		assert.Nil(t, json.Unmarshal([]byte(line), &responses[i]), "response line should be valid JSON")
+	}
+	assert.Equal(t, "post-1", responses[0].Id)
+	assert.Equal(t, "es", responses[0].Iso6391Code)
+	assert.Equal(t, float64(2), responses[1].Id)
+	assert.Equal(t, "ru", responses[1].Iso6391Code)
+	assert.Equal(t, "Unable to parse line - invalid JSON object detected", responses[2].Error)
+	assert.Equal(t, float64(4), responses[3].Id)
+	assert.Equal(t, "Missing text key", responses[3].Error)
+	assert.Equal(t, "id must be a string or a number", responses[4].Error)
+	assert.Equal(t, "Unable to parse line - invalid JSON object detected", responses[5].Error)
+	assert.Nil(t, responses[6].Id)
+	assert.Equal(t, "es", responses[6].Iso6391Code)
+
+	// Streams must be sent as NDJSON
+	resp, err = http.Post(serverUrl+"stream", "application/json", strings.NewReader(`{"text": "hello"}`))
+	assert.Nil(t, err, "request should not error")
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode, "response status code should be 400")
+}
+
+func TestStreamLargeBody(t *testing.T) {
+	fmt.Println(">> Testing POST /stream with a body larger than its read buffer...")
+
+	// prepare request, well over the 4 KB read buffer but under net/http's 256 KB of
+	// body it reads on its own once the response is flushed
+	var body bytes.Buffer
+	for i := 0; i < 200; i++ {
+		fmt.Fprintf(&body, `{"id": %d, "text": "para poner este importante proyecto en práctica"}`+"\n", i)
+	}
+	assert.True(t, body.Len() > 4096)
+
+	// perform request
+	resp, err := http.Post(serverUrl+"stream", "application/x-ndjson", &body)
+	assert.Nil(t, err, "request should not error")
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode, "response status code should be 200")
+
+	// every line should be answered, in order
+	scanner := bufio.NewScanner(resp.Body)
+	count := 0
+	for scanner.Scan() {
+		var response struct {
+			Id          int    `json:"id"`
+			Iso6391Code string `json:"iso6391code"`
+			Error       string `json:"error"`
+		}
+		assert.Nil(t, json.Unmarshal(scanner.Bytes(), &response), "response line should be valid JSON")
+		assert.Equal(t, "", response.Error)
+		assert.Equal(t, count, response.Id)
+		assert.Equal(t, "es", response.Iso6391Code)
+		count++
+	}
+	assert.Nil(t, scanner.Err(), "should not error reading response")
+	assert.Equal(t, 200, count, "response should contain a line per request line")
+}
+
+func TestStreamReadError(t *testing.T) {
+	fmt.Println(">> Testing POST /stream with a failing body...")
+
+	// Bodies failing partway get a last line with the error
+	reader := io.MultiReader(strings.NewReader(`{"id": 1, "text": "para poner este importante proyecto en práctica"}`+"\n"), iotest.ErrReader(errors.New("connection reset")))
+	request := httptest.NewRequest("POST", "/stream", reader)
+	request.Header.Set("Content-Type", "application/x-ndjson")
+	recorder := httptest.NewRecorder()
+	StreamHandler(recorder, request)
+
+	lines := strings.Split(strings.TrimSuffix(recorder.Body.String(), "\n"), "\n")
+	assert.Equal(t, 2, len(lines), "response should end with the error")
+	assert.Contains(t, lines[0], `"iso6391code":"es"`)
+	assert.Contains(t, lines[1], "Error reading request body: connection reset")
+}
+
+func TestReadLine(t *testing.T) {
+	fmt.Println(">> Testing ReadLine...")
+
+	reader := bufio.NewReaderSize(strings.NewReader("short\r\n"+strings.Repeat("x", 100)+"\nend"), 16)
+	line, err := ReadLine(reader, 50)
+	assert.Nil(t, err, "short line should not error")
+	assert.Equal(t, "short", string(line))
+	_, err = ReadLine(reader, 50)
+	assert.Equal(t, ErrLineTooLong, err)
+	line, err = ReadLine(reader, 50)
+	assert.Nil(t, err, "last line should not error")
+	assert.Equal(t, "end", string(line))
+	_, err = ReadLine(reader, 50)
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestLanguageDetection(t *testing.T) {
+	fmt.Println("Testing language detection accuracy")
+	// Tests ported from node langugage-detector code, run against every engine
+
+	for _, test := range []struct {
+		text string
+		code string
+		name string
+	}{
+		{"para poner este importante proyecto en práctica", "es", "Spanish"},
+		{"this is a test of the Emergency text categorizing system.", "en", "English"},
+		{"serait(désigné peu après PDG d'Antenne 2 et de FR 3. Pas même lui ! Le", "fr", "French"},
+		{"studio dell'uomo interiore? La scienza del cuore umano, che", "it", "Italian"},
+		{"taiate pe din doua, in care vezi stralucind brun  sau violet cristalele interioare", "ro", "Romanian"},
+		{"na porozumieniu, na ³±czeniu si³ i ¶rodków. Dlatego szukam ludzi, którzy", "pl", "Polish"},
+		{"sagt Hühsam das war bei Über eine Annonce in einem Frankfurter der Töpfer ein. Anhand von gefundenen gut kennt, hatte ihm die wahren Tatsachen Sechzehn Adorno-Schüler erinnern und daß ein Weiterdenken der Theorie für ihre Festlegung sind drei Jahre Erschütterung Einblick in die Abhängigkeit(der Bauarbeiten sei", "de", "German"},
+		{"esôzéseket egy kissé túlméretezte, ebbôl kifolyólag a Földet egy hatalmas árvíz mosta el", "hu", "Hungarian"},
+		{"koulun arkistoihin pölyttymään, vaan nuoret saavat itse vaikuttaa ajatustensa eteenpäinviemiseen esimerkiksi", "fi", "Finnish"},
+		{"tegen de kabinetsplannen. Een speciaal in het leven geroepen Landelijk", "nl", "Dutch"},
+		{"viksomhed, 58 pct. har et arbejde eller er under uddannelse, 76 pct. forsørges ikke længere af Kolding", "da", "Danish"},
+		{"datují rokem 1862.  Naprosto zakázán byl v pocitech smutku, beznadìje èi jiné", "cs", "Czech"},
+		{"hovedstaden Nanjings fall i desember ble byens innbyggere utsatt for et seks", "no", "Norwegian"},
+		{"popular. Segundo o seu biógrafo, a Maria Adelaide auxiliava muita gente", "pt", "Portuguese"},
+		{"TaffyDB finders looking nice so far! Testing this long sentence.", "en", "English"},
+		{"Och så ska vi prova lite svenska, som också borde fungera utan problem.", "sv", "Swedish"},
+	} {
+		for _, engineName := range detector.EngineNames() {
+			engine, err := detector.GetEngine(engineName)
+			assert.Nil(t, err)
+			code := detectCodeWith(engine, test.text)
+			assert.Equal(t, test.code, code, "language should match with engine "+engineName)
+			name, found := KnownLanguages[code]
+			assert.Equal(t, true, found)
+			assert.Equal(t, test.name, name)
+		}
+	}
 }
 
 func TestLanguageDetectionMore(t *testing.T) {
 	fmt.Println("Testing language detection accuracy for more languages")
 
 	testText := " 私はガラスを食べられます。それは私を傷つけません。"
-	code := Detect_language(testText)
+	code := detectCode(testText)
 	assert.Equal(t, "ja", code)
 	name, found := KnownLanguages[code]
 	assert.Equal(t, true, found)
 	assert.Equal(t, "Japanese", name)
 
 	testText = "我能吞下玻璃而不伤身体。"
-	code = Detect_language(testText)
+	code = detectCode(testText)
 	assert.Equal(t, "zh", code)
 	name, found = KnownLanguages[code]
 	assert.Equal(t, true, found)
 	assert.Equal(t, "Chinese", name)
 
 	testText = "나는 유리를 먹을 수 있어요. 그래도 아프지 않아요"
-	code = Detect_language(testText)
+	code = detectCode(testText)
 	assert.Equal(t, "ko", code)
 	name, found = KnownLanguages[code]
 	assert.Equal(t, true, found)
 	assert.Equal(t, "Korean", name)
 
 	testText = "أنا قادر على أكل الزجاج و هذا لا يؤلمني. "
-	code = Detect_language(testText)
+	code = detectCode(testText)
 	assert.Equal(t, "ar", code)
 	name, found = KnownLanguages[code]
 	assert.Equal(t, true, found)
 	assert.Equal(t, "Arabic", name)
 
 	testText = "ฉันกินกระจกได้ แต่มันไม่ทำให้ฉันเจ็บ"
-	code = Detect_language(testText)
+	code = detectCode(testText)
 	assert.Equal(t, "th", code)
 	name, found = KnownLanguages[code]
 	assert.Equal(t, true, found)
 	assert.Equal(t, "Thai", name)
 
 	testText = ".من می توانم بدونِ احساس درد شيشه بخورم"
-	code = Detect_language(testText)
+	code = detectCode(testText)
 	assert.Equal(t, "fa", code)
 	name, found = KnownLanguages[code]
 	assert.Equal(t, true, found)
 	assert.Equal(t, "Persian", name)
 }
 
+func TestLanguageDetectionFull(t *testing.T) {
+	if Tables() != detector.TABLES_FULL {
+		t.Skip("CLD2 is not linked with its full tables, run with TABLES=full")
+	}
+	fmt.Println("Testing language detection accuracy for languages of the full tables")
+
+	testText := "Татарстан Республикасы Россия Федерациясе составындагы республика. Аның башкаласы Казан шәһәре. Татар теле һәм рус теле республиканың дәүләт телләре булып тора."
+	code := detectCode(testText)
+	assert.Equal(t, "tt", code)
+	name, found := KnownLanguages[code]
+	assert.Equal(t, true, found)
+	assert.Equal(t, "Tatar", name)
+
+	testText = "Башҡортостан Республикаһы Рәсәй Федерацияһы составындағы республика. Уның баш ҡалаһы Өфө ҡалаһы. Башҡорт теле һәм рус теле республиканың дәүләт телдәре булып тора."
+	code = detectCode(testText)
+	assert.Equal(t, "ba", code)
+	name, found = KnownLanguages[code]
+	assert.Equal(t, true, found)
+	assert.Equal(t, "Bashkir", name)
+
+	testText = "Türkmenistan Merkezi Aziýada ýerleşýän döwletdir. Onuň paýtagty Aşgabat şäheridir. Türkmen dili ýurduň döwlet dili bolup durýar."
+	code = detectCode(testText)
+	assert.Equal(t, "tk", code)
+	name, found = KnownLanguages[code]
+	assert.Equal(t, true, found)
+	assert.Equal(t, "Turkmen", name)
+
+	testText = "ئۇيغۇر تىلى تۈركىي تىللار ئائىلىسىگە مەنسۇپ بولۇپ، ئۇيغۇرلارنىڭ ئانا تىلى ھېسابلىنىدۇ."
+	code = detectCode(testText)
+	assert.Equal(t, "ug", code)
+	name, found = KnownLanguages[code]
+	assert.Equal(t, true, found)
+	assert.Equal(t, "Uighur", name)
+}
+
 func TestStripNames(t *testing.T) {
 	fmt.Println(">> Testing input with twitter handles...")
 
@@ -320,8 +1332,11 @@ func TestStripNames(t *testing.T) {
 	assert.Nil(t, err, "should not error reading response")
 	assert.Equal(t, 200, resp.StatusCode, "response status code should be 200")
 
-	expected := `{"response":[{"iso6391code":"es","name":"Spanish"}]}`
-	assert.Equal(t, []byte(expected), body, "response should match")
+	var responses detectionResponses
+	assert.Nil(t, json.Unmarshal(body, &responses), "response should be valid JSON")
+	assert.Equal(t, 1, len(responses.Response), "response should contain one result")
+	assert.Equal(t, "es", responses.Response[0].Iso6391Code)
+	assert.Equal(t, "Spanish", responses.Response[0].Name)
 }
 
 func TestStripLinks(t *testing.T) {
@@ -340,6 +1355,9 @@ func TestStripLinks(t *testing.T) {
 	assert.Nil(t, err, "should not error reading response")
 	assert.Equal(t, 200, resp.StatusCode, "response status code should be 200")
 
-	expected := `{"response":[{"iso6391code":"ms","name":"Malay"}]}`
-	assert.Equal(t, []byte(expected), body, "response should match")
+	var responses detectionResponses
+	assert.Nil(t, json.Unmarshal(body, &responses), "response should be valid JSON")
+	assert.Equal(t, 1, len(responses.Response), "response should contain one result")
+	assert.Equal(t, "ms", responses.Response[0].Iso6391Code)
+	assert.Equal(t, "Malay", responses.Response[0].Name)
 }
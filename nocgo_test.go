@@ -0,0 +1,25 @@
+//go:build !cgo
+// +build !cgo
+
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert" // Assertion package
+)
+
+func TestParseLanguageTableNoCGO(t *testing.T) {
+	fmt.Println(">> Testing language table overrides without cgo...")
+
+	// Without CLD2, any language code can be listed
+	table, err := ParseLanguageTable([]byte(`{"ru": {"name": "Russian", "iso6393": "rus"}, "tt": {"name": "Tatar"}}`))
+	assert.Nil(t, err, "valid table should not error")
+	assert.Equal(t, map[string]LanguageInfo{"ru": {Name: "Russian", ISO6393: "rus"}, "tt": {Name: "Tatar"}}, table)
+
+	_, err = ParseLanguageTable([]byte(`{"ru": {"name": "Russian"}, "ru": {"name": "Русский"}}`))
+	assert.Equal(t, "Duplicate language code: ru", err.Error())
+	_, err = ParseLanguageTable([]byte(`{"tt": {"iso6393": "tat"}}`))
+	assert.Equal(t, "Missing name for language code tt", err.Error())
+}
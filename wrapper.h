@@ -1,13 +0,0 @@
-#ifndef __WRAPPER_H
-#define __WRAPPER_H
-
-#ifdef __cplusplus
-extern "C" {
-#endif
-
-const char* detect_language(const char *text);
-
-#ifdef __cplusplus
-}
-#endif
-#endif